@@ -0,0 +1,1000 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: faction/v1/faction.proto
+
+package factionv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type JoinRequestStatus int32
+
+const (
+	JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED JoinRequestStatus = 0
+	JoinRequestStatus_JOIN_REQUEST_STATUS_PENDING     JoinRequestStatus = 1
+	JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED    JoinRequestStatus = 2
+	JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED    JoinRequestStatus = 3
+)
+
+// Enum value maps for JoinRequestStatus.
+var (
+	JoinRequestStatus_name = map[int32]string{
+		0: "JOIN_REQUEST_STATUS_UNSPECIFIED",
+		1: "JOIN_REQUEST_STATUS_PENDING",
+		2: "JOIN_REQUEST_STATUS_APPROVED",
+		3: "JOIN_REQUEST_STATUS_REJECTED",
+	}
+	JoinRequestStatus_value = map[string]int32{
+		"JOIN_REQUEST_STATUS_UNSPECIFIED": 0,
+		"JOIN_REQUEST_STATUS_PENDING":     1,
+		"JOIN_REQUEST_STATUS_APPROVED":    2,
+		"JOIN_REQUEST_STATUS_REJECTED":    3,
+	}
+)
+
+func (x JoinRequestStatus) Enum() *JoinRequestStatus {
+	p := new(JoinRequestStatus)
+	*p = x
+	return p
+}
+
+func (x JoinRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JoinRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_faction_v1_faction_proto_enumTypes[0].Descriptor()
+}
+
+func (JoinRequestStatus) Type() protoreflect.EnumType {
+	return &file_faction_v1_faction_proto_enumTypes[0]
+}
+
+func (x JoinRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JoinRequestStatus.Descriptor instead.
+func (JoinRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{0}
+}
+
+type Faction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lowercase slug, e.g. "east"; used as the side on imperial points.
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// How many imperial points the faction may control at once; 0 is no limit.
+	PointLimit    int32                  `protobuf:"varint,4,opt,name=point_limit,json=pointLimit,proto3" json:"point_limit,omitempty"`
+	SettlementIds []string               `protobuf:"bytes,5,rep,name=settlement_ids,json=settlementIds,proto3" json:"settlement_ids,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Faction) Reset() {
+	*x = Faction{}
+	mi := &file_faction_v1_faction_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Faction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Faction) ProtoMessage() {}
+
+func (x *Faction) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Faction.ProtoReflect.Descriptor instead.
+func (*Faction) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Faction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Faction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Faction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Faction) GetPointLimit() int32 {
+	if x != nil {
+		return x.PointLimit
+	}
+	return 0
+}
+
+func (x *Faction) GetSettlementIds() []string {
+	if x != nil {
+		return x.SettlementIds
+	}
+	return nil
+}
+
+func (x *Faction) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type FactionJoinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FactionId     string                 `protobuf:"bytes,2,opt,name=faction_id,json=factionId,proto3" json:"faction_id,omitempty"`
+	SettlementId  string                 `protobuf:"bytes,3,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Status        JoinRequestStatus      `protobuf:"varint,5,opt,name=status,proto3,enum=faction.v1.JoinRequestStatus" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DecidedBy     string                 `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	DecidedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FactionJoinRequest) Reset() {
+	*x = FactionJoinRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactionJoinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactionJoinRequest) ProtoMessage() {}
+
+func (x *FactionJoinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactionJoinRequest.ProtoReflect.Descriptor instead.
+func (*FactionJoinRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FactionJoinRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FactionJoinRequest) GetFactionId() string {
+	if x != nil {
+		return x.FactionId
+	}
+	return ""
+}
+
+func (x *FactionJoinRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *FactionJoinRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *FactionJoinRequest) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *FactionJoinRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FactionJoinRequest) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *FactionJoinRequest) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *FactionJoinRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateFactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PointLimit    int32                  `protobuf:"varint,4,opt,name=point_limit,json=pointLimit,proto3" json:"point_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFactionRequest) Reset() {
+	*x = CreateFactionRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFactionRequest) ProtoMessage() {}
+
+func (x *CreateFactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateFactionRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateFactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateFactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateFactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateFactionRequest) GetPointLimit() int32 {
+	if x != nil {
+		return x.PointLimit
+	}
+	return 0
+}
+
+type UpdateFactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PointLimit    int32                  `protobuf:"varint,4,opt,name=point_limit,json=pointLimit,proto3" json:"point_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFactionRequest) Reset() {
+	*x = UpdateFactionRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFactionRequest) ProtoMessage() {}
+
+func (x *UpdateFactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFactionRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateFactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateFactionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateFactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateFactionRequest) GetPointLimit() int32 {
+	if x != nil {
+		return x.PointLimit
+	}
+	return 0
+}
+
+type GetFactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFactionRequest) Reset() {
+	*x = GetFactionRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFactionRequest) ProtoMessage() {}
+
+func (x *GetFactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFactionRequest.ProtoReflect.Descriptor instead.
+func (*GetFactionRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetFactionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFactionsRequest) Reset() {
+	*x = ListFactionsRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFactionsRequest) ProtoMessage() {}
+
+func (x *ListFactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFactionsRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{5}
+}
+
+type ListFactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Factions      []*Faction             `protobuf:"bytes,1,rep,name=factions,proto3" json:"factions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFactionsResponse) Reset() {
+	*x = ListFactionsResponse{}
+	mi := &file_faction_v1_faction_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFactionsResponse) ProtoMessage() {}
+
+func (x *ListFactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFactionsResponse) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListFactionsResponse) GetFactions() []*Faction {
+	if x != nil {
+		return x.Factions
+	}
+	return nil
+}
+
+type RequestJoinFactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FactionId     string                 `protobuf:"bytes,1,opt,name=faction_id,json=factionId,proto3" json:"faction_id,omitempty"`
+	SettlementId  string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestJoinFactionRequest) Reset() {
+	*x = RequestJoinFactionRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestJoinFactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestJoinFactionRequest) ProtoMessage() {}
+
+func (x *RequestJoinFactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestJoinFactionRequest.ProtoReflect.Descriptor instead.
+func (*RequestJoinFactionRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestJoinFactionRequest) GetFactionId() string {
+	if x != nil {
+		return x.FactionId
+	}
+	return ""
+}
+
+func (x *RequestJoinFactionRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type ListJoinRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters; empty or unspecified matches all.
+	FactionId     string            `protobuf:"bytes,1,opt,name=faction_id,json=factionId,proto3" json:"faction_id,omitempty"`
+	Status        JoinRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=faction.v1.JoinRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsRequest) Reset() {
+	*x = ListJoinRequestsRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsRequest) ProtoMessage() {}
+
+func (x *ListJoinRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListJoinRequestsRequest) GetFactionId() string {
+	if x != nil {
+		return x.FactionId
+	}
+	return ""
+}
+
+func (x *ListJoinRequestsRequest) GetStatus() JoinRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+}
+
+type ListJoinRequestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FactionJoinRequest  `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJoinRequestsResponse) Reset() {
+	*x = ListJoinRequestsResponse{}
+	mi := &file_faction_v1_faction_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJoinRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinRequestsResponse) ProtoMessage() {}
+
+func (x *ListJoinRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListJoinRequestsResponse) GetRequests() []*FactionJoinRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ApproveJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveJoinRequestRequest) Reset() {
+	*x = ApproveJoinRequestRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveJoinRequestRequest) ProtoMessage() {}
+
+func (x *ApproveJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveJoinRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectJoinRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectJoinRequestRequest) Reset() {
+	*x = RejectJoinRequestRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectJoinRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectJoinRequestRequest) ProtoMessage() {}
+
+func (x *RejectJoinRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectJoinRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectJoinRequestRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectJoinRequestRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectJoinRequestRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RemoveFactionMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FactionId     string                 `protobuf:"bytes,1,opt,name=faction_id,json=factionId,proto3" json:"faction_id,omitempty"`
+	SettlementId  string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFactionMemberRequest) Reset() {
+	*x = RemoveFactionMemberRequest{}
+	mi := &file_faction_v1_faction_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFactionMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFactionMemberRequest) ProtoMessage() {}
+
+func (x *RemoveFactionMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_faction_v1_faction_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFactionMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFactionMemberRequest) Descriptor() ([]byte, []int) {
+	return file_faction_v1_faction_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RemoveFactionMemberRequest) GetFactionId() string {
+	if x != nil {
+		return x.FactionId
+	}
+	return ""
+}
+
+func (x *RemoveFactionMemberRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+var File_faction_v1_faction_proto protoreflect.FileDescriptor
+
+const file_faction_v1_faction_proto_rawDesc = "" +
+	"\n" +
+	"\x18faction/v1/faction.proto\x12\n" +
+	"faction.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x01\n" +
+	"\aFaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vpoint_limit\x18\x04 \x01(\x05R\n" +
+	"pointLimit\x12%\n" +
+	"\x0esettlement_ids\x18\x05 \x03(\tR\rsettlementIds\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xef\x02\n" +
+	"\x12FactionJoinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"faction_id\x18\x02 \x01(\tR\tfactionId\x12#\n" +
+	"\rsettlement_id\x18\x03 \x01(\tR\fsettlementId\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\tR\vrequestedBy\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.faction.v1.JoinRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\a \x01(\tR\tdecidedBy\x129\n" +
+	"\n" +
+	"decided_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"\x87\x01\n" +
+	"\x14CreateFactionRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vpoint_limit\x18\x04 \x01(\x05R\n" +
+	"pointLimit\"\x87\x01\n" +
+	"\x14UpdateFactionRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vpoint_limit\x18\x04 \x01(\x05R\n" +
+	"pointLimit\"(\n" +
+	"\x11GetFactionRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x15\n" +
+	"\x13ListFactionsRequest\"G\n" +
+	"\x14ListFactionsResponse\x12/\n" +
+	"\bfactions\x18\x01 \x03(\v2\x13.faction.v1.FactionR\bfactions\"i\n" +
+	"\x19RequestJoinFactionRequest\x12\"\n" +
+	"\n" +
+	"faction_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tfactionId\x12(\n" +
+	"\rsettlement_id\x18\x02 \x01(\tB\x03\xe0A\x02R\fsettlementId\"o\n" +
+	"\x17ListJoinRequestsRequest\x12\x1d\n" +
+	"\n" +
+	"faction_id\x18\x01 \x01(\tR\tfactionId\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.faction.v1.JoinRequestStatusR\x06status\"V\n" +
+	"\x18ListJoinRequestsResponse\x12:\n" +
+	"\brequests\x18\x01 \x03(\v2\x1e.faction.v1.FactionJoinRequestR\brequests\"0\n" +
+	"\x19ApproveJoinRequestRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"G\n" +
+	"\x18RejectJoinRequestRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"j\n" +
+	"\x1aRemoveFactionMemberRequest\x12\"\n" +
+	"\n" +
+	"faction_id\x18\x01 \x01(\tB\x03\xe0A\x02R\tfactionId\x12(\n" +
+	"\rsettlement_id\x18\x02 \x01(\tB\x03\xe0A\x02R\fsettlementId*\x9d\x01\n" +
+	"\x11JoinRequestStatus\x12#\n" +
+	"\x1fJOIN_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bJOIN_REQUEST_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cJOIN_REQUEST_STATUS_APPROVED\x10\x02\x12 \n" +
+	"\x1cJOIN_REQUEST_STATUS_REJECTED\x10\x032\xe4\b\n" +
+	"\x0eFactionService\x12_\n" +
+	"\rCreateFaction\x12 .faction.v1.CreateFactionRequest\x1a\x13.faction.v1.Faction\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/factions\x12d\n" +
+	"\rUpdateFaction\x12 .faction.v1.UpdateFactionRequest\x1a\x13.faction.v1.Faction\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/factions/{id}\x12[\n" +
+	"\n" +
+	"GetFaction\x12\x1d.faction.v1.GetFactionRequest\x1a\x13.faction.v1.Faction\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/factions/{id}\x12g\n" +
+	"\fListFactions\x12\x1f.faction.v1.ListFactionsRequest\x1a .faction.v1.ListFactionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/factions\x12\x8f\x01\n" +
+	"\x12RequestJoinFaction\x12%.faction.v1.RequestJoinFactionRequest\x1a\x1e.faction.v1.FactionJoinRequest\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/factions/{faction_id}/join-requests\x12\x80\x01\n" +
+	"\x10ListJoinRequests\x12#.faction.v1.ListJoinRequestsRequest\x1a$.faction.v1.ListJoinRequestsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/faction-join-requests\x12\x8e\x01\n" +
+	"\x12ApproveJoinRequest\x12%.faction.v1.ApproveJoinRequestRequest\x1a\x1e.faction.v1.FactionJoinRequest\"1\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/faction-join-requests/{id}:approve\x12\x8b\x01\n" +
+	"\x11RejectJoinRequest\x12$.faction.v1.RejectJoinRequestRequest\x1a\x1e.faction.v1.FactionJoinRequest\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/faction-join-requests/{id}:reject\x12\x90\x01\n" +
+	"\x13RemoveFactionMember\x12&.faction.v1.RemoveFactionMemberRequest\x1a\x16.google.protobuf.Empty\"9\x82\xd3\xe4\x93\x023*1/v1/factions/{faction_id}/members/{settlement_id}B:Z8github.com/lasthearth/vsservice/gen/faction/v1;factionv1b\x06proto3"
+
+var (
+	file_faction_v1_faction_proto_rawDescOnce sync.Once
+	file_faction_v1_faction_proto_rawDescData []byte
+)
+
+func file_faction_v1_faction_proto_rawDescGZIP() []byte {
+	file_faction_v1_faction_proto_rawDescOnce.Do(func() {
+		file_faction_v1_faction_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_faction_v1_faction_proto_rawDesc), len(file_faction_v1_faction_proto_rawDesc)))
+	})
+	return file_faction_v1_faction_proto_rawDescData
+}
+
+var file_faction_v1_faction_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_faction_v1_faction_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_faction_v1_faction_proto_goTypes = []any{
+	(JoinRequestStatus)(0),             // 0: faction.v1.JoinRequestStatus
+	(*Faction)(nil),                    // 1: faction.v1.Faction
+	(*FactionJoinRequest)(nil),         // 2: faction.v1.FactionJoinRequest
+	(*CreateFactionRequest)(nil),       // 3: faction.v1.CreateFactionRequest
+	(*UpdateFactionRequest)(nil),       // 4: faction.v1.UpdateFactionRequest
+	(*GetFactionRequest)(nil),          // 5: faction.v1.GetFactionRequest
+	(*ListFactionsRequest)(nil),        // 6: faction.v1.ListFactionsRequest
+	(*ListFactionsResponse)(nil),       // 7: faction.v1.ListFactionsResponse
+	(*RequestJoinFactionRequest)(nil),  // 8: faction.v1.RequestJoinFactionRequest
+	(*ListJoinRequestsRequest)(nil),    // 9: faction.v1.ListJoinRequestsRequest
+	(*ListJoinRequestsResponse)(nil),   // 10: faction.v1.ListJoinRequestsResponse
+	(*ApproveJoinRequestRequest)(nil),  // 11: faction.v1.ApproveJoinRequestRequest
+	(*RejectJoinRequestRequest)(nil),   // 12: faction.v1.RejectJoinRequestRequest
+	(*RemoveFactionMemberRequest)(nil), // 13: faction.v1.RemoveFactionMemberRequest
+	(*timestamppb.Timestamp)(nil),      // 14: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 15: google.protobuf.Empty
+}
+var file_faction_v1_faction_proto_depIdxs = []int32{
+	14, // 0: faction.v1.Faction.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: faction.v1.FactionJoinRequest.status:type_name -> faction.v1.JoinRequestStatus
+	14, // 2: faction.v1.FactionJoinRequest.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: faction.v1.FactionJoinRequest.decided_at:type_name -> google.protobuf.Timestamp
+	1,  // 4: faction.v1.ListFactionsResponse.factions:type_name -> faction.v1.Faction
+	0,  // 5: faction.v1.ListJoinRequestsRequest.status:type_name -> faction.v1.JoinRequestStatus
+	2,  // 6: faction.v1.ListJoinRequestsResponse.requests:type_name -> faction.v1.FactionJoinRequest
+	3,  // 7: faction.v1.FactionService.CreateFaction:input_type -> faction.v1.CreateFactionRequest
+	4,  // 8: faction.v1.FactionService.UpdateFaction:input_type -> faction.v1.UpdateFactionRequest
+	5,  // 9: faction.v1.FactionService.GetFaction:input_type -> faction.v1.GetFactionRequest
+	6,  // 10: faction.v1.FactionService.ListFactions:input_type -> faction.v1.ListFactionsRequest
+	8,  // 11: faction.v1.FactionService.RequestJoinFaction:input_type -> faction.v1.RequestJoinFactionRequest
+	9,  // 12: faction.v1.FactionService.ListJoinRequests:input_type -> faction.v1.ListJoinRequestsRequest
+	11, // 13: faction.v1.FactionService.ApproveJoinRequest:input_type -> faction.v1.ApproveJoinRequestRequest
+	12, // 14: faction.v1.FactionService.RejectJoinRequest:input_type -> faction.v1.RejectJoinRequestRequest
+	13, // 15: faction.v1.FactionService.RemoveFactionMember:input_type -> faction.v1.RemoveFactionMemberRequest
+	1,  // 16: faction.v1.FactionService.CreateFaction:output_type -> faction.v1.Faction
+	1,  // 17: faction.v1.FactionService.UpdateFaction:output_type -> faction.v1.Faction
+	1,  // 18: faction.v1.FactionService.GetFaction:output_type -> faction.v1.Faction
+	7,  // 19: faction.v1.FactionService.ListFactions:output_type -> faction.v1.ListFactionsResponse
+	2,  // 20: faction.v1.FactionService.RequestJoinFaction:output_type -> faction.v1.FactionJoinRequest
+	10, // 21: faction.v1.FactionService.ListJoinRequests:output_type -> faction.v1.ListJoinRequestsResponse
+	2,  // 22: faction.v1.FactionService.ApproveJoinRequest:output_type -> faction.v1.FactionJoinRequest
+	2,  // 23: faction.v1.FactionService.RejectJoinRequest:output_type -> faction.v1.FactionJoinRequest
+	15, // 24: faction.v1.FactionService.RemoveFactionMember:output_type -> google.protobuf.Empty
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_faction_v1_faction_proto_init() }
+func file_faction_v1_faction_proto_init() {
+	if File_faction_v1_faction_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_faction_v1_faction_proto_rawDesc), len(file_faction_v1_faction_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_faction_v1_faction_proto_goTypes,
+		DependencyIndexes: file_faction_v1_faction_proto_depIdxs,
+		EnumInfos:         file_faction_v1_faction_proto_enumTypes,
+		MessageInfos:      file_faction_v1_faction_proto_msgTypes,
+	}.Build()
+	File_faction_v1_faction_proto = out.File
+	file_faction_v1_faction_proto_goTypes = nil
+	file_faction_v1_faction_proto_depIdxs = nil
+}
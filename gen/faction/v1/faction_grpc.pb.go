@@ -0,0 +1,570 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: faction/v1/faction.proto
+
+package factionv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	FactionService_CreateFaction_FullMethodName       = "/faction.v1.FactionService/CreateFaction"
+	FactionService_UpdateFaction_FullMethodName       = "/faction.v1.FactionService/UpdateFaction"
+	FactionService_GetFaction_FullMethodName          = "/faction.v1.FactionService/GetFaction"
+	FactionService_ListFactions_FullMethodName        = "/faction.v1.FactionService/ListFactions"
+	FactionService_RequestJoinFaction_FullMethodName  = "/faction.v1.FactionService/RequestJoinFaction"
+	FactionService_ListJoinRequests_FullMethodName    = "/faction.v1.FactionService/ListJoinRequests"
+	FactionService_ApproveJoinRequest_FullMethodName  = "/faction.v1.FactionService/ApproveJoinRequest"
+	FactionService_RejectJoinRequest_FullMethodName   = "/faction.v1.FactionService/RejectJoinRequest"
+	FactionService_RemoveFactionMember_FullMethodName = "/faction.v1.FactionService/RemoveFactionMember"
+)
+
+// FactionServiceClient is the client API for FactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// FactionService manages the factions settlements fight for. A faction's id
+// is the side it takes on imperial points and in point talent progress.
+type FactionServiceClient interface {
+	// Create a faction. Requires faction:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): id is not a lowercase slug, name is empty, or
+	//     point_limit is negative
+	//   - ALREADY_EXISTS (409): a faction with this id exists
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	CreateFaction(ctx context.Context, in *CreateFactionRequest, opts ...grpc.CallOption) (*Faction, error)
+	// Update a faction's name, description and point limit. Requires
+	// faction:write scope. Lowering the limit does not take points away; the
+	// faction cannot gain more until it is under the new limit.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): name is empty or point_limit is negative
+	//   - NOT_FOUND (404): faction not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	UpdateFaction(ctx context.Context, in *UpdateFactionRequest, opts ...grpc.CallOption) (*Faction, error)
+	// Get a faction by id.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found
+	//   - INTERNAL (500): database failure
+	GetFaction(ctx context.Context, in *GetFactionRequest, opts ...grpc.CallOption) (*Faction, error)
+	// List all factions.
+	//
+	// Errors:
+	//   - INTERNAL (500): database failure
+	ListFactions(ctx context.Context, in *ListFactionsRequest, opts ...grpc.CallOption) (*ListFactionsResponse, error)
+	// Ask for a settlement to join a faction. Caller must be the leader of the
+	// settlement. The request waits for an admin's approval.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - FAILED_PRECONDITION (400): settlement already belongs to a faction
+	//   - ALREADY_EXISTS (409): settlement already has a pending request
+	//   - INTERNAL (500): database failure
+	RequestJoinFaction(ctx context.Context, in *RequestJoinFactionRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error)
+	// List join requests, newest first. Requires faction:write scope.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error)
+	// Approve a pending join request, making the settlement a member of the
+	// faction. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): request not found
+	//   - FAILED_PRECONDITION (400): request already decided, or the
+	//     settlement joined another faction meanwhile
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error)
+	// Reject a pending join request. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): request not found
+	//   - FAILED_PRECONDITION (400): request already decided
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error)
+	// Remove a settlement from a faction. Points it holds stay held until
+	// their control changes. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found, or the settlement is not a member
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	RemoveFactionMember(ctx context.Context, in *RemoveFactionMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type factionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFactionServiceClient(cc grpc.ClientConnInterface) FactionServiceClient {
+	return &factionServiceClient{cc}
+}
+
+func (c *factionServiceClient) CreateFaction(ctx context.Context, in *CreateFactionRequest, opts ...grpc.CallOption) (*Faction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Faction)
+	err := c.cc.Invoke(ctx, FactionService_CreateFaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) UpdateFaction(ctx context.Context, in *UpdateFactionRequest, opts ...grpc.CallOption) (*Faction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Faction)
+	err := c.cc.Invoke(ctx, FactionService_UpdateFaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) GetFaction(ctx context.Context, in *GetFactionRequest, opts ...grpc.CallOption) (*Faction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Faction)
+	err := c.cc.Invoke(ctx, FactionService_GetFaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) ListFactions(ctx context.Context, in *ListFactionsRequest, opts ...grpc.CallOption) (*ListFactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFactionsResponse)
+	err := c.cc.Invoke(ctx, FactionService_ListFactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) RequestJoinFaction(ctx context.Context, in *RequestJoinFactionRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FactionJoinRequest)
+	err := c.cc.Invoke(ctx, FactionService_RequestJoinFaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) ListJoinRequests(ctx context.Context, in *ListJoinRequestsRequest, opts ...grpc.CallOption) (*ListJoinRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJoinRequestsResponse)
+	err := c.cc.Invoke(ctx, FactionService_ListJoinRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) ApproveJoinRequest(ctx context.Context, in *ApproveJoinRequestRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FactionJoinRequest)
+	err := c.cc.Invoke(ctx, FactionService_ApproveJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) RejectJoinRequest(ctx context.Context, in *RejectJoinRequestRequest, opts ...grpc.CallOption) (*FactionJoinRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FactionJoinRequest)
+	err := c.cc.Invoke(ctx, FactionService_RejectJoinRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *factionServiceClient) RemoveFactionMember(ctx context.Context, in *RemoveFactionMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, FactionService_RemoveFactionMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FactionServiceServer is the server API for FactionService service.
+// All implementations should embed UnimplementedFactionServiceServer
+// for forward compatibility.
+//
+// FactionService manages the factions settlements fight for. A faction's id
+// is the side it takes on imperial points and in point talent progress.
+type FactionServiceServer interface {
+	// Create a faction. Requires faction:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): id is not a lowercase slug, name is empty, or
+	//     point_limit is negative
+	//   - ALREADY_EXISTS (409): a faction with this id exists
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	CreateFaction(context.Context, *CreateFactionRequest) (*Faction, error)
+	// Update a faction's name, description and point limit. Requires
+	// faction:write scope. Lowering the limit does not take points away; the
+	// faction cannot gain more until it is under the new limit.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): name is empty or point_limit is negative
+	//   - NOT_FOUND (404): faction not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	UpdateFaction(context.Context, *UpdateFactionRequest) (*Faction, error)
+	// Get a faction by id.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found
+	//   - INTERNAL (500): database failure
+	GetFaction(context.Context, *GetFactionRequest) (*Faction, error)
+	// List all factions.
+	//
+	// Errors:
+	//   - INTERNAL (500): database failure
+	ListFactions(context.Context, *ListFactionsRequest) (*ListFactionsResponse, error)
+	// Ask for a settlement to join a faction. Caller must be the leader of the
+	// settlement. The request waits for an admin's approval.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - FAILED_PRECONDITION (400): settlement already belongs to a faction
+	//   - ALREADY_EXISTS (409): settlement already has a pending request
+	//   - INTERNAL (500): database failure
+	RequestJoinFaction(context.Context, *RequestJoinFactionRequest) (*FactionJoinRequest, error)
+	// List join requests, newest first. Requires faction:write scope.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error)
+	// Approve a pending join request, making the settlement a member of the
+	// faction. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): request not found
+	//   - FAILED_PRECONDITION (400): request already decided, or the
+	//     settlement joined another faction meanwhile
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*FactionJoinRequest, error)
+	// Reject a pending join request. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): request not found
+	//   - FAILED_PRECONDITION (400): request already decided
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*FactionJoinRequest, error)
+	// Remove a settlement from a faction. Points it holds stay held until
+	// their control changes. Requires faction:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): faction not found, or the settlement is not a member
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires faction:write scope
+	//   - INTERNAL (500): database failure
+	RemoveFactionMember(context.Context, *RemoveFactionMemberRequest) (*emptypb.Empty, error)
+}
+
+// UnimplementedFactionServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFactionServiceServer struct{}
+
+func (UnimplementedFactionServiceServer) CreateFaction(context.Context, *CreateFactionRequest) (*Faction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFaction not implemented")
+}
+func (UnimplementedFactionServiceServer) UpdateFaction(context.Context, *UpdateFactionRequest) (*Faction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFaction not implemented")
+}
+func (UnimplementedFactionServiceServer) GetFaction(context.Context, *GetFactionRequest) (*Faction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFaction not implemented")
+}
+func (UnimplementedFactionServiceServer) ListFactions(context.Context, *ListFactionsRequest) (*ListFactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFactions not implemented")
+}
+func (UnimplementedFactionServiceServer) RequestJoinFaction(context.Context, *RequestJoinFactionRequest) (*FactionJoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestJoinFaction not implemented")
+}
+func (UnimplementedFactionServiceServer) ListJoinRequests(context.Context, *ListJoinRequestsRequest) (*ListJoinRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinRequests not implemented")
+}
+func (UnimplementedFactionServiceServer) ApproveJoinRequest(context.Context, *ApproveJoinRequestRequest) (*FactionJoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveJoinRequest not implemented")
+}
+func (UnimplementedFactionServiceServer) RejectJoinRequest(context.Context, *RejectJoinRequestRequest) (*FactionJoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectJoinRequest not implemented")
+}
+func (UnimplementedFactionServiceServer) RemoveFactionMember(context.Context, *RemoveFactionMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFactionMember not implemented")
+}
+func (UnimplementedFactionServiceServer) testEmbeddedByValue() {}
+
+// UnsafeFactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FactionServiceServer will
+// result in compilation errors.
+type UnsafeFactionServiceServer interface {
+	mustEmbedUnimplementedFactionServiceServer()
+}
+
+func RegisterFactionServiceServer(s grpc.ServiceRegistrar, srv FactionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFactionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FactionService_ServiceDesc, srv)
+}
+
+func _FactionService_CreateFaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).CreateFaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_CreateFaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).CreateFaction(ctx, req.(*CreateFactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_UpdateFaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).UpdateFaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_UpdateFaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).UpdateFaction(ctx, req.(*UpdateFactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_GetFaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).GetFaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_GetFaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).GetFaction(ctx, req.(*GetFactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_ListFactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).ListFactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_ListFactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).ListFactions(ctx, req.(*ListFactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_RequestJoinFaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestJoinFactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).RequestJoinFaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_RequestJoinFaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).RequestJoinFaction(ctx, req.(*RequestJoinFactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_ListJoinRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).ListJoinRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_ListJoinRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).ListJoinRequests(ctx, req.(*ListJoinRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_ApproveJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).ApproveJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_ApproveJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).ApproveJoinRequest(ctx, req.(*ApproveJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_RejectJoinRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectJoinRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).RejectJoinRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_RejectJoinRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).RejectJoinRequest(ctx, req.(*RejectJoinRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FactionService_RemoveFactionMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFactionMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FactionServiceServer).RemoveFactionMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FactionService_RemoveFactionMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FactionServiceServer).RemoveFactionMember(ctx, req.(*RemoveFactionMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FactionService_ServiceDesc is the grpc.ServiceDesc for FactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "faction.v1.FactionService",
+	HandlerType: (*FactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFaction",
+			Handler:    _FactionService_CreateFaction_Handler,
+		},
+		{
+			MethodName: "UpdateFaction",
+			Handler:    _FactionService_UpdateFaction_Handler,
+		},
+		{
+			MethodName: "GetFaction",
+			Handler:    _FactionService_GetFaction_Handler,
+		},
+		{
+			MethodName: "ListFactions",
+			Handler:    _FactionService_ListFactions_Handler,
+		},
+		{
+			MethodName: "RequestJoinFaction",
+			Handler:    _FactionService_RequestJoinFaction_Handler,
+		},
+		{
+			MethodName: "ListJoinRequests",
+			Handler:    _FactionService_ListJoinRequests_Handler,
+		},
+		{
+			MethodName: "ApproveJoinRequest",
+			Handler:    _FactionService_ApproveJoinRequest_Handler,
+		},
+		{
+			MethodName: "RejectJoinRequest",
+			Handler:    _FactionService_RejectJoinRequest_Handler,
+		},
+		{
+			MethodName: "RemoveFactionMember",
+			Handler:    _FactionService_RemoveFactionMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "faction/v1/faction.proto",
+}
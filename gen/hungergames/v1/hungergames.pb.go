@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: hungergames/v1/hungergames.proto
 
@@ -23,131 +23,108 @@ const (
 
 var File_hungergames_v1_hungergames_proto protoreflect.FileDescriptor
 
-var file_hungergames_v1_hungergames_proto_rawDesc = string([]byte{
-	0x0a, 0x20, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x68, 0x75, 0x6e, 0x67, 0x65,
-	0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d,
-	0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf6, 0x07, 0x0a, 0x12, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x47,
-	0x61, 0x6d, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x78, 0x0a, 0x0b, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x22, 0x2e, 0x68, 0x75, 0x6e,
-	0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
-	0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15,
-	0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x87, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x68, 0x75, 0x6e, 0x67,
-	0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x7f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22,
-	0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a,
-	0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x12, 0x7c, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x23, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67,
-	0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x77,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e,
-	0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x23, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
-	0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f,
-	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x12, 0x2b, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x73, 0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x12, 0xa0, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
-	0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37,
-	0x2f, 0x76, 0x31, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f,
-	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x14, 0xca, 0x41, 0x11, 0x61, 0x70, 0x69, 0x2e,
-	0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2e, 0x72, 0x75, 0x42, 0xc1, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f,
-	0x76, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x68, 0x75,
-	0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x75, 0x6e,
-	0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58,
-	0xaa, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1a, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0f, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x3a, 0x56,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_hungergames_v1_hungergames_proto_rawDesc = "" +
+	"\n" +
+	" hungergames/v1/hungergames.proto\x12\x0ehungergames.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1ahungergames/v1/match.proto\x1a hungergames/v1/leaderboard.proto\x1a\x1bhungergames/v1/season.proto2\x9f\x15\n" +
+	"\x12HungerGamesService\x12x\n" +
+	"\vRecordMatch\x12\".hungergames.v1.RecordMatchRequest\x1a#.hungergames.v1.RecordMatchResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/hungergames/match\x12w\n" +
+	"\vListMatches\x12\".hungergames.v1.ListMatchesRequest\x1a#.hungergames.v1.ListMatchesResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/hungergames/matches\x12n\n" +
+	"\bGetMatch\x12\x1f.hungergames.v1.GetMatchRequest\x1a\x15.hungergames.v1.Match\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/hungergames/matches/{match_id}\x12\x93\x01\n" +
+	"\x12ListFlaggedMatches\x12).hungergames.v1.ListFlaggedMatchesRequest\x1a*.hungergames.v1.ListFlaggedMatchesResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/hungergames/review/matches\x12\x85\x01\n" +
+	"\x0fClearMatchFlags\x12&.hungergames.v1.ClearMatchFlagsRequest\x1a\x15.hungergames.v1.Match\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/hungergames/matches/{match_id}/clear\x12x\n" +
+	"\tVoidMatch\x12 .hungergames.v1.VoidMatchRequest\x1a\x15.hungergames.v1.Match\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/hungergames/matches/{match_id}/void\x12\x99\x01\n" +
+	"\x10GetRatingHistory\x12'.hungergames.v1.GetRatingHistoryRequest\x1a(.hungergames.v1.GetRatingHistoryResponse\"2\x82\xd3\xe4\x93\x02,\x12*/v1/hungergames/players/{player_id}/rating\x12\x87\x01\n" +
+	"\x0fListLeaderboard\x12&.hungergames.v1.ListLeaderboardRequest\x1a'.hungergames.v1.ListLeaderboardResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/hungergames/leaderboard\x12\x7f\n" +
+	"\vResetSeason\x12\".hungergames.v1.ResetSeasonRequest\x1a#.hungergames.v1.ResetSeasonResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/hungergames/season/reset\x12\x8a\x01\n" +
+	"\x0eGetSeasonReset\x12%.hungergames.v1.GetSeasonResetRequest\x1a\x1e.hungergames.v1.SeasonResetJob\"1\x82\xd3\xe4\x93\x02+\x12)/v1/hungergames/seasons/{season_id}/reset\x12\x8c\x01\n" +
+	"\x10ListSeasonResets\x12'.hungergames.v1.ListSeasonResetsRequest\x1a(.hungergames.v1.ListSeasonResetsResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/hungergames/season/resets\x12\x9a\x01\n" +
+	"\x11ResumeSeasonReset\x12(.hungergames.v1.ResumeSeasonResetRequest\x1a\x1e.hungergames.v1.SeasonResetJob\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/hungergames/seasons/{season_id}/reset/resume\x12|\n" +
+	"\fCreateSeason\x12#.hungergames.v1.CreateSeasonRequest\x1a$.hungergames.v1.CreateSeasonResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/hungergames/season\x12\x8b\x01\n" +
+	"\x0eScheduleSeason\x12%.hungergames.v1.ScheduleSeasonRequest\x1a&.hungergames.v1.ScheduleSeasonResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/hungergames/season/schedule\x12\x97\x01\n" +
+	"\x13ListSeasonSchedules\x12*.hungergames.v1.ListSeasonSchedulesRequest\x1a+.hungergames.v1.ListSeasonSchedulesResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/hungergames/season/schedule\x12\xa8\x01\n" +
+	"\x14CancelSeasonSchedule\x12+.hungergames.v1.CancelSeasonScheduleRequest\x1a,.hungergames.v1.CancelSeasonScheduleResponse\"5\x82\xd3\xe4\x93\x02/*-/v1/hungergames/season/schedule/{schedule_id}\x12w\n" +
+	"\vListSeasons\x12\".hungergames.v1.ListSeasonsRequest\x1a#.hungergames.v1.ListSeasonsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/hungergames/seasons\x12\xaa\x01\n" +
+	"\x14GetSeasonLeaderboard\x12+.hungergames.v1.GetSeasonLeaderboardRequest\x1a,.hungergames.v1.GetSeasonLeaderboardResponse\"7\x82\xd3\xe4\x93\x021\x12//v1/hungergames/seasons/{season_id}/leaderboard\x12\xa0\x01\n" +
+	"\x0eGetPlayerStats\x12%.hungergames.v1.GetPlayerStatsRequest\x1a&.hungergames.v1.GetPlayerStatsResponse\"?\x82\xd3\xe4\x93\x029\x127/v1/hungergames/seasons/{season_id}/players/{player_id}\x1a\x14\xcaA\x11api.lasthearth.ruBBZ@github.com/lasthearth/vsservice/gen/hungergames/v1;hungergamesv1b\x06proto3"
 
 var file_hungergames_v1_hungergames_proto_goTypes = []any{
 	(*RecordMatchRequest)(nil),           // 0: hungergames.v1.RecordMatchRequest
-	(*ListLeaderboardRequest)(nil),       // 1: hungergames.v1.ListLeaderboardRequest
-	(*ResetSeasonRequest)(nil),           // 2: hungergames.v1.ResetSeasonRequest
-	(*CreateSeasonRequest)(nil),          // 3: hungergames.v1.CreateSeasonRequest
-	(*ListSeasonsRequest)(nil),           // 4: hungergames.v1.ListSeasonsRequest
-	(*GetSeasonLeaderboardRequest)(nil),  // 5: hungergames.v1.GetSeasonLeaderboardRequest
-	(*GetPlayerStatsRequest)(nil),        // 6: hungergames.v1.GetPlayerStatsRequest
-	(*RecordMatchResponse)(nil),          // 7: hungergames.v1.RecordMatchResponse
-	(*ListLeaderboardResponse)(nil),      // 8: hungergames.v1.ListLeaderboardResponse
-	(*ResetSeasonResponse)(nil),          // 9: hungergames.v1.ResetSeasonResponse
-	(*CreateSeasonResponse)(nil),         // 10: hungergames.v1.CreateSeasonResponse
-	(*ListSeasonsResponse)(nil),          // 11: hungergames.v1.ListSeasonsResponse
-	(*GetSeasonLeaderboardResponse)(nil), // 12: hungergames.v1.GetSeasonLeaderboardResponse
-	(*GetPlayerStatsResponse)(nil),       // 13: hungergames.v1.GetPlayerStatsResponse
+	(*ListMatchesRequest)(nil),           // 1: hungergames.v1.ListMatchesRequest
+	(*GetMatchRequest)(nil),              // 2: hungergames.v1.GetMatchRequest
+	(*ListFlaggedMatchesRequest)(nil),    // 3: hungergames.v1.ListFlaggedMatchesRequest
+	(*ClearMatchFlagsRequest)(nil),       // 4: hungergames.v1.ClearMatchFlagsRequest
+	(*VoidMatchRequest)(nil),             // 5: hungergames.v1.VoidMatchRequest
+	(*GetRatingHistoryRequest)(nil),      // 6: hungergames.v1.GetRatingHistoryRequest
+	(*ListLeaderboardRequest)(nil),       // 7: hungergames.v1.ListLeaderboardRequest
+	(*ResetSeasonRequest)(nil),           // 8: hungergames.v1.ResetSeasonRequest
+	(*GetSeasonResetRequest)(nil),        // 9: hungergames.v1.GetSeasonResetRequest
+	(*ListSeasonResetsRequest)(nil),      // 10: hungergames.v1.ListSeasonResetsRequest
+	(*ResumeSeasonResetRequest)(nil),     // 11: hungergames.v1.ResumeSeasonResetRequest
+	(*CreateSeasonRequest)(nil),          // 12: hungergames.v1.CreateSeasonRequest
+	(*ScheduleSeasonRequest)(nil),        // 13: hungergames.v1.ScheduleSeasonRequest
+	(*ListSeasonSchedulesRequest)(nil),   // 14: hungergames.v1.ListSeasonSchedulesRequest
+	(*CancelSeasonScheduleRequest)(nil),  // 15: hungergames.v1.CancelSeasonScheduleRequest
+	(*ListSeasonsRequest)(nil),           // 16: hungergames.v1.ListSeasonsRequest
+	(*GetSeasonLeaderboardRequest)(nil),  // 17: hungergames.v1.GetSeasonLeaderboardRequest
+	(*GetPlayerStatsRequest)(nil),        // 18: hungergames.v1.GetPlayerStatsRequest
+	(*RecordMatchResponse)(nil),          // 19: hungergames.v1.RecordMatchResponse
+	(*ListMatchesResponse)(nil),          // 20: hungergames.v1.ListMatchesResponse
+	(*Match)(nil),                        // 21: hungergames.v1.Match
+	(*ListFlaggedMatchesResponse)(nil),   // 22: hungergames.v1.ListFlaggedMatchesResponse
+	(*GetRatingHistoryResponse)(nil),     // 23: hungergames.v1.GetRatingHistoryResponse
+	(*ListLeaderboardResponse)(nil),      // 24: hungergames.v1.ListLeaderboardResponse
+	(*ResetSeasonResponse)(nil),          // 25: hungergames.v1.ResetSeasonResponse
+	(*SeasonResetJob)(nil),               // 26: hungergames.v1.SeasonResetJob
+	(*ListSeasonResetsResponse)(nil),     // 27: hungergames.v1.ListSeasonResetsResponse
+	(*CreateSeasonResponse)(nil),         // 28: hungergames.v1.CreateSeasonResponse
+	(*ScheduleSeasonResponse)(nil),       // 29: hungergames.v1.ScheduleSeasonResponse
+	(*ListSeasonSchedulesResponse)(nil),  // 30: hungergames.v1.ListSeasonSchedulesResponse
+	(*CancelSeasonScheduleResponse)(nil), // 31: hungergames.v1.CancelSeasonScheduleResponse
+	(*ListSeasonsResponse)(nil),          // 32: hungergames.v1.ListSeasonsResponse
+	(*GetSeasonLeaderboardResponse)(nil), // 33: hungergames.v1.GetSeasonLeaderboardResponse
+	(*GetPlayerStatsResponse)(nil),       // 34: hungergames.v1.GetPlayerStatsResponse
 }
 var file_hungergames_v1_hungergames_proto_depIdxs = []int32{
 	0,  // 0: hungergames.v1.HungerGamesService.RecordMatch:input_type -> hungergames.v1.RecordMatchRequest
-	1,  // 1: hungergames.v1.HungerGamesService.ListLeaderboard:input_type -> hungergames.v1.ListLeaderboardRequest
-	2,  // 2: hungergames.v1.HungerGamesService.ResetSeason:input_type -> hungergames.v1.ResetSeasonRequest
-	3,  // 3: hungergames.v1.HungerGamesService.CreateSeason:input_type -> hungergames.v1.CreateSeasonRequest
-	4,  // 4: hungergames.v1.HungerGamesService.ListSeasons:input_type -> hungergames.v1.ListSeasonsRequest
-	5,  // 5: hungergames.v1.HungerGamesService.GetSeasonLeaderboard:input_type -> hungergames.v1.GetSeasonLeaderboardRequest
-	6,  // 6: hungergames.v1.HungerGamesService.GetPlayerStats:input_type -> hungergames.v1.GetPlayerStatsRequest
-	7,  // 7: hungergames.v1.HungerGamesService.RecordMatch:output_type -> hungergames.v1.RecordMatchResponse
-	8,  // 8: hungergames.v1.HungerGamesService.ListLeaderboard:output_type -> hungergames.v1.ListLeaderboardResponse
-	9,  // 9: hungergames.v1.HungerGamesService.ResetSeason:output_type -> hungergames.v1.ResetSeasonResponse
-	10, // 10: hungergames.v1.HungerGamesService.CreateSeason:output_type -> hungergames.v1.CreateSeasonResponse
-	11, // 11: hungergames.v1.HungerGamesService.ListSeasons:output_type -> hungergames.v1.ListSeasonsResponse
-	12, // 12: hungergames.v1.HungerGamesService.GetSeasonLeaderboard:output_type -> hungergames.v1.GetSeasonLeaderboardResponse
-	13, // 13: hungergames.v1.HungerGamesService.GetPlayerStats:output_type -> hungergames.v1.GetPlayerStatsResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	1,  // 1: hungergames.v1.HungerGamesService.ListMatches:input_type -> hungergames.v1.ListMatchesRequest
+	2,  // 2: hungergames.v1.HungerGamesService.GetMatch:input_type -> hungergames.v1.GetMatchRequest
+	3,  // 3: hungergames.v1.HungerGamesService.ListFlaggedMatches:input_type -> hungergames.v1.ListFlaggedMatchesRequest
+	4,  // 4: hungergames.v1.HungerGamesService.ClearMatchFlags:input_type -> hungergames.v1.ClearMatchFlagsRequest
+	5,  // 5: hungergames.v1.HungerGamesService.VoidMatch:input_type -> hungergames.v1.VoidMatchRequest
+	6,  // 6: hungergames.v1.HungerGamesService.GetRatingHistory:input_type -> hungergames.v1.GetRatingHistoryRequest
+	7,  // 7: hungergames.v1.HungerGamesService.ListLeaderboard:input_type -> hungergames.v1.ListLeaderboardRequest
+	8,  // 8: hungergames.v1.HungerGamesService.ResetSeason:input_type -> hungergames.v1.ResetSeasonRequest
+	9,  // 9: hungergames.v1.HungerGamesService.GetSeasonReset:input_type -> hungergames.v1.GetSeasonResetRequest
+	10, // 10: hungergames.v1.HungerGamesService.ListSeasonResets:input_type -> hungergames.v1.ListSeasonResetsRequest
+	11, // 11: hungergames.v1.HungerGamesService.ResumeSeasonReset:input_type -> hungergames.v1.ResumeSeasonResetRequest
+	12, // 12: hungergames.v1.HungerGamesService.CreateSeason:input_type -> hungergames.v1.CreateSeasonRequest
+	13, // 13: hungergames.v1.HungerGamesService.ScheduleSeason:input_type -> hungergames.v1.ScheduleSeasonRequest
+	14, // 14: hungergames.v1.HungerGamesService.ListSeasonSchedules:input_type -> hungergames.v1.ListSeasonSchedulesRequest
+	15, // 15: hungergames.v1.HungerGamesService.CancelSeasonSchedule:input_type -> hungergames.v1.CancelSeasonScheduleRequest
+	16, // 16: hungergames.v1.HungerGamesService.ListSeasons:input_type -> hungergames.v1.ListSeasonsRequest
+	17, // 17: hungergames.v1.HungerGamesService.GetSeasonLeaderboard:input_type -> hungergames.v1.GetSeasonLeaderboardRequest
+	18, // 18: hungergames.v1.HungerGamesService.GetPlayerStats:input_type -> hungergames.v1.GetPlayerStatsRequest
+	19, // 19: hungergames.v1.HungerGamesService.RecordMatch:output_type -> hungergames.v1.RecordMatchResponse
+	20, // 20: hungergames.v1.HungerGamesService.ListMatches:output_type -> hungergames.v1.ListMatchesResponse
+	21, // 21: hungergames.v1.HungerGamesService.GetMatch:output_type -> hungergames.v1.Match
+	22, // 22: hungergames.v1.HungerGamesService.ListFlaggedMatches:output_type -> hungergames.v1.ListFlaggedMatchesResponse
+	21, // 23: hungergames.v1.HungerGamesService.ClearMatchFlags:output_type -> hungergames.v1.Match
+	21, // 24: hungergames.v1.HungerGamesService.VoidMatch:output_type -> hungergames.v1.Match
+	23, // 25: hungergames.v1.HungerGamesService.GetRatingHistory:output_type -> hungergames.v1.GetRatingHistoryResponse
+	24, // 26: hungergames.v1.HungerGamesService.ListLeaderboard:output_type -> hungergames.v1.ListLeaderboardResponse
+	25, // 27: hungergames.v1.HungerGamesService.ResetSeason:output_type -> hungergames.v1.ResetSeasonResponse
+	26, // 28: hungergames.v1.HungerGamesService.GetSeasonReset:output_type -> hungergames.v1.SeasonResetJob
+	27, // 29: hungergames.v1.HungerGamesService.ListSeasonResets:output_type -> hungergames.v1.ListSeasonResetsResponse
+	26, // 30: hungergames.v1.HungerGamesService.ResumeSeasonReset:output_type -> hungergames.v1.SeasonResetJob
+	28, // 31: hungergames.v1.HungerGamesService.CreateSeason:output_type -> hungergames.v1.CreateSeasonResponse
+	29, // 32: hungergames.v1.HungerGamesService.ScheduleSeason:output_type -> hungergames.v1.ScheduleSeasonResponse
+	30, // 33: hungergames.v1.HungerGamesService.ListSeasonSchedules:output_type -> hungergames.v1.ListSeasonSchedulesResponse
+	31, // 34: hungergames.v1.HungerGamesService.CancelSeasonSchedule:output_type -> hungergames.v1.CancelSeasonScheduleResponse
+	32, // 35: hungergames.v1.HungerGamesService.ListSeasons:output_type -> hungergames.v1.ListSeasonsResponse
+	33, // 36: hungergames.v1.HungerGamesService.GetSeasonLeaderboard:output_type -> hungergames.v1.GetSeasonLeaderboardResponse
+	34, // 37: hungergames.v1.HungerGamesService.GetPlayerStats:output_type -> hungergames.v1.GetPlayerStatsResponse
+	19, // [19:38] is the sub-list for method output_type
+	0,  // [0:19] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
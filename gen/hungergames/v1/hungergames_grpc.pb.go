@@ -20,9 +20,21 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	HungerGamesService_RecordMatch_FullMethodName          = "/hungergames.v1.HungerGamesService/RecordMatch"
+	HungerGamesService_ListMatches_FullMethodName          = "/hungergames.v1.HungerGamesService/ListMatches"
+	HungerGamesService_GetMatch_FullMethodName             = "/hungergames.v1.HungerGamesService/GetMatch"
+	HungerGamesService_ListFlaggedMatches_FullMethodName   = "/hungergames.v1.HungerGamesService/ListFlaggedMatches"
+	HungerGamesService_ClearMatchFlags_FullMethodName      = "/hungergames.v1.HungerGamesService/ClearMatchFlags"
+	HungerGamesService_VoidMatch_FullMethodName            = "/hungergames.v1.HungerGamesService/VoidMatch"
+	HungerGamesService_GetRatingHistory_FullMethodName     = "/hungergames.v1.HungerGamesService/GetRatingHistory"
 	HungerGamesService_ListLeaderboard_FullMethodName      = "/hungergames.v1.HungerGamesService/ListLeaderboard"
 	HungerGamesService_ResetSeason_FullMethodName          = "/hungergames.v1.HungerGamesService/ResetSeason"
+	HungerGamesService_GetSeasonReset_FullMethodName       = "/hungergames.v1.HungerGamesService/GetSeasonReset"
+	HungerGamesService_ListSeasonResets_FullMethodName     = "/hungergames.v1.HungerGamesService/ListSeasonResets"
+	HungerGamesService_ResumeSeasonReset_FullMethodName    = "/hungergames.v1.HungerGamesService/ResumeSeasonReset"
 	HungerGamesService_CreateSeason_FullMethodName         = "/hungergames.v1.HungerGamesService/CreateSeason"
+	HungerGamesService_ScheduleSeason_FullMethodName       = "/hungergames.v1.HungerGamesService/ScheduleSeason"
+	HungerGamesService_ListSeasonSchedules_FullMethodName  = "/hungergames.v1.HungerGamesService/ListSeasonSchedules"
+	HungerGamesService_CancelSeasonSchedule_FullMethodName = "/hungergames.v1.HungerGamesService/CancelSeasonSchedule"
 	HungerGamesService_ListSeasons_FullMethodName          = "/hungergames.v1.HungerGamesService/ListSeasons"
 	HungerGamesService_GetSeasonLeaderboard_FullMethodName = "/hungergames.v1.HungerGamesService/GetSeasonLeaderboard"
 	HungerGamesService_GetPlayerStats_FullMethodName       = "/hungergames.v1.HungerGamesService/GetPlayerStats"
@@ -35,16 +47,76 @@ const (
 // HungerGamesService manages the Hunger Games game mode: ELO ratings,
 // season lifecycle, leaderboard, and reward distribution.
 type HungerGamesServiceClient interface {
-	// Records a match result and recalculates ELO for all participants.
+	// Records a match result, recalculates ELO for all participants and stores
+	// the match in the history.
 	//
-	// Requires an active season.
+	// Requires an active season. Matches the abuse heuristics find suspicious
+	// are recorded flagged for admin review; when automatic freezes are on,
+	// the ratings of their players stop moving until the review is resolved.
 	//
 	// Errors:
-	//   - INVALID_ARGUMENT (400): fewer than 2 players, duplicate places, or no active season
+	//   - INVALID_ARGUMENT (400): fewer than 2 players or teams, a missing or
+	//     repeated player id, duplicate places, or teammates with different
+	//     places
+	//   - FAILED_PRECONDITION (400): no active season
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	RecordMatch(ctx context.Context, in *RecordMatchRequest, opts ...grpc.CallOption) (*RecordMatchResponse, error)
+	// Returns recorded matches, newest first, optionally only those of a
+	// player and of a season.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - INTERNAL (500): database failure
+	ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error)
+	// Returns a recorded match.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - INTERNAL (500): database failure
+	GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*Match, error)
+	// Returns flagged matches awaiting admin review, newest first.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ListFlaggedMatches(ctx context.Context, in *ListFlaggedMatchesRequest, opts ...grpc.CallOption) (*ListFlaggedMatchesResponse, error)
+	// Resolves the review of a flagged match as legitimate. The match stands,
+	// and its players' ratings are unfrozen unless another of their matches
+	// awaits review.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - FAILED_PRECONDITION (400): match not awaiting review, or resolved
+	//     concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ClearMatchFlags(ctx context.Context, in *ClearMatchFlagsRequest, opts ...grpc.CallOption) (*Match, error)
+	// Voids a match, flagged or not, and rolls back its rating changes and
+	// counted results. A player's earlier rating is restored exactly when no
+	// later match moved it; otherwise only the match's rating change is taken
+	// back. Voiding an already voided match retries any rollback that failed.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - FAILED_PRECONDITION (400): the match's season has ended, or the
+	//     review was resolved concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	VoidMatch(ctx context.Context, in *VoidMatchRequest, opts ...grpc.CallOption) (*Match, error)
+	// Returns a player's ELO after each of their matches in a season. Voided
+	// matches are left out.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): season not found, or no active season when
+	//     season_id is empty
+	//   - INTERNAL (500): database failure
+	GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error)
 	// Returns the current season leaderboard sorted by ELO descending.
 	//
 	// Errors:
@@ -52,22 +124,89 @@ type HungerGamesServiceClient interface {
 	ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardResponse, error)
 	// Distributes season rewards to top-N players and closes the current season.
 	//
-	// Archives the leaderboard. Does NOT create a new season.
+	// Archives the leaderboard with the rewards each player earned. Does NOT
+	// create a new season. Hands out the season's stored reward tiers unless
+	// the request supplies its own: coins are credited, kits assigned and shop
+	// items granted as free purchases; titles and badges are only archived.
+	//
+	// Runs as a persisted job: each reward is delivered once per season and
+	// player, and a reset that fails midway keeps its progress. Inspect it
+	// with GetSeasonReset and continue it with ResumeSeasonReset rather than
+	// calling ResetSeason again.
 	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid rewards
 	//   - NOT_FOUND (404): no active season
+	//   - ALREADY_EXISTS (409): the season's reset already started
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	ResetSeason(ctx context.Context, in *ResetSeasonRequest, opts ...grpc.CallOption) (*ResetSeasonResponse, error)
+	// Returns the reset job of a season.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): the season has no reset
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	GetSeasonReset(ctx context.Context, in *GetSeasonResetRequest, opts ...grpc.CallOption) (*SeasonResetJob, error)
+	// Returns season reset jobs, newest first.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ListSeasonResets(ctx context.Context, in *ListSeasonResetsRequest, opts ...grpc.CallOption) (*ListSeasonResetsResponse, error)
+	// Continues a failed season reset from the step it stopped at. A running
+	// reset can be resumed once it has made no progress for five minutes.
+	// Rewards already credited are not paid again.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): the season has no reset
+	//   - FAILED_PRECONDITION (400): reset completed or still running
+	//   - ABORTED (409): the reset was resumed concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): a step failed again; progress is kept
+	ResumeSeasonReset(ctx context.Context, in *ResumeSeasonResetRequest, opts ...grpc.CallOption) (*SeasonResetJob, error)
 	// Creates a new season. Fails if a season is already active.
 	//
+	// A season with ends_at is closed by the scheduler at that time: rewards
+	// are paid from its stored table and the next season is opened.
+	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): ends_at not in the future or invalid rewards
 	//   - ALREADY_EXISTS (409): active season already exists
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error)
+	// Schedules an upcoming season.
+	//
+	// The scheduler opens it at starts_at once no season is active, and closes
+	// it at ends_at. When the schedule is empty a closing season rolls over
+	// into one of the same length and rewards.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): window not in the future or invalid rewards
+	//   - ALREADY_EXISTS (409): overlaps the active season or another schedule
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ScheduleSeason(ctx context.Context, in *ScheduleSeasonRequest, opts ...grpc.CallOption) (*ScheduleSeasonResponse, error)
+	// Returns the upcoming scheduled seasons, earliest first.
+	//
+	// Errors:
+	//   - INTERNAL (500): database failure
+	ListSeasonSchedules(ctx context.Context, in *ListSeasonSchedulesRequest, opts ...grpc.CallOption) (*ListSeasonSchedulesResponse, error)
+	// Removes an upcoming season from the schedule.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): schedule not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	CancelSeasonSchedule(ctx context.Context, in *CancelSeasonScheduleRequest, opts ...grpc.CallOption) (*CancelSeasonScheduleResponse, error)
 	// Returns a paginated list of all seasons, newest first.
 	//
 	// Errors:
@@ -108,6 +247,66 @@ func (c *hungerGamesServiceClient) RecordMatch(ctx context.Context, in *RecordMa
 	return out, nil
 }
 
+func (c *hungerGamesServiceClient) ListMatches(ctx context.Context, in *ListMatchesRequest, opts ...grpc.CallOption) (*ListMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchesResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_ListMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) GetMatch(ctx context.Context, in *GetMatchRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, HungerGamesService_GetMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) ListFlaggedMatches(ctx context.Context, in *ListFlaggedMatchesRequest, opts ...grpc.CallOption) (*ListFlaggedMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedMatchesResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_ListFlaggedMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) ClearMatchFlags(ctx context.Context, in *ClearMatchFlagsRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, HungerGamesService_ClearMatchFlags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) VoidMatch(ctx context.Context, in *VoidMatchRequest, opts ...grpc.CallOption) (*Match, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Match)
+	err := c.cc.Invoke(ctx, HungerGamesService_VoidMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRatingHistoryResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_GetRatingHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hungerGamesServiceClient) ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLeaderboardResponse)
@@ -128,6 +327,36 @@ func (c *hungerGamesServiceClient) ResetSeason(ctx context.Context, in *ResetSea
 	return out, nil
 }
 
+func (c *hungerGamesServiceClient) GetSeasonReset(ctx context.Context, in *GetSeasonResetRequest, opts ...grpc.CallOption) (*SeasonResetJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonResetJob)
+	err := c.cc.Invoke(ctx, HungerGamesService_GetSeasonReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) ListSeasonResets(ctx context.Context, in *ListSeasonResetsRequest, opts ...grpc.CallOption) (*ListSeasonResetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonResetsResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_ListSeasonResets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) ResumeSeasonReset(ctx context.Context, in *ResumeSeasonResetRequest, opts ...grpc.CallOption) (*SeasonResetJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonResetJob)
+	err := c.cc.Invoke(ctx, HungerGamesService_ResumeSeasonReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hungerGamesServiceClient) CreateSeason(ctx context.Context, in *CreateSeasonRequest, opts ...grpc.CallOption) (*CreateSeasonResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSeasonResponse)
@@ -138,6 +367,36 @@ func (c *hungerGamesServiceClient) CreateSeason(ctx context.Context, in *CreateS
 	return out, nil
 }
 
+func (c *hungerGamesServiceClient) ScheduleSeason(ctx context.Context, in *ScheduleSeasonRequest, opts ...grpc.CallOption) (*ScheduleSeasonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleSeasonResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_ScheduleSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) ListSeasonSchedules(ctx context.Context, in *ListSeasonSchedulesRequest, opts ...grpc.CallOption) (*ListSeasonSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeasonSchedulesResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_ListSeasonSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hungerGamesServiceClient) CancelSeasonSchedule(ctx context.Context, in *CancelSeasonScheduleRequest, opts ...grpc.CallOption) (*CancelSeasonScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelSeasonScheduleResponse)
+	err := c.cc.Invoke(ctx, HungerGamesService_CancelSeasonSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *hungerGamesServiceClient) ListSeasons(ctx context.Context, in *ListSeasonsRequest, opts ...grpc.CallOption) (*ListSeasonsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSeasonsResponse)
@@ -175,16 +434,76 @@ func (c *hungerGamesServiceClient) GetPlayerStats(ctx context.Context, in *GetPl
 // HungerGamesService manages the Hunger Games game mode: ELO ratings,
 // season lifecycle, leaderboard, and reward distribution.
 type HungerGamesServiceServer interface {
-	// Records a match result and recalculates ELO for all participants.
+	// Records a match result, recalculates ELO for all participants and stores
+	// the match in the history.
 	//
-	// Requires an active season.
+	// Requires an active season. Matches the abuse heuristics find suspicious
+	// are recorded flagged for admin review; when automatic freezes are on,
+	// the ratings of their players stop moving until the review is resolved.
 	//
 	// Errors:
-	//   - INVALID_ARGUMENT (400): fewer than 2 players, duplicate places, or no active season
+	//   - INVALID_ARGUMENT (400): fewer than 2 players or teams, a missing or
+	//     repeated player id, duplicate places, or teammates with different
+	//     places
+	//   - FAILED_PRECONDITION (400): no active season
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	RecordMatch(context.Context, *RecordMatchRequest) (*RecordMatchResponse, error)
+	// Returns recorded matches, newest first, optionally only those of a
+	// player and of a season.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - INTERNAL (500): database failure
+	ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error)
+	// Returns a recorded match.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - INTERNAL (500): database failure
+	GetMatch(context.Context, *GetMatchRequest) (*Match, error)
+	// Returns flagged matches awaiting admin review, newest first.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ListFlaggedMatches(context.Context, *ListFlaggedMatchesRequest) (*ListFlaggedMatchesResponse, error)
+	// Resolves the review of a flagged match as legitimate. The match stands,
+	// and its players' ratings are unfrozen unless another of their matches
+	// awaits review.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - FAILED_PRECONDITION (400): match not awaiting review, or resolved
+	//     concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ClearMatchFlags(context.Context, *ClearMatchFlagsRequest) (*Match, error)
+	// Voids a match, flagged or not, and rolls back its rating changes and
+	// counted results. A player's earlier rating is restored exactly when no
+	// later match moved it; otherwise only the match's rating change is taken
+	// back. Voiding an already voided match retries any rollback that failed.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): match not found
+	//   - FAILED_PRECONDITION (400): the match's season has ended, or the
+	//     review was resolved concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	VoidMatch(context.Context, *VoidMatchRequest) (*Match, error)
+	// Returns a player's ELO after each of their matches in a season. Voided
+	// matches are left out.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): season not found, or no active season when
+	//     season_id is empty
+	//   - INTERNAL (500): database failure
+	GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error)
 	// Returns the current season leaderboard sorted by ELO descending.
 	//
 	// Errors:
@@ -192,22 +511,89 @@ type HungerGamesServiceServer interface {
 	ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardResponse, error)
 	// Distributes season rewards to top-N players and closes the current season.
 	//
-	// Archives the leaderboard. Does NOT create a new season.
+	// Archives the leaderboard with the rewards each player earned. Does NOT
+	// create a new season. Hands out the season's stored reward tiers unless
+	// the request supplies its own: coins are credited, kits assigned and shop
+	// items granted as free purchases; titles and badges are only archived.
+	//
+	// Runs as a persisted job: each reward is delivered once per season and
+	// player, and a reset that fails midway keeps its progress. Inspect it
+	// with GetSeasonReset and continue it with ResumeSeasonReset rather than
+	// calling ResetSeason again.
 	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid rewards
 	//   - NOT_FOUND (404): no active season
+	//   - ALREADY_EXISTS (409): the season's reset already started
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	ResetSeason(context.Context, *ResetSeasonRequest) (*ResetSeasonResponse, error)
+	// Returns the reset job of a season.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): the season has no reset
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	GetSeasonReset(context.Context, *GetSeasonResetRequest) (*SeasonResetJob, error)
+	// Returns season reset jobs, newest first.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ListSeasonResets(context.Context, *ListSeasonResetsRequest) (*ListSeasonResetsResponse, error)
+	// Continues a failed season reset from the step it stopped at. A running
+	// reset can be resumed once it has made no progress for five minutes.
+	// Rewards already credited are not paid again.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): the season has no reset
+	//   - FAILED_PRECONDITION (400): reset completed or still running
+	//   - ABORTED (409): the reset was resumed concurrently
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): a step failed again; progress is kept
+	ResumeSeasonReset(context.Context, *ResumeSeasonResetRequest) (*SeasonResetJob, error)
 	// Creates a new season. Fails if a season is already active.
 	//
+	// A season with ends_at is closed by the scheduler at that time: rewards
+	// are paid from its stored table and the next season is opened.
+	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): ends_at not in the future or invalid rewards
 	//   - ALREADY_EXISTS (409): active season already exists
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): insufficient privileges
 	//   - INTERNAL (500): database failure
 	CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error)
+	// Schedules an upcoming season.
+	//
+	// The scheduler opens it at starts_at once no season is active, and closes
+	// it at ends_at. When the schedule is empty a closing season rolls over
+	// into one of the same length and rewards.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): window not in the future or invalid rewards
+	//   - ALREADY_EXISTS (409): overlaps the active season or another schedule
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	ScheduleSeason(context.Context, *ScheduleSeasonRequest) (*ScheduleSeasonResponse, error)
+	// Returns the upcoming scheduled seasons, earliest first.
+	//
+	// Errors:
+	//   - INTERNAL (500): database failure
+	ListSeasonSchedules(context.Context, *ListSeasonSchedulesRequest) (*ListSeasonSchedulesResponse, error)
+	// Removes an upcoming season from the schedule.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): schedule not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): insufficient privileges
+	//   - INTERNAL (500): database failure
+	CancelSeasonSchedule(context.Context, *CancelSeasonScheduleRequest) (*CancelSeasonScheduleResponse, error)
 	// Returns a paginated list of all seasons, newest first.
 	//
 	// Errors:
@@ -240,15 +626,51 @@ type UnimplementedHungerGamesServiceServer struct{}
 func (UnimplementedHungerGamesServiceServer) RecordMatch(context.Context, *RecordMatchRequest) (*RecordMatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordMatch not implemented")
 }
+func (UnimplementedHungerGamesServiceServer) ListMatches(context.Context, *ListMatchesRequest) (*ListMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatches not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) GetMatch(context.Context, *GetMatchRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatch not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) ListFlaggedMatches(context.Context, *ListFlaggedMatchesRequest) (*ListFlaggedMatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedMatches not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) ClearMatchFlags(context.Context, *ClearMatchFlagsRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearMatchFlags not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) VoidMatch(context.Context, *VoidMatchRequest) (*Match, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidMatch not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingHistory not implemented")
+}
 func (UnimplementedHungerGamesServiceServer) ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboard not implemented")
 }
 func (UnimplementedHungerGamesServiceServer) ResetSeason(context.Context, *ResetSeasonRequest) (*ResetSeasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetSeason not implemented")
 }
+func (UnimplementedHungerGamesServiceServer) GetSeasonReset(context.Context, *GetSeasonResetRequest) (*SeasonResetJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonReset not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) ListSeasonResets(context.Context, *ListSeasonResetsRequest) (*ListSeasonResetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonResets not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) ResumeSeasonReset(context.Context, *ResumeSeasonResetRequest) (*SeasonResetJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSeasonReset not implemented")
+}
 func (UnimplementedHungerGamesServiceServer) CreateSeason(context.Context, *CreateSeasonRequest) (*CreateSeasonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSeason not implemented")
 }
+func (UnimplementedHungerGamesServiceServer) ScheduleSeason(context.Context, *ScheduleSeasonRequest) (*ScheduleSeasonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSeason not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) ListSeasonSchedules(context.Context, *ListSeasonSchedulesRequest) (*ListSeasonSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSeasonSchedules not implemented")
+}
+func (UnimplementedHungerGamesServiceServer) CancelSeasonSchedule(context.Context, *CancelSeasonScheduleRequest) (*CancelSeasonScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSeasonSchedule not implemented")
+}
 func (UnimplementedHungerGamesServiceServer) ListSeasons(context.Context, *ListSeasonsRequest) (*ListSeasonsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSeasons not implemented")
 }
@@ -296,6 +718,114 @@ func _HungerGamesService_RecordMatch_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HungerGamesService_ListMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ListMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ListMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ListMatches(ctx, req.(*ListMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_GetMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).GetMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_GetMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).GetMatch(ctx, req.(*GetMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_ListFlaggedMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ListFlaggedMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ListFlaggedMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ListFlaggedMatches(ctx, req.(*ListFlaggedMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_ClearMatchFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearMatchFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ClearMatchFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ClearMatchFlags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ClearMatchFlags(ctx, req.(*ClearMatchFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_VoidMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).VoidMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_VoidMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).VoidMatch(ctx, req.(*VoidMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_GetRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).GetRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_GetRatingHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).GetRatingHistory(ctx, req.(*GetRatingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HungerGamesService_ListLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListLeaderboardRequest)
 	if err := dec(in); err != nil {
@@ -332,6 +862,60 @@ func _HungerGamesService_ResetSeason_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HungerGamesService_GetSeasonReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).GetSeasonReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_GetSeasonReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).GetSeasonReset(ctx, req.(*GetSeasonResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_ListSeasonResets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonResetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ListSeasonResets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ListSeasonResets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ListSeasonResets(ctx, req.(*ListSeasonResetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_ResumeSeasonReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSeasonResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ResumeSeasonReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ResumeSeasonReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ResumeSeasonReset(ctx, req.(*ResumeSeasonResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HungerGamesService_CreateSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSeasonRequest)
 	if err := dec(in); err != nil {
@@ -350,6 +934,60 @@ func _HungerGamesService_CreateSeason_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HungerGamesService_ScheduleSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ScheduleSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ScheduleSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ScheduleSeason(ctx, req.(*ScheduleSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_ListSeasonSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeasonSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).ListSeasonSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_ListSeasonSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).ListSeasonSchedules(ctx, req.(*ListSeasonSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HungerGamesService_CancelSeasonSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSeasonScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HungerGamesServiceServer).CancelSeasonSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HungerGamesService_CancelSeasonSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HungerGamesServiceServer).CancelSeasonSchedule(ctx, req.(*CancelSeasonScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _HungerGamesService_ListSeasons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSeasonsRequest)
 	if err := dec(in); err != nil {
@@ -415,6 +1053,30 @@ var HungerGamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordMatch",
 			Handler:    _HungerGamesService_RecordMatch_Handler,
 		},
+		{
+			MethodName: "ListMatches",
+			Handler:    _HungerGamesService_ListMatches_Handler,
+		},
+		{
+			MethodName: "GetMatch",
+			Handler:    _HungerGamesService_GetMatch_Handler,
+		},
+		{
+			MethodName: "ListFlaggedMatches",
+			Handler:    _HungerGamesService_ListFlaggedMatches_Handler,
+		},
+		{
+			MethodName: "ClearMatchFlags",
+			Handler:    _HungerGamesService_ClearMatchFlags_Handler,
+		},
+		{
+			MethodName: "VoidMatch",
+			Handler:    _HungerGamesService_VoidMatch_Handler,
+		},
+		{
+			MethodName: "GetRatingHistory",
+			Handler:    _HungerGamesService_GetRatingHistory_Handler,
+		},
 		{
 			MethodName: "ListLeaderboard",
 			Handler:    _HungerGamesService_ListLeaderboard_Handler,
@@ -423,10 +1085,34 @@ var HungerGamesService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetSeason",
 			Handler:    _HungerGamesService_ResetSeason_Handler,
 		},
+		{
+			MethodName: "GetSeasonReset",
+			Handler:    _HungerGamesService_GetSeasonReset_Handler,
+		},
+		{
+			MethodName: "ListSeasonResets",
+			Handler:    _HungerGamesService_ListSeasonResets_Handler,
+		},
+		{
+			MethodName: "ResumeSeasonReset",
+			Handler:    _HungerGamesService_ResumeSeasonReset_Handler,
+		},
 		{
 			MethodName: "CreateSeason",
 			Handler:    _HungerGamesService_CreateSeason_Handler,
 		},
+		{
+			MethodName: "ScheduleSeason",
+			Handler:    _HungerGamesService_ScheduleSeason_Handler,
+		},
+		{
+			MethodName: "ListSeasonSchedules",
+			Handler:    _HungerGamesService_ListSeasonSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSeasonSchedule",
+			Handler:    _HungerGamesService_CancelSeasonSchedule_Handler,
+		},
 		{
 			MethodName: "ListSeasons",
 			Handler:    _HungerGamesService_ListSeasons_Handler,
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: hungergames/v1/leaderboard.proto
 
@@ -22,13 +22,22 @@ const (
 )
 
 type LeaderboardEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	Elo           int32                  `protobuf:"varint,3,opt,name=elo,proto3" json:"elo,omitempty"`
-	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
-	Kills         int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
-	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId   string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Elo        int32                  `protobuf:"varint,3,opt,name=elo,proto3" json:"elo,omitempty"`
+	Wins       int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Kills      int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	Rank       int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	// A rating the player is very likely above under the season's rating
+	// system: ELO as is, Glicko-2 rating minus two deviations, OpenSkill
+	// μ minus three σ. Ranks newcomers below proven players.
+	ConservativeRating int32 `protobuf:"varint,7,opt,name=conservative_rating,json=conservativeRating,proto3" json:"conservative_rating,omitempty"`
+	// Wins in team matches; wins counts solo wins only.
+	TeamWins int32 `protobuf:"varint,8,opt,name=team_wins,json=teamWins,proto3" json:"team_wins,omitempty"`
+	// The rating does not move while a match of the player awaits abuse
+	// review.
+	RatingFrozen  bool `protobuf:"varint,9,opt,name=rating_frozen,json=ratingFrozen,proto3" json:"rating_frozen,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -105,10 +114,35 @@ func (x *LeaderboardEntry) GetRank() int32 {
 	return 0
 }
 
+func (x *LeaderboardEntry) GetConservativeRating() int32 {
+	if x != nil {
+		return x.ConservativeRating
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetTeamWins() int32 {
+	if x != nil {
+		return x.TeamWins
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetRatingFrozen() bool {
+	if x != nil {
+		return x.RatingFrozen
+	}
+	return false
+}
+
 type ListLeaderboardRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Maximum number of entries. Defaults to 25.
-	Limit         int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Rank by conservative rating instead of ELO.
+	Conservative bool `protobuf:"varint,2,opt,name=conservative,proto3" json:"conservative,omitempty"`
+	// Only players who played a match in the mode; everyone when unspecified.
+	Mode          MatchMode `protobuf:"varint,3,opt,name=mode,proto3,enum=hungergames.v1.MatchMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -150,6 +184,20 @@ func (x *ListLeaderboardRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListLeaderboardRequest) GetConservative() bool {
+	if x != nil {
+		return x.Conservative
+	}
+	return false
+}
+
+func (x *ListLeaderboardRequest) GetMode() MatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
 type ListLeaderboardResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Entries       []*LeaderboardEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
@@ -196,43 +244,26 @@ func (x *ListLeaderboardResponse) GetEntries() []*LeaderboardEntry {
 
 var File_hungergames_v1_leaderboard_proto protoreflect.FileDescriptor
 
-var file_hungergames_v1_leaderboard_proto_rawDesc = string([]byte{
-	0x0a, 0x20, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6c, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x65, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6b,
-	0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0x2e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x55, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x42, 0xc1, 0x01, 0x0a,
-	0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x2e, 0x76, 0x31, 0x42, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f, 0x76,
-	0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x68, 0x75, 0x6e,
-	0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x75, 0x6e, 0x67,
-	0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa,
-	0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x1a, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0f, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_hungergames_v1_leaderboard_proto_rawDesc = "" +
+	"\n" +
+	" hungergames/v1/leaderboard.proto\x12\x0ehungergames.v1\x1a\x1ahungergames/v1/match.proto\"\x93\x02\n" +
+	"\x10LeaderboardEntry\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x10\n" +
+	"\x03elo\x18\x03 \x01(\x05R\x03elo\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x14\n" +
+	"\x05kills\x18\x05 \x01(\x05R\x05kills\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12/\n" +
+	"\x13conservative_rating\x18\a \x01(\x05R\x12conservativeRating\x12\x1b\n" +
+	"\tteam_wins\x18\b \x01(\x05R\bteamWins\x12#\n" +
+	"\rrating_frozen\x18\t \x01(\bR\fratingFrozen\"\x81\x01\n" +
+	"\x16ListLeaderboardRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\"\n" +
+	"\fconservative\x18\x02 \x01(\bR\fconservative\x12-\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x19.hungergames.v1.MatchModeR\x04mode\"U\n" +
+	"\x17ListLeaderboardResponse\x12:\n" +
+	"\aentries\x18\x01 \x03(\v2 .hungergames.v1.LeaderboardEntryR\aentriesBBZ@github.com/lasthearth/vsservice/gen/hungergames/v1;hungergamesv1b\x06proto3"
 
 var (
 	file_hungergames_v1_leaderboard_proto_rawDescOnce sync.Once
@@ -251,14 +282,16 @@ var file_hungergames_v1_leaderboard_proto_goTypes = []any{
 	(*LeaderboardEntry)(nil),        // 0: hungergames.v1.LeaderboardEntry
 	(*ListLeaderboardRequest)(nil),  // 1: hungergames.v1.ListLeaderboardRequest
 	(*ListLeaderboardResponse)(nil), // 2: hungergames.v1.ListLeaderboardResponse
+	(MatchMode)(0),                  // 3: hungergames.v1.MatchMode
 }
 var file_hungergames_v1_leaderboard_proto_depIdxs = []int32{
-	0, // 0: hungergames.v1.ListLeaderboardResponse.entries:type_name -> hungergames.v1.LeaderboardEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: hungergames.v1.ListLeaderboardRequest.mode:type_name -> hungergames.v1.MatchMode
+	0, // 1: hungergames.v1.ListLeaderboardResponse.entries:type_name -> hungergames.v1.LeaderboardEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_hungergames_v1_leaderboard_proto_init() }
@@ -266,6 +299,7 @@ func file_hungergames_v1_leaderboard_proto_init() {
 	if File_hungergames_v1_leaderboard_proto != nil {
 		return
 	}
+	file_hungergames_v1_match_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: hungergames/v1/match.proto
 
@@ -10,6 +10,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,15 +24,183 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MatchMode is how players compete in a match.
+type MatchMode int32
+
+const (
+	MatchMode_MATCH_MODE_UNSPECIFIED MatchMode = 0
+	// Free-for-all: every player has a place of their own.
+	MatchMode_MATCH_MODE_SOLO MatchMode = 1
+	// Players play in teams; teammates share their team's place.
+	MatchMode_MATCH_MODE_TEAM MatchMode = 2
+)
+
+// Enum value maps for MatchMode.
+var (
+	MatchMode_name = map[int32]string{
+		0: "MATCH_MODE_UNSPECIFIED",
+		1: "MATCH_MODE_SOLO",
+		2: "MATCH_MODE_TEAM",
+	}
+	MatchMode_value = map[string]int32{
+		"MATCH_MODE_UNSPECIFIED": 0,
+		"MATCH_MODE_SOLO":        1,
+		"MATCH_MODE_TEAM":        2,
+	}
+)
+
+func (x MatchMode) Enum() *MatchMode {
+	p := new(MatchMode)
+	*p = x
+	return p
+}
+
+func (x MatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_match_proto_enumTypes[0].Descriptor()
+}
+
+func (MatchMode) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_match_proto_enumTypes[0]
+}
+
+func (x MatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchMode.Descriptor instead.
+func (MatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{0}
+}
+
+// AbuseFlag names a heuristic that found a match suspicious of rating
+// farming.
+type AbuseFlag int32
+
+const (
+	AbuseFlag_ABUSE_FLAG_UNSPECIFIED AbuseFlag = 0
+	// The same small group of players keeps playing each other.
+	AbuseFlag_ABUSE_FLAG_REPEATED_GROUP AbuseFlag = 1
+	// The match ended implausibly fast.
+	AbuseFlag_ABUSE_FLAG_SHORT_MATCH AbuseFlag = 2
+	// A player keeps beating the same opponent in small matches.
+	AbuseFlag_ABUSE_FLAG_WIN_STREAK AbuseFlag = 3
+)
+
+// Enum value maps for AbuseFlag.
+var (
+	AbuseFlag_name = map[int32]string{
+		0: "ABUSE_FLAG_UNSPECIFIED",
+		1: "ABUSE_FLAG_REPEATED_GROUP",
+		2: "ABUSE_FLAG_SHORT_MATCH",
+		3: "ABUSE_FLAG_WIN_STREAK",
+	}
+	AbuseFlag_value = map[string]int32{
+		"ABUSE_FLAG_UNSPECIFIED":    0,
+		"ABUSE_FLAG_REPEATED_GROUP": 1,
+		"ABUSE_FLAG_SHORT_MATCH":    2,
+		"ABUSE_FLAG_WIN_STREAK":     3,
+	}
+)
+
+func (x AbuseFlag) Enum() *AbuseFlag {
+	p := new(AbuseFlag)
+	*p = x
+	return p
+}
+
+func (x AbuseFlag) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AbuseFlag) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_match_proto_enumTypes[1].Descriptor()
+}
+
+func (AbuseFlag) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_match_proto_enumTypes[1]
+}
+
+func (x AbuseFlag) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AbuseFlag.Descriptor instead.
+func (AbuseFlag) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{1}
+}
+
+// MatchReviewStatus is where the admin review of a match stands.
+type MatchReviewStatus int32
+
+const (
+	// Not flagged; nothing to review.
+	MatchReviewStatus_MATCH_REVIEW_STATUS_UNSPECIFIED MatchReviewStatus = 0
+	// Flagged and awaiting an admin.
+	MatchReviewStatus_MATCH_REVIEW_STATUS_PENDING MatchReviewStatus = 1
+	// Found legitimate; the match stands.
+	MatchReviewStatus_MATCH_REVIEW_STATUS_CLEARED MatchReviewStatus = 2
+	// Voided; its rating changes were rolled back.
+	MatchReviewStatus_MATCH_REVIEW_STATUS_VOIDED MatchReviewStatus = 3
+)
+
+// Enum value maps for MatchReviewStatus.
+var (
+	MatchReviewStatus_name = map[int32]string{
+		0: "MATCH_REVIEW_STATUS_UNSPECIFIED",
+		1: "MATCH_REVIEW_STATUS_PENDING",
+		2: "MATCH_REVIEW_STATUS_CLEARED",
+		3: "MATCH_REVIEW_STATUS_VOIDED",
+	}
+	MatchReviewStatus_value = map[string]int32{
+		"MATCH_REVIEW_STATUS_UNSPECIFIED": 0,
+		"MATCH_REVIEW_STATUS_PENDING":     1,
+		"MATCH_REVIEW_STATUS_CLEARED":     2,
+		"MATCH_REVIEW_STATUS_VOIDED":      3,
+	}
+)
+
+func (x MatchReviewStatus) Enum() *MatchReviewStatus {
+	p := new(MatchReviewStatus)
+	*p = x
+	return p
+}
+
+func (x MatchReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MatchReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_match_proto_enumTypes[2].Descriptor()
+}
+
+func (MatchReviewStatus) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_match_proto_enumTypes[2]
+}
+
+func (x MatchReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MatchReviewStatus.Descriptor instead.
+func (MatchReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{2}
+}
+
 // PlayerMatchResult represents a single player's result in a match.
 type PlayerMatchResult struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId   string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	PlayerName string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	// 1-based placement; 1 = winner
+	// 1-based placement; 1 = winner. In team matches, the team's place.
 	Place int32 `protobuf:"varint,3,opt,name=place,proto3" json:"place,omitempty"`
 	// Kills scored during the match
-	Kills         int32 `protobuf:"varint,4,opt,name=kills,proto3" json:"kills,omitempty"`
+	Kills int32 `protobuf:"varint,4,opt,name=kills,proto3" json:"kills,omitempty"`
+	// The player's team; required in team matches, empty in solo.
+	TeamId        string `protobuf:"bytes,5,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -93,9 +263,20 @@ func (x *PlayerMatchResult) GetKills() int32 {
 	return 0
 }
 
+func (x *PlayerMatchResult) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
 type RecordMatchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Players       []*PlayerMatchResult   `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Players []*PlayerMatchResult   `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	// Solo when unspecified.
+	Mode MatchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=hungergames.v1.MatchMode" json:"mode,omitempty"`
+	// How long the match lasted; checked by the abuse heuristics when set.
+	Duration      *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -137,8 +318,23 @@ func (x *RecordMatchRequest) GetPlayers() []*PlayerMatchResult {
 	return nil
 }
 
+func (x *RecordMatchRequest) GetMode() MatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *RecordMatchRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
 type RecordMatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -173,44 +369,890 @@ func (*RecordMatchResponse) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{2}
 }
 
+func (x *RecordMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// MatchParticipant is a player's result in a recorded match and how it
+// moved their rating.
+type MatchParticipant struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId   string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Place      int32                  `protobuf:"varint,3,opt,name=place,proto3" json:"place,omitempty"`
+	Kills      int32                  `protobuf:"varint,4,opt,name=kills,proto3" json:"kills,omitempty"`
+	EloBefore  int32                  `protobuf:"varint,5,opt,name=elo_before,json=eloBefore,proto3" json:"elo_before,omitempty"`
+	EloAfter   int32                  `protobuf:"varint,6,opt,name=elo_after,json=eloAfter,proto3" json:"elo_after,omitempty"`
+	// Empty in solo matches.
+	TeamId        string `protobuf:"bytes,7,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchParticipant) Reset() {
+	*x = MatchParticipant{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchParticipant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchParticipant) ProtoMessage() {}
+
+func (x *MatchParticipant) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchParticipant.ProtoReflect.Descriptor instead.
+func (*MatchParticipant) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MatchParticipant) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MatchParticipant) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *MatchParticipant) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *MatchParticipant) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *MatchParticipant) GetEloBefore() int32 {
+	if x != nil {
+		return x.EloBefore
+	}
+	return 0
+}
+
+func (x *MatchParticipant) GetEloAfter() int32 {
+	if x != nil {
+		return x.EloAfter
+	}
+	return 0
+}
+
+func (x *MatchParticipant) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
+	}
+	return ""
+}
+
+// Match is a recorded match.
+type Match struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SeasonId string                 `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	PlayedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	// Ordered by place.
+	Participants []*MatchParticipant `protobuf:"bytes,4,rep,name=participants,proto3" json:"participants,omitempty"`
+	Mode         MatchMode           `protobuf:"varint,5,opt,name=mode,proto3,enum=hungergames.v1.MatchMode" json:"mode,omitempty"`
+	// Absent when the game server did not report it.
+	Duration *durationpb.Duration `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Abuse heuristics the match tripped when it was recorded. This and the
+	// review fields below are returned only by RecordMatch and the review RPCs.
+	Flags  []AbuseFlag       `protobuf:"varint,7,rep,packed,name=flags,proto3,enum=hungergames.v1.AbuseFlag" json:"flags,omitempty"`
+	Review MatchReviewStatus `protobuf:"varint,8,opt,name=review,proto3,enum=hungergames.v1.MatchReviewStatus" json:"review,omitempty"`
+	// Admin who cleared or voided the match.
+	ReviewedBy    string                 `protobuf:"bytes,9,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	VoidReason    string                 `protobuf:"bytes,11,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Match) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Match) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *Match) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+func (x *Match) GetParticipants() []*MatchParticipant {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *Match) GetMode() MatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MatchMode_MATCH_MODE_UNSPECIFIED
+}
+
+func (x *Match) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Match) GetFlags() []AbuseFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Match) GetReview() MatchReviewStatus {
+	if x != nil {
+		return x.Review
+	}
+	return MatchReviewStatus_MATCH_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *Match) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *Match) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *Match) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+type ListMatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only matches the player took part in.
+	PlayerId string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Only matches of the season.
+	SeasonId string `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Cursor from a previous response for pagination.
+	Next string `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+	// Maximum number of matches. Defaults to 25.
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesRequest) Reset() {
+	*x = ListMatchesRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesRequest) ProtoMessage() {}
+
+func (x *ListMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListMatchesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListMatchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Cursor for the next page; empty when no more results.
+	Next          string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchesResponse) Reset() {
+	*x = ListMatchesResponse{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchesResponse) ProtoMessage() {}
+
+func (x *ListMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ListMatchesResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetRatingHistoryRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Season to read; the active season when empty.
+	SeasonId      string `protobuf:"bytes,2,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingHistoryRequest) Reset() {
+	*x = GetRatingHistoryRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistoryRequest) ProtoMessage() {}
+
+func (x *GetRatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetRatingHistoryRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetRatingHistoryRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+// RatingPoint is a player's ELO right after a match.
+type RatingPoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	PlayedAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	Elo           int32                  `protobuf:"varint,3,opt,name=elo,proto3" json:"elo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RatingPoint) Reset() {
+	*x = RatingPoint{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingPoint) ProtoMessage() {}
+
+func (x *RatingPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingPoint.ProtoReflect.Descriptor instead.
+func (*RatingPoint) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RatingPoint) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *RatingPoint) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+func (x *RatingPoint) GetElo() int32 {
+	if x != nil {
+		return x.Elo
+	}
+	return 0
+}
+
+type GetRatingHistoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// The ELO the player started the season with.
+	InitialElo int32 `protobuf:"varint,2,opt,name=initial_elo,json=initialElo,proto3" json:"initial_elo,omitempty"`
+	// Oldest first.
+	Points        []*RatingPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingHistoryResponse) Reset() {
+	*x = GetRatingHistoryResponse{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistoryResponse) ProtoMessage() {}
+
+func (x *GetRatingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRatingHistoryResponse) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *GetRatingHistoryResponse) GetInitialElo() int32 {
+	if x != nil {
+		return x.InitialElo
+	}
+	return 0
+}
+
+func (x *GetRatingHistoryResponse) GetPoints() []*RatingPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type ListFlaggedMatchesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only matches of the season.
+	SeasonId string `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	// Cursor from a previous response for pagination.
+	Next string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	// Maximum number of matches. Defaults to 25.
+	Limit         int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedMatchesRequest) Reset() {
+	*x = ListFlaggedMatchesRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedMatchesRequest) ProtoMessage() {}
+
+func (x *ListFlaggedMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListFlaggedMatchesRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+func (x *ListFlaggedMatchesRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListFlaggedMatchesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFlaggedMatchesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Matches []*Match `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	// Cursor for the next page; empty when no more results.
+	Next          string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedMatchesResponse) Reset() {
+	*x = ListFlaggedMatchesResponse{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedMatchesResponse) ProtoMessage() {}
+
+func (x *ListFlaggedMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListFlaggedMatchesResponse) GetMatches() []*Match {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *ListFlaggedMatchesResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type ClearMatchFlagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearMatchFlagsRequest) Reset() {
+	*x = ClearMatchFlagsRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearMatchFlagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearMatchFlagsRequest) ProtoMessage() {}
+
+func (x *ClearMatchFlagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearMatchFlagsRequest.ProtoReflect.Descriptor instead.
+func (*ClearMatchFlagsRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClearMatchFlagsRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type VoidMatchRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Why the match was voided, kept on the match.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidMatchRequest) Reset() {
+	*x = VoidMatchRequest{}
+	mi := &file_hungergames_v1_match_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidMatchRequest) ProtoMessage() {}
+
+func (x *VoidMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_match_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidMatchRequest.ProtoReflect.Descriptor instead.
+func (*VoidMatchRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_match_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VoidMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *VoidMatchRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_hungergames_v1_match_proto protoreflect.FileDescriptor
 
-var file_hungergames_v1_match_proto_rawDesc = string([]byte{
-	0x0a, 0x1a, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68, 0x75,
-	0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62,
-	0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01,
-	0x0a, 0x11, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x12,
-	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4d, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x4d, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbb, 0x01, 0x0a, 0x12,
-	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x73,
-	0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f, 0x76, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65,
-	0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67,
-	0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x48, 0x75, 0x6e,
-	0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-})
+const file_hungergames_v1_match_proto_rawDesc = "" +
+	"\n" +
+	"\x1ahungergames/v1/match.proto\x12\x0ehungergames.v1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\x11PlayerMatchResult\x12 \n" +
+	"\tplayer_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bplayerId\x12$\n" +
+	"\vplayer_name\x18\x02 \x01(\tB\x03\xe0A\x02R\n" +
+	"playerName\x12\x19\n" +
+	"\x05place\x18\x03 \x01(\x05B\x03\xe0A\x02R\x05place\x12\x14\n" +
+	"\x05kills\x18\x04 \x01(\x05R\x05kills\x12\x17\n" +
+	"\ateam_id\x18\x05 \x01(\tR\x06teamId\"\xbc\x01\n" +
+	"\x12RecordMatchRequest\x12@\n" +
+	"\aplayers\x18\x01 \x03(\v2!.hungergames.v1.PlayerMatchResultB\x03\xe0A\x02R\aplayers\x12-\n" +
+	"\x04mode\x18\x02 \x01(\x0e2\x19.hungergames.v1.MatchModeR\x04mode\x125\n" +
+	"\bduration\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bduration\"B\n" +
+	"\x13RecordMatchResponse\x12+\n" +
+	"\x05match\x18\x01 \x01(\v2\x15.hungergames.v1.MatchR\x05match\"\xd1\x01\n" +
+	"\x10MatchParticipant\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05place\x18\x03 \x01(\x05R\x05place\x12\x14\n" +
+	"\x05kills\x18\x04 \x01(\x05R\x05kills\x12\x1d\n" +
+	"\n" +
+	"elo_before\x18\x05 \x01(\x05R\teloBefore\x12\x1b\n" +
+	"\telo_after\x18\x06 \x01(\x05R\beloAfter\x12\x17\n" +
+	"\ateam_id\x18\a \x01(\tR\x06teamId\"\x84\x04\n" +
+	"\x05Match\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x127\n" +
+	"\tplayed_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bplayedAt\x12D\n" +
+	"\fparticipants\x18\x04 \x03(\v2 .hungergames.v1.MatchParticipantR\fparticipants\x12-\n" +
+	"\x04mode\x18\x05 \x01(\x0e2\x19.hungergames.v1.MatchModeR\x04mode\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12/\n" +
+	"\x05flags\x18\a \x03(\x0e2\x19.hungergames.v1.AbuseFlagR\x05flags\x129\n" +
+	"\x06review\x18\b \x01(\x0e2!.hungergames.v1.MatchReviewStatusR\x06review\x12\x1f\n" +
+	"\vreviewed_by\x18\t \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vvoid_reason\x18\v \x01(\tR\n" +
+	"voidReason\"x\n" +
+	"\x12ListMatchesRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\x12\x12\n" +
+	"\x04next\x18\x03 \x01(\tR\x04next\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"Z\n" +
+	"\x13ListMatchesResponse\x12/\n" +
+	"\amatches\x18\x01 \x03(\v2\x15.hungergames.v1.MatchR\amatches\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\tR\x04next\"1\n" +
+	"\x0fGetMatchRequest\x12\x1e\n" +
+	"\bmatch_id\x18\x01 \x01(\tB\x03\xe0A\x02R\amatchId\"X\n" +
+	"\x17GetRatingHistoryRequest\x12 \n" +
+	"\tplayer_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bplayerId\x12\x1b\n" +
+	"\tseason_id\x18\x02 \x01(\tR\bseasonId\"s\n" +
+	"\vRatingPoint\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x127\n" +
+	"\tplayed_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bplayedAt\x12\x10\n" +
+	"\x03elo\x18\x03 \x01(\x05R\x03elo\"\x8d\x01\n" +
+	"\x18GetRatingHistoryResponse\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x1f\n" +
+	"\vinitial_elo\x18\x02 \x01(\x05R\n" +
+	"initialElo\x123\n" +
+	"\x06points\x18\x03 \x03(\v2\x1b.hungergames.v1.RatingPointR\x06points\"b\n" +
+	"\x19ListFlaggedMatchesRequest\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\tR\x04next\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"a\n" +
+	"\x1aListFlaggedMatchesResponse\x12/\n" +
+	"\amatches\x18\x01 \x03(\v2\x15.hungergames.v1.MatchR\amatches\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\tR\x04next\"8\n" +
+	"\x16ClearMatchFlagsRequest\x12\x1e\n" +
+	"\bmatch_id\x18\x01 \x01(\tB\x03\xe0A\x02R\amatchId\"J\n" +
+	"\x10VoidMatchRequest\x12\x1e\n" +
+	"\bmatch_id\x18\x01 \x01(\tB\x03\xe0A\x02R\amatchId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason*Q\n" +
+	"\tMatchMode\x12\x1a\n" +
+	"\x16MATCH_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fMATCH_MODE_SOLO\x10\x01\x12\x13\n" +
+	"\x0fMATCH_MODE_TEAM\x10\x02*}\n" +
+	"\tAbuseFlag\x12\x1a\n" +
+	"\x16ABUSE_FLAG_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19ABUSE_FLAG_REPEATED_GROUP\x10\x01\x12\x1a\n" +
+	"\x16ABUSE_FLAG_SHORT_MATCH\x10\x02\x12\x19\n" +
+	"\x15ABUSE_FLAG_WIN_STREAK\x10\x03*\x9a\x01\n" +
+	"\x11MatchReviewStatus\x12#\n" +
+	"\x1fMATCH_REVIEW_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bMATCH_REVIEW_STATUS_PENDING\x10\x01\x12\x1f\n" +
+	"\x1bMATCH_REVIEW_STATUS_CLEARED\x10\x02\x12\x1e\n" +
+	"\x1aMATCH_REVIEW_STATUS_VOIDED\x10\x03BBZ@github.com/lasthearth/vsservice/gen/hungergames/v1;hungergamesv1b\x06proto3"
 
 var (
 	file_hungergames_v1_match_proto_rawDescOnce sync.Once
@@ -224,19 +1266,51 @@ func file_hungergames_v1_match_proto_rawDescGZIP() []byte {
 	return file_hungergames_v1_match_proto_rawDescData
 }
 
-var file_hungergames_v1_match_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hungergames_v1_match_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_hungergames_v1_match_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_hungergames_v1_match_proto_goTypes = []any{
-	(*PlayerMatchResult)(nil),   // 0: hungergames.v1.PlayerMatchResult
-	(*RecordMatchRequest)(nil),  // 1: hungergames.v1.RecordMatchRequest
-	(*RecordMatchResponse)(nil), // 2: hungergames.v1.RecordMatchResponse
+	(MatchMode)(0),                     // 0: hungergames.v1.MatchMode
+	(AbuseFlag)(0),                     // 1: hungergames.v1.AbuseFlag
+	(MatchReviewStatus)(0),             // 2: hungergames.v1.MatchReviewStatus
+	(*PlayerMatchResult)(nil),          // 3: hungergames.v1.PlayerMatchResult
+	(*RecordMatchRequest)(nil),         // 4: hungergames.v1.RecordMatchRequest
+	(*RecordMatchResponse)(nil),        // 5: hungergames.v1.RecordMatchResponse
+	(*MatchParticipant)(nil),           // 6: hungergames.v1.MatchParticipant
+	(*Match)(nil),                      // 7: hungergames.v1.Match
+	(*ListMatchesRequest)(nil),         // 8: hungergames.v1.ListMatchesRequest
+	(*ListMatchesResponse)(nil),        // 9: hungergames.v1.ListMatchesResponse
+	(*GetMatchRequest)(nil),            // 10: hungergames.v1.GetMatchRequest
+	(*GetRatingHistoryRequest)(nil),    // 11: hungergames.v1.GetRatingHistoryRequest
+	(*RatingPoint)(nil),                // 12: hungergames.v1.RatingPoint
+	(*GetRatingHistoryResponse)(nil),   // 13: hungergames.v1.GetRatingHistoryResponse
+	(*ListFlaggedMatchesRequest)(nil),  // 14: hungergames.v1.ListFlaggedMatchesRequest
+	(*ListFlaggedMatchesResponse)(nil), // 15: hungergames.v1.ListFlaggedMatchesResponse
+	(*ClearMatchFlagsRequest)(nil),     // 16: hungergames.v1.ClearMatchFlagsRequest
+	(*VoidMatchRequest)(nil),           // 17: hungergames.v1.VoidMatchRequest
+	(*durationpb.Duration)(nil),        // 18: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
 }
 var file_hungergames_v1_match_proto_depIdxs = []int32{
-	0, // 0: hungergames.v1.RecordMatchRequest.players:type_name -> hungergames.v1.PlayerMatchResult
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: hungergames.v1.RecordMatchRequest.players:type_name -> hungergames.v1.PlayerMatchResult
+	0,  // 1: hungergames.v1.RecordMatchRequest.mode:type_name -> hungergames.v1.MatchMode
+	18, // 2: hungergames.v1.RecordMatchRequest.duration:type_name -> google.protobuf.Duration
+	7,  // 3: hungergames.v1.RecordMatchResponse.match:type_name -> hungergames.v1.Match
+	19, // 4: hungergames.v1.Match.played_at:type_name -> google.protobuf.Timestamp
+	6,  // 5: hungergames.v1.Match.participants:type_name -> hungergames.v1.MatchParticipant
+	0,  // 6: hungergames.v1.Match.mode:type_name -> hungergames.v1.MatchMode
+	18, // 7: hungergames.v1.Match.duration:type_name -> google.protobuf.Duration
+	1,  // 8: hungergames.v1.Match.flags:type_name -> hungergames.v1.AbuseFlag
+	2,  // 9: hungergames.v1.Match.review:type_name -> hungergames.v1.MatchReviewStatus
+	19, // 10: hungergames.v1.Match.reviewed_at:type_name -> google.protobuf.Timestamp
+	7,  // 11: hungergames.v1.ListMatchesResponse.matches:type_name -> hungergames.v1.Match
+	19, // 12: hungergames.v1.RatingPoint.played_at:type_name -> google.protobuf.Timestamp
+	12, // 13: hungergames.v1.GetRatingHistoryResponse.points:type_name -> hungergames.v1.RatingPoint
+	7,  // 14: hungergames.v1.ListFlaggedMatchesResponse.matches:type_name -> hungergames.v1.Match
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_hungergames_v1_match_proto_init() }
@@ -249,13 +1323,14 @@ func file_hungergames_v1_match_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hungergames_v1_match_proto_rawDesc), len(file_hungergames_v1_match_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_hungergames_v1_match_proto_goTypes,
 		DependencyIndexes: file_hungergames_v1_match_proto_depIdxs,
+		EnumInfos:         file_hungergames_v1_match_proto_enumTypes,
 		MessageInfos:      file_hungergames_v1_match_proto_msgTypes,
 	}.Build()
 	File_hungergames_v1_match_proto = out.File
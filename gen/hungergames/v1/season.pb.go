@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: hungergames/v1/season.proto
 
@@ -23,13 +23,256 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RatingSystem is the algorithm that rates a season's matches.
+type RatingSystem int32
+
+const (
+	// Treated as ELO.
+	RatingSystem_RATING_SYSTEM_UNSPECIFIED RatingSystem = 0
+	// Pairwise ELO, normalised by lobby size.
+	RatingSystem_RATING_SYSTEM_ELO RatingSystem = 1
+	// Glicko-2: rating, rating deviation and volatility.
+	RatingSystem_RATING_SYSTEM_GLICKO2 RatingSystem = 2
+	// OpenSkill Plackett-Luce, built for free-for-all lobbies.
+	RatingSystem_RATING_SYSTEM_OPENSKILL RatingSystem = 3
+)
+
+// Enum value maps for RatingSystem.
+var (
+	RatingSystem_name = map[int32]string{
+		0: "RATING_SYSTEM_UNSPECIFIED",
+		1: "RATING_SYSTEM_ELO",
+		2: "RATING_SYSTEM_GLICKO2",
+		3: "RATING_SYSTEM_OPENSKILL",
+	}
+	RatingSystem_value = map[string]int32{
+		"RATING_SYSTEM_UNSPECIFIED": 0,
+		"RATING_SYSTEM_ELO":         1,
+		"RATING_SYSTEM_GLICKO2":     2,
+		"RATING_SYSTEM_OPENSKILL":   3,
+	}
+)
+
+func (x RatingSystem) Enum() *RatingSystem {
+	p := new(RatingSystem)
+	*p = x
+	return p
+}
+
+func (x RatingSystem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RatingSystem) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_season_proto_enumTypes[0].Descriptor()
+}
+
+func (RatingSystem) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_season_proto_enumTypes[0]
+}
+
+func (x RatingSystem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RatingSystem.Descriptor instead.
+func (RatingSystem) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{0}
+}
+
+// RewardKind is what a season reward hands out.
+type RewardKind int32
+
+const (
+	RewardKind_REWARD_KIND_UNSPECIFIED RewardKind = 0
+	// Coins credited to the player's wallet.
+	RewardKind_REWARD_KIND_COINS RewardKind = 1
+	// A kit assigned through the kit service; ref is the kit name.
+	RewardKind_REWARD_KIND_KIT RewardKind = 2
+	// A shop item granted as a free purchase; ref is the shop item id.
+	RewardKind_REWARD_KIND_ITEM RewardKind = 3
+	// A title shown with the season results; ref is the title.
+	RewardKind_REWARD_KIND_TITLE RewardKind = 4
+	// A badge shown with the season results; ref is the badge.
+	RewardKind_REWARD_KIND_BADGE RewardKind = 5
+)
+
+// Enum value maps for RewardKind.
+var (
+	RewardKind_name = map[int32]string{
+		0: "REWARD_KIND_UNSPECIFIED",
+		1: "REWARD_KIND_COINS",
+		2: "REWARD_KIND_KIT",
+		3: "REWARD_KIND_ITEM",
+		4: "REWARD_KIND_TITLE",
+		5: "REWARD_KIND_BADGE",
+	}
+	RewardKind_value = map[string]int32{
+		"REWARD_KIND_UNSPECIFIED": 0,
+		"REWARD_KIND_COINS":       1,
+		"REWARD_KIND_KIT":         2,
+		"REWARD_KIND_ITEM":        3,
+		"REWARD_KIND_TITLE":       4,
+		"REWARD_KIND_BADGE":       5,
+	}
+)
+
+func (x RewardKind) Enum() *RewardKind {
+	p := new(RewardKind)
+	*p = x
+	return p
+}
+
+func (x RewardKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RewardKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_season_proto_enumTypes[1].Descriptor()
+}
+
+func (RewardKind) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_season_proto_enumTypes[1]
+}
+
+func (x RewardKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RewardKind.Descriptor instead.
+func (RewardKind) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{1}
+}
+
+type SeasonResetStep int32
+
+const (
+	SeasonResetStep_SEASON_RESET_STEP_UNSPECIFIED SeasonResetStep = 0
+	// Closing the season and freezing its standings.
+	SeasonResetStep_SEASON_RESET_STEP_CLOSE SeasonResetStep = 1
+	// Crediting rewards, each once per season and player.
+	SeasonResetStep_SEASON_RESET_STEP_PAY_REWARDS SeasonResetStep = 2
+	// Saving the standings as the season's results.
+	SeasonResetStep_SEASON_RESET_STEP_ARCHIVE_RESULTS SeasonResetStep = 3
+	// Deleting the season's live player stats.
+	SeasonResetStep_SEASON_RESET_STEP_CLEAR_STATS SeasonResetStep = 4
+	SeasonResetStep_SEASON_RESET_STEP_DONE        SeasonResetStep = 5
+)
+
+// Enum value maps for SeasonResetStep.
+var (
+	SeasonResetStep_name = map[int32]string{
+		0: "SEASON_RESET_STEP_UNSPECIFIED",
+		1: "SEASON_RESET_STEP_CLOSE",
+		2: "SEASON_RESET_STEP_PAY_REWARDS",
+		3: "SEASON_RESET_STEP_ARCHIVE_RESULTS",
+		4: "SEASON_RESET_STEP_CLEAR_STATS",
+		5: "SEASON_RESET_STEP_DONE",
+	}
+	SeasonResetStep_value = map[string]int32{
+		"SEASON_RESET_STEP_UNSPECIFIED":     0,
+		"SEASON_RESET_STEP_CLOSE":           1,
+		"SEASON_RESET_STEP_PAY_REWARDS":     2,
+		"SEASON_RESET_STEP_ARCHIVE_RESULTS": 3,
+		"SEASON_RESET_STEP_CLEAR_STATS":     4,
+		"SEASON_RESET_STEP_DONE":            5,
+	}
+)
+
+func (x SeasonResetStep) Enum() *SeasonResetStep {
+	p := new(SeasonResetStep)
+	*p = x
+	return p
+}
+
+func (x SeasonResetStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeasonResetStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_season_proto_enumTypes[2].Descriptor()
+}
+
+func (SeasonResetStep) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_season_proto_enumTypes[2]
+}
+
+func (x SeasonResetStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeasonResetStep.Descriptor instead.
+func (SeasonResetStep) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{2}
+}
+
+type SeasonResetStatus int32
+
+const (
+	SeasonResetStatus_SEASON_RESET_STATUS_UNSPECIFIED SeasonResetStatus = 0
+	SeasonResetStatus_SEASON_RESET_STATUS_RUNNING     SeasonResetStatus = 1
+	SeasonResetStatus_SEASON_RESET_STATUS_FAILED      SeasonResetStatus = 2
+	SeasonResetStatus_SEASON_RESET_STATUS_COMPLETED   SeasonResetStatus = 3
+)
+
+// Enum value maps for SeasonResetStatus.
+var (
+	SeasonResetStatus_name = map[int32]string{
+		0: "SEASON_RESET_STATUS_UNSPECIFIED",
+		1: "SEASON_RESET_STATUS_RUNNING",
+		2: "SEASON_RESET_STATUS_FAILED",
+		3: "SEASON_RESET_STATUS_COMPLETED",
+	}
+	SeasonResetStatus_value = map[string]int32{
+		"SEASON_RESET_STATUS_UNSPECIFIED": 0,
+		"SEASON_RESET_STATUS_RUNNING":     1,
+		"SEASON_RESET_STATUS_FAILED":      2,
+		"SEASON_RESET_STATUS_COMPLETED":   3,
+	}
+)
+
+func (x SeasonResetStatus) Enum() *SeasonResetStatus {
+	p := new(SeasonResetStatus)
+	*p = x
+	return p
+}
+
+func (x SeasonResetStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SeasonResetStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_hungergames_v1_season_proto_enumTypes[3].Descriptor()
+}
+
+func (SeasonResetStatus) Type() protoreflect.EnumType {
+	return &file_hungergames_v1_season_proto_enumTypes[3]
+}
+
+func (x SeasonResetStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SeasonResetStatus.Descriptor instead.
+func (SeasonResetStatus) EnumDescriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{3}
+}
+
 type SeasonInfo struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Number    int32                  `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
 	StartedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
 	// Absent if the season is still active.
-	EndedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	EndedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	// When the scheduler closes the season; absent if it runs until reset.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// The exact-rank coin tiers of reward_tiers.
+	// Deprecated: use reward_tiers.
+	Rewards      []*SeasonReward `protobuf:"bytes,6,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	RatingSystem RatingSystem    `protobuf:"varint,7,opt,name=rating_system,json=ratingSystem,proto3,enum=hungergames.v1.RatingSystem" json:"rating_system,omitempty"`
+	// Reward tiers handed out when the season closes.
+	RewardTiers   []*RewardTier `protobuf:"bytes,8,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -92,7 +335,36 @@ func (x *SeasonInfo) GetEndedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SeasonInfo) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SeasonInfo) GetRewards() []*SeasonReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *SeasonInfo) GetRatingSystem() RatingSystem {
+	if x != nil {
+		return x.RatingSystem
+	}
+	return RatingSystem_RATING_SYSTEM_UNSPECIFIED
+}
+
+func (x *SeasonInfo) GetRewardTiers() []*RewardTier {
+	if x != nil {
+		return x.RewardTiers
+	}
+	return nil
+}
+
 // SeasonReward maps a leaderboard rank to a coin reward amount.
+// Deprecated: use RewardTier, which it is converted to.
 type SeasonReward struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 1-based rank (1 = first place)
@@ -146,27 +418,31 @@ func (x *SeasonReward) GetCoins() int64 {
 	return 0
 }
 
-type ResetSeasonRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rewards       []*SeasonReward        `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+type Reward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  RewardKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=hungergames.v1.RewardKind" json:"kind,omitempty"`
+	// Amount of a coins reward.
+	Coins int64 `protobuf:"varint,2,opt,name=coins,proto3" json:"coins,omitempty"`
+	// Kit name, shop item id, title or badge for the other kinds.
+	Ref           string `protobuf:"bytes,3,opt,name=ref,proto3" json:"ref,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetSeasonRequest) Reset() {
-	*x = ResetSeasonRequest{}
+func (x *Reward) Reset() {
+	*x = Reward{}
 	mi := &file_hungergames_v1_season_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetSeasonRequest) String() string {
+func (x *Reward) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSeasonRequest) ProtoMessage() {}
+func (*Reward) ProtoMessage() {}
 
-func (x *ResetSeasonRequest) ProtoReflect() protoreflect.Message {
+func (x *Reward) ProtoReflect() protoreflect.Message {
 	mi := &file_hungergames_v1_season_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -178,38 +454,62 @@ func (x *ResetSeasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSeasonRequest.ProtoReflect.Descriptor instead.
-func (*ResetSeasonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ResetSeasonRequest) GetRewards() []*SeasonReward {
+func (x *Reward) GetKind() RewardKind {
 	if x != nil {
-		return x.Rewards
+		return x.Kind
 	}
-	return nil
+	return RewardKind_REWARD_KIND_UNSPECIFIED
 }
 
-type ResetSeasonResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *Reward) GetCoins() int64 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *Reward) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+// RewardTier hands its rewards either to the ranks from_rank through to_rank
+// or to the best top_percent of ranked players, rounded up. Rank ranges must
+// not overlap. A player gets a single tier: a rank range covering the rank
+// wins over any percentile, and among percentiles the narrowest applies.
+type RewardTier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1-based, inclusive. Unset for a percentile tier.
+	FromRank int32 `protobuf:"varint,1,opt,name=from_rank,json=fromRank,proto3" json:"from_rank,omitempty"`
+	ToRank   int32 `protobuf:"varint,2,opt,name=to_rank,json=toRank,proto3" json:"to_rank,omitempty"`
+	// 1-100. Unset for a rank range.
+	TopPercent    int32     `protobuf:"varint,3,opt,name=top_percent,json=topPercent,proto3" json:"top_percent,omitempty"`
+	Rewards       []*Reward `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetSeasonResponse) Reset() {
-	*x = ResetSeasonResponse{}
+func (x *RewardTier) Reset() {
+	*x = RewardTier{}
 	mi := &file_hungergames_v1_season_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetSeasonResponse) String() string {
+func (x *RewardTier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetSeasonResponse) ProtoMessage() {}
+func (*RewardTier) ProtoMessage() {}
 
-func (x *ResetSeasonResponse) ProtoReflect() protoreflect.Message {
+func (x *RewardTier) ProtoReflect() protoreflect.Message {
 	mi := &file_hungergames_v1_season_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -221,31 +521,65 @@ func (x *ResetSeasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetSeasonResponse.ProtoReflect.Descriptor instead.
-func (*ResetSeasonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RewardTier.ProtoReflect.Descriptor instead.
+func (*RewardTier) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{3}
 }
 
-type CreateSeasonRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RewardTier) GetFromRank() int32 {
+	if x != nil {
+		return x.FromRank
+	}
+	return 0
+}
+
+func (x *RewardTier) GetToRank() int32 {
+	if x != nil {
+		return x.ToRank
+	}
+	return 0
+}
+
+func (x *RewardTier) GetTopPercent() int32 {
+	if x != nil {
+		return x.TopPercent
+	}
+	return 0
+}
+
+func (x *RewardTier) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type ResetSeasonRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Overrides the season's stored rewards when non-empty.
+	// Deprecated: use reward_tiers.
+	Rewards []*SeasonReward `protobuf:"bytes,1,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Overrides the season's stored reward tiers when non-empty. Must not be
+	// combined with rewards.
+	RewardTiers   []*RewardTier `protobuf:"bytes,2,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSeasonRequest) Reset() {
-	*x = CreateSeasonRequest{}
+func (x *ResetSeasonRequest) Reset() {
+	*x = ResetSeasonRequest{}
 	mi := &file_hungergames_v1_season_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSeasonRequest) String() string {
+func (x *ResetSeasonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSeasonRequest) ProtoMessage() {}
+func (*ResetSeasonRequest) ProtoMessage() {}
 
-func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
+func (x *ResetSeasonRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_hungergames_v1_season_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -257,32 +591,46 @@ func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
-func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*ResetSeasonRequest) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{4}
 }
 
-type CreateSeasonResponse struct {
+func (x *ResetSeasonRequest) GetRewards() []*SeasonReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *ResetSeasonRequest) GetRewardTiers() []*RewardTier {
+	if x != nil {
+		return x.RewardTiers
+	}
+	return nil
+}
+
+type ResetSeasonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Season        *SeasonInfo            `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	Job           *SeasonResetJob        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSeasonResponse) Reset() {
-	*x = CreateSeasonResponse{}
+func (x *ResetSeasonResponse) Reset() {
+	*x = ResetSeasonResponse{}
 	mi := &file_hungergames_v1_season_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSeasonResponse) String() string {
+func (x *ResetSeasonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSeasonResponse) ProtoMessage() {}
+func (*ResetSeasonResponse) ProtoMessage() {}
 
-func (x *CreateSeasonResponse) ProtoReflect() protoreflect.Message {
+func (x *ResetSeasonResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_hungergames_v1_season_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -294,42 +642,58 @@ func (x *CreateSeasonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSeasonResponse.ProtoReflect.Descriptor instead.
-func (*CreateSeasonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetSeasonResponse.ProtoReflect.Descriptor instead.
+func (*ResetSeasonResponse) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateSeasonResponse) GetSeason() *SeasonInfo {
+func (x *ResetSeasonResponse) GetJob() *SeasonResetJob {
 	if x != nil {
-		return x.Season
+		return x.Job
 	}
 	return nil
 }
 
-type ListSeasonsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Cursor from a previous response for pagination.
-	Next string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
-	// Maximum number of seasons. Defaults to 20.
-	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+// SeasonResetJob is the persisted progress of a season reset. A failed or
+// interrupted reset stays at the step it stopped at until it is resumed.
+type SeasonResetJob struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId     string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	SeasonNumber int32                  `protobuf:"varint,2,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	// The step to run next; the failed step while status is FAILED.
+	Step   SeasonResetStep   `protobuf:"varint,3,opt,name=step,proto3,enum=hungergames.v1.SeasonResetStep" json:"step,omitempty"`
+	Status SeasonResetStatus `protobuf:"varint,4,opt,name=status,proto3,enum=hungergames.v1.SeasonResetStatus" json:"status,omitempty"`
+	// Deprecated: use reward_tiers.
+	Rewards []*SeasonReward `protobuf:"bytes,5,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Players in the frozen standings; 0 until the season is closed.
+	PlayersTotal int32 `protobuf:"varint,6,opt,name=players_total,json=playersTotal,proto3" json:"players_total,omitempty"`
+	RewardsPaid  int32 `protobuf:"varint,7,opt,name=rewards_paid,json=rewardsPaid,proto3" json:"rewards_paid,omitempty"`
+	RewardsTotal int32 `protobuf:"varint,8,opt,name=rewards_total,json=rewardsTotal,proto3" json:"rewards_total,omitempty"`
+	// Error of the last failed step.
+	LastError     string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	Attempts      int32                  `protobuf:"varint,10,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	RewardTiers   []*RewardTier          `protobuf:"bytes,14,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListSeasonsRequest) Reset() {
-	*x = ListSeasonsRequest{}
+func (x *SeasonResetJob) Reset() {
+	*x = SeasonResetJob{}
 	mi := &file_hungergames_v1_season_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListSeasonsRequest) String() string {
+func (x *SeasonResetJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSeasonsRequest) ProtoMessage() {}
+func (*SeasonResetJob) ProtoMessage() {}
 
-func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+func (x *SeasonResetJob) ProtoReflect() protoreflect.Message {
 	mi := &file_hungergames_v1_season_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,49 +705,735 @@ func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
-func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SeasonResetJob.ProtoReflect.Descriptor instead.
+func (*SeasonResetJob) Descriptor() ([]byte, []int) {
 	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListSeasonsRequest) GetNext() string {
+func (x *SeasonResetJob) GetSeasonId() string {
 	if x != nil {
-		return x.Next
+		return x.SeasonId
 	}
 	return ""
 }
 
-func (x *ListSeasonsRequest) GetLimit() int32 {
+func (x *SeasonResetJob) GetSeasonNumber() int32 {
 	if x != nil {
-		return x.Limit
+		return x.SeasonNumber
 	}
 	return 0
 }
 
-type ListSeasonsResponse struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Seasons []*SeasonInfo          `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
-	// Cursor for the next page; empty when no more results.
-	Next          string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SeasonResetJob) GetStep() SeasonResetStep {
+	if x != nil {
+		return x.Step
+	}
+	return SeasonResetStep_SEASON_RESET_STEP_UNSPECIFIED
 }
 
-func (x *ListSeasonsResponse) Reset() {
-	*x = ListSeasonsResponse{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SeasonResetJob) GetStatus() SeasonResetStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SeasonResetStatus_SEASON_RESET_STATUS_UNSPECIFIED
 }
 
-func (x *ListSeasonsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SeasonResetJob) GetRewards() []*SeasonReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
 }
 
-func (*ListSeasonsResponse) ProtoMessage() {}
-
-func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[7]
+func (x *SeasonResetJob) GetPlayersTotal() int32 {
+	if x != nil {
+		return x.PlayersTotal
+	}
+	return 0
+}
+
+func (x *SeasonResetJob) GetRewardsPaid() int32 {
+	if x != nil {
+		return x.RewardsPaid
+	}
+	return 0
+}
+
+func (x *SeasonResetJob) GetRewardsTotal() int32 {
+	if x != nil {
+		return x.RewardsTotal
+	}
+	return 0
+}
+
+func (x *SeasonResetJob) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *SeasonResetJob) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *SeasonResetJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SeasonResetJob) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SeasonResetJob) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *SeasonResetJob) GetRewardTiers() []*RewardTier {
+	if x != nil {
+		return x.RewardTiers
+	}
+	return nil
+}
+
+type GetSeasonResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonResetRequest) Reset() {
+	*x = GetSeasonResetRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonResetRequest) ProtoMessage() {}
+
+func (x *GetSeasonResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonResetRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonResetRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetSeasonResetRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type ListSeasonResetsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only resets that have not completed.
+	UnfinishedOnly bool `protobuf:"varint,1,opt,name=unfinished_only,json=unfinishedOnly,proto3" json:"unfinished_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListSeasonResetsRequest) Reset() {
+	*x = ListSeasonResetsRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonResetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonResetsRequest) ProtoMessage() {}
+
+func (x *ListSeasonResetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonResetsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonResetsRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSeasonResetsRequest) GetUnfinishedOnly() bool {
+	if x != nil {
+		return x.UnfinishedOnly
+	}
+	return false
+}
+
+type ListSeasonResetsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Newest first.
+	Resets        []*SeasonResetJob `protobuf:"bytes,1,rep,name=resets,proto3" json:"resets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonResetsResponse) Reset() {
+	*x = ListSeasonResetsResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonResetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonResetsResponse) ProtoMessage() {}
+
+func (x *ListSeasonResetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonResetsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonResetsResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSeasonResetsResponse) GetResets() []*SeasonResetJob {
+	if x != nil {
+		return x.Resets
+	}
+	return nil
+}
+
+type ResumeSeasonResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeSeasonResetRequest) Reset() {
+	*x = ResumeSeasonResetRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeSeasonResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeSeasonResetRequest) ProtoMessage() {}
+
+func (x *ResumeSeasonResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeSeasonResetRequest.ProtoReflect.Descriptor instead.
+func (*ResumeSeasonResetRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResumeSeasonResetRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+type CreateSeasonRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When the scheduler closes the season and opens the next one.
+	// Absent keeps the season open until ResetSeason.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Deprecated: use reward_tiers.
+	Rewards []*SeasonReward `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Defaults to ELO.
+	RatingSystem RatingSystem `protobuf:"varint,3,opt,name=rating_system,json=ratingSystem,proto3,enum=hungergames.v1.RatingSystem" json:"rating_system,omitempty"`
+	// Reward tiers handed out when the season closes. Must not be combined
+	// with rewards.
+	RewardTiers   []*RewardTier `protobuf:"bytes,4,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeasonRequest) Reset() {
+	*x = CreateSeasonRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonRequest) ProtoMessage() {}
+
+func (x *CreateSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonRequest.ProtoReflect.Descriptor instead.
+func (*CreateSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateSeasonRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetRewards() []*SeasonReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *CreateSeasonRequest) GetRatingSystem() RatingSystem {
+	if x != nil {
+		return x.RatingSystem
+	}
+	return RatingSystem_RATING_SYSTEM_UNSPECIFIED
+}
+
+func (x *CreateSeasonRequest) GetRewardTiers() []*RewardTier {
+	if x != nil {
+		return x.RewardTiers
+	}
+	return nil
+}
+
+type CreateSeasonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Season        *SeasonInfo            `protobuf:"bytes,1,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSeasonResponse) Reset() {
+	*x = CreateSeasonResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSeasonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSeasonResponse) ProtoMessage() {}
+
+func (x *CreateSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSeasonResponse.ProtoReflect.Descriptor instead.
+func (*CreateSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateSeasonResponse) GetSeason() *SeasonInfo {
+	if x != nil {
+		return x.Season
+	}
+	return nil
+}
+
+type ListSeasonsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Cursor from a previous response for pagination.
+	Next string `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	// Maximum number of seasons. Defaults to 20.
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsRequest) Reset() {
+	*x = ListSeasonsRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsRequest) ProtoMessage() {}
+
+func (x *ListSeasonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonsRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListSeasonsRequest) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+func (x *ListSeasonsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSeasonsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Seasons []*SeasonInfo          `protobuf:"bytes,1,rep,name=seasons,proto3" json:"seasons,omitempty"`
+	// Cursor for the next page; empty when no more results.
+	Next          string `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonsResponse) Reset() {
+	*x = ListSeasonsResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonsResponse) ProtoMessage() {}
+
+func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSeasonsResponse) GetSeasons() []*SeasonInfo {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *ListSeasonsResponse) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type GetSeasonLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonLeaderboardRequest) Reset() {
+	*x = GetSeasonLeaderboardRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetSeasonLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSeasonLeaderboardRequest) GetSeasonId() string {
+	if x != nil {
+		return x.SeasonId
+	}
+	return ""
+}
+
+// SeasonResultEntry is the archived standing of a player at the end of a season.
+type SeasonResultEntry struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId   string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	PlayerName string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Elo        int32                  `protobuf:"varint,3,opt,name=elo,proto3" json:"elo,omitempty"`
+	Wins       int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Kills      int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
+	Rank       int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Sum of the coin rewards.
+	RewardCoins int64 `protobuf:"varint,7,opt,name=reward_coins,json=rewardCoins,proto3" json:"reward_coins,omitempty"`
+	// Wins in team matches; wins counts solo wins only.
+	TeamWins int32 `protobuf:"varint,8,opt,name=team_wins,json=teamWins,proto3" json:"team_wins,omitempty"`
+	// Everything the player earned at this rank.
+	Rewards       []*Reward `protobuf:"bytes,9,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonResultEntry) Reset() {
+	*x = SeasonResultEntry{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonResultEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonResultEntry) ProtoMessage() {}
+
+func (x *SeasonResultEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonResultEntry.ProtoReflect.Descriptor instead.
+func (*SeasonResultEntry) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SeasonResultEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SeasonResultEntry) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *SeasonResultEntry) GetElo() int32 {
+	if x != nil {
+		return x.Elo
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetKills() int32 {
+	if x != nil {
+		return x.Kills
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetRewardCoins() int64 {
+	if x != nil {
+		return x.RewardCoins
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetTeamWins() int32 {
+	if x != nil {
+		return x.TeamWins
+	}
+	return 0
+}
+
+func (x *SeasonResultEntry) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+type GetSeasonLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*SeasonResultEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeasonLeaderboardResponse) Reset() {
+	*x = GetSeasonLeaderboardResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeasonLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetSeasonLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetSeasonLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSeasonLeaderboardResponse) GetEntries() []*SeasonResultEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetPlayerStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlayerStatsRequest) Reset() {
+	*x = GetPlayerStatsRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlayerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlayerStatsRequest) ProtoMessage() {}
+
+func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,47 +1444,47 @@ func (x *ListSeasonsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSeasonsResponse.ProtoReflect.Descriptor instead.
-func (*ListSeasonsResponse) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListSeasonsResponse) GetSeasons() []*SeasonInfo {
+func (x *GetPlayerStatsRequest) GetSeasonId() string {
 	if x != nil {
-		return x.Seasons
+		return x.SeasonId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSeasonsResponse) GetNext() string {
+func (x *GetPlayerStatsRequest) GetPlayerId() string {
 	if x != nil {
-		return x.Next
+		return x.PlayerId
 	}
 	return ""
 }
 
-type GetSeasonLeaderboardRequest struct {
+type GetPlayerStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Stats         *SeasonResultEntry     `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSeasonLeaderboardRequest) Reset() {
-	*x = GetSeasonLeaderboardRequest{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[8]
+func (x *GetPlayerStatsResponse) Reset() {
+	*x = GetPlayerStatsResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeasonLeaderboardRequest) String() string {
+func (x *GetPlayerStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeasonLeaderboardRequest) ProtoMessage() {}
+func (*GetPlayerStatsResponse) ProtoMessage() {}
 
-func (x *GetSeasonLeaderboardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[8]
+func (x *GetPlayerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,47 +1495,47 @@ func (x *GetSeasonLeaderboardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeasonLeaderboardRequest.ProtoReflect.Descriptor instead.
-func (*GetSeasonLeaderboardRequest) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetPlayerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlayerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetSeasonLeaderboardRequest) GetSeasonId() string {
+func (x *GetPlayerStatsResponse) GetStats() *SeasonResultEntry {
 	if x != nil {
-		return x.SeasonId
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-// SeasonResultEntry is the archived standing of a player at the end of a season.
-type SeasonResultEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	Elo           int32                  `protobuf:"varint,3,opt,name=elo,proto3" json:"elo,omitempty"`
-	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
-	Kills         int32                  `protobuf:"varint,5,opt,name=kills,proto3" json:"kills,omitempty"`
-	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
-	RewardCoins   int64                  `protobuf:"varint,7,opt,name=reward_coins,json=rewardCoins,proto3" json:"reward_coins,omitempty"`
+// SeasonSchedule is an upcoming season opened and closed by the scheduler.
+type SeasonSchedule struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Deprecated: use reward_tiers.
+	Rewards       []*SeasonReward `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	RatingSystem  RatingSystem    `protobuf:"varint,5,opt,name=rating_system,json=ratingSystem,proto3,enum=hungergames.v1.RatingSystem" json:"rating_system,omitempty"`
+	RewardTiers   []*RewardTier   `protobuf:"bytes,6,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SeasonResultEntry) Reset() {
-	*x = SeasonResultEntry{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[9]
+func (x *SeasonSchedule) Reset() {
+	*x = SeasonSchedule{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SeasonResultEntry) String() string {
+func (x *SeasonSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeasonResultEntry) ProtoMessage() {}
+func (*SeasonSchedule) ProtoMessage() {}
 
-func (x *SeasonResultEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[9]
+func (x *SeasonSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,82 +1546,154 @@ func (x *SeasonResultEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeasonResultEntry.ProtoReflect.Descriptor instead.
-func (*SeasonResultEntry) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use SeasonSchedule.ProtoReflect.Descriptor instead.
+func (*SeasonSchedule) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SeasonResultEntry) GetPlayerId() string {
+func (x *SeasonSchedule) GetId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SeasonResultEntry) GetPlayerName() string {
+func (x *SeasonSchedule) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PlayerName
+		return x.StartsAt
 	}
-	return ""
+	return nil
 }
 
-func (x *SeasonResultEntry) GetElo() int32 {
+func (x *SeasonSchedule) GetEndsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Elo
+		return x.EndsAt
 	}
-	return 0
+	return nil
 }
 
-func (x *SeasonResultEntry) GetWins() int32 {
+func (x *SeasonSchedule) GetRewards() []*SeasonReward {
 	if x != nil {
-		return x.Wins
+		return x.Rewards
 	}
-	return 0
+	return nil
 }
 
-func (x *SeasonResultEntry) GetKills() int32 {
+func (x *SeasonSchedule) GetRatingSystem() RatingSystem {
 	if x != nil {
-		return x.Kills
+		return x.RatingSystem
 	}
-	return 0
+	return RatingSystem_RATING_SYSTEM_UNSPECIFIED
 }
 
-func (x *SeasonResultEntry) GetRank() int32 {
+func (x *SeasonSchedule) GetRewardTiers() []*RewardTier {
 	if x != nil {
-		return x.Rank
+		return x.RewardTiers
 	}
-	return 0
+	return nil
 }
 
-func (x *SeasonResultEntry) GetRewardCoins() int64 {
+type ScheduleSeasonRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Deprecated: use reward_tiers.
+	Rewards []*SeasonReward `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Defaults to ELO.
+	RatingSystem RatingSystem `protobuf:"varint,4,opt,name=rating_system,json=ratingSystem,proto3,enum=hungergames.v1.RatingSystem" json:"rating_system,omitempty"`
+	// Must not be combined with rewards.
+	RewardTiers   []*RewardTier `protobuf:"bytes,5,rep,name=reward_tiers,json=rewardTiers,proto3" json:"reward_tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleSeasonRequest) Reset() {
+	*x = ScheduleSeasonRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSeasonRequest) ProtoMessage() {}
+
+func (x *ScheduleSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[21]
 	if x != nil {
-		return x.RewardCoins
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type GetSeasonLeaderboardResponse struct {
+// Deprecated: Use ScheduleSeasonRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ScheduleSeasonRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *ScheduleSeasonRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *ScheduleSeasonRequest) GetRewards() []*SeasonReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *ScheduleSeasonRequest) GetRatingSystem() RatingSystem {
+	if x != nil {
+		return x.RatingSystem
+	}
+	return RatingSystem_RATING_SYSTEM_UNSPECIFIED
+}
+
+func (x *ScheduleSeasonRequest) GetRewardTiers() []*RewardTier {
+	if x != nil {
+		return x.RewardTiers
+	}
+	return nil
+}
+
+type ScheduleSeasonResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*SeasonResultEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Schedule      *SeasonSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSeasonLeaderboardResponse) Reset() {
-	*x = GetSeasonLeaderboardResponse{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[10]
+func (x *ScheduleSeasonResponse) Reset() {
+	*x = ScheduleSeasonResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSeasonLeaderboardResponse) String() string {
+func (x *ScheduleSeasonResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSeasonLeaderboardResponse) ProtoMessage() {}
+func (*ScheduleSeasonResponse) ProtoMessage() {}
 
-func (x *GetSeasonLeaderboardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[10]
+func (x *ScheduleSeasonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,41 +1704,39 @@ func (x *GetSeasonLeaderboardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSeasonLeaderboardResponse.ProtoReflect.Descriptor instead.
-func (*GetSeasonLeaderboardResponse) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ScheduleSeasonResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleSeasonResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetSeasonLeaderboardResponse) GetEntries() []*SeasonResultEntry {
+func (x *ScheduleSeasonResponse) GetSchedule() *SeasonSchedule {
 	if x != nil {
-		return x.Entries
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetPlayerStatsRequest struct {
+type ListSeasonSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeasonId      string                 `protobuf:"bytes,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
-	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerStatsRequest) Reset() {
-	*x = GetPlayerStatsRequest{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[11]
+func (x *ListSeasonSchedulesRequest) Reset() {
+	*x = ListSeasonSchedulesRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerStatsRequest) String() string {
+func (x *ListSeasonSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerStatsRequest) ProtoMessage() {}
+func (*ListSeasonSchedulesRequest) ProtoMessage() {}
 
-func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[11]
+func (x *ListSeasonSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,47 +1747,78 @@ func (x *GetPlayerStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetPlayerStatsRequest) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListSeasonSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSeasonSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GetPlayerStatsRequest) GetSeasonId() string {
+type ListSeasonSchedulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Upcoming seasons, earliest first.
+	Schedules     []*SeasonSchedule `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSeasonSchedulesResponse) Reset() {
+	*x = ListSeasonSchedulesResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSeasonSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSeasonSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSeasonSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[24]
 	if x != nil {
-		return x.SeasonId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetPlayerStatsRequest) GetPlayerId() string {
+// Deprecated: Use ListSeasonSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSeasonSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListSeasonSchedulesResponse) GetSchedules() []*SeasonSchedule {
 	if x != nil {
-		return x.PlayerId
+		return x.Schedules
 	}
-	return ""
+	return nil
 }
 
-type GetPlayerStatsResponse struct {
+type CancelSeasonScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         *SeasonResultEntry     `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPlayerStatsResponse) Reset() {
-	*x = GetPlayerStatsResponse{}
-	mi := &file_hungergames_v1_season_proto_msgTypes[12]
+func (x *CancelSeasonScheduleRequest) Reset() {
+	*x = CancelSeasonScheduleRequest{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPlayerStatsResponse) String() string {
+func (x *CancelSeasonScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPlayerStatsResponse) ProtoMessage() {}
+func (*CancelSeasonScheduleRequest) ProtoMessage() {}
 
-func (x *GetPlayerStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_hungergames_v1_season_proto_msgTypes[12]
+func (x *CancelSeasonScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -678,113 +1829,196 @@ func (x *GetPlayerStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPlayerStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetPlayerStatsResponse) Descriptor() ([]byte, []int) {
-	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CancelSeasonScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelSeasonScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetPlayerStatsResponse) GetStats() *SeasonResultEntry {
+func (x *CancelSeasonScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Stats
+		return x.ScheduleId
 	}
-	return nil
+	return ""
+}
+
+type CancelSeasonScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSeasonScheduleResponse) Reset() {
+	*x = CancelSeasonScheduleResponse{}
+	mi := &file_hungergames_v1_season_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSeasonScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSeasonScheduleResponse) ProtoMessage() {}
+
+func (x *CancelSeasonScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_hungergames_v1_season_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSeasonScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelSeasonScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_hungergames_v1_season_proto_rawDescGZIP(), []int{26}
 }
 
 var File_hungergames_v1_season_proto protoreflect.FileDescriptor
 
-var file_hungergames_v1_season_proto_rawDesc = string([]byte{
-	0x0a, 0x1b, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68,
-	0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f,
-	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xa6, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x07, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x72, 0x61, 0x6e,
-	0x6b, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0x4c, 0x0a, 0x12,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x32, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x73, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65,
-	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x68,
-	0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x3f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x73, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc4, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
-	0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6c,
-	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x65, 0x6c, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x77, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x22, 0x5b, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x73, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x70,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0xbc, 0x01, 0x0a, 0x12, 0x63,
-	0x6f, 0x6d, 0x2e, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x76,
-	0x31, 0x42, 0x0b, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x73,
-	0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f, 0x76, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x68, 0x75, 0x6e, 0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73,
-	0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x58, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67, 0x65,
-	0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x48, 0x75, 0x6e, 0x67,
-	0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x48, 0x75, 0x6e,
-	0x67, 0x65, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x48, 0x75, 0x6e, 0x67, 0x65, 0x72,
-	0x67, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-})
+const file_hungergames_v1_season_proto_rawDesc = "" +
+	"\n" +
+	"\x1bhungergames/v1/season.proto\x12\x0ehungergames.v1\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x03\n" +
+	"\n" +
+	"SeasonInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x126\n" +
+	"\arewards\x18\x06 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12A\n" +
+	"\rrating_system\x18\a \x01(\x0e2\x1c.hungergames.v1.RatingSystemR\fratingSystem\x12=\n" +
+	"\freward_tiers\x18\b \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"B\n" +
+	"\fSeasonReward\x12\x17\n" +
+	"\x04rank\x18\x01 \x01(\x05B\x03\xe0A\x02R\x04rank\x12\x19\n" +
+	"\x05coins\x18\x02 \x01(\x03B\x03\xe0A\x02R\x05coins\"e\n" +
+	"\x06Reward\x123\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1a.hungergames.v1.RewardKindB\x03\xe0A\x02R\x04kind\x12\x14\n" +
+	"\x05coins\x18\x02 \x01(\x03R\x05coins\x12\x10\n" +
+	"\x03ref\x18\x03 \x01(\tR\x03ref\"\x95\x01\n" +
+	"\n" +
+	"RewardTier\x12\x1b\n" +
+	"\tfrom_rank\x18\x01 \x01(\x05R\bfromRank\x12\x17\n" +
+	"\ato_rank\x18\x02 \x01(\x05R\x06toRank\x12\x1f\n" +
+	"\vtop_percent\x18\x03 \x01(\x05R\n" +
+	"topPercent\x120\n" +
+	"\arewards\x18\x04 \x03(\v2\x16.hungergames.v1.RewardR\arewards\"\x8b\x01\n" +
+	"\x12ResetSeasonRequest\x126\n" +
+	"\arewards\x18\x01 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12=\n" +
+	"\freward_tiers\x18\x02 \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"G\n" +
+	"\x13ResetSeasonResponse\x120\n" +
+	"\x03job\x18\x01 \x01(\v2\x1e.hungergames.v1.SeasonResetJobR\x03job\"\x96\x05\n" +
+	"\x0eSeasonResetJob\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\tR\bseasonId\x12#\n" +
+	"\rseason_number\x18\x02 \x01(\x05R\fseasonNumber\x123\n" +
+	"\x04step\x18\x03 \x01(\x0e2\x1f.hungergames.v1.SeasonResetStepR\x04step\x129\n" +
+	"\x06status\x18\x04 \x01(\x0e2!.hungergames.v1.SeasonResetStatusR\x06status\x126\n" +
+	"\arewards\x18\x05 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12#\n" +
+	"\rplayers_total\x18\x06 \x01(\x05R\fplayersTotal\x12!\n" +
+	"\frewards_paid\x18\a \x01(\x05R\vrewardsPaid\x12#\n" +
+	"\rrewards_total\x18\b \x01(\x05R\frewardsTotal\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12\x1a\n" +
+	"\battempts\x18\n" +
+	" \x01(\x05R\battempts\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12=\n" +
+	"\freward_tiers\x18\x0e \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"9\n" +
+	"\x15GetSeasonResetRequest\x12 \n" +
+	"\tseason_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bseasonId\"B\n" +
+	"\x17ListSeasonResetsRequest\x12'\n" +
+	"\x0funfinished_only\x18\x01 \x01(\bR\x0eunfinishedOnly\"R\n" +
+	"\x18ListSeasonResetsResponse\x126\n" +
+	"\x06resets\x18\x01 \x03(\v2\x1e.hungergames.v1.SeasonResetJobR\x06resets\"<\n" +
+	"\x18ResumeSeasonResetRequest\x12 \n" +
+	"\tseason_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bseasonId\"\x84\x02\n" +
+	"\x13CreateSeasonRequest\x123\n" +
+	"\aends_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x126\n" +
+	"\arewards\x18\x02 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12A\n" +
+	"\rrating_system\x18\x03 \x01(\x0e2\x1c.hungergames.v1.RatingSystemR\fratingSystem\x12=\n" +
+	"\freward_tiers\x18\x04 \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"J\n" +
+	"\x14CreateSeasonResponse\x122\n" +
+	"\x06season\x18\x01 \x01(\v2\x1a.hungergames.v1.SeasonInfoR\x06season\">\n" +
+	"\x12ListSeasonsRequest\x12\x12\n" +
+	"\x04next\x18\x01 \x01(\tR\x04next\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"_\n" +
+	"\x13ListSeasonsResponse\x124\n" +
+	"\aseasons\x18\x01 \x03(\v2\x1a.hungergames.v1.SeasonInfoR\aseasons\x12\x12\n" +
+	"\x04next\x18\x02 \x01(\tR\x04next\"?\n" +
+	"\x1bGetSeasonLeaderboardRequest\x12 \n" +
+	"\tseason_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bseasonId\"\x93\x02\n" +
+	"\x11SeasonResultEntry\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x10\n" +
+	"\x03elo\x18\x03 \x01(\x05R\x03elo\x12\x12\n" +
+	"\x04wins\x18\x04 \x01(\x05R\x04wins\x12\x14\n" +
+	"\x05kills\x18\x05 \x01(\x05R\x05kills\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12!\n" +
+	"\freward_coins\x18\a \x01(\x03R\vrewardCoins\x12\x1b\n" +
+	"\tteam_wins\x18\b \x01(\x05R\bteamWins\x120\n" +
+	"\arewards\x18\t \x03(\v2\x16.hungergames.v1.RewardR\arewards\"[\n" +
+	"\x1cGetSeasonLeaderboardResponse\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.hungergames.v1.SeasonResultEntryR\aentries\"[\n" +
+	"\x15GetPlayerStatsRequest\x12 \n" +
+	"\tseason_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bseasonId\x12 \n" +
+	"\tplayer_id\x18\x02 \x01(\tB\x03\xe0A\x02R\bplayerId\"Q\n" +
+	"\x16GetPlayerStatsResponse\x127\n" +
+	"\x05stats\x18\x01 \x01(\v2!.hungergames.v1.SeasonResultEntryR\x05stats\"\xc8\x02\n" +
+	"\x0eSeasonSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x126\n" +
+	"\arewards\x18\x04 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12A\n" +
+	"\rrating_system\x18\x05 \x01(\x0e2\x1c.hungergames.v1.RatingSystemR\fratingSystem\x12=\n" +
+	"\freward_tiers\x18\x06 \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"\xc9\x02\n" +
+	"\x15ScheduleSeasonRequest\x12<\n" +
+	"\tstarts_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\bstartsAt\x128\n" +
+	"\aends_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\x03\xe0A\x02R\x06endsAt\x126\n" +
+	"\arewards\x18\x03 \x03(\v2\x1c.hungergames.v1.SeasonRewardR\arewards\x12A\n" +
+	"\rrating_system\x18\x04 \x01(\x0e2\x1c.hungergames.v1.RatingSystemR\fratingSystem\x12=\n" +
+	"\freward_tiers\x18\x05 \x03(\v2\x1a.hungergames.v1.RewardTierR\vrewardTiers\"T\n" +
+	"\x16ScheduleSeasonResponse\x12:\n" +
+	"\bschedule\x18\x01 \x01(\v2\x1e.hungergames.v1.SeasonScheduleR\bschedule\"\x1c\n" +
+	"\x1aListSeasonSchedulesRequest\"[\n" +
+	"\x1bListSeasonSchedulesResponse\x12<\n" +
+	"\tschedules\x18\x01 \x03(\v2\x1e.hungergames.v1.SeasonScheduleR\tschedules\"C\n" +
+	"\x1bCancelSeasonScheduleRequest\x12$\n" +
+	"\vschedule_id\x18\x01 \x01(\tB\x03\xe0A\x02R\n" +
+	"scheduleId\"\x1e\n" +
+	"\x1cCancelSeasonScheduleResponse*|\n" +
+	"\fRatingSystem\x12\x1d\n" +
+	"\x19RATING_SYSTEM_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11RATING_SYSTEM_ELO\x10\x01\x12\x19\n" +
+	"\x15RATING_SYSTEM_GLICKO2\x10\x02\x12\x1b\n" +
+	"\x17RATING_SYSTEM_OPENSKILL\x10\x03*\x99\x01\n" +
+	"\n" +
+	"RewardKind\x12\x1b\n" +
+	"\x17REWARD_KIND_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11REWARD_KIND_COINS\x10\x01\x12\x13\n" +
+	"\x0fREWARD_KIND_KIT\x10\x02\x12\x14\n" +
+	"\x10REWARD_KIND_ITEM\x10\x03\x12\x15\n" +
+	"\x11REWARD_KIND_TITLE\x10\x04\x12\x15\n" +
+	"\x11REWARD_KIND_BADGE\x10\x05*\xda\x01\n" +
+	"\x0fSeasonResetStep\x12!\n" +
+	"\x1dSEASON_RESET_STEP_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SEASON_RESET_STEP_CLOSE\x10\x01\x12!\n" +
+	"\x1dSEASON_RESET_STEP_PAY_REWARDS\x10\x02\x12%\n" +
+	"!SEASON_RESET_STEP_ARCHIVE_RESULTS\x10\x03\x12!\n" +
+	"\x1dSEASON_RESET_STEP_CLEAR_STATS\x10\x04\x12\x1a\n" +
+	"\x16SEASON_RESET_STEP_DONE\x10\x05*\x9c\x01\n" +
+	"\x11SeasonResetStatus\x12#\n" +
+	"\x1fSEASON_RESET_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSEASON_RESET_STATUS_RUNNING\x10\x01\x12\x1e\n" +
+	"\x1aSEASON_RESET_STATUS_FAILED\x10\x02\x12!\n" +
+	"\x1dSEASON_RESET_STATUS_COMPLETED\x10\x03BBZ@github.com/lasthearth/vsservice/gen/hungergames/v1;hungergamesv1b\x06proto3"
 
 var (
 	file_hungergames_v1_season_proto_rawDescOnce sync.Once
@@ -798,36 +2032,88 @@ func file_hungergames_v1_season_proto_rawDescGZIP() []byte {
 	return file_hungergames_v1_season_proto_rawDescData
 }
 
-var file_hungergames_v1_season_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_hungergames_v1_season_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_hungergames_v1_season_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_hungergames_v1_season_proto_goTypes = []any{
-	(*SeasonInfo)(nil),                   // 0: hungergames.v1.SeasonInfo
-	(*SeasonReward)(nil),                 // 1: hungergames.v1.SeasonReward
-	(*ResetSeasonRequest)(nil),           // 2: hungergames.v1.ResetSeasonRequest
-	(*ResetSeasonResponse)(nil),          // 3: hungergames.v1.ResetSeasonResponse
-	(*CreateSeasonRequest)(nil),          // 4: hungergames.v1.CreateSeasonRequest
-	(*CreateSeasonResponse)(nil),         // 5: hungergames.v1.CreateSeasonResponse
-	(*ListSeasonsRequest)(nil),           // 6: hungergames.v1.ListSeasonsRequest
-	(*ListSeasonsResponse)(nil),          // 7: hungergames.v1.ListSeasonsResponse
-	(*GetSeasonLeaderboardRequest)(nil),  // 8: hungergames.v1.GetSeasonLeaderboardRequest
-	(*SeasonResultEntry)(nil),            // 9: hungergames.v1.SeasonResultEntry
-	(*GetSeasonLeaderboardResponse)(nil), // 10: hungergames.v1.GetSeasonLeaderboardResponse
-	(*GetPlayerStatsRequest)(nil),        // 11: hungergames.v1.GetPlayerStatsRequest
-	(*GetPlayerStatsResponse)(nil),       // 12: hungergames.v1.GetPlayerStatsResponse
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
+	(RatingSystem)(0),                    // 0: hungergames.v1.RatingSystem
+	(RewardKind)(0),                      // 1: hungergames.v1.RewardKind
+	(SeasonResetStep)(0),                 // 2: hungergames.v1.SeasonResetStep
+	(SeasonResetStatus)(0),               // 3: hungergames.v1.SeasonResetStatus
+	(*SeasonInfo)(nil),                   // 4: hungergames.v1.SeasonInfo
+	(*SeasonReward)(nil),                 // 5: hungergames.v1.SeasonReward
+	(*Reward)(nil),                       // 6: hungergames.v1.Reward
+	(*RewardTier)(nil),                   // 7: hungergames.v1.RewardTier
+	(*ResetSeasonRequest)(nil),           // 8: hungergames.v1.ResetSeasonRequest
+	(*ResetSeasonResponse)(nil),          // 9: hungergames.v1.ResetSeasonResponse
+	(*SeasonResetJob)(nil),               // 10: hungergames.v1.SeasonResetJob
+	(*GetSeasonResetRequest)(nil),        // 11: hungergames.v1.GetSeasonResetRequest
+	(*ListSeasonResetsRequest)(nil),      // 12: hungergames.v1.ListSeasonResetsRequest
+	(*ListSeasonResetsResponse)(nil),     // 13: hungergames.v1.ListSeasonResetsResponse
+	(*ResumeSeasonResetRequest)(nil),     // 14: hungergames.v1.ResumeSeasonResetRequest
+	(*CreateSeasonRequest)(nil),          // 15: hungergames.v1.CreateSeasonRequest
+	(*CreateSeasonResponse)(nil),         // 16: hungergames.v1.CreateSeasonResponse
+	(*ListSeasonsRequest)(nil),           // 17: hungergames.v1.ListSeasonsRequest
+	(*ListSeasonsResponse)(nil),          // 18: hungergames.v1.ListSeasonsResponse
+	(*GetSeasonLeaderboardRequest)(nil),  // 19: hungergames.v1.GetSeasonLeaderboardRequest
+	(*SeasonResultEntry)(nil),            // 20: hungergames.v1.SeasonResultEntry
+	(*GetSeasonLeaderboardResponse)(nil), // 21: hungergames.v1.GetSeasonLeaderboardResponse
+	(*GetPlayerStatsRequest)(nil),        // 22: hungergames.v1.GetPlayerStatsRequest
+	(*GetPlayerStatsResponse)(nil),       // 23: hungergames.v1.GetPlayerStatsResponse
+	(*SeasonSchedule)(nil),               // 24: hungergames.v1.SeasonSchedule
+	(*ScheduleSeasonRequest)(nil),        // 25: hungergames.v1.ScheduleSeasonRequest
+	(*ScheduleSeasonResponse)(nil),       // 26: hungergames.v1.ScheduleSeasonResponse
+	(*ListSeasonSchedulesRequest)(nil),   // 27: hungergames.v1.ListSeasonSchedulesRequest
+	(*ListSeasonSchedulesResponse)(nil),  // 28: hungergames.v1.ListSeasonSchedulesResponse
+	(*CancelSeasonScheduleRequest)(nil),  // 29: hungergames.v1.CancelSeasonScheduleRequest
+	(*CancelSeasonScheduleResponse)(nil), // 30: hungergames.v1.CancelSeasonScheduleResponse
+	(*timestamppb.Timestamp)(nil),        // 31: google.protobuf.Timestamp
 }
 var file_hungergames_v1_season_proto_depIdxs = []int32{
-	13, // 0: hungergames.v1.SeasonInfo.started_at:type_name -> google.protobuf.Timestamp
-	13, // 1: hungergames.v1.SeasonInfo.ended_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: hungergames.v1.ResetSeasonRequest.rewards:type_name -> hungergames.v1.SeasonReward
-	0,  // 3: hungergames.v1.CreateSeasonResponse.season:type_name -> hungergames.v1.SeasonInfo
-	0,  // 4: hungergames.v1.ListSeasonsResponse.seasons:type_name -> hungergames.v1.SeasonInfo
-	9,  // 5: hungergames.v1.GetSeasonLeaderboardResponse.entries:type_name -> hungergames.v1.SeasonResultEntry
-	9,  // 6: hungergames.v1.GetPlayerStatsResponse.stats:type_name -> hungergames.v1.SeasonResultEntry
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	31, // 0: hungergames.v1.SeasonInfo.started_at:type_name -> google.protobuf.Timestamp
+	31, // 1: hungergames.v1.SeasonInfo.ended_at:type_name -> google.protobuf.Timestamp
+	31, // 2: hungergames.v1.SeasonInfo.ends_at:type_name -> google.protobuf.Timestamp
+	5,  // 3: hungergames.v1.SeasonInfo.rewards:type_name -> hungergames.v1.SeasonReward
+	0,  // 4: hungergames.v1.SeasonInfo.rating_system:type_name -> hungergames.v1.RatingSystem
+	7,  // 5: hungergames.v1.SeasonInfo.reward_tiers:type_name -> hungergames.v1.RewardTier
+	1,  // 6: hungergames.v1.Reward.kind:type_name -> hungergames.v1.RewardKind
+	6,  // 7: hungergames.v1.RewardTier.rewards:type_name -> hungergames.v1.Reward
+	5,  // 8: hungergames.v1.ResetSeasonRequest.rewards:type_name -> hungergames.v1.SeasonReward
+	7,  // 9: hungergames.v1.ResetSeasonRequest.reward_tiers:type_name -> hungergames.v1.RewardTier
+	10, // 10: hungergames.v1.ResetSeasonResponse.job:type_name -> hungergames.v1.SeasonResetJob
+	2,  // 11: hungergames.v1.SeasonResetJob.step:type_name -> hungergames.v1.SeasonResetStep
+	3,  // 12: hungergames.v1.SeasonResetJob.status:type_name -> hungergames.v1.SeasonResetStatus
+	5,  // 13: hungergames.v1.SeasonResetJob.rewards:type_name -> hungergames.v1.SeasonReward
+	31, // 14: hungergames.v1.SeasonResetJob.started_at:type_name -> google.protobuf.Timestamp
+	31, // 15: hungergames.v1.SeasonResetJob.updated_at:type_name -> google.protobuf.Timestamp
+	31, // 16: hungergames.v1.SeasonResetJob.completed_at:type_name -> google.protobuf.Timestamp
+	7,  // 17: hungergames.v1.SeasonResetJob.reward_tiers:type_name -> hungergames.v1.RewardTier
+	10, // 18: hungergames.v1.ListSeasonResetsResponse.resets:type_name -> hungergames.v1.SeasonResetJob
+	31, // 19: hungergames.v1.CreateSeasonRequest.ends_at:type_name -> google.protobuf.Timestamp
+	5,  // 20: hungergames.v1.CreateSeasonRequest.rewards:type_name -> hungergames.v1.SeasonReward
+	0,  // 21: hungergames.v1.CreateSeasonRequest.rating_system:type_name -> hungergames.v1.RatingSystem
+	7,  // 22: hungergames.v1.CreateSeasonRequest.reward_tiers:type_name -> hungergames.v1.RewardTier
+	4,  // 23: hungergames.v1.CreateSeasonResponse.season:type_name -> hungergames.v1.SeasonInfo
+	4,  // 24: hungergames.v1.ListSeasonsResponse.seasons:type_name -> hungergames.v1.SeasonInfo
+	6,  // 25: hungergames.v1.SeasonResultEntry.rewards:type_name -> hungergames.v1.Reward
+	20, // 26: hungergames.v1.GetSeasonLeaderboardResponse.entries:type_name -> hungergames.v1.SeasonResultEntry
+	20, // 27: hungergames.v1.GetPlayerStatsResponse.stats:type_name -> hungergames.v1.SeasonResultEntry
+	31, // 28: hungergames.v1.SeasonSchedule.starts_at:type_name -> google.protobuf.Timestamp
+	31, // 29: hungergames.v1.SeasonSchedule.ends_at:type_name -> google.protobuf.Timestamp
+	5,  // 30: hungergames.v1.SeasonSchedule.rewards:type_name -> hungergames.v1.SeasonReward
+	0,  // 31: hungergames.v1.SeasonSchedule.rating_system:type_name -> hungergames.v1.RatingSystem
+	7,  // 32: hungergames.v1.SeasonSchedule.reward_tiers:type_name -> hungergames.v1.RewardTier
+	31, // 33: hungergames.v1.ScheduleSeasonRequest.starts_at:type_name -> google.protobuf.Timestamp
+	31, // 34: hungergames.v1.ScheduleSeasonRequest.ends_at:type_name -> google.protobuf.Timestamp
+	5,  // 35: hungergames.v1.ScheduleSeasonRequest.rewards:type_name -> hungergames.v1.SeasonReward
+	0,  // 36: hungergames.v1.ScheduleSeasonRequest.rating_system:type_name -> hungergames.v1.RatingSystem
+	7,  // 37: hungergames.v1.ScheduleSeasonRequest.reward_tiers:type_name -> hungergames.v1.RewardTier
+	24, // 38: hungergames.v1.ScheduleSeasonResponse.schedule:type_name -> hungergames.v1.SeasonSchedule
+	24, // 39: hungergames.v1.ListSeasonSchedulesResponse.schedules:type_name -> hungergames.v1.SeasonSchedule
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_hungergames_v1_season_proto_init() }
@@ -840,13 +2126,14 @@ func file_hungergames_v1_season_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hungergames_v1_season_proto_rawDesc), len(file_hungergames_v1_season_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   13,
+			NumEnums:      4,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_hungergames_v1_season_proto_goTypes,
 		DependencyIndexes: file_hungergames_v1_season_proto_depIdxs,
+		EnumInfos:         file_hungergames_v1_season_proto_enumTypes,
 		MessageInfos:      file_hungergames_v1_season_proto_msgTypes,
 	}.Build()
 	File_hungergames_v1_season_proto = out.File
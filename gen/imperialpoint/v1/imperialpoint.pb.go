@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: imperialpoint/v1/imperialpoint.proto
 
@@ -84,6 +84,94 @@ func (x *PointControl) GetControlledSince() *timestamppb.Timestamp {
 	return nil
 }
 
+// An attack in progress on a point.
+type PointSiege struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	AttackerSettlementId string                 `protobuf:"bytes,1,opt,name=attacker_settlement_id,json=attackerSettlementId,proto3" json:"attacker_settlement_id,omitempty"`
+	AttackerSide         string                 `protobuf:"bytes,2,opt,name=attacker_side,json=attackerSide,proto3" json:"attacker_side,omitempty"`
+	// Empty if the point was unclaimed when the attack was declared.
+	DefenderSettlementId string                 `protobuf:"bytes,3,opt,name=defender_settlement_id,json=defenderSettlementId,proto3" json:"defender_settlement_id,omitempty"`
+	DeclaredAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=declared_at,json=declaredAt,proto3" json:"declared_at,omitempty"`
+	// The capture must complete before this instant.
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	// Capture progress in percent, 0-100.
+	Progress      int32 `protobuf:"varint,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PointSiege) Reset() {
+	*x = PointSiege{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointSiege) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointSiege) ProtoMessage() {}
+
+func (x *PointSiege) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointSiege.ProtoReflect.Descriptor instead.
+func (*PointSiege) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PointSiege) GetAttackerSettlementId() string {
+	if x != nil {
+		return x.AttackerSettlementId
+	}
+	return ""
+}
+
+func (x *PointSiege) GetAttackerSide() string {
+	if x != nil {
+		return x.AttackerSide
+	}
+	return ""
+}
+
+func (x *PointSiege) GetDefenderSettlementId() string {
+	if x != nil {
+		return x.DefenderSettlementId
+	}
+	return ""
+}
+
+func (x *PointSiege) GetDeclaredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeclaredAt
+	}
+	return nil
+}
+
+func (x *PointSiege) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *PointSiege) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
 type ImperialPoint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -92,13 +180,15 @@ type ImperialPoint struct {
 	BiRatePerHour int64                  `protobuf:"varint,4,opt,name=bi_rate_per_hour,json=biRatePerHour,proto3" json:"bi_rate_per_hour,omitempty"`
 	TreeId        string                 `protobuf:"bytes,5,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
 	Control       *PointControl          `protobuf:"bytes,6,opt,name=control,proto3" json:"control,omitempty"`
+	// Set while the point is contested.
+	Siege         *PointSiege `protobuf:"bytes,7,opt,name=siege,proto3" json:"siege,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ImperialPoint) Reset() {
 	*x = ImperialPoint{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[1]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -110,7 +200,7 @@ func (x *ImperialPoint) String() string {
 func (*ImperialPoint) ProtoMessage() {}
 
 func (x *ImperialPoint) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[1]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,7 +213,7 @@ func (x *ImperialPoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImperialPoint.ProtoReflect.Descriptor instead.
 func (*ImperialPoint) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{1}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ImperialPoint) GetId() string {
@@ -168,6 +258,13 @@ func (x *ImperialPoint) GetControl() *PointControl {
 	return nil
 }
 
+func (x *ImperialPoint) GetSiege() *PointSiege {
+	if x != nil {
+		return x.Siege
+	}
+	return nil
+}
+
 type CreatePointRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -180,7 +277,7 @@ type CreatePointRequest struct {
 
 func (x *CreatePointRequest) Reset() {
 	*x = CreatePointRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[2]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -192,7 +289,7 @@ func (x *CreatePointRequest) String() string {
 func (*CreatePointRequest) ProtoMessage() {}
 
 func (x *CreatePointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[2]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -205,7 +302,7 @@ func (x *CreatePointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePointRequest.ProtoReflect.Descriptor instead.
 func (*CreatePointRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{2}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreatePointRequest) GetName() string {
@@ -249,7 +346,7 @@ type UpdatePointRequest struct {
 
 func (x *UpdatePointRequest) Reset() {
 	*x = UpdatePointRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[3]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -261,7 +358,7 @@ func (x *UpdatePointRequest) String() string {
 func (*UpdatePointRequest) ProtoMessage() {}
 
 func (x *UpdatePointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[3]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,7 +371,7 @@ func (x *UpdatePointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePointRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePointRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{3}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdatePointRequest) GetId() string {
@@ -321,7 +418,7 @@ type GetPointRequest struct {
 
 func (x *GetPointRequest) Reset() {
 	*x = GetPointRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[4]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -333,7 +430,7 @@ func (x *GetPointRequest) String() string {
 func (*GetPointRequest) ProtoMessage() {}
 
 func (x *GetPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[4]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +443,7 @@ func (x *GetPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPointRequest.ProtoReflect.Descriptor instead.
 func (*GetPointRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{4}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetPointRequest) GetId() string {
@@ -364,7 +461,7 @@ type ListPointsRequest struct {
 
 func (x *ListPointsRequest) Reset() {
 	*x = ListPointsRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[5]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -376,7 +473,7 @@ func (x *ListPointsRequest) String() string {
 func (*ListPointsRequest) ProtoMessage() {}
 
 func (x *ListPointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[5]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -389,7 +486,7 @@ func (x *ListPointsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPointsRequest.ProtoReflect.Descriptor instead.
 func (*ListPointsRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{5}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{6}
 }
 
 type ListPointsResponse struct {
@@ -401,7 +498,7 @@ type ListPointsResponse struct {
 
 func (x *ListPointsResponse) Reset() {
 	*x = ListPointsResponse{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[6]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -413,7 +510,7 @@ func (x *ListPointsResponse) String() string {
 func (*ListPointsResponse) ProtoMessage() {}
 
 func (x *ListPointsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[6]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,7 +523,7 @@ func (x *ListPointsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPointsResponse.ProtoReflect.Descriptor instead.
 func (*ListPointsResponse) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{6}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListPointsResponse) GetPoints() []*ImperialPoint {
@@ -437,17 +534,18 @@ func (x *ListPointsResponse) GetPoints() []*ImperialPoint {
 }
 
 type SetControlRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PointId       string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
-	SettlementId  string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
-	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PointId      string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	SettlementId string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Id of the settlement's faction.
+	Side          string `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetControlRequest) Reset() {
 	*x = SetControlRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[7]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +557,7 @@ func (x *SetControlRequest) String() string {
 func (*SetControlRequest) ProtoMessage() {}
 
 func (x *SetControlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[7]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +570,7 @@ func (x *SetControlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetControlRequest.ProtoReflect.Descriptor instead.
 func (*SetControlRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{7}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SetControlRequest) GetPointId() string {
@@ -505,7 +603,7 @@ type ReleaseControlRequest struct {
 
 func (x *ReleaseControlRequest) Reset() {
 	*x = ReleaseControlRequest{}
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[8]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -517,7 +615,7 @@ func (x *ReleaseControlRequest) String() string {
 func (*ReleaseControlRequest) ProtoMessage() {}
 
 func (x *ReleaseControlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[8]
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -530,7 +628,7 @@ func (x *ReleaseControlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseControlRequest.ProtoReflect.Descriptor instead.
 func (*ReleaseControlRequest) Descriptor() ([]byte, []int) {
-	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{8}
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ReleaseControlRequest) GetPointId() string {
@@ -540,147 +638,588 @@ func (x *ReleaseControlRequest) GetPointId() string {
 	return ""
 }
 
+type DeclareAttackRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PointId      string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	SettlementId string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Id of the settlement's faction.
+	Side          string `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclareAttackRequest) Reset() {
+	*x = DeclareAttackRequest{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclareAttackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclareAttackRequest) ProtoMessage() {}
+
+func (x *DeclareAttackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclareAttackRequest.ProtoReflect.Descriptor instead.
+func (*DeclareAttackRequest) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeclareAttackRequest) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *DeclareAttackRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *DeclareAttackRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+// One stretch of a settlement holding a point.
+type ControlPeriod struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Side         string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	From         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Unset while the settlement still holds the point.
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlPeriod) Reset() {
+	*x = ControlPeriod{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlPeriod) ProtoMessage() {}
+
+func (x *ControlPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlPeriod.ProtoReflect.Descriptor instead.
+func (*ControlPeriod) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ControlPeriod) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ControlPeriod) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ControlPeriod) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ControlPeriod) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetPointTimelineRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	PointId string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	// Only periods overlapping [since, until) are returned. since defaults to
+	// the first recorded period, until to now.
+	Since         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPointTimelineRequest) Reset() {
+	*x = GetPointTimelineRequest{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPointTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPointTimelineRequest) ProtoMessage() {}
+
+func (x *GetPointTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPointTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPointTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPointTimelineRequest) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *GetPointTimelineRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetPointTimelineRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type PointTimeline struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PointId       string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	Periods       []*ControlPeriod       `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PointTimeline) Reset() {
+	*x = PointTimeline{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PointTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointTimeline) ProtoMessage() {}
+
+func (x *PointTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointTimeline.ProtoReflect.Descriptor instead.
+func (*PointTimeline) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PointTimeline) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *PointTimeline) GetPeriods() []*ControlPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type GetControlStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Range to aggregate over. since defaults to 7 days before until, until to
+	// now; hold time after now is not counted.
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetControlStatsRequest) Reset() {
+	*x = GetControlStatsRequest{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetControlStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetControlStatsRequest) ProtoMessage() {}
+
+func (x *GetControlStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetControlStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetControlStatsRequest) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetControlStatsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetControlStatsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type SettlementHoldTime struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	HeldSeconds  int64                  `protobuf:"varint,2,opt,name=held_seconds,json=heldSeconds,proto3" json:"held_seconds,omitempty"`
+	// Distinct points held at some time in the range.
+	PointsHeld    int32 `protobuf:"varint,3,opt,name=points_held,json=pointsHeld,proto3" json:"points_held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementHoldTime) Reset() {
+	*x = SettlementHoldTime{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementHoldTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementHoldTime) ProtoMessage() {}
+
+func (x *SettlementHoldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementHoldTime.ProtoReflect.Descriptor instead.
+func (*SettlementHoldTime) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SettlementHoldTime) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *SettlementHoldTime) GetHeldSeconds() int64 {
+	if x != nil {
+		return x.HeldSeconds
+	}
+	return 0
+}
+
+func (x *SettlementHoldTime) GetPointsHeld() int32 {
+	if x != nil {
+		return x.PointsHeld
+	}
+	return 0
+}
+
+type SideHoldTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Side          string                 `protobuf:"bytes,1,opt,name=side,proto3" json:"side,omitempty"`
+	HeldSeconds   int64                  `protobuf:"varint,2,opt,name=held_seconds,json=heldSeconds,proto3" json:"held_seconds,omitempty"`
+	PointsHeld    int32                  `protobuf:"varint,3,opt,name=points_held,json=pointsHeld,proto3" json:"points_held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SideHoldTime) Reset() {
+	*x = SideHoldTime{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SideHoldTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SideHoldTime) ProtoMessage() {}
+
+func (x *SideHoldTime) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SideHoldTime.ProtoReflect.Descriptor instead.
+func (*SideHoldTime) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SideHoldTime) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *SideHoldTime) GetHeldSeconds() int64 {
+	if x != nil {
+		return x.HeldSeconds
+	}
+	return 0
+}
+
+func (x *SideHoldTime) GetPointsHeld() int32 {
+	if x != nil {
+		return x.PointsHeld
+	}
+	return 0
+}
+
+type ControlStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Until         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	Settlements   []*SettlementHoldTime  `protobuf:"bytes,3,rep,name=settlements,proto3" json:"settlements,omitempty"`
+	Sides         []*SideHoldTime        `protobuf:"bytes,4,rep,name=sides,proto3" json:"sides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlStats) Reset() {
+	*x = ControlStats{}
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlStats) ProtoMessage() {}
+
+func (x *ControlStats) ProtoReflect() protoreflect.Message {
+	mi := &file_imperialpoint_v1_imperialpoint_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlStats.ProtoReflect.Descriptor instead.
+func (*ControlStats) Descriptor() ([]byte, []int) {
+	return file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ControlStats) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ControlStats) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ControlStats) GetSettlements() []*SettlementHoldTime {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+func (x *ControlStats) GetSides() []*SideHoldTime {
+	if x != nil {
+		return x.Sides
+	}
+	return nil
+}
+
 var File_imperialpoint_v1_imperialpoint_proto protoreflect.FileDescriptor
 
-var file_imperialpoint_v1_imperialpoint_proto_rawDesc = string([]byte{
-	0x0a, 0x24, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f,
-	0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x45, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
-	0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
-	0x0a, 0x10, 0x62, 0x69, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
-	0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x69, 0x52, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64,
-	0x12, 0x38, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0x91, 0x01, 0x0a, 0x12, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x10,
-	0x62, 0x69, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x75, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x69, 0x52, 0x61, 0x74, 0x65, 0x50, 0x65,
-	0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x22, 0xa1,
-	0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x27, 0x0a, 0x10, 0x62, 0x69, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f,
-	0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x69, 0x52, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x65,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65,
-	0x49, 0x64, 0x22, 0x26, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69,
-	0x73, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x76,
-	0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x08, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x32,
-	0x93, 0x06, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a, 0x01, 0x2a, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x69,
-	0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x79,
-	0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x24, 0x2e,
-	0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x32,
-	0x18, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x70, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1a, 0x12, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0a, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76,
-	0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x73, 0x12, 0x89, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x12, 0x23, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01,
-	0x2a, 0x22, 0x2a, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x7d, 0x3a, 0x73, 0x65, 0x74, 0x2d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x95, 0x01,
-	0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x12, 0x27, 0x2e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x2d, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2d, 0x63, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0xd1, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6d,
-	0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x12,
-	0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f, 0x76, 0x73, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x69, 0x6d, 0x70, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x58, 0x58,
-	0xaa, 0x02, 0x10, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x10, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1c, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-})
+const file_imperialpoint_v1_imperialpoint_proto_rawDesc = "" +
+	"\n" +
+	"$imperialpoint/v1/imperialpoint.proto\x12\x10imperialpoint.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8e\x01\n" +
+	"\fPointControl\x12\x12\n" +
+	"\x04side\x18\x01 \x01(\tR\x04side\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12E\n" +
+	"\x10controlled_since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fcontrolledSince\"\xab\x02\n" +
+	"\n" +
+	"PointSiege\x124\n" +
+	"\x16attacker_settlement_id\x18\x01 \x01(\tR\x14attackerSettlementId\x12#\n" +
+	"\rattacker_side\x18\x02 \x01(\tR\fattackerSide\x124\n" +
+	"\x16defender_settlement_id\x18\x03 \x01(\tR\x14defenderSettlementId\x12;\n" +
+	"\vdeclared_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"declaredAt\x123\n" +
+	"\aends_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1a\n" +
+	"\bprogress\x18\x06 \x01(\x05R\bprogress\"\x85\x02\n" +
+	"\rImperialPoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x10bi_rate_per_hour\x18\x04 \x01(\x03R\rbiRatePerHour\x12\x17\n" +
+	"\atree_id\x18\x05 \x01(\tR\x06treeId\x128\n" +
+	"\acontrol\x18\x06 \x01(\v2\x1e.imperialpoint.v1.PointControlR\acontrol\x122\n" +
+	"\x05siege\x18\a \x01(\v2\x1c.imperialpoint.v1.PointSiegeR\x05siege\"\x91\x01\n" +
+	"\x12CreatePointRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
+	"\x10bi_rate_per_hour\x18\x03 \x01(\x03R\rbiRatePerHour\x12\x17\n" +
+	"\atree_id\x18\x04 \x01(\tR\x06treeId\"\xa1\x01\n" +
+	"\x12UpdatePointRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x10bi_rate_per_hour\x18\x04 \x01(\x03R\rbiRatePerHour\x12\x17\n" +
+	"\atree_id\x18\x05 \x01(\tR\x06treeId\"&\n" +
+	"\x0fGetPointRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x13\n" +
+	"\x11ListPointsRequest\"M\n" +
+	"\x12ListPointsResponse\x127\n" +
+	"\x06points\x18\x01 \x03(\v2\x1f.imperialpoint.v1.ImperialPointR\x06points\"v\n" +
+	"\x11SetControlRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\x12(\n" +
+	"\rsettlement_id\x18\x02 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x17\n" +
+	"\x04side\x18\x03 \x01(\tB\x03\xe0A\x02R\x04side\"7\n" +
+	"\x15ReleaseControlRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\"y\n" +
+	"\x14DeclareAttackRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\x12(\n" +
+	"\rsettlement_id\x18\x02 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x17\n" +
+	"\x04side\x18\x03 \x01(\tB\x03\xe0A\x02R\x04side\"\xa4\x01\n" +
+	"\rControlPeriod\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x9d\x01\n" +
+	"\x17GetPointTimelineRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\x120\n" +
+	"\x05since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"e\n" +
+	"\rPointTimeline\x12\x19\n" +
+	"\bpoint_id\x18\x01 \x01(\tR\apointId\x129\n" +
+	"\aperiods\x18\x02 \x03(\v2\x1f.imperialpoint.v1.ControlPeriodR\aperiods\"|\n" +
+	"\x16GetControlStatsRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"}\n" +
+	"\x12SettlementHoldTime\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12!\n" +
+	"\fheld_seconds\x18\x02 \x01(\x03R\vheldSeconds\x12\x1f\n" +
+	"\vpoints_held\x18\x03 \x01(\x05R\n" +
+	"pointsHeld\"f\n" +
+	"\fSideHoldTime\x12\x12\n" +
+	"\x04side\x18\x01 \x01(\tR\x04side\x12!\n" +
+	"\fheld_seconds\x18\x02 \x01(\x03R\vheldSeconds\x12\x1f\n" +
+	"\vpoints_held\x18\x03 \x01(\x05R\n" +
+	"pointsHeld\"\xf0\x01\n" +
+	"\fControlStats\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x120\n" +
+	"\x05until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\x12F\n" +
+	"\vsettlements\x18\x03 \x03(\v2$.imperialpoint.v1.SettlementHoldTimeR\vsettlements\x124\n" +
+	"\x05sides\x18\x04 \x03(\v2\x1e.imperialpoint.v1.SideHoldTimeR\x05sides2\xbb\t\n" +
+	"\x14ImperialPointService\x12t\n" +
+	"\vCreatePoint\x12$.imperialpoint.v1.CreatePointRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/imperial-points\x12y\n" +
+	"\vUpdatePoint\x12$.imperialpoint.v1.UpdatePointRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\"#\x82\xd3\xe4\x93\x02\x1d:\x01*2\x18/v1/imperial-points/{id}\x12p\n" +
+	"\bGetPoint\x12!.imperialpoint.v1.GetPointRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/imperial-points/{id}\x12t\n" +
+	"\n" +
+	"ListPoints\x12#.imperialpoint.v1.ListPointsRequest\x1a$.imperialpoint.v1.ListPointsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/imperial-points\x12\x89\x01\n" +
+	"\n" +
+	"SetControl\x12#.imperialpoint.v1.SetControlRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/imperial-points/{point_id}:set-control\x12\x95\x01\n" +
+	"\x0eReleaseControl\x12'.imperialpoint.v1.ReleaseControlRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\"9\x82\xd3\xe4\x93\x023:\x01*\"./v1/imperial-points/{point_id}:release-control\x12\x8a\x01\n" +
+	"\rDeclareAttack\x12&.imperialpoint.v1.DeclareAttackRequest\x1a\x1f.imperialpoint.v1.ImperialPoint\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/imperial-points/{point_id}:attack\x12\x8f\x01\n" +
+	"\x10GetPointTimeline\x12).imperialpoint.v1.GetPointTimelineRequest\x1a\x1f.imperialpoint.v1.PointTimeline\"/\x82\xd3\xe4\x93\x02)\x12'/v1/imperial-points/{point_id}/timeline\x12\x86\x01\n" +
+	"\x0fGetControlStats\x12(.imperialpoint.v1.GetControlStatsRequest\x1a\x1e.imperialpoint.v1.ControlStats\")\x82\xd3\xe4\x93\x02#\x12!/v1/imperial-points/control-statsBFZDgithub.com/lasthearth/vsservice/gen/imperialpoint/v1;imperialpointv1b\x06proto3"
 
 var (
 	file_imperialpoint_v1_imperialpoint_proto_rawDescOnce sync.Once
@@ -694,40 +1233,69 @@ func file_imperialpoint_v1_imperialpoint_proto_rawDescGZIP() []byte {
 	return file_imperialpoint_v1_imperialpoint_proto_rawDescData
 }
 
-var file_imperialpoint_v1_imperialpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_imperialpoint_v1_imperialpoint_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_imperialpoint_v1_imperialpoint_proto_goTypes = []any{
-	(*PointControl)(nil),          // 0: imperialpoint.v1.PointControl
-	(*ImperialPoint)(nil),         // 1: imperialpoint.v1.ImperialPoint
-	(*CreatePointRequest)(nil),    // 2: imperialpoint.v1.CreatePointRequest
-	(*UpdatePointRequest)(nil),    // 3: imperialpoint.v1.UpdatePointRequest
-	(*GetPointRequest)(nil),       // 4: imperialpoint.v1.GetPointRequest
-	(*ListPointsRequest)(nil),     // 5: imperialpoint.v1.ListPointsRequest
-	(*ListPointsResponse)(nil),    // 6: imperialpoint.v1.ListPointsResponse
-	(*SetControlRequest)(nil),     // 7: imperialpoint.v1.SetControlRequest
-	(*ReleaseControlRequest)(nil), // 8: imperialpoint.v1.ReleaseControlRequest
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*PointControl)(nil),            // 0: imperialpoint.v1.PointControl
+	(*PointSiege)(nil),              // 1: imperialpoint.v1.PointSiege
+	(*ImperialPoint)(nil),           // 2: imperialpoint.v1.ImperialPoint
+	(*CreatePointRequest)(nil),      // 3: imperialpoint.v1.CreatePointRequest
+	(*UpdatePointRequest)(nil),      // 4: imperialpoint.v1.UpdatePointRequest
+	(*GetPointRequest)(nil),         // 5: imperialpoint.v1.GetPointRequest
+	(*ListPointsRequest)(nil),       // 6: imperialpoint.v1.ListPointsRequest
+	(*ListPointsResponse)(nil),      // 7: imperialpoint.v1.ListPointsResponse
+	(*SetControlRequest)(nil),       // 8: imperialpoint.v1.SetControlRequest
+	(*ReleaseControlRequest)(nil),   // 9: imperialpoint.v1.ReleaseControlRequest
+	(*DeclareAttackRequest)(nil),    // 10: imperialpoint.v1.DeclareAttackRequest
+	(*ControlPeriod)(nil),           // 11: imperialpoint.v1.ControlPeriod
+	(*GetPointTimelineRequest)(nil), // 12: imperialpoint.v1.GetPointTimelineRequest
+	(*PointTimeline)(nil),           // 13: imperialpoint.v1.PointTimeline
+	(*GetControlStatsRequest)(nil),  // 14: imperialpoint.v1.GetControlStatsRequest
+	(*SettlementHoldTime)(nil),      // 15: imperialpoint.v1.SettlementHoldTime
+	(*SideHoldTime)(nil),            // 16: imperialpoint.v1.SideHoldTime
+	(*ControlStats)(nil),            // 17: imperialpoint.v1.ControlStats
+	(*timestamppb.Timestamp)(nil),   // 18: google.protobuf.Timestamp
 }
 var file_imperialpoint_v1_imperialpoint_proto_depIdxs = []int32{
-	9, // 0: imperialpoint.v1.PointControl.controlled_since:type_name -> google.protobuf.Timestamp
-	0, // 1: imperialpoint.v1.ImperialPoint.control:type_name -> imperialpoint.v1.PointControl
-	1, // 2: imperialpoint.v1.ListPointsResponse.points:type_name -> imperialpoint.v1.ImperialPoint
-	2, // 3: imperialpoint.v1.ImperialPointService.CreatePoint:input_type -> imperialpoint.v1.CreatePointRequest
-	3, // 4: imperialpoint.v1.ImperialPointService.UpdatePoint:input_type -> imperialpoint.v1.UpdatePointRequest
-	4, // 5: imperialpoint.v1.ImperialPointService.GetPoint:input_type -> imperialpoint.v1.GetPointRequest
-	5, // 6: imperialpoint.v1.ImperialPointService.ListPoints:input_type -> imperialpoint.v1.ListPointsRequest
-	7, // 7: imperialpoint.v1.ImperialPointService.SetControl:input_type -> imperialpoint.v1.SetControlRequest
-	8, // 8: imperialpoint.v1.ImperialPointService.ReleaseControl:input_type -> imperialpoint.v1.ReleaseControlRequest
-	1, // 9: imperialpoint.v1.ImperialPointService.CreatePoint:output_type -> imperialpoint.v1.ImperialPoint
-	1, // 10: imperialpoint.v1.ImperialPointService.UpdatePoint:output_type -> imperialpoint.v1.ImperialPoint
-	1, // 11: imperialpoint.v1.ImperialPointService.GetPoint:output_type -> imperialpoint.v1.ImperialPoint
-	6, // 12: imperialpoint.v1.ImperialPointService.ListPoints:output_type -> imperialpoint.v1.ListPointsResponse
-	1, // 13: imperialpoint.v1.ImperialPointService.SetControl:output_type -> imperialpoint.v1.ImperialPoint
-	1, // 14: imperialpoint.v1.ImperialPointService.ReleaseControl:output_type -> imperialpoint.v1.ImperialPoint
-	9, // [9:15] is the sub-list for method output_type
-	3, // [3:9] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	18, // 0: imperialpoint.v1.PointControl.controlled_since:type_name -> google.protobuf.Timestamp
+	18, // 1: imperialpoint.v1.PointSiege.declared_at:type_name -> google.protobuf.Timestamp
+	18, // 2: imperialpoint.v1.PointSiege.ends_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: imperialpoint.v1.ImperialPoint.control:type_name -> imperialpoint.v1.PointControl
+	1,  // 4: imperialpoint.v1.ImperialPoint.siege:type_name -> imperialpoint.v1.PointSiege
+	2,  // 5: imperialpoint.v1.ListPointsResponse.points:type_name -> imperialpoint.v1.ImperialPoint
+	18, // 6: imperialpoint.v1.ControlPeriod.from:type_name -> google.protobuf.Timestamp
+	18, // 7: imperialpoint.v1.ControlPeriod.to:type_name -> google.protobuf.Timestamp
+	18, // 8: imperialpoint.v1.GetPointTimelineRequest.since:type_name -> google.protobuf.Timestamp
+	18, // 9: imperialpoint.v1.GetPointTimelineRequest.until:type_name -> google.protobuf.Timestamp
+	11, // 10: imperialpoint.v1.PointTimeline.periods:type_name -> imperialpoint.v1.ControlPeriod
+	18, // 11: imperialpoint.v1.GetControlStatsRequest.since:type_name -> google.protobuf.Timestamp
+	18, // 12: imperialpoint.v1.GetControlStatsRequest.until:type_name -> google.protobuf.Timestamp
+	18, // 13: imperialpoint.v1.ControlStats.since:type_name -> google.protobuf.Timestamp
+	18, // 14: imperialpoint.v1.ControlStats.until:type_name -> google.protobuf.Timestamp
+	15, // 15: imperialpoint.v1.ControlStats.settlements:type_name -> imperialpoint.v1.SettlementHoldTime
+	16, // 16: imperialpoint.v1.ControlStats.sides:type_name -> imperialpoint.v1.SideHoldTime
+	3,  // 17: imperialpoint.v1.ImperialPointService.CreatePoint:input_type -> imperialpoint.v1.CreatePointRequest
+	4,  // 18: imperialpoint.v1.ImperialPointService.UpdatePoint:input_type -> imperialpoint.v1.UpdatePointRequest
+	5,  // 19: imperialpoint.v1.ImperialPointService.GetPoint:input_type -> imperialpoint.v1.GetPointRequest
+	6,  // 20: imperialpoint.v1.ImperialPointService.ListPoints:input_type -> imperialpoint.v1.ListPointsRequest
+	8,  // 21: imperialpoint.v1.ImperialPointService.SetControl:input_type -> imperialpoint.v1.SetControlRequest
+	9,  // 22: imperialpoint.v1.ImperialPointService.ReleaseControl:input_type -> imperialpoint.v1.ReleaseControlRequest
+	10, // 23: imperialpoint.v1.ImperialPointService.DeclareAttack:input_type -> imperialpoint.v1.DeclareAttackRequest
+	12, // 24: imperialpoint.v1.ImperialPointService.GetPointTimeline:input_type -> imperialpoint.v1.GetPointTimelineRequest
+	14, // 25: imperialpoint.v1.ImperialPointService.GetControlStats:input_type -> imperialpoint.v1.GetControlStatsRequest
+	2,  // 26: imperialpoint.v1.ImperialPointService.CreatePoint:output_type -> imperialpoint.v1.ImperialPoint
+	2,  // 27: imperialpoint.v1.ImperialPointService.UpdatePoint:output_type -> imperialpoint.v1.ImperialPoint
+	2,  // 28: imperialpoint.v1.ImperialPointService.GetPoint:output_type -> imperialpoint.v1.ImperialPoint
+	7,  // 29: imperialpoint.v1.ImperialPointService.ListPoints:output_type -> imperialpoint.v1.ListPointsResponse
+	2,  // 30: imperialpoint.v1.ImperialPointService.SetControl:output_type -> imperialpoint.v1.ImperialPoint
+	2,  // 31: imperialpoint.v1.ImperialPointService.ReleaseControl:output_type -> imperialpoint.v1.ImperialPoint
+	2,  // 32: imperialpoint.v1.ImperialPointService.DeclareAttack:output_type -> imperialpoint.v1.ImperialPoint
+	13, // 33: imperialpoint.v1.ImperialPointService.GetPointTimeline:output_type -> imperialpoint.v1.PointTimeline
+	17, // 34: imperialpoint.v1.ImperialPointService.GetControlStats:output_type -> imperialpoint.v1.ControlStats
+	26, // [26:35] is the sub-list for method output_type
+	17, // [17:26] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_imperialpoint_v1_imperialpoint_proto_init() }
@@ -741,7 +1309,7 @@ func file_imperialpoint_v1_imperialpoint_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_imperialpoint_v1_imperialpoint_proto_rawDesc), len(file_imperialpoint_v1_imperialpoint_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
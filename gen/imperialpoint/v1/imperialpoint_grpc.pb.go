@@ -19,12 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ImperialPointService_CreatePoint_FullMethodName    = "/imperialpoint.v1.ImperialPointService/CreatePoint"
-	ImperialPointService_UpdatePoint_FullMethodName    = "/imperialpoint.v1.ImperialPointService/UpdatePoint"
-	ImperialPointService_GetPoint_FullMethodName       = "/imperialpoint.v1.ImperialPointService/GetPoint"
-	ImperialPointService_ListPoints_FullMethodName     = "/imperialpoint.v1.ImperialPointService/ListPoints"
-	ImperialPointService_SetControl_FullMethodName     = "/imperialpoint.v1.ImperialPointService/SetControl"
-	ImperialPointService_ReleaseControl_FullMethodName = "/imperialpoint.v1.ImperialPointService/ReleaseControl"
+	ImperialPointService_CreatePoint_FullMethodName      = "/imperialpoint.v1.ImperialPointService/CreatePoint"
+	ImperialPointService_UpdatePoint_FullMethodName      = "/imperialpoint.v1.ImperialPointService/UpdatePoint"
+	ImperialPointService_GetPoint_FullMethodName         = "/imperialpoint.v1.ImperialPointService/GetPoint"
+	ImperialPointService_ListPoints_FullMethodName       = "/imperialpoint.v1.ImperialPointService/ListPoints"
+	ImperialPointService_SetControl_FullMethodName       = "/imperialpoint.v1.ImperialPointService/SetControl"
+	ImperialPointService_ReleaseControl_FullMethodName   = "/imperialpoint.v1.ImperialPointService/ReleaseControl"
+	ImperialPointService_DeclareAttack_FullMethodName    = "/imperialpoint.v1.ImperialPointService/DeclareAttack"
+	ImperialPointService_GetPointTimeline_FullMethodName = "/imperialpoint.v1.ImperialPointService/GetPointTimeline"
+	ImperialPointService_GetControlStats_FullMethodName  = "/imperialpoint.v1.ImperialPointService/GetControlStats"
 )
 
 // ImperialPointServiceClient is the client API for ImperialPointService service.
@@ -70,7 +73,9 @@ type ImperialPointServiceClient interface {
 	//   - NOT_FOUND (404): point or settlement not found
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires imperialpoint:write scope
-	//   - FAILED_PRECONDITION (412): side already controls 2 points
+	//   - INVALID_ARGUMENT (400): side is not a known faction
+	//   - FAILED_PRECONDITION (412): settlement is not a member of the side's
+	//     faction, or the faction is at its point limit
 	//   - INTERNAL (500): database failure
 	SetControl(ctx context.Context, in *SetControlRequest, opts ...grpc.CallOption) (*ImperialPoint, error)
 	// Release control of an imperial point. Rolls back the last purchased node.
@@ -82,6 +87,38 @@ type ImperialPointServiceClient interface {
 	//   - PERMISSION_DENIED (403): requires imperialpoint:write scope
 	//   - INTERNAL (500): database failure
 	ReleaseControl(ctx context.Context, in *ReleaseControlRequest, opts ...grpc.CallOption) (*ImperialPoint, error)
+	// Declare an attack on an imperial point. The point is contested for the
+	// configured siege window, during which the game server reports capture
+	// progress; if the capture completes in time, control passes to the
+	// attacker as with SetControl. Members of the defending settlement are
+	// notified. Caller must be the leader of the attacking settlement.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): point not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - INVALID_ARGUMENT (400): side is not a known faction
+	//   - FAILED_PRECONDITION (412): point already contested, the settlement
+	//     already controls it or is not a member of the side's faction, or the
+	//     faction is at its point limit
+	//   - INTERNAL (500): database failure
+	DeclareAttack(ctx context.Context, in *DeclareAttackRequest, opts ...grpc.CallOption) (*ImperialPoint, error)
+	// Get who held an imperial point and when, newest first. Every control
+	// change — SetControl, ReleaseControl or a capture — ends one period and
+	// may start the next. No authentication required.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): point not found
+	//   - INVALID_ARGUMENT (400): since is not before until
+	//   - INTERNAL (500): database failure
+	GetPointTimeline(ctx context.Context, in *GetPointTimelineRequest, opts ...grpc.CallOption) (*PointTimeline, error)
+	// Get how long each settlement and each side held imperial points over a
+	// period, longest first. No authentication required.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): since is not before until
+	//   - INTERNAL (500): database failure
+	GetControlStats(ctx context.Context, in *GetControlStatsRequest, opts ...grpc.CallOption) (*ControlStats, error)
 }
 
 type imperialPointServiceClient struct {
@@ -152,6 +189,36 @@ func (c *imperialPointServiceClient) ReleaseControl(ctx context.Context, in *Rel
 	return out, nil
 }
 
+func (c *imperialPointServiceClient) DeclareAttack(ctx context.Context, in *DeclareAttackRequest, opts ...grpc.CallOption) (*ImperialPoint, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImperialPoint)
+	err := c.cc.Invoke(ctx, ImperialPointService_DeclareAttack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imperialPointServiceClient) GetPointTimeline(ctx context.Context, in *GetPointTimelineRequest, opts ...grpc.CallOption) (*PointTimeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PointTimeline)
+	err := c.cc.Invoke(ctx, ImperialPointService_GetPointTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imperialPointServiceClient) GetControlStats(ctx context.Context, in *GetControlStatsRequest, opts ...grpc.CallOption) (*ControlStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ControlStats)
+	err := c.cc.Invoke(ctx, ImperialPointService_GetControlStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImperialPointServiceServer is the server API for ImperialPointService service.
 // All implementations should embed UnimplementedImperialPointServiceServer
 // for forward compatibility.
@@ -195,7 +262,9 @@ type ImperialPointServiceServer interface {
 	//   - NOT_FOUND (404): point or settlement not found
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires imperialpoint:write scope
-	//   - FAILED_PRECONDITION (412): side already controls 2 points
+	//   - INVALID_ARGUMENT (400): side is not a known faction
+	//   - FAILED_PRECONDITION (412): settlement is not a member of the side's
+	//     faction, or the faction is at its point limit
 	//   - INTERNAL (500): database failure
 	SetControl(context.Context, *SetControlRequest) (*ImperialPoint, error)
 	// Release control of an imperial point. Rolls back the last purchased node.
@@ -207,6 +276,38 @@ type ImperialPointServiceServer interface {
 	//   - PERMISSION_DENIED (403): requires imperialpoint:write scope
 	//   - INTERNAL (500): database failure
 	ReleaseControl(context.Context, *ReleaseControlRequest) (*ImperialPoint, error)
+	// Declare an attack on an imperial point. The point is contested for the
+	// configured siege window, during which the game server reports capture
+	// progress; if the capture completes in time, control passes to the
+	// attacker as with SetControl. Members of the defending settlement are
+	// notified. Caller must be the leader of the attacking settlement.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): point not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - INVALID_ARGUMENT (400): side is not a known faction
+	//   - FAILED_PRECONDITION (412): point already contested, the settlement
+	//     already controls it or is not a member of the side's faction, or the
+	//     faction is at its point limit
+	//   - INTERNAL (500): database failure
+	DeclareAttack(context.Context, *DeclareAttackRequest) (*ImperialPoint, error)
+	// Get who held an imperial point and when, newest first. Every control
+	// change — SetControl, ReleaseControl or a capture — ends one period and
+	// may start the next. No authentication required.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): point not found
+	//   - INVALID_ARGUMENT (400): since is not before until
+	//   - INTERNAL (500): database failure
+	GetPointTimeline(context.Context, *GetPointTimelineRequest) (*PointTimeline, error)
+	// Get how long each settlement and each side held imperial points over a
+	// period, longest first. No authentication required.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): since is not before until
+	//   - INTERNAL (500): database failure
+	GetControlStats(context.Context, *GetControlStatsRequest) (*ControlStats, error)
 }
 
 // UnimplementedImperialPointServiceServer should be embedded to have
@@ -234,6 +335,15 @@ func (UnimplementedImperialPointServiceServer) SetControl(context.Context, *SetC
 func (UnimplementedImperialPointServiceServer) ReleaseControl(context.Context, *ReleaseControlRequest) (*ImperialPoint, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReleaseControl not implemented")
 }
+func (UnimplementedImperialPointServiceServer) DeclareAttack(context.Context, *DeclareAttackRequest) (*ImperialPoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclareAttack not implemented")
+}
+func (UnimplementedImperialPointServiceServer) GetPointTimeline(context.Context, *GetPointTimelineRequest) (*PointTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPointTimeline not implemented")
+}
+func (UnimplementedImperialPointServiceServer) GetControlStats(context.Context, *GetControlStatsRequest) (*ControlStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetControlStats not implemented")
+}
 func (UnimplementedImperialPointServiceServer) testEmbeddedByValue() {}
 
 // UnsafeImperialPointServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -362,6 +472,60 @@ func _ImperialPointService_ReleaseControl_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImperialPointService_DeclareAttack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclareAttackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImperialPointServiceServer).DeclareAttack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImperialPointService_DeclareAttack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImperialPointServiceServer).DeclareAttack(ctx, req.(*DeclareAttackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImperialPointService_GetPointTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPointTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImperialPointServiceServer).GetPointTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImperialPointService_GetPointTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImperialPointServiceServer).GetPointTimeline(ctx, req.(*GetPointTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImperialPointService_GetControlStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetControlStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImperialPointServiceServer).GetControlStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImperialPointService_GetControlStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImperialPointServiceServer).GetControlStats(ctx, req.(*GetControlStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ImperialPointService_ServiceDesc is the grpc.ServiceDesc for ImperialPointService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -393,6 +557,18 @@ var ImperialPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReleaseControl",
 			Handler:    _ImperialPointService_ReleaseControl_Handler,
 		},
+		{
+			MethodName: "DeclareAttack",
+			Handler:    _ImperialPointService_DeclareAttack_Handler,
+		},
+		{
+			MethodName: "GetPointTimeline",
+			Handler:    _ImperialPointService_GetPointTimeline_Handler,
+		},
+		{
+			MethodName: "GetControlStats",
+			Handler:    _ImperialPointService_GetControlStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "imperialpoint/v1/imperialpoint.proto",
@@ -1,13 +1,15 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: progression/v1/progression.proto
 
 package progressionv1
 
 import (
+	v1 "github.com/lasthearth/vsservice/gen/settlement/v1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/known/emptypb"
@@ -24,13 +26,168 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Import / export requests
+type BundleFormat int32
+
+const (
+	// JSON.
+	BundleFormat_BUNDLE_FORMAT_UNSPECIFIED BundleFormat = 0
+	BundleFormat_BUNDLE_FORMAT_JSON        BundleFormat = 1
+	BundleFormat_BUNDLE_FORMAT_YAML        BundleFormat = 2
+)
+
+// Enum value maps for BundleFormat.
+var (
+	BundleFormat_name = map[int32]string{
+		0: "BUNDLE_FORMAT_UNSPECIFIED",
+		1: "BUNDLE_FORMAT_JSON",
+		2: "BUNDLE_FORMAT_YAML",
+	}
+	BundleFormat_value = map[string]int32{
+		"BUNDLE_FORMAT_UNSPECIFIED": 0,
+		"BUNDLE_FORMAT_JSON":        1,
+		"BUNDLE_FORMAT_YAML":        2,
+	}
+)
+
+func (x BundleFormat) Enum() *BundleFormat {
+	p := new(BundleFormat)
+	*p = x
+	return p
+}
+
+func (x BundleFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BundleFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_progression_v1_progression_proto_enumTypes[0].Descriptor()
+}
+
+func (BundleFormat) Type() protoreflect.EnumType {
+	return &file_progression_v1_progression_proto_enumTypes[0]
+}
+
+func (x BundleFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BundleFormat.Descriptor instead.
+func (BundleFormat) EnumDescriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{0}
+}
+
+type ImportAction int32
+
+const (
+	ImportAction_IMPORT_ACTION_UNSPECIFIED ImportAction = 0
+	ImportAction_IMPORT_ACTION_CREATE      ImportAction = 1
+	ImportAction_IMPORT_ACTION_UPDATE      ImportAction = 2
+	ImportAction_IMPORT_ACTION_UNCHANGED   ImportAction = 3
+)
+
+// Enum value maps for ImportAction.
+var (
+	ImportAction_name = map[int32]string{
+		0: "IMPORT_ACTION_UNSPECIFIED",
+		1: "IMPORT_ACTION_CREATE",
+		2: "IMPORT_ACTION_UPDATE",
+		3: "IMPORT_ACTION_UNCHANGED",
+	}
+	ImportAction_value = map[string]int32{
+		"IMPORT_ACTION_UNSPECIFIED": 0,
+		"IMPORT_ACTION_CREATE":      1,
+		"IMPORT_ACTION_UPDATE":      2,
+		"IMPORT_ACTION_UNCHANGED":   3,
+	}
+)
+
+func (x ImportAction) Enum() *ImportAction {
+	p := new(ImportAction)
+	*p = x
+	return p
+}
+
+func (x ImportAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_progression_v1_progression_proto_enumTypes[1].Descriptor()
+}
+
+func (ImportAction) Type() protoreflect.EnumType {
+	return &file_progression_v1_progression_proto_enumTypes[1]
+}
+
+func (x ImportAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportAction.Descriptor instead.
+func (ImportAction) EnumDescriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{1}
+}
+
+type StatModifier_Operation int32
+
+const (
+	StatModifier_OPERATION_UNSPECIFIED StatModifier_Operation = 0
+	// Add value to the stat.
+	StatModifier_OPERATION_ADD StatModifier_Operation = 1
+	// Scale the stat by value; must not be negative.
+	StatModifier_OPERATION_MULTIPLY StatModifier_Operation = 2
+)
+
+// Enum value maps for StatModifier_Operation.
+var (
+	StatModifier_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_ADD",
+		2: "OPERATION_MULTIPLY",
+	}
+	StatModifier_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_ADD":         1,
+		"OPERATION_MULTIPLY":    2,
+	}
+)
+
+func (x StatModifier_Operation) Enum() *StatModifier_Operation {
+	p := new(StatModifier_Operation)
+	*p = x
+	return p
+}
+
+func (x StatModifier_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatModifier_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_progression_v1_progression_proto_enumTypes[2].Descriptor()
+}
+
+func (StatModifier_Operation) Type() protoreflect.EnumType {
+	return &file_progression_v1_progression_proto_enumTypes[2]
+}
+
+func (x StatModifier_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatModifier_Operation.Descriptor instead.
+func (StatModifier_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type TalentNode struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Effect        string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
-	CostBi        int64                  `protobuf:"varint,5,opt,name=cost_bi,json=costBi,proto3" json:"cost_bi,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CostBi      int64                  `protobuf:"varint,5,opt,name=cost_bi,json=costBi,proto3" json:"cost_bi,omitempty"`
+	// What the node grants once purchased.
+	Effects       []*NodeEffect `protobuf:"bytes,6,rep,name=effects,proto3" json:"effects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -86,20 +243,210 @@ func (x *TalentNode) GetDescription() string {
 	return ""
 }
 
-func (x *TalentNode) GetEffect() string {
+func (x *TalentNode) GetCostBi() int64 {
+	if x != nil {
+		return x.CostBi
+	}
+	return 0
+}
+
+func (x *TalentNode) GetEffects() []*NodeEffect {
+	if x != nil {
+		return x.Effects
+	}
+	return nil
+}
+
+// NodeEffect is one typed effect of a talent node.
+type NodeEffect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Effect:
+	//
+	//	*NodeEffect_Modifier
+	//	*NodeEffect_Unlock
+	Effect        isNodeEffect_Effect `protobuf_oneof:"effect"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeEffect) Reset() {
+	*x = NodeEffect{}
+	mi := &file_progression_v1_progression_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEffect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEffect) ProtoMessage() {}
+
+func (x *NodeEffect) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEffect.ProtoReflect.Descriptor instead.
+func (*NodeEffect) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NodeEffect) GetEffect() isNodeEffect_Effect {
 	if x != nil {
 		return x.Effect
 	}
+	return nil
+}
+
+func (x *NodeEffect) GetModifier() *StatModifier {
+	if x != nil {
+		if x, ok := x.Effect.(*NodeEffect_Modifier); ok {
+			return x.Modifier
+		}
+	}
+	return nil
+}
+
+func (x *NodeEffect) GetUnlock() *Unlock {
+	if x != nil {
+		if x, ok := x.Effect.(*NodeEffect_Unlock); ok {
+			return x.Unlock
+		}
+	}
+	return nil
+}
+
+type isNodeEffect_Effect interface {
+	isNodeEffect_Effect()
+}
+
+type NodeEffect_Modifier struct {
+	Modifier *StatModifier `protobuf:"bytes,1,opt,name=modifier,proto3,oneof"`
+}
+
+type NodeEffect_Unlock struct {
+	Unlock *Unlock `protobuf:"bytes,2,opt,name=unlock,proto3,oneof"`
+}
+
+func (*NodeEffect_Modifier) isNodeEffect_Effect() {}
+
+func (*NodeEffect_Unlock) isNodeEffect_Effect() {}
+
+// StatModifier changes the numeric stat named by key.
+type StatModifier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stat key, e.g. "settlement.member_cap".
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operation     StatModifier_Operation `protobuf:"varint,2,opt,name=operation,proto3,enum=progression.v1.StatModifier_Operation" json:"operation,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatModifier) Reset() {
+	*x = StatModifier{}
+	mi := &file_progression_v1_progression_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatModifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatModifier) ProtoMessage() {}
+
+func (x *StatModifier) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatModifier.ProtoReflect.Descriptor instead.
+func (*StatModifier) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StatModifier) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
 	return ""
 }
 
-func (x *TalentNode) GetCostBi() int64 {
+func (x *StatModifier) GetOperation() StatModifier_Operation {
 	if x != nil {
-		return x.CostBi
+		return x.Operation
+	}
+	return StatModifier_OPERATION_UNSPECIFIED
+}
+
+func (x *StatModifier) GetValue() float64 {
+	if x != nil {
+		return x.Value
 	}
 	return 0
 }
 
+// Unlock turns on the feature named by key.
+type Unlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unlock) Reset() {
+	*x = Unlock{}
+	mi := &file_progression_v1_progression_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unlock) ProtoMessage() {}
+
+func (x *Unlock) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unlock.ProtoReflect.Descriptor instead.
+func (*Unlock) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Unlock) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
 type TalentEdge struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
@@ -110,7 +457,7 @@ type TalentEdge struct {
 
 func (x *TalentEdge) Reset() {
 	*x = TalentEdge{}
-	mi := &file_progression_v1_progression_proto_msgTypes[1]
+	mi := &file_progression_v1_progression_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -122,7 +469,7 @@ func (x *TalentEdge) String() string {
 func (*TalentEdge) ProtoMessage() {}
 
 func (x *TalentEdge) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[1]
+	mi := &file_progression_v1_progression_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -135,7 +482,7 @@ func (x *TalentEdge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalentEdge.ProtoReflect.Descriptor instead.
 func (*TalentEdge) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{1}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *TalentEdge) GetFrom() string {
@@ -153,19 +500,21 @@ func (x *TalentEdge) GetTo() string {
 }
 
 type TalentTree struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Nodes         []*TalentNode          `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	Edges         []*TalentEdge          `protobuf:"bytes,5,rep,name=edges,proto3" json:"edges,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Nodes       []*TalentNode          `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges       []*TalentEdge          `protobuf:"bytes,5,rep,name=edges,proto3" json:"edges,omitempty"`
+	// Version of the tree, starting at 1 and bumped by each UpdateTree.
+	Version       int32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TalentTree) Reset() {
 	*x = TalentTree{}
-	mi := &file_progression_v1_progression_proto_msgTypes[2]
+	mi := &file_progression_v1_progression_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -177,7 +526,7 @@ func (x *TalentTree) String() string {
 func (*TalentTree) ProtoMessage() {}
 
 func (x *TalentTree) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[2]
+	mi := &file_progression_v1_progression_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -190,7 +539,7 @@ func (x *TalentTree) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalentTree.ProtoReflect.Descriptor instead.
 func (*TalentTree) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{2}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TalentTree) GetId() string {
@@ -228,6 +577,13 @@ func (x *TalentTree) GetEdges() []*TalentEdge {
 	return nil
 }
 
+func (x *TalentTree) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type TalentPreset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -239,7 +595,7 @@ type TalentPreset struct {
 
 func (x *TalentPreset) Reset() {
 	*x = TalentPreset{}
-	mi := &file_progression_v1_progression_proto_msgTypes[3]
+	mi := &file_progression_v1_progression_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +607,7 @@ func (x *TalentPreset) String() string {
 func (*TalentPreset) ProtoMessage() {}
 
 func (x *TalentPreset) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[3]
+	mi := &file_progression_v1_progression_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +620,7 @@ func (x *TalentPreset) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalentPreset.ProtoReflect.Descriptor instead.
 func (*TalentPreset) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{3}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TalentPreset) GetId() string {
@@ -299,7 +655,7 @@ type PurchasedNode struct {
 
 func (x *PurchasedNode) Reset() {
 	*x = PurchasedNode{}
-	mi := &file_progression_v1_progression_proto_msgTypes[4]
+	mi := &file_progression_v1_progression_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -311,7 +667,7 @@ func (x *PurchasedNode) String() string {
 func (*PurchasedNode) ProtoMessage() {}
 
 func (x *PurchasedNode) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[4]
+	mi := &file_progression_v1_progression_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,7 +680,7 @@ func (x *PurchasedNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurchasedNode.ProtoReflect.Descriptor instead.
 func (*PurchasedNode) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{4}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PurchasedNode) GetNodeId() string {
@@ -353,13 +709,15 @@ type TalentProgress struct {
 	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	TreeId         string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
 	PurchasedNodes []*PurchasedNode       `protobuf:"bytes,3,rep,name=purchased_nodes,json=purchasedNodes,proto3" json:"purchased_nodes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Version of the tree the purchased nodes belong to.
+	TreeVersion   int32 `protobuf:"varint,4,opt,name=tree_version,json=treeVersion,proto3" json:"tree_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TalentProgress) Reset() {
 	*x = TalentProgress{}
-	mi := &file_progression_v1_progression_proto_msgTypes[5]
+	mi := &file_progression_v1_progression_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +729,7 @@ func (x *TalentProgress) String() string {
 func (*TalentProgress) ProtoMessage() {}
 
 func (x *TalentProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[5]
+	mi := &file_progression_v1_progression_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +742,7 @@ func (x *TalentProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TalentProgress.ProtoReflect.Descriptor instead.
 func (*TalentProgress) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{5}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TalentProgress) GetId() string {
@@ -408,6 +766,13 @@ func (x *TalentProgress) GetPurchasedNodes() []*PurchasedNode {
 	return nil
 }
 
+func (x *TalentProgress) GetTreeVersion() int32 {
+	if x != nil {
+		return x.TreeVersion
+	}
+	return 0
+}
+
 // Tree requests
 type CreateTreeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -421,7 +786,7 @@ type CreateTreeRequest struct {
 
 func (x *CreateTreeRequest) Reset() {
 	*x = CreateTreeRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[6]
+	mi := &file_progression_v1_progression_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -433,7 +798,7 @@ func (x *CreateTreeRequest) String() string {
 func (*CreateTreeRequest) ProtoMessage() {}
 
 func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[6]
+	mi := &file_progression_v1_progression_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +811,7 @@ func (x *CreateTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTreeRequest.ProtoReflect.Descriptor instead.
 func (*CreateTreeRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{6}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CreateTreeRequest) GetName() string {
@@ -490,7 +855,7 @@ type UpdateTreeRequest struct {
 
 func (x *UpdateTreeRequest) Reset() {
 	*x = UpdateTreeRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[7]
+	mi := &file_progression_v1_progression_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +867,7 @@ func (x *UpdateTreeRequest) String() string {
 func (*UpdateTreeRequest) ProtoMessage() {}
 
 func (x *UpdateTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[7]
+	mi := &file_progression_v1_progression_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +880,7 @@ func (x *UpdateTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTreeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTreeRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{7}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateTreeRequest) GetId() string {
@@ -554,15 +919,17 @@ func (x *UpdateTreeRequest) GetEdges() []*TalentEdge {
 }
 
 type GetTreeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Version to get; 0 gets the latest.
+	Version       int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetTreeRequest) Reset() {
 	*x = GetTreeRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[8]
+	mi := &file_progression_v1_progression_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -574,7 +941,7 @@ func (x *GetTreeRequest) String() string {
 func (*GetTreeRequest) ProtoMessage() {}
 
 func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[8]
+	mi := &file_progression_v1_progression_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +954,7 @@ func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
 func (*GetTreeRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{8}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetTreeRequest) GetId() string {
@@ -597,6 +964,13 @@ func (x *GetTreeRequest) GetId() string {
 	return ""
 }
 
+func (x *GetTreeRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type ListTreesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -605,7 +979,7 @@ type ListTreesRequest struct {
 
 func (x *ListTreesRequest) Reset() {
 	*x = ListTreesRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[9]
+	mi := &file_progression_v1_progression_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +991,7 @@ func (x *ListTreesRequest) String() string {
 func (*ListTreesRequest) ProtoMessage() {}
 
 func (x *ListTreesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[9]
+	mi := &file_progression_v1_progression_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +1004,259 @@ func (x *ListTreesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTreesRequest.ProtoReflect.Descriptor instead.
 func (*ListTreesRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{9}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{12}
+}
+
+type TreeMigrationRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TreeId      string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	FromVersion int32                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// Version to migrate to; 0 migrates to the latest.
+	ToVersion int32 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// Old node id to new node id. A node left out keeps its id if to_version
+	// still has it and is removed otherwise.
+	NodeMapping   map[string]string `protobuf:"bytes,4,rep,name=node_mapping,json=nodeMapping,proto3" json:"node_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreeMigrationRequest) Reset() {
+	*x = TreeMigrationRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeMigrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeMigrationRequest) ProtoMessage() {}
+
+func (x *TreeMigrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeMigrationRequest.ProtoReflect.Descriptor instead.
+func (*TreeMigrationRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TreeMigrationRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *TreeMigrationRequest) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *TreeMigrationRequest) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *TreeMigrationRequest) GetNodeMapping() map[string]string {
+	if x != nil {
+		return x.NodeMapping
+	}
+	return nil
+}
+
+// What a migration does to one progress.
+type ProgressMigration struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProgressId   string                 `protobuf:"bytes,1,opt,name=progress_id,json=progressId,proto3" json:"progress_id,omitempty"`
+	OwnerType    string                 `protobuf:"bytes,2,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`
+	SettlementId string                 `protobuf:"bytes,3,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	PointId      string                 `protobuf:"bytes,4,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	Side         string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	// Node ids in to_version.
+	KeptNodeIds []string `protobuf:"bytes,6,rep,name=kept_node_ids,json=keptNodeIds,proto3" json:"kept_node_ids,omitempty"`
+	// Node ids in from_version.
+	RemovedNodeIds []string `protobuf:"bytes,7,rep,name=removed_node_ids,json=removedNodeIds,proto3" json:"removed_node_ids,omitempty"`
+	RefundBi       int64    `protobuf:"varint,8,opt,name=refund_bi,json=refundBi,proto3" json:"refund_bi,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProgressMigration) Reset() {
+	*x = ProgressMigration{}
+	mi := &file_progression_v1_progression_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProgressMigration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProgressMigration) ProtoMessage() {}
+
+func (x *ProgressMigration) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProgressMigration.ProtoReflect.Descriptor instead.
+func (*ProgressMigration) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ProgressMigration) GetProgressId() string {
+	if x != nil {
+		return x.ProgressId
+	}
+	return ""
+}
+
+func (x *ProgressMigration) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
+	}
+	return ""
+}
+
+func (x *ProgressMigration) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ProgressMigration) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *ProgressMigration) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *ProgressMigration) GetKeptNodeIds() []string {
+	if x != nil {
+		return x.KeptNodeIds
+	}
+	return nil
+}
+
+func (x *ProgressMigration) GetRemovedNodeIds() []string {
+	if x != nil {
+		return x.RemovedNodeIds
+	}
+	return nil
+}
+
+func (x *ProgressMigration) GetRefundBi() int64 {
+	if x != nil {
+		return x.RefundBi
+	}
+	return 0
+}
+
+type TreeMigrationPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromVersion   int32                  `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int32                  `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	Progress      []*ProgressMigration   `protobuf:"bytes,3,rep,name=progress,proto3" json:"progress,omitempty"`
+	TotalRefundBi int64                  `protobuf:"varint,4,opt,name=total_refund_bi,json=totalRefundBi,proto3" json:"total_refund_bi,omitempty"`
+	// Progress left on from_version because it changed during the migration
+	// or could not be saved. Always empty for a preview.
+	FailedProgressIds []string `protobuf:"bytes,5,rep,name=failed_progress_ids,json=failedProgressIds,proto3" json:"failed_progress_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TreeMigrationPlan) Reset() {
+	*x = TreeMigrationPlan{}
+	mi := &file_progression_v1_progression_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeMigrationPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeMigrationPlan) ProtoMessage() {}
+
+func (x *TreeMigrationPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeMigrationPlan.ProtoReflect.Descriptor instead.
+func (*TreeMigrationPlan) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TreeMigrationPlan) GetFromVersion() int32 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *TreeMigrationPlan) GetToVersion() int32 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+func (x *TreeMigrationPlan) GetProgress() []*ProgressMigration {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *TreeMigrationPlan) GetTotalRefundBi() int64 {
+	if x != nil {
+		return x.TotalRefundBi
+	}
+	return 0
+}
+
+func (x *TreeMigrationPlan) GetFailedProgressIds() []string {
+	if x != nil {
+		return x.FailedProgressIds
+	}
+	return nil
 }
 
 type ListTreesResponse struct {
@@ -642,7 +1268,7 @@ type ListTreesResponse struct {
 
 func (x *ListTreesResponse) Reset() {
 	*x = ListTreesResponse{}
-	mi := &file_progression_v1_progression_proto_msgTypes[10]
+	mi := &file_progression_v1_progression_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -654,7 +1280,7 @@ func (x *ListTreesResponse) String() string {
 func (*ListTreesResponse) ProtoMessage() {}
 
 func (x *ListTreesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[10]
+	mi := &file_progression_v1_progression_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -667,7 +1293,7 @@ func (x *ListTreesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTreesResponse.ProtoReflect.Descriptor instead.
 func (*ListTreesResponse) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{10}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListTreesResponse) GetTrees() []*TalentTree {
@@ -688,7 +1314,7 @@ type CreatePresetRequest struct {
 
 func (x *CreatePresetRequest) Reset() {
 	*x = CreatePresetRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[11]
+	mi := &file_progression_v1_progression_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +1326,7 @@ func (x *CreatePresetRequest) String() string {
 func (*CreatePresetRequest) ProtoMessage() {}
 
 func (x *CreatePresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[11]
+	mi := &file_progression_v1_progression_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +1339,7 @@ func (x *CreatePresetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePresetRequest.ProtoReflect.Descriptor instead.
 func (*CreatePresetRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{11}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *CreatePresetRequest) GetName() string {
@@ -741,7 +1367,7 @@ type UpdatePresetRequest struct {
 
 func (x *UpdatePresetRequest) Reset() {
 	*x = UpdatePresetRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[12]
+	mi := &file_progression_v1_progression_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +1379,7 @@ func (x *UpdatePresetRequest) String() string {
 func (*UpdatePresetRequest) ProtoMessage() {}
 
 func (x *UpdatePresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[12]
+	mi := &file_progression_v1_progression_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +1392,7 @@ func (x *UpdatePresetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdatePresetRequest.ProtoReflect.Descriptor instead.
 func (*UpdatePresetRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{12}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdatePresetRequest) GetId() string {
@@ -799,7 +1425,7 @@ type GetPresetRequest struct {
 
 func (x *GetPresetRequest) Reset() {
 	*x = GetPresetRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[13]
+	mi := &file_progression_v1_progression_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -811,7 +1437,7 @@ func (x *GetPresetRequest) String() string {
 func (*GetPresetRequest) ProtoMessage() {}
 
 func (x *GetPresetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[13]
+	mi := &file_progression_v1_progression_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1450,7 @@ func (x *GetPresetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPresetRequest.ProtoReflect.Descriptor instead.
 func (*GetPresetRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{13}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetPresetRequest) GetId() string {
@@ -842,7 +1468,7 @@ type ListPresetsRequest struct {
 
 func (x *ListPresetsRequest) Reset() {
 	*x = ListPresetsRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[14]
+	mi := &file_progression_v1_progression_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -854,7 +1480,7 @@ func (x *ListPresetsRequest) String() string {
 func (*ListPresetsRequest) ProtoMessage() {}
 
 func (x *ListPresetsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[14]
+	mi := &file_progression_v1_progression_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -867,7 +1493,7 @@ func (x *ListPresetsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPresetsRequest.ProtoReflect.Descriptor instead.
 func (*ListPresetsRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{14}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{20}
 }
 
 type ListPresetsResponse struct {
@@ -879,7 +1505,7 @@ type ListPresetsResponse struct {
 
 func (x *ListPresetsResponse) Reset() {
 	*x = ListPresetsResponse{}
-	mi := &file_progression_v1_progression_proto_msgTypes[15]
+	mi := &file_progression_v1_progression_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -891,7 +1517,7 @@ func (x *ListPresetsResponse) String() string {
 func (*ListPresetsResponse) ProtoMessage() {}
 
 func (x *ListPresetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[15]
+	mi := &file_progression_v1_progression_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,7 +1530,7 @@ func (x *ListPresetsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPresetsResponse.ProtoReflect.Descriptor instead.
 func (*ListPresetsResponse) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{15}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListPresetsResponse) GetPresets() []*TalentPreset {
@@ -914,30 +1540,34 @@ func (x *ListPresetsResponse) GetPresets() []*TalentPreset {
 	return nil
 }
 
-// Progress requests
-type GetSettlementProgressRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
-	TreeId        string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PresetAssignment binds a preset to one settlement, or to every settlement
+// of a type that has none of its own; exactly one of settlement_id and
+// settlement_type is set.
+type PresetAssignment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId   string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	SettlementType v1.SettlementType      `protobuf:"varint,2,opt,name=settlement_type,json=settlementType,proto3,enum=settlement.v1.SettlementType" json:"settlement_type,omitempty"`
+	PresetId       string                 `protobuf:"bytes,3,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetSettlementProgressRequest) Reset() {
-	*x = GetSettlementProgressRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[16]
+func (x *PresetAssignment) Reset() {
+	*x = PresetAssignment{}
+	mi := &file_progression_v1_progression_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSettlementProgressRequest) String() string {
+func (x *PresetAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSettlementProgressRequest) ProtoMessage() {}
+func (*PresetAssignment) ProtoMessage() {}
 
-func (x *GetSettlementProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[16]
+func (x *PresetAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,37 +1578,817 @@ func (x *GetSettlementProgressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSettlementProgressRequest.ProtoReflect.Descriptor instead.
-func (*GetSettlementProgressRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PresetAssignment.ProtoReflect.Descriptor instead.
+func (*PresetAssignment) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetSettlementProgressRequest) GetSettlementId() string {
+func (x *PresetAssignment) GetSettlementId() string {
 	if x != nil {
 		return x.SettlementId
 	}
 	return ""
 }
 
-func (x *GetSettlementProgressRequest) GetTreeId() string {
+func (x *PresetAssignment) GetSettlementType() v1.SettlementType {
 	if x != nil {
-		return x.TreeId
+		return x.SettlementType
+	}
+	return v1.SettlementType(0)
+}
+
+func (x *PresetAssignment) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
 	}
 	return ""
 }
 
-type PurchaseSettlementNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
-	TreeId        string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+func (x *PresetAssignment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AssignSettlementPresetRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Empty removes the assignment.
+	PresetId      string `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignSettlementPresetRequest) Reset() {
+	*x = AssignSettlementPresetRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignSettlementPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignSettlementPresetRequest) ProtoMessage() {}
+
+func (x *AssignSettlementPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignSettlementPresetRequest.ProtoReflect.Descriptor instead.
+func (*AssignSettlementPresetRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AssignSettlementPresetRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *AssignSettlementPresetRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+type SetDefaultPresetRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SettlementType v1.SettlementType      `protobuf:"varint,1,opt,name=settlement_type,json=settlementType,proto3,enum=settlement.v1.SettlementType" json:"settlement_type,omitempty"`
+	// Empty removes the default.
+	PresetId      string `protobuf:"bytes,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultPresetRequest) Reset() {
+	*x = SetDefaultPresetRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultPresetRequest) ProtoMessage() {}
+
+func (x *SetDefaultPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultPresetRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultPresetRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetDefaultPresetRequest) GetSettlementType() v1.SettlementType {
+	if x != nil {
+		return x.SettlementType
+	}
+	return v1.SettlementType(0)
+}
+
+func (x *SetDefaultPresetRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+type ListPresetAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPresetAssignmentsRequest) Reset() {
+	*x = ListPresetAssignmentsRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresetAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresetAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListPresetAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresetAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPresetAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{25}
+}
+
+type ListPresetAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assignments   []*PresetAssignment    `protobuf:"bytes,1,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPresetAssignmentsResponse) Reset() {
+	*x = ListPresetAssignmentsResponse{}
+	mi := &file_progression_v1_progression_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPresetAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPresetAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListPresetAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPresetAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPresetAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPresetAssignmentsResponse) GetAssignments() []*PresetAssignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+type ExportTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	Format        BundleFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=progression.v1.BundleFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTreeRequest) Reset() {
+	*x = ExportTreeRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTreeRequest) ProtoMessage() {}
+
+func (x *ExportTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTreeRequest.ProtoReflect.Descriptor instead.
+func (*ExportTreeRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *ExportTreeRequest) GetFormat() BundleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BundleFormat_BUNDLE_FORMAT_UNSPECIFIED
+}
+
+type ExportPresetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresetId      string                 `protobuf:"bytes,1,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+	Format        BundleFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=progression.v1.BundleFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPresetRequest) Reset() {
+	*x = ExportPresetRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPresetRequest) ProtoMessage() {}
+
+func (x *ExportPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPresetRequest.ProtoReflect.Descriptor instead.
+func (*ExportPresetRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExportPresetRequest) GetPresetId() string {
+	if x != nil {
+		return x.PresetId
+	}
+	return ""
+}
+
+func (x *ExportPresetRequest) GetFormat() BundleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BundleFormat_BUNDLE_FORMAT_UNSPECIFIED
+}
+
+type ImportTalentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The bundle file; JSON or YAML, whatever the content type.
+	File          *httpbody.HttpBody `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	DryRun        bool               `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTalentsRequest) Reset() {
+	*x = ImportTalentsRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTalentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTalentsRequest) ProtoMessage() {}
+
+func (x *ImportTalentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTalentsRequest.ProtoReflect.Descriptor instead.
+func (*ImportTalentsRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImportTalentsRequest) GetFile() *httpbody.HttpBody {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportTalentsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportedTree struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the tree in the file.
+	SourceId string `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Id of the tree here; empty for a tree a dry run would create.
+	Id     string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Action ImportAction `protobuf:"varint,4,opt,name=action,proto3,enum=progression.v1.ImportAction" json:"action,omitempty"`
+	// Version of the tree after the import.
+	Version       int32 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedTree) Reset() {
+	*x = ImportedTree{}
+	mi := &file_progression_v1_progression_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedTree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedTree) ProtoMessage() {}
+
+func (x *ImportedTree) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedTree.ProtoReflect.Descriptor instead.
+func (*ImportedTree) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ImportedTree) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ImportedTree) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportedTree) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedTree) GetAction() ImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ImportAction_IMPORT_ACTION_UNSPECIFIED
+}
+
+func (x *ImportedTree) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ImportedPreset struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SourceId string                 `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// Empty for a preset a dry run would create.
+	Id            string       `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Action        ImportAction `protobuf:"varint,4,opt,name=action,proto3,enum=progression.v1.ImportAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportedPreset) Reset() {
+	*x = ImportedPreset{}
+	mi := &file_progression_v1_progression_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportedPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportedPreset) ProtoMessage() {}
+
+func (x *ImportedPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportedPreset.ProtoReflect.Descriptor instead.
+func (*ImportedPreset) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportedPreset) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *ImportedPreset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportedPreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportedPreset) GetAction() ImportAction {
+	if x != nil {
+		return x.Action
+	}
+	return ImportAction_IMPORT_ACTION_UNSPECIFIED
+}
+
+type ImportTalentsResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DryRun bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Trees  []*ImportedTree        `protobuf:"bytes,2,rep,name=trees,proto3" json:"trees,omitempty"`
+	// Set when the file holds a preset.
+	Preset        *ImportedPreset `protobuf:"bytes,3,opt,name=preset,proto3" json:"preset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportTalentsResponse) Reset() {
+	*x = ImportTalentsResponse{}
+	mi := &file_progression_v1_progression_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTalentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTalentsResponse) ProtoMessage() {}
+
+func (x *ImportTalentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTalentsResponse.ProtoReflect.Descriptor instead.
+func (*ImportTalentsResponse) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportTalentsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportTalentsResponse) GetTrees() []*ImportedTree {
+	if x != nil {
+		return x.Trees
+	}
+	return nil
+}
+
+func (x *ImportTalentsResponse) GetPreset() *ImportedPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+// Progress requests
+type GetSettlementTalentOverviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementTalentOverviewRequest) Reset() {
+	*x = GetSettlementTalentOverviewRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementTalentOverviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementTalentOverviewRequest) ProtoMessage() {}
+
+func (x *GetSettlementTalentOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementTalentOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetSettlementTalentOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSettlementTalentOverviewRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type TreeOverview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tree          *TalentTree            `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	Progress      *TalentProgress        `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TreeOverview) Reset() {
+	*x = TreeOverview{}
+	mi := &file_progression_v1_progression_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TreeOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeOverview) ProtoMessage() {}
+
+func (x *TreeOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeOverview.ProtoReflect.Descriptor instead.
+func (*TreeOverview) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TreeOverview) GetTree() *TalentTree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+func (x *TreeOverview) GetProgress() *TalentProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type SettlementTalentOverview struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Preset *TalentPreset          `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	// Whether the preset is assigned to the settlement itself rather than
+	// being its type's default.
+	Assigned bool `protobuf:"varint,2,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	// In the preset's order.
+	Trees         []*TreeOverview `protobuf:"bytes,3,rep,name=trees,proto3" json:"trees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettlementTalentOverview) Reset() {
+	*x = SettlementTalentOverview{}
+	mi := &file_progression_v1_progression_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettlementTalentOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettlementTalentOverview) ProtoMessage() {}
+
+func (x *SettlementTalentOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettlementTalentOverview.ProtoReflect.Descriptor instead.
+func (*SettlementTalentOverview) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SettlementTalentOverview) GetPreset() *TalentPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+func (x *SettlementTalentOverview) GetAssigned() bool {
+	if x != nil {
+		return x.Assigned
+	}
+	return false
+}
+
+func (x *SettlementTalentOverview) GetTrees() []*TreeOverview {
+	if x != nil {
+		return x.Trees
+	}
+	return nil
+}
+
+type GetSettlementProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	TreeId        string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettlementProgressRequest) Reset() {
+	*x = GetSettlementProgressRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettlementProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettlementProgressRequest) ProtoMessage() {}
+
+func (x *GetSettlementProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettlementProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetSettlementProgressRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetSettlementProgressRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *GetSettlementProgressRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+type PurchaseSettlementNodeRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	TreeId       string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	NodeId       string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Client-chosen id that makes retries safe; one is generated when empty.
+	PurchaseId    string `protobuf:"bytes,4,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PurchaseSettlementNodeRequest) Reset() {
 	*x = PurchaseSettlementNodeRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[17]
+	mi := &file_progression_v1_progression_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -990,7 +2400,7 @@ func (x *PurchaseSettlementNodeRequest) String() string {
 func (*PurchaseSettlementNodeRequest) ProtoMessage() {}
 
 func (x *PurchaseSettlementNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[17]
+	mi := &file_progression_v1_progression_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +2413,7 @@ func (x *PurchaseSettlementNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurchaseSettlementNodeRequest.ProtoReflect.Descriptor instead.
 func (*PurchaseSettlementNodeRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{17}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *PurchaseSettlementNodeRequest) GetSettlementId() string {
@@ -1027,6 +2437,145 @@ func (x *PurchaseSettlementNodeRequest) GetNodeId() string {
 	return ""
 }
 
+func (x *PurchaseSettlementNodeRequest) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+type RespecSettlementTreeRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	TreeId       string                 `protobuf:"bytes,2,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	// Nodes to remove along with their dependants; empty clears the tree.
+	NodeIds       []string `protobuf:"bytes,3,rep,name=node_ids,json=nodeIds,proto3" json:"node_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespecSettlementTreeRequest) Reset() {
+	*x = RespecSettlementTreeRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespecSettlementTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespecSettlementTreeRequest) ProtoMessage() {}
+
+func (x *RespecSettlementTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespecSettlementTreeRequest.ProtoReflect.Descriptor instead.
+func (*RespecSettlementTreeRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RespecSettlementTreeRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *RespecSettlementTreeRequest) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *RespecSettlementTreeRequest) GetNodeIds() []string {
+	if x != nil {
+		return x.NodeIds
+	}
+	return nil
+}
+
+type RespecSettlementTreeResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Progress *TalentProgress        `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Every node removed, dependants included, in purchase order.
+	RemovedNodeIds []string `protobuf:"bytes,2,rep,name=removed_node_ids,json=removedNodeIds,proto3" json:"removed_node_ids,omitempty"`
+	// Favor refunded. If crediting it fails it is retried in the background.
+	RefundBi int64 `protobuf:"varint,3,opt,name=refund_bi,json=refundBi,proto3" json:"refund_bi,omitempty"`
+	// When the tree may be respecced again.
+	NextRespecAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_respec_at,json=nextRespecAt,proto3" json:"next_respec_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespecSettlementTreeResponse) Reset() {
+	*x = RespecSettlementTreeResponse{}
+	mi := &file_progression_v1_progression_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespecSettlementTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespecSettlementTreeResponse) ProtoMessage() {}
+
+func (x *RespecSettlementTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespecSettlementTreeResponse.ProtoReflect.Descriptor instead.
+func (*RespecSettlementTreeResponse) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RespecSettlementTreeResponse) GetProgress() *TalentProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *RespecSettlementTreeResponse) GetRemovedNodeIds() []string {
+	if x != nil {
+		return x.RemovedNodeIds
+	}
+	return nil
+}
+
+func (x *RespecSettlementTreeResponse) GetRefundBi() int64 {
+	if x != nil {
+		return x.RefundBi
+	}
+	return 0
+}
+
+func (x *RespecSettlementTreeResponse) GetNextRespecAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRespecAt
+	}
+	return nil
+}
+
 type GetPointProgressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PointId       string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
@@ -1038,7 +2587,7 @@ type GetPointProgressRequest struct {
 
 func (x *GetPointProgressRequest) Reset() {
 	*x = GetPointProgressRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[18]
+	mi := &file_progression_v1_progression_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1050,7 +2599,7 @@ func (x *GetPointProgressRequest) String() string {
 func (*GetPointProgressRequest) ProtoMessage() {}
 
 func (x *GetPointProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[18]
+	mi := &file_progression_v1_progression_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +2612,7 @@ func (x *GetPointProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPointProgressRequest.ProtoReflect.Descriptor instead.
 func (*GetPointProgressRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{18}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetPointProgressRequest) GetPointId() string {
@@ -1088,19 +2637,21 @@ func (x *GetPointProgressRequest) GetTreeId() string {
 }
 
 type PurchasePointNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PointId       string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
-	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
-	TreeId        string                 `protobuf:"bytes,3,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	SettlementId  string                 `protobuf:"bytes,5,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PointId      string                 `protobuf:"bytes,1,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	Side         string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	TreeId       string                 `protobuf:"bytes,3,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	NodeId       string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	SettlementId string                 `protobuf:"bytes,5,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Client-chosen id that makes retries safe; one is generated when empty.
+	PurchaseId    string `protobuf:"bytes,6,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PurchasePointNodeRequest) Reset() {
 	*x = PurchasePointNodeRequest{}
-	mi := &file_progression_v1_progression_proto_msgTypes[19]
+	mi := &file_progression_v1_progression_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1112,7 +2663,7 @@ func (x *PurchasePointNodeRequest) String() string {
 func (*PurchasePointNodeRequest) ProtoMessage() {}
 
 func (x *PurchasePointNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_progression_v1_progression_proto_msgTypes[19]
+	mi := &file_progression_v1_progression_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,7 +2676,7 @@ func (x *PurchasePointNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurchasePointNodeRequest.ProtoReflect.Descriptor instead.
 func (*PurchasePointNodeRequest) Descriptor() ([]byte, []int) {
-	return file_progression_v1_progression_proto_rawDescGZIP(), []int{19}
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *PurchasePointNodeRequest) GetPointId() string {
@@ -1163,271 +2714,417 @@ func (x *PurchasePointNodeRequest) GetSettlementId() string {
 	return ""
 }
 
+func (x *PurchasePointNodeRequest) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+// Bonus requests
+type GetEffectiveBonusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	PointId       string                 `protobuf:"bytes,2,opt,name=point_id,json=pointId,proto3" json:"point_id,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectiveBonusesRequest) Reset() {
+	*x = GetEffectiveBonusesRequest{}
+	mi := &file_progression_v1_progression_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectiveBonusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectiveBonusesRequest) ProtoMessage() {}
+
+func (x *GetEffectiveBonusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectiveBonusesRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectiveBonusesRequest) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetEffectiveBonusesRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *GetEffectiveBonusesRequest) GetPointId() string {
+	if x != nil {
+		return x.PointId
+	}
+	return ""
+}
+
+func (x *GetEffectiveBonusesRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+// StatBonus aggregates every modifier on a stat: the stat becomes
+// (base + add) * multiply.
+type StatBonus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Add           float64                `protobuf:"fixed64,1,opt,name=add,proto3" json:"add,omitempty"`
+	Multiply      float64                `protobuf:"fixed64,2,opt,name=multiply,proto3" json:"multiply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatBonus) Reset() {
+	*x = StatBonus{}
+	mi := &file_progression_v1_progression_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatBonus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatBonus) ProtoMessage() {}
+
+func (x *StatBonus) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatBonus.ProtoReflect.Descriptor instead.
+func (*StatBonus) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatBonus) GetAdd() float64 {
+	if x != nil {
+		return x.Add
+	}
+	return 0
+}
+
+func (x *StatBonus) GetMultiply() float64 {
+	if x != nil {
+		return x.Multiply
+	}
+	return 0
+}
+
+type EffectiveBonuses struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keyed by stat key.
+	Modifiers map[string]*StatBonus `protobuf:"bytes,1,rep,name=modifiers,proto3" json:"modifiers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Unlocked feature keys, sorted.
+	Unlocks       []string `protobuf:"bytes,2,rep,name=unlocks,proto3" json:"unlocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectiveBonuses) Reset() {
+	*x = EffectiveBonuses{}
+	mi := &file_progression_v1_progression_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectiveBonuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectiveBonuses) ProtoMessage() {}
+
+func (x *EffectiveBonuses) ProtoReflect() protoreflect.Message {
+	mi := &file_progression_v1_progression_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectiveBonuses.ProtoReflect.Descriptor instead.
+func (*EffectiveBonuses) Descriptor() ([]byte, []int) {
+	return file_progression_v1_progression_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *EffectiveBonuses) GetModifiers() map[string]*StatBonus {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *EffectiveBonuses) GetUnlocks() []string {
+	if x != nil {
+		return x.Unlocks
+	}
+	return nil
+}
+
 var File_progression_v1_progression_proto protoreflect.FileDescriptor
 
-var file_progression_v1_progression_proto_rawDesc = string([]byte{
-	0x0a, 0x20, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x83, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63,
-	0x6f, 0x73, 0x74, 0x42, 0x69, 0x22, 0x30, 0x0a, 0x0a, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x45,
-	0x64, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x54, 0x61, 0x6c, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c,
-	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x30,
-	0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x6c, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73,
-	0x22, 0x4d, 0x0a, 0x0c, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x73, 0x22,
-	0xa4, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x70, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x1a, 0x70, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x42, 0x79, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x6c, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x72, 0x65,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65,
-	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f,
-	0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x63,
-	0x68, 0x61, 0x73, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x11, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65,
-	0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x30, 0x0a,
-	0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
-	0x6c, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22,
-	0xc2, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x30, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05, 0x65,
-	0x64, 0x67, 0x65, 0x73, 0x22, 0x25, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x45, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x74, 0x72, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52,
-	0x05, 0x74, 0x72, 0x65, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64,
-	0x73, 0x22, 0x59, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x02, 0x69, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4d, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x74, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x22, 0x66, 0x0a, 0x1c, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65,
-	0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x07, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x73, 0x69, 0x64, 0x65, 0x12, 0x1c,
-	0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64, 0x22, 0xb9, 0x01, 0x0a,
-	0x18, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x73, 0x69,
-	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x74, 0x72, 0x65, 0x65, 0x49, 0x64,
-	0x12, 0x1c, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x32, 0x94, 0x0d, 0x0a, 0x12, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x6d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x21, 0x2e,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x22, 0x20, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x12, 0x72,
-	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x12, 0x69, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12, 0x1e, 0x2e,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x6c, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x65, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6f, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x72, 0x65, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x12, 0x75,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x23,
-	0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65,
-	0x74, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x7a, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21,
-	0x3a, 0x01, 0x2a, 0x32, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64,
-	0x7d, 0x12, 0x71, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x20,
-	0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x24,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x2f,
-	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0xaa, 0x01,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x12, 0x3b, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73,
-	0x2f, 0x7b, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc8, 0x01, 0x0a, 0x16, 0x50,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x59, 0x3a, 0x01, 0x2a, 0x22,
-	0x54, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x72, 0x65,
-	0x65, 0x73, 0x2f, 0x7b, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x64,
-	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x70, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
-	0x69, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x69, 0x64, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x65, 0x65,
-	0x73, 0x2f, 0x7b, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xc1, 0x01, 0x0a, 0x11,
-	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72,
-	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x62, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x5c, 0x3a, 0x01, 0x2a, 0x22, 0x57, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67,
-	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x69, 0x64, 0x65, 0x73, 0x2f,
-	0x7b, 0x73, 0x69, 0x64, 0x65, 0x7d, 0x2f, 0x74, 0x72, 0x65, 0x65, 0x73, 0x2f, 0x7b, 0x74, 0x72,
-	0x65, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x42,
-	0xc1, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74,
-	0x68, 0x2f, 0x76, 0x73, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x70,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x50,
-	0x58, 0x58, 0xaa, 0x02, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x0f, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_progression_v1_progression_proto_rawDesc = "" +
+	"\n" +
+	" progression/v1/progression.proto\x12\x0eprogression.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x19google/api/httpbody.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1esettlement/v1/settlement.proto\"\xaf\x01\n" +
+	"\n" +
+	"TalentNode\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x17\n" +
+	"\acost_bi\x18\x05 \x01(\x03R\x06costBi\x124\n" +
+	"\aeffects\x18\x06 \x03(\v2\x1a.progression.v1.NodeEffectR\aeffectsJ\x04\b\x04\x10\x05R\x06effect\"\x84\x01\n" +
+	"\n" +
+	"NodeEffect\x12:\n" +
+	"\bmodifier\x18\x01 \x01(\v2\x1c.progression.v1.StatModifierH\x00R\bmodifier\x120\n" +
+	"\x06unlock\x18\x02 \x01(\v2\x16.progression.v1.UnlockH\x00R\x06unlockB\b\n" +
+	"\x06effect\"\xcf\x01\n" +
+	"\fStatModifier\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12D\n" +
+	"\toperation\x18\x02 \x01(\x0e2&.progression.v1.StatModifier.OperationR\toperation\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"Q\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rOPERATION_ADD\x10\x01\x12\x16\n" +
+	"\x12OPERATION_MULTIPLY\x10\x02\"\x1a\n" +
+	"\x06Unlock\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"0\n" +
+	"\n" +
+	"TalentEdge\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xd0\x01\n" +
+	"\n" +
+	"TalentTree\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
+	"\x05nodes\x18\x04 \x03(\v2\x1a.progression.v1.TalentNodeR\x05nodes\x120\n" +
+	"\x05edges\x18\x05 \x03(\v2\x1a.progression.v1.TalentEdgeR\x05edges\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x05R\aversion\"M\n" +
+	"\fTalentPreset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\btree_ids\x18\x03 \x03(\tR\atreeIds\"\xa4\x01\n" +
+	"\rPurchasedNode\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12=\n" +
+	"\fpurchased_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vpurchasedAt\x12;\n" +
+	"\x1apurchased_by_settlement_id\x18\x03 \x01(\tR\x17purchasedBySettlementId\"\xa4\x01\n" +
+	"\x0eTalentProgress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atree_id\x18\x02 \x01(\tR\x06treeId\x12F\n" +
+	"\x0fpurchased_nodes\x18\x03 \x03(\v2\x1d.progression.v1.PurchasedNodeR\x0epurchasedNodes\x12!\n" +
+	"\ftree_version\x18\x04 \x01(\x05R\vtreeVersion\"\xb2\x01\n" +
+	"\x11CreateTreeRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x120\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x1a.progression.v1.TalentNodeR\x05nodes\x120\n" +
+	"\x05edges\x18\x04 \x03(\v2\x1a.progression.v1.TalentEdgeR\x05edges\"\xda\x01\n" +
+	"\x11UpdateTreeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
+	"\x05nodes\x18\x04 \x03(\v2\x1a.progression.v1.TalentNodeR\x05nodes\x120\n" +
+	"\x05edges\x18\x05 \x03(\v2\x1a.progression.v1.TalentEdgeR\x05edgesJ\x04\b\x06\x10\aR\x10migrate_progress\"?\n" +
+	"\x0eGetTreeRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\x12\n" +
+	"\x10ListTreesRequest\"\x95\x02\n" +
+	"\x14TreeMigrationRequest\x12\x1c\n" +
+	"\atree_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06treeId\x12&\n" +
+	"\ffrom_version\x18\x02 \x01(\x05B\x03\xe0A\x02R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x05R\ttoVersion\x12X\n" +
+	"\fnode_mapping\x18\x04 \x03(\v25.progression.v1.TreeMigrationRequest.NodeMappingEntryR\vnodeMapping\x1a>\n" +
+	"\x10NodeMappingEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x02\n" +
+	"\x11ProgressMigration\x12\x1f\n" +
+	"\vprogress_id\x18\x01 \x01(\tR\n" +
+	"progressId\x12\x1d\n" +
+	"\n" +
+	"owner_type\x18\x02 \x01(\tR\townerType\x12#\n" +
+	"\rsettlement_id\x18\x03 \x01(\tR\fsettlementId\x12\x19\n" +
+	"\bpoint_id\x18\x04 \x01(\tR\apointId\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\"\n" +
+	"\rkept_node_ids\x18\x06 \x03(\tR\vkeptNodeIds\x12(\n" +
+	"\x10removed_node_ids\x18\a \x03(\tR\x0eremovedNodeIds\x12\x1b\n" +
+	"\trefund_bi\x18\b \x01(\x03R\brefundBi\"\xec\x01\n" +
+	"\x11TreeMigrationPlan\x12!\n" +
+	"\ffrom_version\x18\x01 \x01(\x05R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x02 \x01(\x05R\ttoVersion\x12=\n" +
+	"\bprogress\x18\x03 \x03(\v2!.progression.v1.ProgressMigrationR\bprogress\x12&\n" +
+	"\x0ftotal_refund_bi\x18\x04 \x01(\x03R\rtotalRefundBi\x12.\n" +
+	"\x13failed_progress_ids\x18\x05 \x03(\tR\x11failedProgressIds\"E\n" +
+	"\x11ListTreesResponse\x120\n" +
+	"\x05trees\x18\x01 \x03(\v2\x1a.progression.v1.TalentTreeR\x05trees\"I\n" +
+	"\x13CreatePresetRequest\x12\x17\n" +
+	"\x04name\x18\x01 \x01(\tB\x03\xe0A\x02R\x04name\x12\x19\n" +
+	"\btree_ids\x18\x02 \x03(\tR\atreeIds\"Y\n" +
+	"\x13UpdatePresetRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x19\n" +
+	"\btree_ids\x18\x03 \x03(\tR\atreeIds\"'\n" +
+	"\x10GetPresetRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x14\n" +
+	"\x12ListPresetsRequest\"M\n" +
+	"\x13ListPresetsResponse\x126\n" +
+	"\apresets\x18\x01 \x03(\v2\x1c.progression.v1.TalentPresetR\apresets\"\xd7\x01\n" +
+	"\x10PresetAssignment\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12F\n" +
+	"\x0fsettlement_type\x18\x02 \x01(\x0e2\x1d.settlement.v1.SettlementTypeR\x0esettlementType\x12\x1b\n" +
+	"\tpreset_id\x18\x03 \x01(\tR\bpresetId\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"f\n" +
+	"\x1dAssignSettlementPresetRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\tpreset_id\x18\x02 \x01(\tR\bpresetId\"\x83\x01\n" +
+	"\x17SetDefaultPresetRequest\x12K\n" +
+	"\x0fsettlement_type\x18\x01 \x01(\x0e2\x1d.settlement.v1.SettlementTypeB\x03\xe0A\x02R\x0esettlementType\x12\x1b\n" +
+	"\tpreset_id\x18\x02 \x01(\tR\bpresetId\"\x1e\n" +
+	"\x1cListPresetAssignmentsRequest\"c\n" +
+	"\x1dListPresetAssignmentsResponse\x12B\n" +
+	"\vassignments\x18\x01 \x03(\v2 .progression.v1.PresetAssignmentR\vassignments\"g\n" +
+	"\x11ExportTreeRequest\x12\x1c\n" +
+	"\atree_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06treeId\x124\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.progression.v1.BundleFormatR\x06format\"m\n" +
+	"\x13ExportPresetRequest\x12 \n" +
+	"\tpreset_id\x18\x01 \x01(\tB\x03\xe0A\x02R\bpresetId\x124\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1c.progression.v1.BundleFormatR\x06format\"^\n" +
+	"\x14ImportTalentsRequest\x12-\n" +
+	"\x04file\x18\x01 \x01(\v2\x14.google.api.HttpBodyB\x03\xe0A\x02R\x04file\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\x9f\x01\n" +
+	"\fImportedTree\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x124\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1c.progression.v1.ImportActionR\x06action\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\"\x87\x01\n" +
+	"\x0eImportedPreset\x12\x1b\n" +
+	"\tsource_id\x18\x01 \x01(\tR\bsourceId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x124\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1c.progression.v1.ImportActionR\x06action\"\x9c\x01\n" +
+	"\x15ImportTalentsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x122\n" +
+	"\x05trees\x18\x02 \x03(\v2\x1c.progression.v1.ImportedTreeR\x05trees\x126\n" +
+	"\x06preset\x18\x03 \x01(\v2\x1e.progression.v1.ImportedPresetR\x06preset\"N\n" +
+	"\"GetSettlementTalentOverviewRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\"z\n" +
+	"\fTreeOverview\x12.\n" +
+	"\x04tree\x18\x01 \x01(\v2\x1a.progression.v1.TalentTreeR\x04tree\x12:\n" +
+	"\bprogress\x18\x02 \x01(\v2\x1e.progression.v1.TalentProgressR\bprogress\"\xa0\x01\n" +
+	"\x18SettlementTalentOverview\x124\n" +
+	"\x06preset\x18\x01 \x01(\v2\x1c.progression.v1.TalentPresetR\x06preset\x12\x1a\n" +
+	"\bassigned\x18\x02 \x01(\bR\bassigned\x122\n" +
+	"\x05trees\x18\x03 \x03(\v2\x1c.progression.v1.TreeOverviewR\x05trees\"f\n" +
+	"\x1cGetSettlementProgressRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\atree_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06treeId\"\xa6\x01\n" +
+	"\x1dPurchaseSettlementNodeRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\atree_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06treeId\x12\x1c\n" +
+	"\anode_id\x18\x03 \x01(\tB\x03\xe0A\x02R\x06nodeId\x12\x1f\n" +
+	"\vpurchase_id\x18\x04 \x01(\tR\n" +
+	"purchaseId\"\x80\x01\n" +
+	"\x1bRespecSettlementTreeRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\atree_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06treeId\x12\x19\n" +
+	"\bnode_ids\x18\x03 \x03(\tR\anodeIds\"\xe3\x01\n" +
+	"\x1cRespecSettlementTreeResponse\x12:\n" +
+	"\bprogress\x18\x01 \x01(\v2\x1e.progression.v1.TalentProgressR\bprogress\x12(\n" +
+	"\x10removed_node_ids\x18\x02 \x03(\tR\x0eremovedNodeIds\x12\x1b\n" +
+	"\trefund_bi\x18\x03 \x01(\x03R\brefundBi\x12@\n" +
+	"\x0enext_respec_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fnextRespecAt\"p\n" +
+	"\x17GetPointProgressRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\x12\x17\n" +
+	"\x04side\x18\x02 \x01(\tB\x03\xe0A\x02R\x04side\x12\x1c\n" +
+	"\atree_id\x18\x03 \x01(\tB\x03\xe0A\x02R\x06treeId\"\xda\x01\n" +
+	"\x18PurchasePointNodeRequest\x12\x1e\n" +
+	"\bpoint_id\x18\x01 \x01(\tB\x03\xe0A\x02R\apointId\x12\x17\n" +
+	"\x04side\x18\x02 \x01(\tB\x03\xe0A\x02R\x04side\x12\x1c\n" +
+	"\atree_id\x18\x03 \x01(\tB\x03\xe0A\x02R\x06treeId\x12\x1c\n" +
+	"\anode_id\x18\x04 \x01(\tB\x03\xe0A\x02R\x06nodeId\x12(\n" +
+	"\rsettlement_id\x18\x05 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1f\n" +
+	"\vpurchase_id\x18\x06 \x01(\tR\n" +
+	"purchaseId\"p\n" +
+	"\x1aGetEffectiveBonusesRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x19\n" +
+	"\bpoint_id\x18\x02 \x01(\tR\apointId\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\"9\n" +
+	"\tStatBonus\x12\x10\n" +
+	"\x03add\x18\x01 \x01(\x01R\x03add\x12\x1a\n" +
+	"\bmultiply\x18\x02 \x01(\x01R\bmultiply\"\xd4\x01\n" +
+	"\x10EffectiveBonuses\x12M\n" +
+	"\tmodifiers\x18\x01 \x03(\v2/.progression.v1.EffectiveBonuses.ModifiersEntryR\tmodifiers\x12\x18\n" +
+	"\aunlocks\x18\x02 \x03(\tR\aunlocks\x1aW\n" +
+	"\x0eModifiersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.progression.v1.StatBonusR\x05value:\x028\x01*]\n" +
+	"\fBundleFormat\x12\x1d\n" +
+	"\x19BUNDLE_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12BUNDLE_FORMAT_JSON\x10\x01\x12\x16\n" +
+	"\x12BUNDLE_FORMAT_YAML\x10\x02*~\n" +
+	"\fImportAction\x12\x1d\n" +
+	"\x19IMPORT_ACTION_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14IMPORT_ACTION_CREATE\x10\x01\x12\x18\n" +
+	"\x14IMPORT_ACTION_UPDATE\x10\x02\x12\x1b\n" +
+	"\x17IMPORT_ACTION_UNCHANGED\x10\x032\x94\x1b\n" +
+	"\x12ProgressionService\x12m\n" +
+	"\n" +
+	"CreateTree\x12!.progression.v1.CreateTreeRequest\x1a\x1a.progression.v1.TalentTree\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/progression/trees\x12r\n" +
+	"\n" +
+	"UpdateTree\x12!.progression.v1.UpdateTreeRequest\x1a\x1a.progression.v1.TalentTree\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/v1/progression/trees/{id}\x12i\n" +
+	"\aGetTree\x12\x1e.progression.v1.GetTreeRequest\x1a\x1a.progression.v1.TalentTree\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/progression/trees/{id}\x12o\n" +
+	"\tListTrees\x12 .progression.v1.ListTreesRequest\x1a!.progression.v1.ListTreesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/progression/trees\x12\x9e\x01\n" +
+	"\x14PreviewTreeMigration\x12$.progression.v1.TreeMigrationRequest\x1a!.progression.v1.TreeMigrationPlan\"=\x82\xd3\xe4\x93\x027:\x01*\"2/v1/progression/trees/{tree_id}/migrations:preview\x12\x9a\x01\n" +
+	"\x12ApplyTreeMigration\x12$.progression.v1.TreeMigrationRequest\x1a!.progression.v1.TreeMigrationPlan\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/progression/trees/{tree_id}/migrations:apply\x12u\n" +
+	"\fCreatePreset\x12#.progression.v1.CreatePresetRequest\x1a\x1c.progression.v1.TalentPreset\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/progression/presets\x12z\n" +
+	"\fUpdatePreset\x12#.progression.v1.UpdatePresetRequest\x1a\x1c.progression.v1.TalentPreset\"'\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/progression/presets/{id}\x12q\n" +
+	"\tGetPreset\x12 .progression.v1.GetPresetRequest\x1a\x1c.progression.v1.TalentPreset\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/progression/presets/{id}\x12w\n" +
+	"\vListPresets\x12\".progression.v1.ListPresetsRequest\x1a#.progression.v1.ListPresetsResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/progression/presets\x12\xa8\x01\n" +
+	"\x16AssignSettlementPreset\x12-.progression.v1.AssignSettlementPresetRequest\x1a .progression.v1.PresetAssignment\"=\x82\xd3\xe4\x93\x027:\x01*\x1a2/v1/progression/settlements/{settlement_id}/preset\x12\x9b\x01\n" +
+	"\x10SetDefaultPreset\x12'.progression.v1.SetDefaultPresetRequest\x1a .progression.v1.PresetAssignment\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/v1/progression/default-presets/{settlement_type}\x12\xa0\x01\n" +
+	"\x15ListPresetAssignments\x12,.progression.v1.ListPresetAssignmentsRequest\x1a-.progression.v1.ListPresetAssignmentsResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/progression/preset-assignments\x12u\n" +
+	"\n" +
+	"ExportTree\x12!.progression.v1.ExportTreeRequest\x1a\x14.google.api.HttpBody\".\x82\xd3\xe4\x93\x02(\x12&/v1/progression/trees/{tree_id}:export\x12}\n" +
+	"\fExportPreset\x12#.progression.v1.ExportPresetRequest\x1a\x14.google.api.HttpBody\"2\x82\xd3\xe4\x93\x02,\x12*/v1/progression/presets/{preset_id}:export\x12\x82\x01\n" +
+	"\rImportTalents\x12$.progression.v1.ImportTalentsRequest\x1a%.progression.v1.ImportTalentsResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x04file\"\x16/v1/progression:import\x12\xaa\x01\n" +
+	"\x15GetSettlementProgress\x12,.progression.v1.GetSettlementProgressRequest\x1a\x1e.progression.v1.TalentProgress\"C\x82\xd3\xe4\x93\x02=\x12;/v1/progression/settlements/{settlement_id}/trees/{tree_id}\x12\xb8\x01\n" +
+	"\x1bGetSettlementTalentOverview\x122.progression.v1.GetSettlementTalentOverviewRequest\x1a(.progression.v1.SettlementTalentOverview\";\x82\xd3\xe4\x93\x025\x123/v1/progression/settlements/{settlement_id}/talents\x12\xc8\x01\n" +
+	"\x16PurchaseSettlementNode\x12-.progression.v1.PurchaseSettlementNodeRequest\x1a\x1e.progression.v1.TalentProgress\"_\x82\xd3\xe4\x93\x02Y:\x01*\"T/v1/progression/settlements/{settlement_id}/trees/{tree_id}/nodes/{node_id}:purchase\x12\xc0\x01\n" +
+	"\x14RespecSettlementTree\x12+.progression.v1.RespecSettlementTreeRequest\x1a,.progression.v1.RespecSettlementTreeResponse\"M\x82\xd3\xe4\x93\x02G:\x01*\"B/v1/progression/settlements/{settlement_id}/trees/{tree_id}:respec\x12\xa3\x01\n" +
+	"\x10GetPointProgress\x12'.progression.v1.GetPointProgressRequest\x1a\x1e.progression.v1.TalentProgress\"F\x82\xd3\xe4\x93\x02@\x12>/v1/progression/points/{point_id}/sides/{side}/trees/{tree_id}\x12\xc1\x01\n" +
+	"\x11PurchasePointNode\x12(.progression.v1.PurchasePointNodeRequest\x1a\x1e.progression.v1.TalentProgress\"b\x82\xd3\xe4\x93\x02\\:\x01*\"W/v1/progression/points/{point_id}/sides/{side}/trees/{tree_id}/nodes/{node_id}:purchase\x12\xda\x01\n" +
+	"\x13GetEffectiveBonuses\x12*.progression.v1.GetEffectiveBonusesRequest\x1a .progression.v1.EffectiveBonuses\"u\x82\xd3\xe4\x93\x02oZ8\x126/v1/progression/points/{point_id}/sides/{side}/bonuses\x123/v1/progression/settlements/{settlement_id}/bonusesBBZ@github.com/lasthearth/vsservice/gen/progression/v1;progressionv1b\x06proto3"
 
 var (
 	file_progression_v1_progression_proto_rawDescOnce sync.Once
@@ -1441,70 +3138,150 @@ func file_progression_v1_progression_proto_rawDescGZIP() []byte {
 	return file_progression_v1_progression_proto_rawDescData
 }
 
-var file_progression_v1_progression_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_progression_v1_progression_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_progression_v1_progression_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_progression_v1_progression_proto_goTypes = []any{
-	(*TalentNode)(nil),                    // 0: progression.v1.TalentNode
-	(*TalentEdge)(nil),                    // 1: progression.v1.TalentEdge
-	(*TalentTree)(nil),                    // 2: progression.v1.TalentTree
-	(*TalentPreset)(nil),                  // 3: progression.v1.TalentPreset
-	(*PurchasedNode)(nil),                 // 4: progression.v1.PurchasedNode
-	(*TalentProgress)(nil),                // 5: progression.v1.TalentProgress
-	(*CreateTreeRequest)(nil),             // 6: progression.v1.CreateTreeRequest
-	(*UpdateTreeRequest)(nil),             // 7: progression.v1.UpdateTreeRequest
-	(*GetTreeRequest)(nil),                // 8: progression.v1.GetTreeRequest
-	(*ListTreesRequest)(nil),              // 9: progression.v1.ListTreesRequest
-	(*ListTreesResponse)(nil),             // 10: progression.v1.ListTreesResponse
-	(*CreatePresetRequest)(nil),           // 11: progression.v1.CreatePresetRequest
-	(*UpdatePresetRequest)(nil),           // 12: progression.v1.UpdatePresetRequest
-	(*GetPresetRequest)(nil),              // 13: progression.v1.GetPresetRequest
-	(*ListPresetsRequest)(nil),            // 14: progression.v1.ListPresetsRequest
-	(*ListPresetsResponse)(nil),           // 15: progression.v1.ListPresetsResponse
-	(*GetSettlementProgressRequest)(nil),  // 16: progression.v1.GetSettlementProgressRequest
-	(*PurchaseSettlementNodeRequest)(nil), // 17: progression.v1.PurchaseSettlementNodeRequest
-	(*GetPointProgressRequest)(nil),       // 18: progression.v1.GetPointProgressRequest
-	(*PurchasePointNodeRequest)(nil),      // 19: progression.v1.PurchasePointNodeRequest
-	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
+	(BundleFormat)(0),                          // 0: progression.v1.BundleFormat
+	(ImportAction)(0),                          // 1: progression.v1.ImportAction
+	(StatModifier_Operation)(0),                // 2: progression.v1.StatModifier.Operation
+	(*TalentNode)(nil),                         // 3: progression.v1.TalentNode
+	(*NodeEffect)(nil),                         // 4: progression.v1.NodeEffect
+	(*StatModifier)(nil),                       // 5: progression.v1.StatModifier
+	(*Unlock)(nil),                             // 6: progression.v1.Unlock
+	(*TalentEdge)(nil),                         // 7: progression.v1.TalentEdge
+	(*TalentTree)(nil),                         // 8: progression.v1.TalentTree
+	(*TalentPreset)(nil),                       // 9: progression.v1.TalentPreset
+	(*PurchasedNode)(nil),                      // 10: progression.v1.PurchasedNode
+	(*TalentProgress)(nil),                     // 11: progression.v1.TalentProgress
+	(*CreateTreeRequest)(nil),                  // 12: progression.v1.CreateTreeRequest
+	(*UpdateTreeRequest)(nil),                  // 13: progression.v1.UpdateTreeRequest
+	(*GetTreeRequest)(nil),                     // 14: progression.v1.GetTreeRequest
+	(*ListTreesRequest)(nil),                   // 15: progression.v1.ListTreesRequest
+	(*TreeMigrationRequest)(nil),               // 16: progression.v1.TreeMigrationRequest
+	(*ProgressMigration)(nil),                  // 17: progression.v1.ProgressMigration
+	(*TreeMigrationPlan)(nil),                  // 18: progression.v1.TreeMigrationPlan
+	(*ListTreesResponse)(nil),                  // 19: progression.v1.ListTreesResponse
+	(*CreatePresetRequest)(nil),                // 20: progression.v1.CreatePresetRequest
+	(*UpdatePresetRequest)(nil),                // 21: progression.v1.UpdatePresetRequest
+	(*GetPresetRequest)(nil),                   // 22: progression.v1.GetPresetRequest
+	(*ListPresetsRequest)(nil),                 // 23: progression.v1.ListPresetsRequest
+	(*ListPresetsResponse)(nil),                // 24: progression.v1.ListPresetsResponse
+	(*PresetAssignment)(nil),                   // 25: progression.v1.PresetAssignment
+	(*AssignSettlementPresetRequest)(nil),      // 26: progression.v1.AssignSettlementPresetRequest
+	(*SetDefaultPresetRequest)(nil),            // 27: progression.v1.SetDefaultPresetRequest
+	(*ListPresetAssignmentsRequest)(nil),       // 28: progression.v1.ListPresetAssignmentsRequest
+	(*ListPresetAssignmentsResponse)(nil),      // 29: progression.v1.ListPresetAssignmentsResponse
+	(*ExportTreeRequest)(nil),                  // 30: progression.v1.ExportTreeRequest
+	(*ExportPresetRequest)(nil),                // 31: progression.v1.ExportPresetRequest
+	(*ImportTalentsRequest)(nil),               // 32: progression.v1.ImportTalentsRequest
+	(*ImportedTree)(nil),                       // 33: progression.v1.ImportedTree
+	(*ImportedPreset)(nil),                     // 34: progression.v1.ImportedPreset
+	(*ImportTalentsResponse)(nil),              // 35: progression.v1.ImportTalentsResponse
+	(*GetSettlementTalentOverviewRequest)(nil), // 36: progression.v1.GetSettlementTalentOverviewRequest
+	(*TreeOverview)(nil),                       // 37: progression.v1.TreeOverview
+	(*SettlementTalentOverview)(nil),           // 38: progression.v1.SettlementTalentOverview
+	(*GetSettlementProgressRequest)(nil),       // 39: progression.v1.GetSettlementProgressRequest
+	(*PurchaseSettlementNodeRequest)(nil),      // 40: progression.v1.PurchaseSettlementNodeRequest
+	(*RespecSettlementTreeRequest)(nil),        // 41: progression.v1.RespecSettlementTreeRequest
+	(*RespecSettlementTreeResponse)(nil),       // 42: progression.v1.RespecSettlementTreeResponse
+	(*GetPointProgressRequest)(nil),            // 43: progression.v1.GetPointProgressRequest
+	(*PurchasePointNodeRequest)(nil),           // 44: progression.v1.PurchasePointNodeRequest
+	(*GetEffectiveBonusesRequest)(nil),         // 45: progression.v1.GetEffectiveBonusesRequest
+	(*StatBonus)(nil),                          // 46: progression.v1.StatBonus
+	(*EffectiveBonuses)(nil),                   // 47: progression.v1.EffectiveBonuses
+	nil,                                        // 48: progression.v1.TreeMigrationRequest.NodeMappingEntry
+	nil,                                        // 49: progression.v1.EffectiveBonuses.ModifiersEntry
+	(*timestamppb.Timestamp)(nil),              // 50: google.protobuf.Timestamp
+	(v1.SettlementType)(0),                     // 51: settlement.v1.SettlementType
+	(*httpbody.HttpBody)(nil),                  // 52: google.api.HttpBody
 }
 var file_progression_v1_progression_proto_depIdxs = []int32{
-	0,  // 0: progression.v1.TalentTree.nodes:type_name -> progression.v1.TalentNode
-	1,  // 1: progression.v1.TalentTree.edges:type_name -> progression.v1.TalentEdge
-	20, // 2: progression.v1.PurchasedNode.purchased_at:type_name -> google.protobuf.Timestamp
-	4,  // 3: progression.v1.TalentProgress.purchased_nodes:type_name -> progression.v1.PurchasedNode
-	0,  // 4: progression.v1.CreateTreeRequest.nodes:type_name -> progression.v1.TalentNode
-	1,  // 5: progression.v1.CreateTreeRequest.edges:type_name -> progression.v1.TalentEdge
-	0,  // 6: progression.v1.UpdateTreeRequest.nodes:type_name -> progression.v1.TalentNode
-	1,  // 7: progression.v1.UpdateTreeRequest.edges:type_name -> progression.v1.TalentEdge
-	2,  // 8: progression.v1.ListTreesResponse.trees:type_name -> progression.v1.TalentTree
-	3,  // 9: progression.v1.ListPresetsResponse.presets:type_name -> progression.v1.TalentPreset
-	6,  // 10: progression.v1.ProgressionService.CreateTree:input_type -> progression.v1.CreateTreeRequest
-	7,  // 11: progression.v1.ProgressionService.UpdateTree:input_type -> progression.v1.UpdateTreeRequest
-	8,  // 12: progression.v1.ProgressionService.GetTree:input_type -> progression.v1.GetTreeRequest
-	9,  // 13: progression.v1.ProgressionService.ListTrees:input_type -> progression.v1.ListTreesRequest
-	11, // 14: progression.v1.ProgressionService.CreatePreset:input_type -> progression.v1.CreatePresetRequest
-	12, // 15: progression.v1.ProgressionService.UpdatePreset:input_type -> progression.v1.UpdatePresetRequest
-	13, // 16: progression.v1.ProgressionService.GetPreset:input_type -> progression.v1.GetPresetRequest
-	14, // 17: progression.v1.ProgressionService.ListPresets:input_type -> progression.v1.ListPresetsRequest
-	16, // 18: progression.v1.ProgressionService.GetSettlementProgress:input_type -> progression.v1.GetSettlementProgressRequest
-	17, // 19: progression.v1.ProgressionService.PurchaseSettlementNode:input_type -> progression.v1.PurchaseSettlementNodeRequest
-	18, // 20: progression.v1.ProgressionService.GetPointProgress:input_type -> progression.v1.GetPointProgressRequest
-	19, // 21: progression.v1.ProgressionService.PurchasePointNode:input_type -> progression.v1.PurchasePointNodeRequest
-	2,  // 22: progression.v1.ProgressionService.CreateTree:output_type -> progression.v1.TalentTree
-	2,  // 23: progression.v1.ProgressionService.UpdateTree:output_type -> progression.v1.TalentTree
-	2,  // 24: progression.v1.ProgressionService.GetTree:output_type -> progression.v1.TalentTree
-	10, // 25: progression.v1.ProgressionService.ListTrees:output_type -> progression.v1.ListTreesResponse
-	3,  // 26: progression.v1.ProgressionService.CreatePreset:output_type -> progression.v1.TalentPreset
-	3,  // 27: progression.v1.ProgressionService.UpdatePreset:output_type -> progression.v1.TalentPreset
-	3,  // 28: progression.v1.ProgressionService.GetPreset:output_type -> progression.v1.TalentPreset
-	15, // 29: progression.v1.ProgressionService.ListPresets:output_type -> progression.v1.ListPresetsResponse
-	5,  // 30: progression.v1.ProgressionService.GetSettlementProgress:output_type -> progression.v1.TalentProgress
-	5,  // 31: progression.v1.ProgressionService.PurchaseSettlementNode:output_type -> progression.v1.TalentProgress
-	5,  // 32: progression.v1.ProgressionService.GetPointProgress:output_type -> progression.v1.TalentProgress
-	5,  // 33: progression.v1.ProgressionService.PurchasePointNode:output_type -> progression.v1.TalentProgress
-	22, // [22:34] is the sub-list for method output_type
-	10, // [10:22] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,  // 0: progression.v1.TalentNode.effects:type_name -> progression.v1.NodeEffect
+	5,  // 1: progression.v1.NodeEffect.modifier:type_name -> progression.v1.StatModifier
+	6,  // 2: progression.v1.NodeEffect.unlock:type_name -> progression.v1.Unlock
+	2,  // 3: progression.v1.StatModifier.operation:type_name -> progression.v1.StatModifier.Operation
+	3,  // 4: progression.v1.TalentTree.nodes:type_name -> progression.v1.TalentNode
+	7,  // 5: progression.v1.TalentTree.edges:type_name -> progression.v1.TalentEdge
+	50, // 6: progression.v1.PurchasedNode.purchased_at:type_name -> google.protobuf.Timestamp
+	10, // 7: progression.v1.TalentProgress.purchased_nodes:type_name -> progression.v1.PurchasedNode
+	3,  // 8: progression.v1.CreateTreeRequest.nodes:type_name -> progression.v1.TalentNode
+	7,  // 9: progression.v1.CreateTreeRequest.edges:type_name -> progression.v1.TalentEdge
+	3,  // 10: progression.v1.UpdateTreeRequest.nodes:type_name -> progression.v1.TalentNode
+	7,  // 11: progression.v1.UpdateTreeRequest.edges:type_name -> progression.v1.TalentEdge
+	48, // 12: progression.v1.TreeMigrationRequest.node_mapping:type_name -> progression.v1.TreeMigrationRequest.NodeMappingEntry
+	17, // 13: progression.v1.TreeMigrationPlan.progress:type_name -> progression.v1.ProgressMigration
+	8,  // 14: progression.v1.ListTreesResponse.trees:type_name -> progression.v1.TalentTree
+	9,  // 15: progression.v1.ListPresetsResponse.presets:type_name -> progression.v1.TalentPreset
+	51, // 16: progression.v1.PresetAssignment.settlement_type:type_name -> settlement.v1.SettlementType
+	50, // 17: progression.v1.PresetAssignment.updated_at:type_name -> google.protobuf.Timestamp
+	51, // 18: progression.v1.SetDefaultPresetRequest.settlement_type:type_name -> settlement.v1.SettlementType
+	25, // 19: progression.v1.ListPresetAssignmentsResponse.assignments:type_name -> progression.v1.PresetAssignment
+	0,  // 20: progression.v1.ExportTreeRequest.format:type_name -> progression.v1.BundleFormat
+	0,  // 21: progression.v1.ExportPresetRequest.format:type_name -> progression.v1.BundleFormat
+	52, // 22: progression.v1.ImportTalentsRequest.file:type_name -> google.api.HttpBody
+	1,  // 23: progression.v1.ImportedTree.action:type_name -> progression.v1.ImportAction
+	1,  // 24: progression.v1.ImportedPreset.action:type_name -> progression.v1.ImportAction
+	33, // 25: progression.v1.ImportTalentsResponse.trees:type_name -> progression.v1.ImportedTree
+	34, // 26: progression.v1.ImportTalentsResponse.preset:type_name -> progression.v1.ImportedPreset
+	8,  // 27: progression.v1.TreeOverview.tree:type_name -> progression.v1.TalentTree
+	11, // 28: progression.v1.TreeOverview.progress:type_name -> progression.v1.TalentProgress
+	9,  // 29: progression.v1.SettlementTalentOverview.preset:type_name -> progression.v1.TalentPreset
+	37, // 30: progression.v1.SettlementTalentOverview.trees:type_name -> progression.v1.TreeOverview
+	11, // 31: progression.v1.RespecSettlementTreeResponse.progress:type_name -> progression.v1.TalentProgress
+	50, // 32: progression.v1.RespecSettlementTreeResponse.next_respec_at:type_name -> google.protobuf.Timestamp
+	49, // 33: progression.v1.EffectiveBonuses.modifiers:type_name -> progression.v1.EffectiveBonuses.ModifiersEntry
+	46, // 34: progression.v1.EffectiveBonuses.ModifiersEntry.value:type_name -> progression.v1.StatBonus
+	12, // 35: progression.v1.ProgressionService.CreateTree:input_type -> progression.v1.CreateTreeRequest
+	13, // 36: progression.v1.ProgressionService.UpdateTree:input_type -> progression.v1.UpdateTreeRequest
+	14, // 37: progression.v1.ProgressionService.GetTree:input_type -> progression.v1.GetTreeRequest
+	15, // 38: progression.v1.ProgressionService.ListTrees:input_type -> progression.v1.ListTreesRequest
+	16, // 39: progression.v1.ProgressionService.PreviewTreeMigration:input_type -> progression.v1.TreeMigrationRequest
+	16, // 40: progression.v1.ProgressionService.ApplyTreeMigration:input_type -> progression.v1.TreeMigrationRequest
+	20, // 41: progression.v1.ProgressionService.CreatePreset:input_type -> progression.v1.CreatePresetRequest
+	21, // 42: progression.v1.ProgressionService.UpdatePreset:input_type -> progression.v1.UpdatePresetRequest
+	22, // 43: progression.v1.ProgressionService.GetPreset:input_type -> progression.v1.GetPresetRequest
+	23, // 44: progression.v1.ProgressionService.ListPresets:input_type -> progression.v1.ListPresetsRequest
+	26, // 45: progression.v1.ProgressionService.AssignSettlementPreset:input_type -> progression.v1.AssignSettlementPresetRequest
+	27, // 46: progression.v1.ProgressionService.SetDefaultPreset:input_type -> progression.v1.SetDefaultPresetRequest
+	28, // 47: progression.v1.ProgressionService.ListPresetAssignments:input_type -> progression.v1.ListPresetAssignmentsRequest
+	30, // 48: progression.v1.ProgressionService.ExportTree:input_type -> progression.v1.ExportTreeRequest
+	31, // 49: progression.v1.ProgressionService.ExportPreset:input_type -> progression.v1.ExportPresetRequest
+	32, // 50: progression.v1.ProgressionService.ImportTalents:input_type -> progression.v1.ImportTalentsRequest
+	39, // 51: progression.v1.ProgressionService.GetSettlementProgress:input_type -> progression.v1.GetSettlementProgressRequest
+	36, // 52: progression.v1.ProgressionService.GetSettlementTalentOverview:input_type -> progression.v1.GetSettlementTalentOverviewRequest
+	40, // 53: progression.v1.ProgressionService.PurchaseSettlementNode:input_type -> progression.v1.PurchaseSettlementNodeRequest
+	41, // 54: progression.v1.ProgressionService.RespecSettlementTree:input_type -> progression.v1.RespecSettlementTreeRequest
+	43, // 55: progression.v1.ProgressionService.GetPointProgress:input_type -> progression.v1.GetPointProgressRequest
+	44, // 56: progression.v1.ProgressionService.PurchasePointNode:input_type -> progression.v1.PurchasePointNodeRequest
+	45, // 57: progression.v1.ProgressionService.GetEffectiveBonuses:input_type -> progression.v1.GetEffectiveBonusesRequest
+	8,  // 58: progression.v1.ProgressionService.CreateTree:output_type -> progression.v1.TalentTree
+	8,  // 59: progression.v1.ProgressionService.UpdateTree:output_type -> progression.v1.TalentTree
+	8,  // 60: progression.v1.ProgressionService.GetTree:output_type -> progression.v1.TalentTree
+	19, // 61: progression.v1.ProgressionService.ListTrees:output_type -> progression.v1.ListTreesResponse
+	18, // 62: progression.v1.ProgressionService.PreviewTreeMigration:output_type -> progression.v1.TreeMigrationPlan
+	18, // 63: progression.v1.ProgressionService.ApplyTreeMigration:output_type -> progression.v1.TreeMigrationPlan
+	9,  // 64: progression.v1.ProgressionService.CreatePreset:output_type -> progression.v1.TalentPreset
+	9,  // 65: progression.v1.ProgressionService.UpdatePreset:output_type -> progression.v1.TalentPreset
+	9,  // 66: progression.v1.ProgressionService.GetPreset:output_type -> progression.v1.TalentPreset
+	24, // 67: progression.v1.ProgressionService.ListPresets:output_type -> progression.v1.ListPresetsResponse
+	25, // 68: progression.v1.ProgressionService.AssignSettlementPreset:output_type -> progression.v1.PresetAssignment
+	25, // 69: progression.v1.ProgressionService.SetDefaultPreset:output_type -> progression.v1.PresetAssignment
+	29, // 70: progression.v1.ProgressionService.ListPresetAssignments:output_type -> progression.v1.ListPresetAssignmentsResponse
+	52, // 71: progression.v1.ProgressionService.ExportTree:output_type -> google.api.HttpBody
+	52, // 72: progression.v1.ProgressionService.ExportPreset:output_type -> google.api.HttpBody
+	35, // 73: progression.v1.ProgressionService.ImportTalents:output_type -> progression.v1.ImportTalentsResponse
+	11, // 74: progression.v1.ProgressionService.GetSettlementProgress:output_type -> progression.v1.TalentProgress
+	38, // 75: progression.v1.ProgressionService.GetSettlementTalentOverview:output_type -> progression.v1.SettlementTalentOverview
+	11, // 76: progression.v1.ProgressionService.PurchaseSettlementNode:output_type -> progression.v1.TalentProgress
+	42, // 77: progression.v1.ProgressionService.RespecSettlementTree:output_type -> progression.v1.RespecSettlementTreeResponse
+	11, // 78: progression.v1.ProgressionService.GetPointProgress:output_type -> progression.v1.TalentProgress
+	11, // 79: progression.v1.ProgressionService.PurchasePointNode:output_type -> progression.v1.TalentProgress
+	47, // 80: progression.v1.ProgressionService.GetEffectiveBonuses:output_type -> progression.v1.EffectiveBonuses
+	58, // [58:81] is the sub-list for method output_type
+	35, // [35:58] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_progression_v1_progression_proto_init() }
@@ -1512,18 +3289,23 @@ func file_progression_v1_progression_proto_init() {
 	if File_progression_v1_progression_proto != nil {
 		return
 	}
+	file_progression_v1_progression_proto_msgTypes[1].OneofWrappers = []any{
+		(*NodeEffect_Modifier)(nil),
+		(*NodeEffect_Unlock)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_progression_v1_progression_proto_rawDesc), len(file_progression_v1_progression_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_progression_v1_progression_proto_goTypes,
 		DependencyIndexes: file_progression_v1_progression_proto_depIdxs,
+		EnumInfos:         file_progression_v1_progression_proto_enumTypes,
 		MessageInfos:      file_progression_v1_progression_proto_msgTypes,
 	}.Build()
 	File_progression_v1_progression_proto = out.File
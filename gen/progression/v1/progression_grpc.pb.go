@@ -8,6 +8,7 @@ package progressionv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,18 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProgressionService_CreateTree_FullMethodName             = "/progression.v1.ProgressionService/CreateTree"
-	ProgressionService_UpdateTree_FullMethodName             = "/progression.v1.ProgressionService/UpdateTree"
-	ProgressionService_GetTree_FullMethodName                = "/progression.v1.ProgressionService/GetTree"
-	ProgressionService_ListTrees_FullMethodName              = "/progression.v1.ProgressionService/ListTrees"
-	ProgressionService_CreatePreset_FullMethodName           = "/progression.v1.ProgressionService/CreatePreset"
-	ProgressionService_UpdatePreset_FullMethodName           = "/progression.v1.ProgressionService/UpdatePreset"
-	ProgressionService_GetPreset_FullMethodName              = "/progression.v1.ProgressionService/GetPreset"
-	ProgressionService_ListPresets_FullMethodName            = "/progression.v1.ProgressionService/ListPresets"
-	ProgressionService_GetSettlementProgress_FullMethodName  = "/progression.v1.ProgressionService/GetSettlementProgress"
-	ProgressionService_PurchaseSettlementNode_FullMethodName = "/progression.v1.ProgressionService/PurchaseSettlementNode"
-	ProgressionService_GetPointProgress_FullMethodName       = "/progression.v1.ProgressionService/GetPointProgress"
-	ProgressionService_PurchasePointNode_FullMethodName      = "/progression.v1.ProgressionService/PurchasePointNode"
+	ProgressionService_CreateTree_FullMethodName                  = "/progression.v1.ProgressionService/CreateTree"
+	ProgressionService_UpdateTree_FullMethodName                  = "/progression.v1.ProgressionService/UpdateTree"
+	ProgressionService_GetTree_FullMethodName                     = "/progression.v1.ProgressionService/GetTree"
+	ProgressionService_ListTrees_FullMethodName                   = "/progression.v1.ProgressionService/ListTrees"
+	ProgressionService_PreviewTreeMigration_FullMethodName        = "/progression.v1.ProgressionService/PreviewTreeMigration"
+	ProgressionService_ApplyTreeMigration_FullMethodName          = "/progression.v1.ProgressionService/ApplyTreeMigration"
+	ProgressionService_CreatePreset_FullMethodName                = "/progression.v1.ProgressionService/CreatePreset"
+	ProgressionService_UpdatePreset_FullMethodName                = "/progression.v1.ProgressionService/UpdatePreset"
+	ProgressionService_GetPreset_FullMethodName                   = "/progression.v1.ProgressionService/GetPreset"
+	ProgressionService_ListPresets_FullMethodName                 = "/progression.v1.ProgressionService/ListPresets"
+	ProgressionService_AssignSettlementPreset_FullMethodName      = "/progression.v1.ProgressionService/AssignSettlementPreset"
+	ProgressionService_SetDefaultPreset_FullMethodName            = "/progression.v1.ProgressionService/SetDefaultPreset"
+	ProgressionService_ListPresetAssignments_FullMethodName       = "/progression.v1.ProgressionService/ListPresetAssignments"
+	ProgressionService_ExportTree_FullMethodName                  = "/progression.v1.ProgressionService/ExportTree"
+	ProgressionService_ExportPreset_FullMethodName                = "/progression.v1.ProgressionService/ExportPreset"
+	ProgressionService_ImportTalents_FullMethodName               = "/progression.v1.ProgressionService/ImportTalents"
+	ProgressionService_GetSettlementProgress_FullMethodName       = "/progression.v1.ProgressionService/GetSettlementProgress"
+	ProgressionService_GetSettlementTalentOverview_FullMethodName = "/progression.v1.ProgressionService/GetSettlementTalentOverview"
+	ProgressionService_PurchaseSettlementNode_FullMethodName      = "/progression.v1.ProgressionService/PurchaseSettlementNode"
+	ProgressionService_RespecSettlementTree_FullMethodName        = "/progression.v1.ProgressionService/RespecSettlementTree"
+	ProgressionService_GetPointProgress_FullMethodName            = "/progression.v1.ProgressionService/GetPointProgress"
+	ProgressionService_PurchasePointNode_FullMethodName           = "/progression.v1.ProgressionService/PurchasePointNode"
+	ProgressionService_GetEffectiveBonuses_FullMethodName         = "/progression.v1.ProgressionService/GetEffectiveBonuses"
 )
 
 // ProgressionServiceClient is the client API for ProgressionService service.
@@ -42,23 +54,30 @@ type ProgressionServiceClient interface {
 	// Create a new talent tree template. Requires progression:write scope.
 	//
 	// Errors:
-	//   - INVALID_ARGUMENT (400): missing required fields
+	//   - INVALID_ARGUMENT (400): missing required fields, or an invalid graph:
+	//     empty or duplicate node ids, negative cost_bi, edges to unknown nodes,
+	//     self-loops, duplicate edges or cycles. A BadRequest detail names each
+	//     offending node or edge.
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires progression:write scope
 	//   - INTERNAL (500): database failure
 	CreateTree(ctx context.Context, in *CreateTreeRequest, opts ...grpc.CallOption) (*TalentTree, error)
-	// Update an existing talent tree template. Requires progression:write scope.
+	// Publish a new version of a talent tree. Requires progression:write scope.
+	// The previous version stays readable, and existing progress stays on the
+	// version it was bought against until ApplyTreeMigration moves it.
 	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid graph, as for CreateTree
 	//   - NOT_FOUND (404): tree not found
+	//   - ABORTED (409): another version was published concurrently; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires progression:write scope
 	//   - INTERNAL (500): database failure
 	UpdateTree(ctx context.Context, in *UpdateTreeRequest, opts ...grpc.CallOption) (*TalentTree, error)
-	// Get a talent tree by ID.
+	// Get a talent tree by ID, at its latest version unless version is set.
 	//
 	// Errors:
-	//   - NOT_FOUND (404): tree not found
+	//   - NOT_FOUND (404): tree or version not found
 	//   - INVALID_ARGUMENT (400): invalid id format
 	//   - INTERNAL (500): database failure
 	GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (*TalentTree, error)
@@ -67,6 +86,28 @@ type ProgressionServiceClient interface {
 	// Errors:
 	//   - INTERNAL (500): database failure
 	ListTrees(ctx context.Context, in *ListTreesRequest, opts ...grpc.CallOption) (*ListTreesResponse, error)
+	// Preview moving every progress on from_version of a tree to to_version:
+	// what each keeps, loses and gets refunded. Nothing is changed.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): to_version is not newer than from_version, or
+	//     node_mapping names unknown nodes or maps two nodes to one. A
+	//     BadRequest detail names each offending entry.
+	//   - NOT_FOUND (404): tree or version not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	PreviewTreeMigration(ctx context.Context, in *TreeMigrationRequest, opts ...grpc.CallOption) (*TreeMigrationPlan, error)
+	// Move every progress on from_version of a tree to to_version, as
+	// PreviewTreeMigration describes. Purchased nodes without a place in the new
+	// version are removed and their cost_bi refunded as imperial favor to the
+	// settlement that paid. Progress that changes during the migration is left
+	// on from_version and listed in failed_progress_ids; rerunning the same
+	// request migrates it. Requires progression:write scope.
+	//
+	// Errors: as for PreviewTreeMigration.
+	ApplyTreeMigration(ctx context.Context, in *TreeMigrationRequest, opts ...grpc.CallOption) (*TreeMigrationPlan, error)
 	// Create a settlement preset (a named collection of tree IDs available to settlements).
 	// Requires progression:write scope.
 	//
@@ -95,38 +136,158 @@ type ProgressionServiceClient interface {
 	// Errors:
 	//   - INTERNAL (500): database failure
 	ListPresets(ctx context.Context, in *ListPresetsRequest, opts ...grpc.CallOption) (*ListPresetsResponse, error)
-	// Get a settlement's progress on a specific tree.
+	// Assign a preset to a settlement; its leader may then buy only into the
+	// preset's trees. An empty preset_id removes the assignment, so the
+	// default preset of the settlement's type applies again.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or preset not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	AssignSettlementPreset(ctx context.Context, in *AssignSettlementPresetRequest, opts ...grpc.CallOption) (*PresetAssignment, error)
+	// Set the preset for settlements of a type that have none assigned. An
+	// empty preset_id removes the default. Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): settlement_type is unspecified
+	//   - NOT_FOUND (404): preset not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	SetDefaultPreset(ctx context.Context, in *SetDefaultPresetRequest, opts ...grpc.CallOption) (*PresetAssignment, error)
+	// List preset assignments, per settlement and per settlement type.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ListPresetAssignments(ctx context.Context, in *ListPresetAssignmentsRequest, opts ...grpc.CallOption) (*ListPresetAssignmentsResponse, error)
+	// Export a tree as a talent bundle file, for moving it to another
+	// environment with ImportTalents. The gateway serves the file itself.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): tree not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ExportTree(ctx context.Context, in *ExportTreeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Export a preset with all its trees as a talent bundle file.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): preset or one of its trees not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ExportPreset(ctx context.Context, in *ExportPresetRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Import a talent bundle file, JSON or YAML, as produced by ExportTree or
+	// ExportPreset. The gateway takes the file as the raw request body.
+	//
+	// Trees and the preset are matched to existing ones by name: a match with
+	// different content gets a new tree version, or new tree ids for a preset;
+	// anything unmatched is created. Tree ids in the file are remapped to the
+	// ids here. Importing the same file again changes nothing. With dry_run
+	// the report says what would happen and nothing is written.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): the file does not parse, has an unsupported
+	//     version, references trees it does not contain, or holds an invalid
+	//     tree. A BadRequest detail names each offending tree, node or edge.
+	//   - FAILED_PRECONDITION (412): several existing trees or presets have the
+	//     name being imported
+	//   - ABORTED (409): a matched tree changed concurrently; retry
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ImportTalents(ctx context.Context, in *ImportTalentsRequest, opts ...grpc.CallOption) (*ImportTalentsResponse, error)
+	// Get a settlement's progress on a specific tree of its preset.
 	//
 	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - FAILED_PRECONDITION (412): the settlement has no preset, or the tree
+	//     is not in it
 	//   - INTERNAL (500): database failure
 	GetSettlementProgress(ctx context.Context, in *GetSettlementProgressRequest, opts ...grpc.CallOption) (*TalentProgress, error)
-	// Purchase a node in a settlement's talent tree.
-	// Caller must be the leader of settlement_id.
+	// Get every tree of a settlement's preset with the settlement's progress
+	// in it. Each tree is the version the progress is pinned to.
 	//
 	// Errors:
-	//   - NOT_FOUND (404): tree or node not found
-	//   - INVALID_ARGUMENT (400): node already purchased or parent not purchased
+	//   - NOT_FOUND (404): settlement, or a tree of the preset, not found
+	//   - FAILED_PRECONDITION (412): the settlement has no preset
+	//   - INTERNAL (500): database failure
+	GetSettlementTalentOverview(ctx context.Context, in *GetSettlementTalentOverviewRequest, opts ...grpc.CallOption) (*SettlementTalentOverview, error)
+	// Purchase a node in a settlement's talent tree. The tree must be in the
+	// settlement's preset. Caller must be the leader of settlement_id.
+	// Retrying with the same purchase_id charges the favor once; a purchase
+	// that fails after the charge is refunded.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement, tree or node not found
+	//   - INVALID_ARGUMENT (400): node already purchased or parent not
+	//     purchased, or purchase_id was used for another node
+	//   - ABORTED (409): the progress changed concurrently or another purchase
+	//     of the node is in progress; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
-	//   - FAILED_PRECONDITION (412): insufficient imperial favor
+	//   - FAILED_PRECONDITION (412): insufficient imperial favor, the
+	//     settlement has no preset, or the tree is not in it
 	//   - INTERNAL (500): database failure
 	PurchaseSettlementNode(ctx context.Context, in *PurchaseSettlementNodeRequest, opts ...grpc.CallOption) (*TalentProgress, error)
-	// Get a point's progression for a specific side (east/west).
+	// Undo purchases in a settlement's talent tree. Caller must be the leader
+	// of settlement_id. node_ids are removed together with every purchased
+	// node that depends on them; an empty node_ids clears the tree. A share of
+	// the removed nodes' cost_bi is refunded as imperial favor, and the tree
+	// cannot be respecced again until the cooldown passes.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): tree not found
+	//   - INVALID_ARGUMENT (400): a node in node_ids is not purchased
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - FAILED_PRECONDITION (400): nothing purchased, or respec on cooldown
+	//   - ABORTED (409): progress changed concurrently; retry
+	//   - INTERNAL (500): database failure, or the previous respec's refund
+	//     could not be credited yet
+	RespecSettlementTree(ctx context.Context, in *RespecSettlementTreeRequest, opts ...grpc.CallOption) (*RespecSettlementTreeResponse, error)
+	// Get a point's progression for a specific side (a faction id).
 	//
 	// Errors:
 	//   - INTERNAL (500): database failure
 	GetPointProgress(ctx context.Context, in *GetPointProgressRequest, opts ...grpc.CallOption) (*TalentProgress, error)
 	// Purchase a node in a key point's talent tree.
 	// Caller must be the leader of the settlement that controls the point.
+	// Retrying with the same purchase_id charges the favor once; a purchase
+	// that fails after the charge is refunded.
 	//
 	// Errors:
 	//   - NOT_FOUND (404): point, tree, or node not found
-	//   - INVALID_ARGUMENT (400): node already purchased or parent not purchased
+	//   - INVALID_ARGUMENT (400): node already purchased or parent not
+	//     purchased, or purchase_id was used for another node
+	//   - ABORTED (409): the progress changed concurrently or another purchase
+	//     of the node is in progress; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
-	//   - PERMISSION_DENIED (403): caller's settlement does not control this point
-	//   - FAILED_PRECONDITION (412): insufficient imperial favor
+	//   - PERMISSION_DENIED (403): caller's settlement, or side, does not
+	//     control this point
+	//   - FAILED_PRECONDITION (412): settlement is not a member of the side's
+	//     faction, or insufficient imperial favor
 	//   - INTERNAL (500): database failure
 	PurchasePointNode(ctx context.Context, in *PurchasePointNodeRequest, opts ...grpc.CallOption) (*TalentProgress, error)
+	// Get the bonuses a settlement's, or a point side's, purchased nodes add up
+	// to across all their trees. Set either settlement_id or point_id and side.
+	//
+	// Whenever these change, a JSON event is published on NATS subject
+	// "progression.bonuses.changed" carrying the owner and the new bonuses.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): neither or both owners given
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetEffectiveBonuses(ctx context.Context, in *GetEffectiveBonusesRequest, opts ...grpc.CallOption) (*EffectiveBonuses, error)
 }
 
 type progressionServiceClient struct {
@@ -177,6 +338,26 @@ func (c *progressionServiceClient) ListTrees(ctx context.Context, in *ListTreesR
 	return out, nil
 }
 
+func (c *progressionServiceClient) PreviewTreeMigration(ctx context.Context, in *TreeMigrationRequest, opts ...grpc.CallOption) (*TreeMigrationPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreeMigrationPlan)
+	err := c.cc.Invoke(ctx, ProgressionService_PreviewTreeMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) ApplyTreeMigration(ctx context.Context, in *TreeMigrationRequest, opts ...grpc.CallOption) (*TreeMigrationPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TreeMigrationPlan)
+	err := c.cc.Invoke(ctx, ProgressionService_ApplyTreeMigration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *progressionServiceClient) CreatePreset(ctx context.Context, in *CreatePresetRequest, opts ...grpc.CallOption) (*TalentPreset, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TalentPreset)
@@ -217,6 +398,66 @@ func (c *progressionServiceClient) ListPresets(ctx context.Context, in *ListPres
 	return out, nil
 }
 
+func (c *progressionServiceClient) AssignSettlementPreset(ctx context.Context, in *AssignSettlementPresetRequest, opts ...grpc.CallOption) (*PresetAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetAssignment)
+	err := c.cc.Invoke(ctx, ProgressionService_AssignSettlementPreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) SetDefaultPreset(ctx context.Context, in *SetDefaultPresetRequest, opts ...grpc.CallOption) (*PresetAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresetAssignment)
+	err := c.cc.Invoke(ctx, ProgressionService_SetDefaultPreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) ListPresetAssignments(ctx context.Context, in *ListPresetAssignmentsRequest, opts ...grpc.CallOption) (*ListPresetAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPresetAssignmentsResponse)
+	err := c.cc.Invoke(ctx, ProgressionService_ListPresetAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) ExportTree(ctx context.Context, in *ExportTreeRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ProgressionService_ExportTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) ExportPreset(ctx context.Context, in *ExportPresetRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, ProgressionService_ExportPreset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *progressionServiceClient) ImportTalents(ctx context.Context, in *ImportTalentsRequest, opts ...grpc.CallOption) (*ImportTalentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTalentsResponse)
+	err := c.cc.Invoke(ctx, ProgressionService_ImportTalents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *progressionServiceClient) GetSettlementProgress(ctx context.Context, in *GetSettlementProgressRequest, opts ...grpc.CallOption) (*TalentProgress, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TalentProgress)
@@ -227,6 +468,16 @@ func (c *progressionServiceClient) GetSettlementProgress(ctx context.Context, in
 	return out, nil
 }
 
+func (c *progressionServiceClient) GetSettlementTalentOverview(ctx context.Context, in *GetSettlementTalentOverviewRequest, opts ...grpc.CallOption) (*SettlementTalentOverview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettlementTalentOverview)
+	err := c.cc.Invoke(ctx, ProgressionService_GetSettlementTalentOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *progressionServiceClient) PurchaseSettlementNode(ctx context.Context, in *PurchaseSettlementNodeRequest, opts ...grpc.CallOption) (*TalentProgress, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TalentProgress)
@@ -237,6 +488,16 @@ func (c *progressionServiceClient) PurchaseSettlementNode(ctx context.Context, i
 	return out, nil
 }
 
+func (c *progressionServiceClient) RespecSettlementTree(ctx context.Context, in *RespecSettlementTreeRequest, opts ...grpc.CallOption) (*RespecSettlementTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RespecSettlementTreeResponse)
+	err := c.cc.Invoke(ctx, ProgressionService_RespecSettlementTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *progressionServiceClient) GetPointProgress(ctx context.Context, in *GetPointProgressRequest, opts ...grpc.CallOption) (*TalentProgress, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TalentProgress)
@@ -257,6 +518,16 @@ func (c *progressionServiceClient) PurchasePointNode(ctx context.Context, in *Pu
 	return out, nil
 }
 
+func (c *progressionServiceClient) GetEffectiveBonuses(ctx context.Context, in *GetEffectiveBonusesRequest, opts ...grpc.CallOption) (*EffectiveBonuses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EffectiveBonuses)
+	err := c.cc.Invoke(ctx, ProgressionService_GetEffectiveBonuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProgressionServiceServer is the server API for ProgressionService service.
 // All implementations should embed UnimplementedProgressionServiceServer
 // for forward compatibility.
@@ -266,23 +537,30 @@ type ProgressionServiceServer interface {
 	// Create a new talent tree template. Requires progression:write scope.
 	//
 	// Errors:
-	//   - INVALID_ARGUMENT (400): missing required fields
+	//   - INVALID_ARGUMENT (400): missing required fields, or an invalid graph:
+	//     empty or duplicate node ids, negative cost_bi, edges to unknown nodes,
+	//     self-loops, duplicate edges or cycles. A BadRequest detail names each
+	//     offending node or edge.
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires progression:write scope
 	//   - INTERNAL (500): database failure
 	CreateTree(context.Context, *CreateTreeRequest) (*TalentTree, error)
-	// Update an existing talent tree template. Requires progression:write scope.
+	// Publish a new version of a talent tree. Requires progression:write scope.
+	// The previous version stays readable, and existing progress stays on the
+	// version it was bought against until ApplyTreeMigration moves it.
 	//
 	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid graph, as for CreateTree
 	//   - NOT_FOUND (404): tree not found
+	//   - ABORTED (409): another version was published concurrently; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): requires progression:write scope
 	//   - INTERNAL (500): database failure
 	UpdateTree(context.Context, *UpdateTreeRequest) (*TalentTree, error)
-	// Get a talent tree by ID.
+	// Get a talent tree by ID, at its latest version unless version is set.
 	//
 	// Errors:
-	//   - NOT_FOUND (404): tree not found
+	//   - NOT_FOUND (404): tree or version not found
 	//   - INVALID_ARGUMENT (400): invalid id format
 	//   - INTERNAL (500): database failure
 	GetTree(context.Context, *GetTreeRequest) (*TalentTree, error)
@@ -291,6 +569,28 @@ type ProgressionServiceServer interface {
 	// Errors:
 	//   - INTERNAL (500): database failure
 	ListTrees(context.Context, *ListTreesRequest) (*ListTreesResponse, error)
+	// Preview moving every progress on from_version of a tree to to_version:
+	// what each keeps, loses and gets refunded. Nothing is changed.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): to_version is not newer than from_version, or
+	//     node_mapping names unknown nodes or maps two nodes to one. A
+	//     BadRequest detail names each offending entry.
+	//   - NOT_FOUND (404): tree or version not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	PreviewTreeMigration(context.Context, *TreeMigrationRequest) (*TreeMigrationPlan, error)
+	// Move every progress on from_version of a tree to to_version, as
+	// PreviewTreeMigration describes. Purchased nodes without a place in the new
+	// version are removed and their cost_bi refunded as imperial favor to the
+	// settlement that paid. Progress that changes during the migration is left
+	// on from_version and listed in failed_progress_ids; rerunning the same
+	// request migrates it. Requires progression:write scope.
+	//
+	// Errors: as for PreviewTreeMigration.
+	ApplyTreeMigration(context.Context, *TreeMigrationRequest) (*TreeMigrationPlan, error)
 	// Create a settlement preset (a named collection of tree IDs available to settlements).
 	// Requires progression:write scope.
 	//
@@ -319,38 +619,158 @@ type ProgressionServiceServer interface {
 	// Errors:
 	//   - INTERNAL (500): database failure
 	ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error)
-	// Get a settlement's progress on a specific tree.
+	// Assign a preset to a settlement; its leader may then buy only into the
+	// preset's trees. An empty preset_id removes the assignment, so the
+	// default preset of the settlement's type applies again.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or preset not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	AssignSettlementPreset(context.Context, *AssignSettlementPresetRequest) (*PresetAssignment, error)
+	// Set the preset for settlements of a type that have none assigned. An
+	// empty preset_id removes the default. Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): settlement_type is unspecified
+	//   - NOT_FOUND (404): preset not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	SetDefaultPreset(context.Context, *SetDefaultPresetRequest) (*PresetAssignment, error)
+	// List preset assignments, per settlement and per settlement type.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ListPresetAssignments(context.Context, *ListPresetAssignmentsRequest) (*ListPresetAssignmentsResponse, error)
+	// Export a tree as a talent bundle file, for moving it to another
+	// environment with ImportTalents. The gateway serves the file itself.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): tree not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ExportTree(context.Context, *ExportTreeRequest) (*httpbody.HttpBody, error)
+	// Export a preset with all its trees as a talent bundle file.
+	// Requires progression:write scope.
 	//
 	// Errors:
+	//   - NOT_FOUND (404): preset or one of its trees not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ExportPreset(context.Context, *ExportPresetRequest) (*httpbody.HttpBody, error)
+	// Import a talent bundle file, JSON or YAML, as produced by ExportTree or
+	// ExportPreset. The gateway takes the file as the raw request body.
+	//
+	// Trees and the preset are matched to existing ones by name: a match with
+	// different content gets a new tree version, or new tree ids for a preset;
+	// anything unmatched is created. Tree ids in the file are remapped to the
+	// ids here. Importing the same file again changes nothing. With dry_run
+	// the report says what would happen and nothing is written.
+	// Requires progression:write scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): the file does not parse, has an unsupported
+	//     version, references trees it does not contain, or holds an invalid
+	//     tree. A BadRequest detail names each offending tree, node or edge.
+	//   - FAILED_PRECONDITION (412): several existing trees or presets have the
+	//     name being imported
+	//   - ABORTED (409): a matched tree changed concurrently; retry
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): requires progression:write scope
+	//   - INTERNAL (500): database failure
+	ImportTalents(context.Context, *ImportTalentsRequest) (*ImportTalentsResponse, error)
+	// Get a settlement's progress on a specific tree of its preset.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - FAILED_PRECONDITION (412): the settlement has no preset, or the tree
+	//     is not in it
 	//   - INTERNAL (500): database failure
 	GetSettlementProgress(context.Context, *GetSettlementProgressRequest) (*TalentProgress, error)
-	// Purchase a node in a settlement's talent tree.
-	// Caller must be the leader of settlement_id.
+	// Get every tree of a settlement's preset with the settlement's progress
+	// in it. Each tree is the version the progress is pinned to.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement, or a tree of the preset, not found
+	//   - FAILED_PRECONDITION (412): the settlement has no preset
+	//   - INTERNAL (500): database failure
+	GetSettlementTalentOverview(context.Context, *GetSettlementTalentOverviewRequest) (*SettlementTalentOverview, error)
+	// Purchase a node in a settlement's talent tree. The tree must be in the
+	// settlement's preset. Caller must be the leader of settlement_id.
+	// Retrying with the same purchase_id charges the favor once; a purchase
+	// that fails after the charge is refunded.
 	//
 	// Errors:
-	//   - NOT_FOUND (404): tree or node not found
-	//   - INVALID_ARGUMENT (400): node already purchased or parent not purchased
+	//   - NOT_FOUND (404): settlement, tree or node not found
+	//   - INVALID_ARGUMENT (400): node already purchased or parent not
+	//     purchased, or purchase_id was used for another node
+	//   - ABORTED (409): the progress changed concurrently or another purchase
+	//     of the node is in progress; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
-	//   - FAILED_PRECONDITION (412): insufficient imperial favor
+	//   - FAILED_PRECONDITION (412): insufficient imperial favor, the
+	//     settlement has no preset, or the tree is not in it
 	//   - INTERNAL (500): database failure
 	PurchaseSettlementNode(context.Context, *PurchaseSettlementNodeRequest) (*TalentProgress, error)
-	// Get a point's progression for a specific side (east/west).
+	// Undo purchases in a settlement's talent tree. Caller must be the leader
+	// of settlement_id. node_ids are removed together with every purchased
+	// node that depends on them; an empty node_ids clears the tree. A share of
+	// the removed nodes' cost_bi is refunded as imperial favor, and the tree
+	// cannot be respecced again until the cooldown passes.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): tree not found
+	//   - INVALID_ARGUMENT (400): a node in node_ids is not purchased
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - PERMISSION_DENIED (403): caller is not the leader of the settlement
+	//   - FAILED_PRECONDITION (400): nothing purchased, or respec on cooldown
+	//   - ABORTED (409): progress changed concurrently; retry
+	//   - INTERNAL (500): database failure, or the previous respec's refund
+	//     could not be credited yet
+	RespecSettlementTree(context.Context, *RespecSettlementTreeRequest) (*RespecSettlementTreeResponse, error)
+	// Get a point's progression for a specific side (a faction id).
 	//
 	// Errors:
 	//   - INTERNAL (500): database failure
 	GetPointProgress(context.Context, *GetPointProgressRequest) (*TalentProgress, error)
 	// Purchase a node in a key point's talent tree.
 	// Caller must be the leader of the settlement that controls the point.
+	// Retrying with the same purchase_id charges the favor once; a purchase
+	// that fails after the charge is refunded.
 	//
 	// Errors:
 	//   - NOT_FOUND (404): point, tree, or node not found
-	//   - INVALID_ARGUMENT (400): node already purchased or parent not purchased
+	//   - INVALID_ARGUMENT (400): node already purchased or parent not
+	//     purchased, or purchase_id was used for another node
+	//   - ABORTED (409): the progress changed concurrently or another purchase
+	//     of the node is in progress; retry
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
-	//   - PERMISSION_DENIED (403): caller's settlement does not control this point
-	//   - FAILED_PRECONDITION (412): insufficient imperial favor
+	//   - PERMISSION_DENIED (403): caller's settlement, or side, does not
+	//     control this point
+	//   - FAILED_PRECONDITION (412): settlement is not a member of the side's
+	//     faction, or insufficient imperial favor
 	//   - INTERNAL (500): database failure
 	PurchasePointNode(context.Context, *PurchasePointNodeRequest) (*TalentProgress, error)
+	// Get the bonuses a settlement's, or a point side's, purchased nodes add up
+	// to across all their trees. Set either settlement_id or point_id and side.
+	//
+	// Whenever these change, a JSON event is published on NATS subject
+	// "progression.bonuses.changed" carrying the owner and the new bonuses.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): neither or both owners given
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetEffectiveBonuses(context.Context, *GetEffectiveBonusesRequest) (*EffectiveBonuses, error)
 }
 
 // UnimplementedProgressionServiceServer should be embedded to have
@@ -372,6 +792,12 @@ func (UnimplementedProgressionServiceServer) GetTree(context.Context, *GetTreeRe
 func (UnimplementedProgressionServiceServer) ListTrees(context.Context, *ListTreesRequest) (*ListTreesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTrees not implemented")
 }
+func (UnimplementedProgressionServiceServer) PreviewTreeMigration(context.Context, *TreeMigrationRequest) (*TreeMigrationPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTreeMigration not implemented")
+}
+func (UnimplementedProgressionServiceServer) ApplyTreeMigration(context.Context, *TreeMigrationRequest) (*TreeMigrationPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyTreeMigration not implemented")
+}
 func (UnimplementedProgressionServiceServer) CreatePreset(context.Context, *CreatePresetRequest) (*TalentPreset, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePreset not implemented")
 }
@@ -384,18 +810,45 @@ func (UnimplementedProgressionServiceServer) GetPreset(context.Context, *GetPres
 func (UnimplementedProgressionServiceServer) ListPresets(context.Context, *ListPresetsRequest) (*ListPresetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPresets not implemented")
 }
+func (UnimplementedProgressionServiceServer) AssignSettlementPreset(context.Context, *AssignSettlementPresetRequest) (*PresetAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignSettlementPreset not implemented")
+}
+func (UnimplementedProgressionServiceServer) SetDefaultPreset(context.Context, *SetDefaultPresetRequest) (*PresetAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultPreset not implemented")
+}
+func (UnimplementedProgressionServiceServer) ListPresetAssignments(context.Context, *ListPresetAssignmentsRequest) (*ListPresetAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPresetAssignments not implemented")
+}
+func (UnimplementedProgressionServiceServer) ExportTree(context.Context, *ExportTreeRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTree not implemented")
+}
+func (UnimplementedProgressionServiceServer) ExportPreset(context.Context, *ExportPresetRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPreset not implemented")
+}
+func (UnimplementedProgressionServiceServer) ImportTalents(context.Context, *ImportTalentsRequest) (*ImportTalentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTalents not implemented")
+}
 func (UnimplementedProgressionServiceServer) GetSettlementProgress(context.Context, *GetSettlementProgressRequest) (*TalentProgress, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementProgress not implemented")
 }
+func (UnimplementedProgressionServiceServer) GetSettlementTalentOverview(context.Context, *GetSettlementTalentOverviewRequest) (*SettlementTalentOverview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementTalentOverview not implemented")
+}
 func (UnimplementedProgressionServiceServer) PurchaseSettlementNode(context.Context, *PurchaseSettlementNodeRequest) (*TalentProgress, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurchaseSettlementNode not implemented")
 }
+func (UnimplementedProgressionServiceServer) RespecSettlementTree(context.Context, *RespecSettlementTreeRequest) (*RespecSettlementTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespecSettlementTree not implemented")
+}
 func (UnimplementedProgressionServiceServer) GetPointProgress(context.Context, *GetPointProgressRequest) (*TalentProgress, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPointProgress not implemented")
 }
 func (UnimplementedProgressionServiceServer) PurchasePointNode(context.Context, *PurchasePointNodeRequest) (*TalentProgress, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurchasePointNode not implemented")
 }
+func (UnimplementedProgressionServiceServer) GetEffectiveBonuses(context.Context, *GetEffectiveBonusesRequest) (*EffectiveBonuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectiveBonuses not implemented")
+}
 func (UnimplementedProgressionServiceServer) testEmbeddedByValue() {}
 
 // UnsafeProgressionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -488,6 +941,42 @@ func _ProgressionService_ListTrees_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressionService_PreviewTreeMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).PreviewTreeMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_PreviewTreeMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).PreviewTreeMigration(ctx, req.(*TreeMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_ApplyTreeMigration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeMigrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).ApplyTreeMigration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_ApplyTreeMigration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).ApplyTreeMigration(ctx, req.(*TreeMigrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProgressionService_CreatePreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePresetRequest)
 	if err := dec(in); err != nil {
@@ -560,6 +1049,114 @@ func _ProgressionService_ListPresets_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressionService_AssignSettlementPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignSettlementPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).AssignSettlementPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_AssignSettlementPreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).AssignSettlementPreset(ctx, req.(*AssignSettlementPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_SetDefaultPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).SetDefaultPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_SetDefaultPreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).SetDefaultPreset(ctx, req.(*SetDefaultPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_ListPresetAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPresetAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).ListPresetAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_ListPresetAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).ListPresetAssignments(ctx, req.(*ListPresetAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_ExportTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).ExportTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_ExportTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).ExportTree(ctx, req.(*ExportTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_ExportPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).ExportPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_ExportPreset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).ExportPreset(ctx, req.(*ExportPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgressionService_ImportTalents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTalentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).ImportTalents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_ImportTalents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).ImportTalents(ctx, req.(*ImportTalentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProgressionService_GetSettlementProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSettlementProgressRequest)
 	if err := dec(in); err != nil {
@@ -578,6 +1175,24 @@ func _ProgressionService_GetSettlementProgress_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressionService_GetSettlementTalentOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettlementTalentOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).GetSettlementTalentOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_GetSettlementTalentOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).GetSettlementTalentOverview(ctx, req.(*GetSettlementTalentOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProgressionService_PurchaseSettlementNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurchaseSettlementNodeRequest)
 	if err := dec(in); err != nil {
@@ -596,6 +1211,24 @@ func _ProgressionService_PurchaseSettlementNode_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressionService_RespecSettlementTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespecSettlementTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).RespecSettlementTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_RespecSettlementTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).RespecSettlementTree(ctx, req.(*RespecSettlementTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProgressionService_GetPointProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPointProgressRequest)
 	if err := dec(in); err != nil {
@@ -632,6 +1265,24 @@ func _ProgressionService_PurchasePointNode_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressionService_GetEffectiveBonuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectiveBonusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressionServiceServer).GetEffectiveBonuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressionService_GetEffectiveBonuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressionServiceServer).GetEffectiveBonuses(ctx, req.(*GetEffectiveBonusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProgressionService_ServiceDesc is the grpc.ServiceDesc for ProgressionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -655,6 +1306,14 @@ var ProgressionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTrees",
 			Handler:    _ProgressionService_ListTrees_Handler,
 		},
+		{
+			MethodName: "PreviewTreeMigration",
+			Handler:    _ProgressionService_PreviewTreeMigration_Handler,
+		},
+		{
+			MethodName: "ApplyTreeMigration",
+			Handler:    _ProgressionService_ApplyTreeMigration_Handler,
+		},
 		{
 			MethodName: "CreatePreset",
 			Handler:    _ProgressionService_CreatePreset_Handler,
@@ -671,14 +1330,46 @@ var ProgressionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPresets",
 			Handler:    _ProgressionService_ListPresets_Handler,
 		},
+		{
+			MethodName: "AssignSettlementPreset",
+			Handler:    _ProgressionService_AssignSettlementPreset_Handler,
+		},
+		{
+			MethodName: "SetDefaultPreset",
+			Handler:    _ProgressionService_SetDefaultPreset_Handler,
+		},
+		{
+			MethodName: "ListPresetAssignments",
+			Handler:    _ProgressionService_ListPresetAssignments_Handler,
+		},
+		{
+			MethodName: "ExportTree",
+			Handler:    _ProgressionService_ExportTree_Handler,
+		},
+		{
+			MethodName: "ExportPreset",
+			Handler:    _ProgressionService_ExportPreset_Handler,
+		},
+		{
+			MethodName: "ImportTalents",
+			Handler:    _ProgressionService_ImportTalents_Handler,
+		},
 		{
 			MethodName: "GetSettlementProgress",
 			Handler:    _ProgressionService_GetSettlementProgress_Handler,
 		},
+		{
+			MethodName: "GetSettlementTalentOverview",
+			Handler:    _ProgressionService_GetSettlementTalentOverview_Handler,
+		},
 		{
 			MethodName: "PurchaseSettlementNode",
 			Handler:    _ProgressionService_PurchaseSettlementNode_Handler,
 		},
+		{
+			MethodName: "RespecSettlementTree",
+			Handler:    _ProgressionService_RespecSettlementTree_Handler,
+		},
 		{
 			MethodName: "GetPointProgress",
 			Handler:    _ProgressionService_GetPointProgress_Handler,
@@ -687,6 +1378,10 @@ var ProgressionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurchasePointNode",
 			Handler:    _ProgressionService_PurchasePointNode_Handler,
 		},
+		{
+			MethodName: "GetEffectiveBonuses",
+			Handler:    _ProgressionService_GetEffectiveBonuses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "progression/v1/progression.proto",
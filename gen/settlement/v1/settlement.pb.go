@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.5
+// 	protoc-gen-go v1.36.11
 // 	protoc        (unknown)
 // source: settlement/v1/settlement.proto
 
@@ -127,6 +127,173 @@ func (SubmitRequest_Type) EnumDescriptor() ([]byte, []int) {
 	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{8, 0}
 }
 
+type TreasuryEntry_Kind int32
+
+const (
+	TreasuryEntry_KIND_UNSPECIFIED TreasuryEntry_Kind = 0
+	// Member paid coins in from their donate wallet.
+	TreasuryEntry_DEPOSIT TreasuryEntry_Kind = 1
+	// Coins paid out to a member's donate wallet.
+	TreasuryEntry_WITHDRAWAL TreasuryEntry_Kind = 2
+	// Coins spent on a shop item.
+	TreasuryEntry_SPEND TreasuryEntry_Kind = 3
+)
+
+// Enum value maps for TreasuryEntry_Kind.
+var (
+	TreasuryEntry_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "DEPOSIT",
+		2: "WITHDRAWAL",
+		3: "SPEND",
+	}
+	TreasuryEntry_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"DEPOSIT":          1,
+		"WITHDRAWAL":       2,
+		"SPEND":            3,
+	}
+)
+
+func (x TreasuryEntry_Kind) Enum() *TreasuryEntry_Kind {
+	p := new(TreasuryEntry_Kind)
+	*p = x
+	return p
+}
+
+func (x TreasuryEntry_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TreasuryEntry_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_settlement_v1_settlement_proto_enumTypes[2].Descriptor()
+}
+
+func (TreasuryEntry_Kind) Type() protoreflect.EnumType {
+	return &file_settlement_v1_settlement_proto_enumTypes[2]
+}
+
+func (x TreasuryEntry_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TreasuryEntry_Kind.Descriptor instead.
+func (TreasuryEntry_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{53, 0}
+}
+
+type RequirementProgress_Kind int32
+
+const (
+	RequirementProgress_KIND_UNSPECIFIED        RequirementProgress_Kind = 0
+	RequirementProgress_KIND_MEMBERS            RequirementProgress_Kind = 1
+	RequirementProgress_KIND_IMPERIAL_FAVOR     RequirementProgress_Kind = 2
+	RequirementProgress_KIND_AGE_DAYS           RequirementProgress_Kind = 3
+	RequirementProgress_KIND_TALENT_NODE        RequirementProgress_Kind = 4
+	RequirementProgress_KIND_FEE_IMPERIAL_FAVOR RequirementProgress_Kind = 5
+	RequirementProgress_KIND_FEE_COINS          RequirementProgress_Kind = 6
+)
+
+// Enum value maps for RequirementProgress_Kind.
+var (
+	RequirementProgress_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "KIND_MEMBERS",
+		2: "KIND_IMPERIAL_FAVOR",
+		3: "KIND_AGE_DAYS",
+		4: "KIND_TALENT_NODE",
+		5: "KIND_FEE_IMPERIAL_FAVOR",
+		6: "KIND_FEE_COINS",
+	}
+	RequirementProgress_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED":        0,
+		"KIND_MEMBERS":            1,
+		"KIND_IMPERIAL_FAVOR":     2,
+		"KIND_AGE_DAYS":           3,
+		"KIND_TALENT_NODE":        4,
+		"KIND_FEE_IMPERIAL_FAVOR": 5,
+		"KIND_FEE_COINS":          6,
+	}
+)
+
+func (x RequirementProgress_Kind) Enum() *RequirementProgress_Kind {
+	p := new(RequirementProgress_Kind)
+	*p = x
+	return p
+}
+
+func (x RequirementProgress_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RequirementProgress_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_settlement_v1_settlement_proto_enumTypes[3].Descriptor()
+}
+
+func (RequirementProgress_Kind) Type() protoreflect.EnumType {
+	return &file_settlement_v1_settlement_proto_enumTypes[3]
+}
+
+func (x RequirementProgress_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RequirementProgress_Kind.Descriptor instead.
+func (RequirementProgress_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{72, 0}
+}
+
+type BoardPost_Visibility int32
+
+const (
+	BoardPost_VISIBILITY_UNSPECIFIED BoardPost_Visibility = 0
+	// Shown to members of the settlement only.
+	BoardPost_MEMBERS BoardPost_Visibility = 1
+	// Shown to everyone.
+	BoardPost_PUBLIC BoardPost_Visibility = 2
+)
+
+// Enum value maps for BoardPost_Visibility.
+var (
+	BoardPost_Visibility_name = map[int32]string{
+		0: "VISIBILITY_UNSPECIFIED",
+		1: "MEMBERS",
+		2: "PUBLIC",
+	}
+	BoardPost_Visibility_value = map[string]int32{
+		"VISIBILITY_UNSPECIFIED": 0,
+		"MEMBERS":                1,
+		"PUBLIC":                 2,
+	}
+)
+
+func (x BoardPost_Visibility) Enum() *BoardPost_Visibility {
+	p := new(BoardPost_Visibility)
+	*p = x
+	return p
+}
+
+func (x BoardPost_Visibility) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BoardPost_Visibility) Descriptor() protoreflect.EnumDescriptor {
+	return file_settlement_v1_settlement_proto_enumTypes[4].Descriptor()
+}
+
+func (BoardPost_Visibility) Type() protoreflect.EnumType {
+	return &file_settlement_v1_settlement_proto_enumTypes[4]
+}
+
+func (x BoardPost_Visibility) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BoardPost_Visibility.Descriptor instead.
+func (BoardPost_Visibility) EnumDescriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{79, 0}
+}
+
 type GetUserInvitationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -389,9 +556,17 @@ type Settlement struct {
 	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	ImperialFavor int64                  `protobuf:"varint,12,opt,name=imperial_favor,json=imperialFavor,proto3" json:"imperial_favor,omitempty"`
-	Tags          []*TagReference        `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Latest time any member was online, unix seconds. Zero until first measured.
+	LastActivityAt int64 `protobuf:"varint,13,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	// When the settlement was flagged inactive, unix seconds. Zero while active.
+	InactiveSince int64 `protobuf:"varint,14,opt,name=inactive_since,json=inactiveSince,proto3" json:"inactive_since,omitempty"`
+	// Hidden settlements are left out of List.
+	Hidden bool `protobuf:"varint,15,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Exempt settlements are never flagged or decayed for inactivity.
+	ActivityExempt bool            `protobuf:"varint,16,opt,name=activity_exempt,json=activityExempt,proto3" json:"activity_exempt,omitempty"`
+	Tags           []*TagReference `protobuf:"bytes,20,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Settlement) Reset() {
@@ -508,6 +683,34 @@ func (x *Settlement) GetImperialFavor() int64 {
 	return 0
 }
 
+func (x *Settlement) GetLastActivityAt() int64 {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return 0
+}
+
+func (x *Settlement) GetInactiveSince() int64 {
+	if x != nil {
+		return x.InactiveSince
+	}
+	return 0
+}
+
+func (x *Settlement) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Settlement) GetActivityExempt() bool {
+	if x != nil {
+		return x.ActivityExempt
+	}
+	return false
+}
+
 func (x *Settlement) GetTags() []*TagReference {
 	if x != nil {
 		return x.Tags
@@ -828,8 +1031,13 @@ func (x *GetRequest) GetId() string {
 }
 
 type GetResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Settlement *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	// Number of members, the leader included.
+	MemberCount int32 `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Maximum number of members for the settlement's type plus talent bonuses;
+	// 0 means unlimited.
+	MemberCap     int32 `protobuf:"varint,3,opt,name=member_cap,json=memberCap,proto3" json:"member_cap,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -871,6 +1079,20 @@ func (x *GetResponse) GetSettlement() *Settlement {
 	return nil
 }
 
+func (x *GetResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *GetResponse) GetMemberCap() int32 {
+	if x != nil {
+		return x.MemberCap
+	}
+	return 0
+}
+
 type ListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2734,28 +2956,31 @@ func (x *TransferImperialFavorResponse) GetToSettlement() *Settlement {
 	return nil
 }
 
-type SubmitRequest_SubmitAttachment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+type Treasury struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// Balance in donate coins.
+	Balance int64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Members allowed to withdraw and spend, besides the leader.
+	TreasurerIds  []string `protobuf:"bytes,3,rep,name=treasurer_ids,json=treasurerIds,proto3" json:"treasurer_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitRequest_SubmitAttachment) Reset() {
-	*x = SubmitRequest_SubmitAttachment{}
+func (x *Treasury) Reset() {
+	*x = Treasury{}
 	mi := &file_settlement_v1_settlement_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitRequest_SubmitAttachment) String() string {
+func (x *Treasury) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitRequest_SubmitAttachment) ProtoMessage() {}
+func (*Treasury) ProtoMessage() {}
 
-func (x *SubmitRequest_SubmitAttachment) ProtoReflect() protoreflect.Message {
+func (x *Treasury) ProtoReflect() protoreflect.Message {
 	mi := &file_settlement_v1_settlement_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2767,47 +2992,65 @@ func (x *SubmitRequest_SubmitAttachment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitRequest_SubmitAttachment.ProtoReflect.Descriptor instead.
-func (*SubmitRequest_SubmitAttachment) Descriptor() ([]byte, []int) {
-	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use Treasury.ProtoReflect.Descriptor instead.
+func (*Treasury) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SubmitRequest_SubmitAttachment) GetUrl() string {
+func (x *Treasury) GetSettlementId() string {
 	if x != nil {
-		return x.Url
+		return x.SettlementId
 	}
 	return ""
 }
 
-func (x *SubmitRequest_SubmitAttachment) GetDescription() string {
+func (x *Treasury) GetBalance() int64 {
 	if x != nil {
-		return x.Description
+		return x.Balance
 	}
-	return ""
+	return 0
 }
 
-type UpdateSettlementRequest_UpdateAttachment struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+func (x *Treasury) GetTreasurerIds() []string {
+	if x != nil {
+		return x.TreasurerIds
+	}
+	return nil
+}
+
+type TreasuryEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SettlementId string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	// User who made the movement.
+	ActorId string             `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Kind    TreasuryEntry_Kind `protobuf:"varint,4,opt,name=kind,proto3,enum=settlement.v1.TreasuryEntry_Kind" json:"kind,omitempty"`
+	// Signed: positive for coins in, negative for coins out.
+	Amount       int64  `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	BalanceAfter int64  `protobuf:"varint,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Reason       string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Set for SPEND.
+	ItemId        string `protobuf:"bytes,8,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	PurchaseId    string `protobuf:"bytes,9,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSettlementRequest_UpdateAttachment) Reset() {
-	*x = UpdateSettlementRequest_UpdateAttachment{}
+func (x *TreasuryEntry) Reset() {
+	*x = TreasuryEntry{}
 	mi := &file_settlement_v1_settlement_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSettlementRequest_UpdateAttachment) String() string {
+func (x *TreasuryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSettlementRequest_UpdateAttachment) ProtoMessage() {}
+func (*TreasuryEntry) ProtoMessage() {}
 
-func (x *UpdateSettlementRequest_UpdateAttachment) ProtoReflect() protoreflect.Message {
+func (x *TreasuryEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_settlement_v1_settlement_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2819,6 +3062,2317 @@ func (x *UpdateSettlementRequest_UpdateAttachment) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use TreasuryEntry.ProtoReflect.Descriptor instead.
+func (*TreasuryEntry) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TreasuryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetKind() TreasuryEntry_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return TreasuryEntry_KIND_UNSPECIFIED
+}
+
+func (x *TreasuryEntry) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TreasuryEntry) GetBalanceAfter() int64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *TreasuryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+func (x *TreasuryEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetTreasuryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreasuryRequest) Reset() {
+	*x = GetTreasuryRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreasuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreasuryRequest) ProtoMessage() {}
+
+func (x *GetTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*GetTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTreasuryRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type GetTreasuryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTreasuryResponse) Reset() {
+	*x = GetTreasuryResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreasuryResponse) ProtoMessage() {}
+
+func (x *GetTreasuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*GetTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTreasuryResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+type DepositToTreasuryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositToTreasuryRequest) Reset() {
+	*x = DepositToTreasuryRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositToTreasuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositToTreasuryRequest) ProtoMessage() {}
+
+func (x *DepositToTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositToTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*DepositToTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DepositToTreasuryRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *DepositToTreasuryRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type DepositToTreasuryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositToTreasuryResponse) Reset() {
+	*x = DepositToTreasuryResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositToTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositToTreasuryResponse) ProtoMessage() {}
+
+func (x *DepositToTreasuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositToTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*DepositToTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DepositToTreasuryResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+type WithdrawFromTreasuryRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Amount       int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Member whose wallet receives the coins. Defaults to the caller.
+	RecipientId   string `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawFromTreasuryRequest) Reset() {
+	*x = WithdrawFromTreasuryRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawFromTreasuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawFromTreasuryRequest) ProtoMessage() {}
+
+func (x *WithdrawFromTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawFromTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFromTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WithdrawFromTreasuryRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *WithdrawFromTreasuryRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WithdrawFromTreasuryRequest) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+func (x *WithdrawFromTreasuryRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type WithdrawFromTreasuryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WithdrawFromTreasuryResponse) Reset() {
+	*x = WithdrawFromTreasuryResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WithdrawFromTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithdrawFromTreasuryResponse) ProtoMessage() {}
+
+func (x *WithdrawFromTreasuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithdrawFromTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawFromTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WithdrawFromTreasuryResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+type BuyShopItemFromTreasuryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyShopItemFromTreasuryRequest) Reset() {
+	*x = BuyShopItemFromTreasuryRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyShopItemFromTreasuryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyShopItemFromTreasuryRequest) ProtoMessage() {}
+
+func (x *BuyShopItemFromTreasuryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyShopItemFromTreasuryRequest.ProtoReflect.Descriptor instead.
+func (*BuyShopItemFromTreasuryRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BuyShopItemFromTreasuryRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *BuyShopItemFromTreasuryRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type BuyShopItemFromTreasuryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	PurchaseId    string                 `protobuf:"bytes,2,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuyShopItemFromTreasuryResponse) Reset() {
+	*x = BuyShopItemFromTreasuryResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuyShopItemFromTreasuryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyShopItemFromTreasuryResponse) ProtoMessage() {}
+
+func (x *BuyShopItemFromTreasuryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyShopItemFromTreasuryResponse.ProtoReflect.Descriptor instead.
+func (*BuyShopItemFromTreasuryResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *BuyShopItemFromTreasuryResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+func (x *BuyShopItemFromTreasuryResponse) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+type ListTreasuryLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTreasuryLedgerRequest) Reset() {
+	*x = ListTreasuryLedgerRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTreasuryLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTreasuryLedgerRequest) ProtoMessage() {}
+
+func (x *ListTreasuryLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTreasuryLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ListTreasuryLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListTreasuryLedgerRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ListTreasuryLedgerRequest) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type ListTreasuryLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TreasuryEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTreasuryLedgerResponse) Reset() {
+	*x = ListTreasuryLedgerResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTreasuryLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTreasuryLedgerResponse) ProtoMessage() {}
+
+func (x *ListTreasuryLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTreasuryLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ListTreasuryLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListTreasuryLedgerResponse) GetEntries() []*TreasuryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListTreasuryLedgerResponse) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type AssignTreasurerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTreasurerRequest) Reset() {
+	*x = AssignTreasurerRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTreasurerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTreasurerRequest) ProtoMessage() {}
+
+func (x *AssignTreasurerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTreasurerRequest.ProtoReflect.Descriptor instead.
+func (*AssignTreasurerRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AssignTreasurerRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *AssignTreasurerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignTreasurerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTreasurerResponse) Reset() {
+	*x = AssignTreasurerResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTreasurerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTreasurerResponse) ProtoMessage() {}
+
+func (x *AssignTreasurerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTreasurerResponse.ProtoReflect.Descriptor instead.
+func (*AssignTreasurerResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AssignTreasurerResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+type RevokeTreasurerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTreasurerRequest) Reset() {
+	*x = RevokeTreasurerRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTreasurerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTreasurerRequest) ProtoMessage() {}
+
+func (x *RevokeTreasurerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTreasurerRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTreasurerRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RevokeTreasurerRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *RevokeTreasurerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeTreasurerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Treasury      *Treasury              `protobuf:"bytes,1,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTreasurerResponse) Reset() {
+	*x = RevokeTreasurerResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTreasurerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTreasurerResponse) ProtoMessage() {}
+
+func (x *RevokeTreasurerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTreasurerResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTreasurerResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RevokeTreasurerResponse) GetTreasury() *Treasury {
+	if x != nil {
+		return x.Treasury
+	}
+	return nil
+}
+
+type SetActivityExemptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Exempt        bool                   `protobuf:"varint,2,opt,name=exempt,proto3" json:"exempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActivityExemptionRequest) Reset() {
+	*x = SetActivityExemptionRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActivityExemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActivityExemptionRequest) ProtoMessage() {}
+
+func (x *SetActivityExemptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActivityExemptionRequest.ProtoReflect.Descriptor instead.
+func (*SetActivityExemptionRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetActivityExemptionRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *SetActivityExemptionRequest) GetExempt() bool {
+	if x != nil {
+		return x.Exempt
+	}
+	return false
+}
+
+type SetActivityExemptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settlement    *Settlement            `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetActivityExemptionResponse) Reset() {
+	*x = SetActivityExemptionResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetActivityExemptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetActivityExemptionResponse) ProtoMessage() {}
+
+func (x *SetActivityExemptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetActivityExemptionResponse.ProtoReflect.Descriptor instead.
+func (*SetActivityExemptionResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetActivityExemptionResponse) GetSettlement() *Settlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+// Reference to a node of a progression talent tree.
+type TalentNodeRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TreeId        string                 `protobuf:"bytes,1,opt,name=tree_id,json=treeId,proto3" json:"tree_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TalentNodeRef) Reset() {
+	*x = TalentNodeRef{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TalentNodeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TalentNodeRef) ProtoMessage() {}
+
+func (x *TalentNodeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TalentNodeRef.ProtoReflect.Descriptor instead.
+func (*TalentNodeRef) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TalentNodeRef) GetTreeId() string {
+	if x != nil {
+		return x.TreeId
+	}
+	return ""
+}
+
+func (x *TalentNodeRef) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+// What a settlement must meet to level up to type. Zero fields are not required.
+type LevelUpRequirements struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  SettlementType         `protobuf:"varint,1,opt,name=type,proto3,enum=settlement.v1.SettlementType" json:"type,omitempty"`
+	// Counts the leader.
+	MinMembers       int32 `protobuf:"varint,2,opt,name=min_members,json=minMembers,proto3" json:"min_members,omitempty"`
+	MinImperialFavor int64 `protobuf:"varint,3,opt,name=min_imperial_favor,json=minImperialFavor,proto3" json:"min_imperial_favor,omitempty"`
+	// Days since the settlement was first approved.
+	MinAgeDays    int32            `protobuf:"varint,4,opt,name=min_age_days,json=minAgeDays,proto3" json:"min_age_days,omitempty"`
+	RequiredNodes []*TalentNodeRef `protobuf:"bytes,5,rep,name=required_nodes,json=requiredNodes,proto3" json:"required_nodes,omitempty"`
+	// Deducted from imperial favor when the level-up is approved.
+	FeeImperialFavor int64 `protobuf:"varint,6,opt,name=fee_imperial_favor,json=feeImperialFavor,proto3" json:"fee_imperial_favor,omitempty"`
+	// Taken from the settlement treasury when the level-up is approved.
+	FeeCoins      int64 `protobuf:"varint,7,opt,name=fee_coins,json=feeCoins,proto3" json:"fee_coins,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LevelUpRequirements) Reset() {
+	*x = LevelUpRequirements{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LevelUpRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LevelUpRequirements) ProtoMessage() {}
+
+func (x *LevelUpRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LevelUpRequirements.ProtoReflect.Descriptor instead.
+func (*LevelUpRequirements) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *LevelUpRequirements) GetType() SettlementType {
+	if x != nil {
+		return x.Type
+	}
+	return SettlementType_SETTLEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *LevelUpRequirements) GetMinMembers() int32 {
+	if x != nil {
+		return x.MinMembers
+	}
+	return 0
+}
+
+func (x *LevelUpRequirements) GetMinImperialFavor() int64 {
+	if x != nil {
+		return x.MinImperialFavor
+	}
+	return 0
+}
+
+func (x *LevelUpRequirements) GetMinAgeDays() int32 {
+	if x != nil {
+		return x.MinAgeDays
+	}
+	return 0
+}
+
+func (x *LevelUpRequirements) GetRequiredNodes() []*TalentNodeRef {
+	if x != nil {
+		return x.RequiredNodes
+	}
+	return nil
+}
+
+func (x *LevelUpRequirements) GetFeeImperialFavor() int64 {
+	if x != nil {
+		return x.FeeImperialFavor
+	}
+	return 0
+}
+
+func (x *LevelUpRequirements) GetFeeCoins() int64 {
+	if x != nil {
+		return x.FeeCoins
+	}
+	return 0
+}
+
+func (x *LevelUpRequirements) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Progress toward one level-up requirement.
+type RequirementProgress struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Kind  RequirementProgress_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=settlement.v1.RequirementProgress_Kind" json:"kind,omitempty"`
+	// Set for KIND_TALENT_NODE.
+	Node          *TalentNodeRef `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Required      int64          `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Current       int64          `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	Met           bool           `protobuf:"varint,5,opt,name=met,proto3" json:"met,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequirementProgress) Reset() {
+	*x = RequirementProgress{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequirementProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequirementProgress) ProtoMessage() {}
+
+func (x *RequirementProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequirementProgress.ProtoReflect.Descriptor instead.
+func (*RequirementProgress) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RequirementProgress) GetKind() RequirementProgress_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RequirementProgress_KIND_UNSPECIFIED
+}
+
+func (x *RequirementProgress) GetNode() *TalentNodeRef {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *RequirementProgress) GetRequired() int64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *RequirementProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *RequirementProgress) GetMet() bool {
+	if x != nil {
+		return x.Met
+	}
+	return false
+}
+
+type GetLevelUpRequirementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLevelUpRequirementsRequest) Reset() {
+	*x = GetLevelUpRequirementsRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLevelUpRequirementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLevelUpRequirementsRequest) ProtoMessage() {}
+
+func (x *GetLevelUpRequirementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLevelUpRequirementsRequest.ProtoReflect.Descriptor instead.
+func (*GetLevelUpRequirementsRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetLevelUpRequirementsRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+type GetLevelUpRequirementsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	CurrentType SettlementType         `protobuf:"varint,1,opt,name=current_type,json=currentType,proto3,enum=settlement.v1.SettlementType" json:"current_type,omitempty"`
+	// Unspecified when the settlement is already a province.
+	NextType     SettlementType         `protobuf:"varint,2,opt,name=next_type,json=nextType,proto3,enum=settlement.v1.SettlementType" json:"next_type,omitempty"`
+	Requirements []*RequirementProgress `protobuf:"bytes,3,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	// True when every requirement is met, or none are configured.
+	Ready         bool `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLevelUpRequirementsResponse) Reset() {
+	*x = GetLevelUpRequirementsResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLevelUpRequirementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLevelUpRequirementsResponse) ProtoMessage() {}
+
+func (x *GetLevelUpRequirementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLevelUpRequirementsResponse.ProtoReflect.Descriptor instead.
+func (*GetLevelUpRequirementsResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetLevelUpRequirementsResponse) GetCurrentType() SettlementType {
+	if x != nil {
+		return x.CurrentType
+	}
+	return SettlementType_SETTLEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *GetLevelUpRequirementsResponse) GetNextType() SettlementType {
+	if x != nil {
+		return x.NextType
+	}
+	return SettlementType_SETTLEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *GetLevelUpRequirementsResponse) GetRequirements() []*RequirementProgress {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *GetLevelUpRequirementsResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type SetLevelUpRequirementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requirements  *LevelUpRequirements   `protobuf:"bytes,1,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLevelUpRequirementsRequest) Reset() {
+	*x = SetLevelUpRequirementsRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLevelUpRequirementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLevelUpRequirementsRequest) ProtoMessage() {}
+
+func (x *SetLevelUpRequirementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLevelUpRequirementsRequest.ProtoReflect.Descriptor instead.
+func (*SetLevelUpRequirementsRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetLevelUpRequirementsRequest) GetRequirements() *LevelUpRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+type SetLevelUpRequirementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requirements  *LevelUpRequirements   `protobuf:"bytes,1,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLevelUpRequirementsResponse) Reset() {
+	*x = SetLevelUpRequirementsResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLevelUpRequirementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLevelUpRequirementsResponse) ProtoMessage() {}
+
+func (x *SetLevelUpRequirementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLevelUpRequirementsResponse.ProtoReflect.Descriptor instead.
+func (*SetLevelUpRequirementsResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetLevelUpRequirementsResponse) GetRequirements() *LevelUpRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+type ListLevelUpRequirementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLevelUpRequirementsRequest) Reset() {
+	*x = ListLevelUpRequirementsRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLevelUpRequirementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLevelUpRequirementsRequest) ProtoMessage() {}
+
+func (x *ListLevelUpRequirementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLevelUpRequirementsRequest.ProtoReflect.Descriptor instead.
+func (*ListLevelUpRequirementsRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{77}
+}
+
+type ListLevelUpRequirementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requirements  []*LevelUpRequirements `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLevelUpRequirementsResponse) Reset() {
+	*x = ListLevelUpRequirementsResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLevelUpRequirementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLevelUpRequirementsResponse) ProtoMessage() {}
+
+func (x *ListLevelUpRequirementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLevelUpRequirementsResponse.ProtoReflect.Descriptor instead.
+func (*ListLevelUpRequirementsResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListLevelUpRequirementsResponse) GetRequirements() []*LevelUpRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+// A post on a settlement's bulletin board.
+type BoardPost struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SettlementId string                 `protobuf:"bytes,2,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	AuthorId     string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Title        string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body         string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments  []*Attachment          `protobuf:"bytes,6,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Visibility   BoardPost_Visibility   `protobuf:"varint,7,opt,name=visibility,proto3,enum=settlement.v1.BoardPost_Visibility" json:"visibility,omitempty"`
+	// Announcements notified every member when published.
+	Announcement  bool  `protobuf:"varint,8,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	Pinned        bool  `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	CreatedAt     int64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64 `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BoardPost) Reset() {
+	*x = BoardPost{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BoardPost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardPost) ProtoMessage() {}
+
+func (x *BoardPost) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardPost.ProtoReflect.Descriptor instead.
+func (*BoardPost) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BoardPost) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BoardPost) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *BoardPost) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *BoardPost) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BoardPost) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *BoardPost) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *BoardPost) GetVisibility() BoardPost_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return BoardPost_VISIBILITY_UNSPECIFIED
+}
+
+func (x *BoardPost) GetAnnouncement() bool {
+	if x != nil {
+		return x.Announcement
+	}
+	return false
+}
+
+func (x *BoardPost) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *BoardPost) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *BoardPost) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateBoardPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Attachments   []*Attachment          `protobuf:"bytes,4,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	Visibility    BoardPost_Visibility   `protobuf:"varint,5,opt,name=visibility,proto3,enum=settlement.v1.BoardPost_Visibility" json:"visibility,omitempty"`
+	Announcement  bool                   `protobuf:"varint,6,opt,name=announcement,proto3" json:"announcement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardPostRequest) Reset() {
+	*x = CreateBoardPostRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardPostRequest) ProtoMessage() {}
+
+func (x *CreateBoardPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardPostRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardPostRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateBoardPostRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *CreateBoardPostRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBoardPostRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateBoardPostRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *CreateBoardPostRequest) GetVisibility() BoardPost_Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return BoardPost_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateBoardPostRequest) GetAnnouncement() bool {
+	if x != nil {
+		return x.Announcement
+	}
+	return false
+}
+
+type CreateBoardPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *BoardPost             `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBoardPostResponse) Reset() {
+	*x = CreateBoardPostResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBoardPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoardPostResponse) ProtoMessage() {}
+
+func (x *CreateBoardPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoardPostResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardPostResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateBoardPostResponse) GetPost() *BoardPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type ListBoardPostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	NextToken     string                 `protobuf:"bytes,2,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardPostsRequest) Reset() {
+	*x = ListBoardPostsRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardPostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardPostsRequest) ProtoMessage() {}
+
+func (x *ListBoardPostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListBoardPostsRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListBoardPostsRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *ListBoardPostsRequest) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type ListBoardPostsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Set on the first page only.
+	Pinned        []*BoardPost `protobuf:"bytes,1,rep,name=pinned,proto3" json:"pinned,omitempty"`
+	Posts         []*BoardPost `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts,omitempty"`
+	NextToken     string       `protobuf:"bytes,3,opt,name=next_token,json=nextToken,proto3" json:"next_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBoardPostsResponse) Reset() {
+	*x = ListBoardPostsResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBoardPostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBoardPostsResponse) ProtoMessage() {}
+
+func (x *ListBoardPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBoardPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListBoardPostsResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListBoardPostsResponse) GetPinned() []*BoardPost {
+	if x != nil {
+		return x.Pinned
+	}
+	return nil
+}
+
+func (x *ListBoardPostsResponse) GetPosts() []*BoardPost {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *ListBoardPostsResponse) GetNextToken() string {
+	if x != nil {
+		return x.NextToken
+	}
+	return ""
+}
+
+type PinBoardPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,3,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinBoardPostRequest) Reset() {
+	*x = PinBoardPostRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBoardPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBoardPostRequest) ProtoMessage() {}
+
+func (x *PinBoardPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBoardPostRequest.ProtoReflect.Descriptor instead.
+func (*PinBoardPostRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PinBoardPostRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *PinBoardPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *PinBoardPostRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type PinBoardPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *BoardPost             `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinBoardPostResponse) Reset() {
+	*x = PinBoardPostResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinBoardPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinBoardPostResponse) ProtoMessage() {}
+
+func (x *PinBoardPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinBoardPostResponse.ProtoReflect.Descriptor instead.
+func (*PinBoardPostResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PinBoardPostResponse) GetPost() *BoardPost {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type DeleteBoardPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardPostRequest) Reset() {
+	*x = DeleteBoardPostRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardPostRequest) ProtoMessage() {}
+
+func (x *DeleteBoardPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardPostRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBoardPostRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteBoardPostRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *DeleteBoardPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type DeleteBoardPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBoardPostResponse) Reset() {
+	*x = DeleteBoardPostResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBoardPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardPostResponse) ProtoMessage() {}
+
+func (x *DeleteBoardPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardPostResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBoardPostResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{87}
+}
+
+type TakeDownBoardPostRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeDownBoardPostRequest) Reset() {
+	*x = TakeDownBoardPostRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownBoardPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownBoardPostRequest) ProtoMessage() {}
+
+func (x *TakeDownBoardPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownBoardPostRequest.ProtoReflect.Descriptor instead.
+func (*TakeDownBoardPostRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TakeDownBoardPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *TakeDownBoardPostRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TakeDownBoardPostResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeDownBoardPostResponse) Reset() {
+	*x = TakeDownBoardPostResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeDownBoardPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeDownBoardPostResponse) ProtoMessage() {}
+
+func (x *TakeDownBoardPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeDownBoardPostResponse.ProtoReflect.Descriptor instead.
+func (*TakeDownBoardPostResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{89}
+}
+
+type AssignHeraldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignHeraldRequest) Reset() {
+	*x = AssignHeraldRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignHeraldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignHeraldRequest) ProtoMessage() {}
+
+func (x *AssignHeraldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignHeraldRequest.ProtoReflect.Descriptor instead.
+func (*AssignHeraldRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AssignHeraldRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *AssignHeraldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignHeraldResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Members allowed to post, besides the leader.
+	HeraldIds     []string `protobuf:"bytes,1,rep,name=herald_ids,json=heraldIds,proto3" json:"herald_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignHeraldResponse) Reset() {
+	*x = AssignHeraldResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignHeraldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignHeraldResponse) ProtoMessage() {}
+
+func (x *AssignHeraldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignHeraldResponse.ProtoReflect.Descriptor instead.
+func (*AssignHeraldResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AssignHeraldResponse) GetHeraldIds() []string {
+	if x != nil {
+		return x.HeraldIds
+	}
+	return nil
+}
+
+type RevokeHeraldRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SettlementId  string                 `protobuf:"bytes,1,opt,name=settlement_id,json=settlementId,proto3" json:"settlement_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeHeraldRequest) Reset() {
+	*x = RevokeHeraldRequest{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeHeraldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeHeraldRequest) ProtoMessage() {}
+
+func (x *RevokeHeraldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeHeraldRequest.ProtoReflect.Descriptor instead.
+func (*RevokeHeraldRequest) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RevokeHeraldRequest) GetSettlementId() string {
+	if x != nil {
+		return x.SettlementId
+	}
+	return ""
+}
+
+func (x *RevokeHeraldRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RevokeHeraldResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HeraldIds     []string               `protobuf:"bytes,1,rep,name=herald_ids,json=heraldIds,proto3" json:"herald_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeHeraldResponse) Reset() {
+	*x = RevokeHeraldResponse{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeHeraldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeHeraldResponse) ProtoMessage() {}
+
+func (x *RevokeHeraldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeHeraldResponse.ProtoReflect.Descriptor instead.
+func (*RevokeHeraldResponse) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RevokeHeraldResponse) GetHeraldIds() []string {
+	if x != nil {
+		return x.HeraldIds
+	}
+	return nil
+}
+
+type SubmitRequest_SubmitAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitRequest_SubmitAttachment) Reset() {
+	*x = SubmitRequest_SubmitAttachment{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRequest_SubmitAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRequest_SubmitAttachment) ProtoMessage() {}
+
+func (x *SubmitRequest_SubmitAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRequest_SubmitAttachment.ProtoReflect.Descriptor instead.
+func (*SubmitRequest_SubmitAttachment) Descriptor() ([]byte, []int) {
+	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *SubmitRequest_SubmitAttachment) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SubmitRequest_SubmitAttachment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateSettlementRequest_UpdateAttachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettlementRequest_UpdateAttachment) Reset() {
+	*x = UpdateSettlementRequest_UpdateAttachment{}
+	mi := &file_settlement_v1_settlement_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettlementRequest_UpdateAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettlementRequest_UpdateAttachment) ProtoMessage() {}
+
+func (x *UpdateSettlementRequest_UpdateAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_settlement_v1_settlement_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use UpdateSettlementRequest_UpdateAttachment.ProtoReflect.Descriptor instead.
 func (*UpdateSettlementRequest_UpdateAttachment) Descriptor() ([]byte, []int) {
 	return file_settlement_v1_settlement_proto_rawDescGZIP(), []int{41, 0}
@@ -2840,572 +5394,428 @@ func (x *UpdateSettlementRequest_UpdateAttachment) GetDescription() string {
 
 var File_settlement_v1_settlement_proto protoreflect.FileDescriptor
 
-var file_settlement_v1_settlement_proto_rawDesc = string([]byte{
-	0x0a, 0x1e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
-	0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x0a, 0x17, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x28, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x04, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
-	0x09, 0x64, 0x69, 0x70, 0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x64, 0x69, 0x70, 0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79, 0x12, 0x2d, 0x0a, 0x06, 0x6c,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x61,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72,
-	0x64, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x32, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x76,
-	0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
-	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x26, 0x0a,
-	0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbd, 0x03, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x70, 0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x64, 0x69, 0x70,
-	0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x32, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b, 0x63, 0x6f, 0x6f, 0x72, 0x64, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0b,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x50, 0x0a, 0x10, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x43,
-	0x41, 0x4d, 0x50, 0x10, 0x01, 0x22, 0x25, 0x0a, 0x07, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x32,
-	0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c,
-	0x0a, 0x01, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0x3c, 0x0a, 0x0a,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x64, 0x65,
-	0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x64,
-	0x65, 0x73, 0x63, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x75,
-	0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
-	0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x0d, 0x0a, 0x0b, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x13, 0x4c,
-	0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
-	0x25, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x0d, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2e, 0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0f,
-	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x10, 0x0a, 0x0e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x22, 0x55, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0b,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x0a, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0d, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x50, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x39, 0x0a,
-	0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x19, 0x41, 0x64, 0x64,
-	0x54, 0x61, 0x67, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74,
-	0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67,
-	0x49, 0x64, 0x22, 0x57, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x54, 0x6f, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x1e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
-	0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x67, 0x49, 0x64, 0x22, 0x5c, 0x0a, 0x1f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x56, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x09,
-	0x64, 0x69, 0x70, 0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x64, 0x69, 0x70, 0x6c, 0x6f, 0x6d, 0x61, 0x63, 0x79, 0x22,
-	0x5a, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xa0, 0x02, 0x0a, 0x17,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x0b,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52,
-	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x50, 0x0a, 0x10,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41,
-	0x02, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7d, 0x0a, 0x17, 0x41, 0x64, 0x64,
-	0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x49,
-	0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x80, 0x01, 0x0a, 0x1a, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
-	0x0a, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x1b, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xac, 0x01, 0x0a,
-	0x1c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76,
-	0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
-	0x0d, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x01,
-	0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x01, 0x52, 0x07, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x01, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x22, 0x73, 0x0a, 0x1d, 0x4c,
-	0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72,
-	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04,
-	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72,
-	0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x9d, 0x01, 0x0a, 0x1c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6d, 0x70,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x31, 0x0a, 0x12, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x10, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
-	0xe0, 0x41, 0x02, 0x52, 0x0e, 0x74, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0xa3, 0x01, 0x0a, 0x1d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6d, 0x70,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x53, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0x6e, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x45, 0x54, 0x54,
-	0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x41, 0x4d,
-	0x50, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x56, 0x49, 0x4c, 0x4c, 0x41, 0x47, 0x45, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x54, 0x4f, 0x57, 0x4e, 0x53, 0x48, 0x49, 0x50, 0x10, 0x03, 0x12, 0x08,
-	0x0a, 0x04, 0x43, 0x49, 0x54, 0x59, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x56,
-	0x49, 0x4e, 0x43, 0x45, 0x10, 0x05, 0x32, 0x83, 0x1b, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x61, 0x0a, 0x06,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x5a, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7d, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x04, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x7b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x7e, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x12, 0x7a, 0x0a, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1c, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d,
-	0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0xa6, 0x01,
-	0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x35, 0x12, 0x33, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x2a, 0x31, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x9e, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f,
-	0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22,
-	0x32, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x12, 0x9f, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x34, 0x22, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72,
-	0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x8f, 0x01, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x47, 0x3a, 0x01, 0x2a, 0x22, 0x42, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x84, 0x01, 0x0a,
-	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x32, 0x14, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
-	0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
-	0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
-	0xa8, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
-	0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3d, 0x3a, 0x01, 0x2a,
-	0x22, 0x38, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x2d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x3a, 0x61, 0x64, 0x64, 0x12, 0xb4, 0x01, 0x0a, 0x13, 0x44,
-	0x65, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76,
-	0x6f, 0x72, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
-	0x64, 0x75, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x40, 0x3a, 0x01, 0x2a, 0x22, 0x3b, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f,
-	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x2d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x3a, 0x64, 0x65, 0x64, 0x75, 0x63,
-	0x74, 0x12, 0xb5, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x6f, 0x67,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70,
-	0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x39,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d, 0x66,
-	0x61, 0x76, 0x6f, 0x72, 0x2f, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0xbb, 0x01, 0x0a, 0x15, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x46, 0x61,
-	0x76, 0x6f, 0x72, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x65,
-	0x72, 0x69, 0x61, 0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a, 0x01, 0x2a, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x66, 0x72, 0x6f, 0x6d,
-	0x5f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x2d, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x3a, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x54,
-	0x61, 0x67, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28,
-	0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x64, 0x64, 0x54, 0x61, 0x67, 0x54, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x54,
-	0x6f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
-	0x74, 0x61, 0x67, 0x73, 0x12, 0xaf, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x61, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x2d, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31,
-	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x74,
-	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x61, 0x67, 0x73, 0x2f, 0x7b, 0x74,
-	0x61, 0x67, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x14, 0xca, 0x41, 0x11, 0x61, 0x70, 0x69, 0x2e, 0x6c,
-	0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2e, 0x72, 0x75, 0x42, 0xb9, 0x01, 0x0a,
-	0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x76, 0x31, 0x42, 0x0f, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x68, 0x65, 0x61, 0x72, 0x74, 0x68, 0x2f, 0x76, 0x73, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x6c,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x53, 0x58, 0x58, 0xaa, 0x02, 0x0d, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x53, 0x65,
-	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+const file_settlement_v1_settlement_proto_rawDesc = "" +
+	"\n" +
+	"\x1esettlement/v1/settlement.proto\x12\rsettlement.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x17settlement/v1/tag.proto\"9\n" +
+	"\x19GetUserInvitationsRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"Y\n" +
+	"\x1aGetUserInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.settlement.v1.InvitationR\vinvitations\"C\n" +
+	"\x17AcceptInvitationRequest\x12(\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\x03\xe0A\x02R\finvitationId\"\x1a\n" +
+	"\x18AcceptInvitationResponse\"C\n" +
+	"\x17RejectInvitationRequest\x12(\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\x03\xe0A\x02R\finvitationId\"\x1a\n" +
+	"\x18RejectInvitationResponse\"\xa7\x05\n" +
+	"\n" +
+	"Settlement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1d.settlement.v1.SettlementTypeR\x04type\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tdiplomacy\x18\x05 \x01(\tR\tdiplomacy\x12-\n" +
+	"\x06leader\x18\x06 \x01(\v2\x15.settlement.v1.MemberR\x06leader\x12/\n" +
+	"\amembers\x18\a \x03(\v2\x15.settlement.v1.MemberR\amembers\x12;\n" +
+	"\vattachments\x18\b \x03(\v2\x19.settlement.v1.AttachmentR\vattachments\x128\n" +
+	"\vcoordinates\x18\t \x01(\v2\x16.settlement.v1.Vector2R\vcoordinates\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x0eimperial_favor\x18\f \x01(\x03R\rimperialFavor\x12(\n" +
+	"\x10last_activity_at\x18\r \x01(\x03R\x0elastActivityAt\x12%\n" +
+	"\x0einactive_since\x18\x0e \x01(\x03R\rinactiveSince\x12\x16\n" +
+	"\x06hidden\x18\x0f \x01(\bR\x06hidden\x12'\n" +
+	"\x0factivity_exempt\x18\x10 \x01(\bR\x0eactivityExempt\x124\n" +
+	"\x04tags\x18\x14 \x03(\v2\x1b.settlement.v1.TagReferenceB\x03\xe0A\x01R\x04tags\"&\n" +
+	"\x06Member\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"\xbd\x03\n" +
+	"\rSubmitRequest\x12:\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.settlement.v1.SubmitRequest.TypeB\x03\xe0A\x02R\x04type\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x02R\vdescription\x12!\n" +
+	"\tdiplomacy\x18\x04 \x01(\tB\x03\xe0A\x02R\tdiplomacy\x12=\n" +
+	"\vcoordinates\x18\x05 \x01(\v2\x16.settlement.v1.Vector2B\x03\xe0A\x02R\vcoordinates\x12T\n" +
+	"\vattachments\x18\x06 \x03(\v2-.settlement.v1.SubmitRequest.SubmitAttachmentB\x03\xe0A\x02R\vattachments\x1aP\n" +
+	"\x10SubmitAttachment\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x02R\vdescription\"&\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04CAMP\x10\x01\"%\n" +
+	"\aVector2\x12\f\n" +
+	"\x01x\x18\x01 \x01(\x05R\x01x\x12\f\n" +
+	"\x01y\x18\x02 \x01(\x05R\x01y\"<\n" +
+	"\n" +
+	"Attachment\x12\x17\n" +
+	"\x04desc\x18\x01 \x01(\tB\x03\xe0A\x02R\x04desc\x12\x15\n" +
+	"\x03url\x18\x02 \x01(\tB\x03\xe0A\x02R\x03url\"\x10\n" +
+	"\x0eSubmitResponse\"!\n" +
+	"\n" +
+	"GetRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x8a\x01\n" +
+	"\vGetResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\vmemberCount\x12\x1d\n" +
+	"\n" +
+	"member_cap\x18\x03 \x01(\x05R\tmemberCap\"\r\n" +
+	"\vListRequest\"K\n" +
+	"\fListResponse\x12;\n" +
+	"\vsettlements\x18\x01 \x03(\v2\x19.settlement.v1.SettlementR\vsettlements\"\x14\n" +
+	"\x12ListPendingRequest\"R\n" +
+	"\x13ListPendingResponse\x12;\n" +
+	"\vsettlements\x18\x01 \x03(\v2\x19.settlement.v1.SettlementR\vsettlements\"%\n" +
+	"\x0eApproveRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\"\x11\n" +
+	"\x0fApproveResponse\"T\n" +
+	"\rRejectRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12.\n" +
+	"\x10rejection_reason\x18\x02 \x01(\tB\x03\xe0A\x02R\x0frejectionReason\"\x10\n" +
+	"\x0eRejectResponse\"]\n" +
+	"\x13RemoveMemberRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"\x16\n" +
+	"\x14RemoveMemberResponse\"]\n" +
+	"\x13InviteMemberRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"\x16\n" +
+	"\x14InviteMemberResponse\"A\n" +
+	"\x15GetInvitationsRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\"U\n" +
+	"\x16GetInvitationsResponse\x12;\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x19.settlement.v1.InvitationR\vinvitations\"Z\n" +
+	"\n" +
+	"Invitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\rsettlement_id\x18\x03 \x01(\tR\fsettlementId\"m\n" +
+	"\x17RevokeInvitationRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12(\n" +
+	"\rinvitation_id\x18\x02 \x01(\tB\x03\xe0A\x02R\finvitationId\"A\n" +
+	"\x18RevokeInvitationResponse\x12%\n" +
+	"\x0einvitation_ids\x18\x01 \x03(\tR\rinvitationIds\"2\n" +
+	"\x12GetByUserIdRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"P\n" +
+	"\x13GetByUserIdResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"9\n" +
+	"\x19VerificationStatusRequest\x12\x1c\n" +
+	"\auser_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06userId\"_\n" +
+	"\x1aVerificationStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12)\n" +
+	"\x10rejection_reason\x18\x02 \x01(\tR\x0frejectionReason\"W\n" +
+	"\x19AddTagToSettlementRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\"W\n" +
+	"\x1aAddTagToSettlementResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"\\\n" +
+	"\x1eRemoveTagFromSettlementRequest\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x15\n" +
+	"\x06tag_id\x18\x02 \x01(\tR\x05tagId\"\\\n" +
+	"\x1fRemoveTagFromSettlementResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"V\n" +
+	"\x1cAdminUpdateSettlementRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12!\n" +
+	"\tdiplomacy\x18\x02 \x01(\tB\x03\xe0A\x02R\tdiplomacy\"Z\n" +
+	"\x1dAdminUpdateSettlementResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"\xa0\x02\n" +
+	"\x17UpdateSettlementRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tB\x03\xe0A\x02R\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tB\x03\xe0A\x02R\vdescription\x12^\n" +
+	"\vattachments\x18\x04 \x03(\v27.settlement.v1.UpdateSettlementRequest.UpdateAttachmentB\x03\xe0A\x02R\vattachments\x1aP\n" +
+	"\x10UpdateAttachment\x12\x15\n" +
+	"\x03url\x18\x01 \x01(\tB\x03\xe0A\x02R\x03url\x12%\n" +
+	"\vdescription\x18\x02 \x01(\tB\x03\xe0A\x02R\vdescription\"U\n" +
+	"\x18UpdateSettlementResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"\xb1\x01\n" +
+	"\x10ImperialFavorLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\tR\aadminId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"}\n" +
+	"\x17AddImperialFavorRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06amount\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tB\x03\xe0A\x01R\x06reason\"U\n" +
+	"\x18AddImperialFavorResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"\x80\x01\n" +
+	"\x1aDeductImperialFavorRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06amount\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tB\x03\xe0A\x01R\x06reason\"X\n" +
+	"\x1bDeductImperialFavorResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"\xac\x01\n" +
+	"\x1cListImperialFavorLogsRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\"\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tnextToken\x12\x1e\n" +
+	"\badmin_id\x18\x03 \x01(\tB\x03\xe0A\x01R\aadminId\x12\x1e\n" +
+	"\border_by\x18\x04 \x01(\tB\x03\xe0A\x01R\aorderBy\"s\n" +
+	"\x1dListImperialFavorLogsResponse\x123\n" +
+	"\x04logs\x18\x01 \x03(\v2\x1f.settlement.v1.ImperialFavorLogR\x04logs\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"\x9d\x01\n" +
+	"\x1cTransferImperialFavorRequest\x121\n" +
+	"\x12from_settlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x10fromSettlementId\x12-\n" +
+	"\x10to_settlement_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x0etoSettlementId\x12\x1b\n" +
+	"\x06amount\x18\x03 \x01(\x03B\x03\xe0A\x02R\x06amount\"\xa3\x01\n" +
+	"\x1dTransferImperialFavorResponse\x12B\n" +
+	"\x0ffrom_settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\x0efromSettlement\x12>\n" +
+	"\rto_settlement\x18\x02 \x01(\v2\x19.settlement.v1.SettlementR\ftoSettlement\"n\n" +
+	"\bTreasury\x12#\n" +
+	"\rsettlement_id\x18\x01 \x01(\tR\fsettlementId\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x03R\abalance\x12#\n" +
+	"\rtreasurer_ids\x18\x03 \x03(\tR\ftreasurerIds\"\x8a\x03\n" +
+	"\rTreasuryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\x125\n" +
+	"\x04kind\x18\x04 \x01(\x0e2!.settlement.v1.TreasuryEntry.KindR\x04kind\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x03R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\x03R\fbalanceAfter\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x17\n" +
+	"\aitem_id\x18\b \x01(\tR\x06itemId\x12\x1f\n" +
+	"\vpurchase_id\x18\t \x01(\tR\n" +
+	"purchaseId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\"D\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aDEPOSIT\x10\x01\x12\x0e\n" +
+	"\n" +
+	"WITHDRAWAL\x10\x02\x12\t\n" +
+	"\x05SPEND\x10\x03\">\n" +
+	"\x12GetTreasuryRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\"J\n" +
+	"\x13GetTreasuryResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\"a\n" +
+	"\x18DepositToTreasuryRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06amount\"P\n" +
+	"\x19DepositToTreasuryResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\"\xa9\x01\n" +
+	"\x1bWithdrawFromTreasuryRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x03B\x03\xe0A\x02R\x06amount\x12&\n" +
+	"\frecipient_id\x18\x03 \x01(\tB\x03\xe0A\x01R\vrecipientId\x12\x1b\n" +
+	"\x06reason\x18\x04 \x01(\tB\x03\xe0A\x01R\x06reason\"S\n" +
+	"\x1cWithdrawFromTreasuryResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\"h\n" +
+	"\x1eBuyShopItemFromTreasuryRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\aitem_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06itemId\"w\n" +
+	"\x1fBuyShopItemFromTreasuryResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\x12\x1f\n" +
+	"\vpurchase_id\x18\x02 \x01(\tR\n" +
+	"purchaseId\"i\n" +
+	"\x19ListTreasuryLedgerRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\"\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tnextToken\"s\n" +
+	"\x1aListTreasuryLedgerResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.settlement.v1.TreasuryEntryR\aentries\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tR\tnextToken\"`\n" +
+	"\x16AssignTreasurerRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"N\n" +
+	"\x17AssignTreasurerResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\"`\n" +
+	"\x16RevokeTreasurerRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"N\n" +
+	"\x17RevokeTreasurerResponse\x123\n" +
+	"\btreasury\x18\x01 \x01(\v2\x17.settlement.v1.TreasuryR\btreasury\"d\n" +
+	"\x1bSetActivityExemptionRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1b\n" +
+	"\x06exempt\x18\x02 \x01(\bB\x03\xe0A\x02R\x06exempt\"Y\n" +
+	"\x1cSetActivityExemptionResponse\x129\n" +
+	"\n" +
+	"settlement\x18\x01 \x01(\v2\x19.settlement.v1.SettlementR\n" +
+	"settlement\"K\n" +
+	"\rTalentNodeRef\x12\x1c\n" +
+	"\atree_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06treeId\x12\x1c\n" +
+	"\anode_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06nodeId\"\xf2\x02\n" +
+	"\x13LevelUpRequirements\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.settlement.v1.SettlementTypeB\x03\xe0A\x02R\x04type\x12\x1f\n" +
+	"\vmin_members\x18\x02 \x01(\x05R\n" +
+	"minMembers\x12,\n" +
+	"\x12min_imperial_favor\x18\x03 \x01(\x03R\x10minImperialFavor\x12 \n" +
+	"\fmin_age_days\x18\x04 \x01(\x05R\n" +
+	"minAgeDays\x12C\n" +
+	"\x0erequired_nodes\x18\x05 \x03(\v2\x1c.settlement.v1.TalentNodeRefR\rrequiredNodes\x12,\n" +
+	"\x12fee_imperial_favor\x18\x06 \x01(\x03R\x10feeImperialFavor\x12\x1b\n" +
+	"\tfee_coins\x18\a \x01(\x03R\bfeeCoins\x12\"\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x03B\x03\xe0A\x03R\tupdatedAt\"\xf0\x02\n" +
+	"\x13RequirementProgress\x12;\n" +
+	"\x04kind\x18\x01 \x01(\x0e2'.settlement.v1.RequirementProgress.KindR\x04kind\x120\n" +
+	"\x04node\x18\x02 \x01(\v2\x1c.settlement.v1.TalentNodeRefR\x04node\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\x03R\brequired\x12\x18\n" +
+	"\acurrent\x18\x04 \x01(\x03R\acurrent\x12\x10\n" +
+	"\x03met\x18\x05 \x01(\bR\x03met\"\xa1\x01\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\x10\n" +
+	"\fKIND_MEMBERS\x10\x01\x12\x17\n" +
+	"\x13KIND_IMPERIAL_FAVOR\x10\x02\x12\x11\n" +
+	"\rKIND_AGE_DAYS\x10\x03\x12\x14\n" +
+	"\x10KIND_TALENT_NODE\x10\x04\x12\x1b\n" +
+	"\x17KIND_FEE_IMPERIAL_FAVOR\x10\x05\x12\x12\n" +
+	"\x0eKIND_FEE_COINS\x10\x06\"I\n" +
+	"\x1dGetLevelUpRequirementsRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\"\xfc\x01\n" +
+	"\x1eGetLevelUpRequirementsResponse\x12@\n" +
+	"\fcurrent_type\x18\x01 \x01(\x0e2\x1d.settlement.v1.SettlementTypeR\vcurrentType\x12:\n" +
+	"\tnext_type\x18\x02 \x01(\x0e2\x1d.settlement.v1.SettlementTypeR\bnextType\x12F\n" +
+	"\frequirements\x18\x03 \x03(\v2\".settlement.v1.RequirementProgressR\frequirements\x12\x14\n" +
+	"\x05ready\x18\x04 \x01(\bR\x05ready\"l\n" +
+	"\x1dSetLevelUpRequirementsRequest\x12K\n" +
+	"\frequirements\x18\x01 \x01(\v2\".settlement.v1.LevelUpRequirementsB\x03\xe0A\x02R\frequirements\"h\n" +
+	"\x1eSetLevelUpRequirementsResponse\x12F\n" +
+	"\frequirements\x18\x01 \x01(\v2\".settlement.v1.LevelUpRequirementsR\frequirements\" \n" +
+	"\x1eListLevelUpRequirementsRequest\"i\n" +
+	"\x1fListLevelUpRequirementsResponse\x12F\n" +
+	"\frequirements\x18\x01 \x03(\v2\".settlement.v1.LevelUpRequirementsR\frequirements\"\xc6\x03\n" +
+	"\tBoardPost\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsettlement_id\x18\x02 \x01(\tR\fsettlementId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12;\n" +
+	"\vattachments\x18\x06 \x03(\v2\x19.settlement.v1.AttachmentR\vattachments\x12C\n" +
+	"\n" +
+	"visibility\x18\a \x01(\x0e2#.settlement.v1.BoardPost.VisibilityR\n" +
+	"visibility\x12\"\n" +
+	"\fannouncement\x18\b \x01(\bR\fannouncement\x12\x16\n" +
+	"\x06pinned\x18\t \x01(\bR\x06pinned\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\"A\n" +
+	"\n" +
+	"Visibility\x12\x1a\n" +
+	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aMEMBERS\x10\x01\x12\n" +
+	"\n" +
+	"\x06PUBLIC\x10\x02\"\xab\x02\n" +
+	"\x16CreateBoardPostRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tB\x03\xe0A\x02R\x05title\x12\x17\n" +
+	"\x04body\x18\x03 \x01(\tB\x03\xe0A\x01R\x04body\x12@\n" +
+	"\vattachments\x18\x04 \x03(\v2\x19.settlement.v1.AttachmentB\x03\xe0A\x01R\vattachments\x12H\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2#.settlement.v1.BoardPost.VisibilityB\x03\xe0A\x02R\n" +
+	"visibility\x12'\n" +
+	"\fannouncement\x18\x06 \x01(\bB\x03\xe0A\x01R\fannouncement\"G\n" +
+	"\x17CreateBoardPostResponse\x12,\n" +
+	"\x04post\x18\x01 \x01(\v2\x18.settlement.v1.BoardPostR\x04post\"e\n" +
+	"\x15ListBoardPostsRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\"\n" +
+	"\n" +
+	"next_token\x18\x02 \x01(\tB\x03\xe0A\x01R\tnextToken\"\x99\x01\n" +
+	"\x16ListBoardPostsResponse\x120\n" +
+	"\x06pinned\x18\x01 \x03(\v2\x18.settlement.v1.BoardPostR\x06pinned\x12.\n" +
+	"\x05posts\x18\x02 \x03(\v2\x18.settlement.v1.BoardPostR\x05posts\x12\x1d\n" +
+	"\n" +
+	"next_token\x18\x03 \x01(\tR\tnextToken\"u\n" +
+	"\x13PinBoardPostRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\apost_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06postId\x12\x16\n" +
+	"\x06pinned\x18\x03 \x01(\bR\x06pinned\"D\n" +
+	"\x14PinBoardPostResponse\x12,\n" +
+	"\x04post\x18\x01 \x01(\v2\x18.settlement.v1.BoardPostR\x04post\"`\n" +
+	"\x16DeleteBoardPostRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\apost_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06postId\"\x19\n" +
+	"\x17DeleteBoardPostResponse\"U\n" +
+	"\x18TakeDownBoardPostRequest\x12\x1c\n" +
+	"\apost_id\x18\x01 \x01(\tB\x03\xe0A\x02R\x06postId\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tB\x03\xe0A\x02R\x06reason\"\x1b\n" +
+	"\x19TakeDownBoardPostResponse\"]\n" +
+	"\x13AssignHeraldRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"5\n" +
+	"\x14AssignHeraldResponse\x12\x1d\n" +
+	"\n" +
+	"herald_ids\x18\x01 \x03(\tR\theraldIds\"]\n" +
+	"\x13RevokeHeraldRequest\x12(\n" +
+	"\rsettlement_id\x18\x01 \x01(\tB\x03\xe0A\x02R\fsettlementId\x12\x1c\n" +
+	"\auser_id\x18\x02 \x01(\tB\x03\xe0A\x02R\x06userId\"5\n" +
+	"\x14RevokeHeraldResponse\x12\x1d\n" +
+	"\n" +
+	"herald_ids\x18\x01 \x03(\tR\theraldIds*n\n" +
+	"\x0eSettlementType\x12\x1f\n" +
+	"\x1bSETTLEMENT_TYPE_UNSPECIFIED\x10\x00\x12\b\n" +
+	"\x04CAMP\x10\x01\x12\v\n" +
+	"\aVILLAGE\x10\x02\x12\f\n" +
+	"\bTOWNSHIP\x10\x03\x12\b\n" +
+	"\x04CITY\x10\x04\x12\f\n" +
+	"\bPROVINCE\x10\x052\xa53\n" +
+	"\x11SettlementService\x12a\n" +
+	"\x06Submit\x12\x1c.settlement.v1.SubmitRequest\x1a\x1d.settlement.v1.SubmitResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/settlements\x12Z\n" +
+	"\x03Get\x12\x19.settlement.v1.GetRequest\x1a\x1a.settlement.v1.GetResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/settlements/{id}\x12}\n" +
+	"\vGetByUserId\x12!.settlement.v1.GetByUserIdRequest\x1a\".settlement.v1.GetByUserIdResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/users/{user_id}/settlements\x12X\n" +
+	"\x04List\x12\x1a.settlement.v1.ListRequest\x1a\x1b.settlement.v1.ListResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/settlements\x12{\n" +
+	"\vListPending\x12!.settlement.v1.ListPendingRequest\x1a\".settlement.v1.ListPendingResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/settlements/verifications\x12~\n" +
+	"\aApprove\x12\x1d.settlement.v1.ApproveRequest\x1a\x1e.settlement.v1.ApproveResponse\"4\x82\xd3\xe4\x93\x02.:\x01*\")/v1/settlements/{id}/verification:approve\x12z\n" +
+	"\x06Reject\x12\x1c.settlement.v1.RejectRequest\x1a\x1d.settlement.v1.RejectResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/settlements/{id}/verification:reject\x12\xa6\x01\n" +
+	"\x12VerificationStatus\x12(.settlement.v1.VerificationStatusRequest\x1a).settlement.v1.VerificationStatusResponse\";\x82\xd3\xe4\x93\x025\x123/v1/users/{user_id}/settlements/verification:status\x12\x92\x01\n" +
+	"\fRemoveMember\x12\".settlement.v1.RemoveMemberRequest\x1a#.settlement.v1.RemoveMemberResponse\"9\x82\xd3\xe4\x93\x023*1/v1/settlements/{settlement_id}/members/{user_id}\x12\x92\x01\n" +
+	"\x0eGetInvitations\x12$.settlement.v1.GetInvitationsRequest\x1a%.settlement.v1.GetInvitationsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/settlements/{settlement_id}/invitations\x12\x9e\x01\n" +
+	"\x12GetUserInvitations\x12(.settlement.v1.GetUserInvitationsRequest\x1a).settlement.v1.GetUserInvitationsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/users/{user_id}/settlements/invitations\x12\x9f\x01\n" +
+	"\x10AcceptInvitation\x12&.settlement.v1.AcceptInvitationRequest\x1a'.settlement.v1.AcceptInvitationResponse\":\x82\xd3\xe4\x93\x024\"2/v1/settlements/invitations/{invitation_id}:accept\x12\x9f\x01\n" +
+	"\x10RejectInvitation\x12&.settlement.v1.RejectInvitationRequest\x1a'.settlement.v1.RejectInvitationResponse\":\x82\xd3\xe4\x93\x024\"2/v1/settlements/invitations/{invitation_id}:reject\x12\x8f\x01\n" +
+	"\fInviteMember\x12\".settlement.v1.InviteMemberRequest\x1a#.settlement.v1.InviteMemberResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/settlements/{settlement_id}/invitations\x12\xb2\x01\n" +
+	"\x10RevokeInvitation\x12&.settlement.v1.RevokeInvitationRequest\x1a'.settlement.v1.RevokeInvitationResponse\"M\x82\xd3\xe4\x93\x02G:\x01*\"B/v1/settlements/{settlement_id}/invitations/{invitation_id}:revoke\x12\x84\x01\n" +
+	"\x10UpdateSettlement\x12&.settlement.v1.UpdateSettlementRequest\x1a'.settlement.v1.UpdateSettlementResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*2\x14/v1/settlements/{id}\x12\x99\x01\n" +
+	"\x15AdminUpdateSettlement\x12+.settlement.v1.AdminUpdateSettlementRequest\x1a,.settlement.v1.AdminUpdateSettlementResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*2\x1a/v1/admin/settlements/{id}\x12\xa8\x01\n" +
+	"\x10AddImperialFavor\x12&.settlement.v1.AddImperialFavorRequest\x1a'.settlement.v1.AddImperialFavorResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/admin/settlements/{settlement_id}/imperial-favor:add\x12\xb4\x01\n" +
+	"\x13DeductImperialFavor\x12).settlement.v1.DeductImperialFavorRequest\x1a*.settlement.v1.DeductImperialFavorResponse\"F\x82\xd3\xe4\x93\x02@:\x01*\";/v1/admin/settlements/{settlement_id}/imperial-favor:deduct\x12\xb5\x01\n" +
+	"\x15ListImperialFavorLogs\x12+.settlement.v1.ListImperialFavorLogsRequest\x1a,.settlement.v1.ListImperialFavorLogsResponse\"A\x82\xd3\xe4\x93\x02;\x129/v1/admin/settlements/{settlement_id}/imperial-favor/logs\x12\xbb\x01\n" +
+	"\x15TransferImperialFavor\x12+.settlement.v1.TransferImperialFavorRequest\x1a,.settlement.v1.TransferImperialFavorResponse\"G\x82\xd3\xe4\x93\x02A:\x01*\"</v1/settlements/{from_settlement_id}/imperial-favor:transfer\x12\x9a\x01\n" +
+	"\x12AddTagToSettlement\x12(.settlement.v1.AddTagToSettlementRequest\x1a).settlement.v1.AddTagToSettlementResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/settlements/{settlement_id}/tags\x12\xaf\x01\n" +
+	"\x17RemoveTagFromSettlement\x12-.settlement.v1.RemoveTagFromSettlementRequest\x1a..settlement.v1.RemoveTagFromSettlementResponse\"5\x82\xd3\xe4\x93\x02/*-/v1/settlements/{settlement_id}/tags/{tag_id}\x12\xb4\x01\n" +
+	"\x16GetLevelUpRequirements\x12,.settlement.v1.GetLevelUpRequirementsRequest\x1a-.settlement.v1.GetLevelUpRequirementsResponse\"=\x82\xd3\xe4\x93\x027\x125/v1/settlements/{settlement_id}/level-up-requirements\x12\xad\x01\n" +
+	"\x16SetLevelUpRequirements\x12,.settlement.v1.SetLevelUpRequirementsRequest\x1a-.settlement.v1.SetLevelUpRequirementsResponse\"6\x82\xd3\xe4\x93\x020:\x01*\x1a+/v1/admin/settlements/level-up-requirements\x12\xad\x01\n" +
+	"\x17ListLevelUpRequirements\x12-.settlement.v1.ListLevelUpRequirementsRequest\x1a..settlement.v1.ListLevelUpRequirementsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/admin/settlements/level-up-requirements\x12\xb4\x01\n" +
+	"\x14SetActivityExemption\x12*.settlement.v1.SetActivityExemptionRequest\x1a+.settlement.v1.SetActivityExemptionResponse\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/v1/admin/settlements/{settlement_id}/activity-exemption\x12\x86\x01\n" +
+	"\vGetTreasury\x12!.settlement.v1.GetTreasuryRequest\x1a\".settlement.v1.GetTreasuryResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/settlements/{settlement_id}/treasury\x12\xa3\x01\n" +
+	"\x11DepositToTreasury\x12'.settlement.v1.DepositToTreasuryRequest\x1a(.settlement.v1.DepositToTreasuryResponse\";\x82\xd3\xe4\x93\x025:\x01*\"0/v1/settlements/{settlement_id}/treasury:deposit\x12\xad\x01\n" +
+	"\x14WithdrawFromTreasury\x12*.settlement.v1.WithdrawFromTreasuryRequest\x1a+.settlement.v1.WithdrawFromTreasuryResponse\"<\x82\xd3\xe4\x93\x026:\x01*\"1/v1/settlements/{settlement_id}/treasury:withdraw\x12\xb1\x01\n" +
+	"\x17BuyShopItemFromTreasury\x12-.settlement.v1.BuyShopItemFromTreasuryRequest\x1a..settlement.v1.BuyShopItemFromTreasuryResponse\"7\x82\xd3\xe4\x93\x021:\x01*\",/v1/settlements/{settlement_id}/treasury:buy\x12\xa2\x01\n" +
+	"\x12ListTreasuryLedger\x12(.settlement.v1.ListTreasuryLedgerRequest\x1a).settlement.v1.ListTreasuryLedgerResponse\"7\x82\xd3\xe4\x93\x021\x12//v1/settlements/{settlement_id}/treasury/ledger\x12\x97\x01\n" +
+	"\x0fAssignTreasurer\x12%.settlement.v1.AssignTreasurerRequest\x1a&.settlement.v1.AssignTreasurerResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/settlements/{settlement_id}/treasurers\x12\x9e\x01\n" +
+	"\x0fRevokeTreasurer\x12%.settlement.v1.RevokeTreasurerRequest\x1a&.settlement.v1.RevokeTreasurerResponse\"<\x82\xd3\xe4\x93\x026*4/v1/settlements/{settlement_id}/treasurers/{user_id}\x12\x98\x01\n" +
+	"\x0fCreateBoardPost\x12%.settlement.v1.CreateBoardPostRequest\x1a&.settlement.v1.CreateBoardPostResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/v1/settlements/{settlement_id}/board/posts\x12\x92\x01\n" +
+	"\x0eListBoardPosts\x12$.settlement.v1.ListBoardPostsRequest\x1a%.settlement.v1.ListBoardPostsResponse\"3\x82\xd3\xe4\x93\x02-\x12+/v1/settlements/{settlement_id}/board/posts\x12\x9f\x01\n" +
+	"\x14ListPublicBoardPosts\x12$.settlement.v1.ListBoardPostsRequest\x1a%.settlement.v1.ListBoardPostsResponse\":\x82\xd3\xe4\x93\x024\x122/v1/settlements/{settlement_id}/board/public-posts\x12\x9d\x01\n" +
+	"\fPinBoardPost\x12\".settlement.v1.PinBoardPostRequest\x1a#.settlement.v1.PinBoardPostResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/settlements/{settlement_id}/board/posts/{post_id}:pin\x12\x9f\x01\n" +
+	"\x0fDeleteBoardPost\x12%.settlement.v1.DeleteBoardPostRequest\x1a&.settlement.v1.DeleteBoardPostResponse\"=\x82\xd3\xe4\x93\x027*5/v1/settlements/{settlement_id}/board/posts/{post_id}\x12\xa7\x01\n" +
+	"\x11TakeDownBoardPost\x12'.settlement.v1.TakeDownBoardPostRequest\x1a(.settlement.v1.TakeDownBoardPostResponse\"?\x82\xd3\xe4\x93\x029:\x01*\"4/v1/admin/settlements/board/posts/{post_id}:takedown\x12\x8b\x01\n" +
+	"\fAssignHerald\x12\".settlement.v1.AssignHeraldRequest\x1a#.settlement.v1.AssignHeraldResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/v1/settlements/{settlement_id}/heralds\x12\x92\x01\n" +
+	"\fRevokeHerald\x12\".settlement.v1.RevokeHeraldRequest\x1a#.settlement.v1.RevokeHeraldResponse\"9\x82\xd3\xe4\x93\x023*1/v1/settlements/{settlement_id}/heralds/{user_id}\x1a\x14\xcaA\x11api.lasthearth.ruB@Z>github.com/lasthearth/vsservice/gen/settlement/v1;settlementv1b\x06proto3"
 
 var (
 	file_settlement_v1_settlement_proto_rawDescOnce sync.Once
@@ -3419,144 +5829,254 @@ func file_settlement_v1_settlement_proto_rawDescGZIP() []byte {
 	return file_settlement_v1_settlement_proto_rawDescData
 }
 
-var file_settlement_v1_settlement_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_settlement_v1_settlement_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
+var file_settlement_v1_settlement_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_settlement_v1_settlement_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
 var file_settlement_v1_settlement_proto_goTypes = []any{
 	(SettlementType)(0),                              // 0: settlement.v1.SettlementType
 	(SubmitRequest_Type)(0),                          // 1: settlement.v1.SubmitRequest.Type
-	(*GetUserInvitationsRequest)(nil),                // 2: settlement.v1.GetUserInvitationsRequest
-	(*GetUserInvitationsResponse)(nil),               // 3: settlement.v1.GetUserInvitationsResponse
-	(*AcceptInvitationRequest)(nil),                  // 4: settlement.v1.AcceptInvitationRequest
-	(*AcceptInvitationResponse)(nil),                 // 5: settlement.v1.AcceptInvitationResponse
-	(*RejectInvitationRequest)(nil),                  // 6: settlement.v1.RejectInvitationRequest
-	(*RejectInvitationResponse)(nil),                 // 7: settlement.v1.RejectInvitationResponse
-	(*Settlement)(nil),                               // 8: settlement.v1.Settlement
-	(*Member)(nil),                                   // 9: settlement.v1.Member
-	(*SubmitRequest)(nil),                            // 10: settlement.v1.SubmitRequest
-	(*Vector2)(nil),                                  // 11: settlement.v1.Vector2
-	(*Attachment)(nil),                               // 12: settlement.v1.Attachment
-	(*SubmitResponse)(nil),                           // 13: settlement.v1.SubmitResponse
-	(*GetRequest)(nil),                               // 14: settlement.v1.GetRequest
-	(*GetResponse)(nil),                              // 15: settlement.v1.GetResponse
-	(*ListRequest)(nil),                              // 16: settlement.v1.ListRequest
-	(*ListResponse)(nil),                             // 17: settlement.v1.ListResponse
-	(*ListPendingRequest)(nil),                       // 18: settlement.v1.ListPendingRequest
-	(*ListPendingResponse)(nil),                      // 19: settlement.v1.ListPendingResponse
-	(*ApproveRequest)(nil),                           // 20: settlement.v1.ApproveRequest
-	(*ApproveResponse)(nil),                          // 21: settlement.v1.ApproveResponse
-	(*RejectRequest)(nil),                            // 22: settlement.v1.RejectRequest
-	(*RejectResponse)(nil),                           // 23: settlement.v1.RejectResponse
-	(*RemoveMemberRequest)(nil),                      // 24: settlement.v1.RemoveMemberRequest
-	(*RemoveMemberResponse)(nil),                     // 25: settlement.v1.RemoveMemberResponse
-	(*InviteMemberRequest)(nil),                      // 26: settlement.v1.InviteMemberRequest
-	(*InviteMemberResponse)(nil),                     // 27: settlement.v1.InviteMemberResponse
-	(*GetInvitationsRequest)(nil),                    // 28: settlement.v1.GetInvitationsRequest
-	(*GetInvitationsResponse)(nil),                   // 29: settlement.v1.GetInvitationsResponse
-	(*Invitation)(nil),                               // 30: settlement.v1.Invitation
-	(*RevokeInvitationRequest)(nil),                  // 31: settlement.v1.RevokeInvitationRequest
-	(*RevokeInvitationResponse)(nil),                 // 32: settlement.v1.RevokeInvitationResponse
-	(*GetByUserIdRequest)(nil),                       // 33: settlement.v1.GetByUserIdRequest
-	(*GetByUserIdResponse)(nil),                      // 34: settlement.v1.GetByUserIdResponse
-	(*VerificationStatusRequest)(nil),                // 35: settlement.v1.VerificationStatusRequest
-	(*VerificationStatusResponse)(nil),               // 36: settlement.v1.VerificationStatusResponse
-	(*AddTagToSettlementRequest)(nil),                // 37: settlement.v1.AddTagToSettlementRequest
-	(*AddTagToSettlementResponse)(nil),               // 38: settlement.v1.AddTagToSettlementResponse
-	(*RemoveTagFromSettlementRequest)(nil),           // 39: settlement.v1.RemoveTagFromSettlementRequest
-	(*RemoveTagFromSettlementResponse)(nil),          // 40: settlement.v1.RemoveTagFromSettlementResponse
-	(*AdminUpdateSettlementRequest)(nil),             // 41: settlement.v1.AdminUpdateSettlementRequest
-	(*AdminUpdateSettlementResponse)(nil),            // 42: settlement.v1.AdminUpdateSettlementResponse
-	(*UpdateSettlementRequest)(nil),                  // 43: settlement.v1.UpdateSettlementRequest
-	(*UpdateSettlementResponse)(nil),                 // 44: settlement.v1.UpdateSettlementResponse
-	(*ImperialFavorLog)(nil),                         // 45: settlement.v1.ImperialFavorLog
-	(*AddImperialFavorRequest)(nil),                  // 46: settlement.v1.AddImperialFavorRequest
-	(*AddImperialFavorResponse)(nil),                 // 47: settlement.v1.AddImperialFavorResponse
-	(*DeductImperialFavorRequest)(nil),               // 48: settlement.v1.DeductImperialFavorRequest
-	(*DeductImperialFavorResponse)(nil),              // 49: settlement.v1.DeductImperialFavorResponse
-	(*ListImperialFavorLogsRequest)(nil),             // 50: settlement.v1.ListImperialFavorLogsRequest
-	(*ListImperialFavorLogsResponse)(nil),            // 51: settlement.v1.ListImperialFavorLogsResponse
-	(*TransferImperialFavorRequest)(nil),             // 52: settlement.v1.TransferImperialFavorRequest
-	(*TransferImperialFavorResponse)(nil),            // 53: settlement.v1.TransferImperialFavorResponse
-	(*SubmitRequest_SubmitAttachment)(nil),           // 54: settlement.v1.SubmitRequest.SubmitAttachment
-	(*UpdateSettlementRequest_UpdateAttachment)(nil), // 55: settlement.v1.UpdateSettlementRequest.UpdateAttachment
-	(*TagReference)(nil),                             // 56: settlement.v1.TagReference
+	(TreasuryEntry_Kind)(0),                          // 2: settlement.v1.TreasuryEntry.Kind
+	(RequirementProgress_Kind)(0),                    // 3: settlement.v1.RequirementProgress.Kind
+	(BoardPost_Visibility)(0),                        // 4: settlement.v1.BoardPost.Visibility
+	(*GetUserInvitationsRequest)(nil),                // 5: settlement.v1.GetUserInvitationsRequest
+	(*GetUserInvitationsResponse)(nil),               // 6: settlement.v1.GetUserInvitationsResponse
+	(*AcceptInvitationRequest)(nil),                  // 7: settlement.v1.AcceptInvitationRequest
+	(*AcceptInvitationResponse)(nil),                 // 8: settlement.v1.AcceptInvitationResponse
+	(*RejectInvitationRequest)(nil),                  // 9: settlement.v1.RejectInvitationRequest
+	(*RejectInvitationResponse)(nil),                 // 10: settlement.v1.RejectInvitationResponse
+	(*Settlement)(nil),                               // 11: settlement.v1.Settlement
+	(*Member)(nil),                                   // 12: settlement.v1.Member
+	(*SubmitRequest)(nil),                            // 13: settlement.v1.SubmitRequest
+	(*Vector2)(nil),                                  // 14: settlement.v1.Vector2
+	(*Attachment)(nil),                               // 15: settlement.v1.Attachment
+	(*SubmitResponse)(nil),                           // 16: settlement.v1.SubmitResponse
+	(*GetRequest)(nil),                               // 17: settlement.v1.GetRequest
+	(*GetResponse)(nil),                              // 18: settlement.v1.GetResponse
+	(*ListRequest)(nil),                              // 19: settlement.v1.ListRequest
+	(*ListResponse)(nil),                             // 20: settlement.v1.ListResponse
+	(*ListPendingRequest)(nil),                       // 21: settlement.v1.ListPendingRequest
+	(*ListPendingResponse)(nil),                      // 22: settlement.v1.ListPendingResponse
+	(*ApproveRequest)(nil),                           // 23: settlement.v1.ApproveRequest
+	(*ApproveResponse)(nil),                          // 24: settlement.v1.ApproveResponse
+	(*RejectRequest)(nil),                            // 25: settlement.v1.RejectRequest
+	(*RejectResponse)(nil),                           // 26: settlement.v1.RejectResponse
+	(*RemoveMemberRequest)(nil),                      // 27: settlement.v1.RemoveMemberRequest
+	(*RemoveMemberResponse)(nil),                     // 28: settlement.v1.RemoveMemberResponse
+	(*InviteMemberRequest)(nil),                      // 29: settlement.v1.InviteMemberRequest
+	(*InviteMemberResponse)(nil),                     // 30: settlement.v1.InviteMemberResponse
+	(*GetInvitationsRequest)(nil),                    // 31: settlement.v1.GetInvitationsRequest
+	(*GetInvitationsResponse)(nil),                   // 32: settlement.v1.GetInvitationsResponse
+	(*Invitation)(nil),                               // 33: settlement.v1.Invitation
+	(*RevokeInvitationRequest)(nil),                  // 34: settlement.v1.RevokeInvitationRequest
+	(*RevokeInvitationResponse)(nil),                 // 35: settlement.v1.RevokeInvitationResponse
+	(*GetByUserIdRequest)(nil),                       // 36: settlement.v1.GetByUserIdRequest
+	(*GetByUserIdResponse)(nil),                      // 37: settlement.v1.GetByUserIdResponse
+	(*VerificationStatusRequest)(nil),                // 38: settlement.v1.VerificationStatusRequest
+	(*VerificationStatusResponse)(nil),               // 39: settlement.v1.VerificationStatusResponse
+	(*AddTagToSettlementRequest)(nil),                // 40: settlement.v1.AddTagToSettlementRequest
+	(*AddTagToSettlementResponse)(nil),               // 41: settlement.v1.AddTagToSettlementResponse
+	(*RemoveTagFromSettlementRequest)(nil),           // 42: settlement.v1.RemoveTagFromSettlementRequest
+	(*RemoveTagFromSettlementResponse)(nil),          // 43: settlement.v1.RemoveTagFromSettlementResponse
+	(*AdminUpdateSettlementRequest)(nil),             // 44: settlement.v1.AdminUpdateSettlementRequest
+	(*AdminUpdateSettlementResponse)(nil),            // 45: settlement.v1.AdminUpdateSettlementResponse
+	(*UpdateSettlementRequest)(nil),                  // 46: settlement.v1.UpdateSettlementRequest
+	(*UpdateSettlementResponse)(nil),                 // 47: settlement.v1.UpdateSettlementResponse
+	(*ImperialFavorLog)(nil),                         // 48: settlement.v1.ImperialFavorLog
+	(*AddImperialFavorRequest)(nil),                  // 49: settlement.v1.AddImperialFavorRequest
+	(*AddImperialFavorResponse)(nil),                 // 50: settlement.v1.AddImperialFavorResponse
+	(*DeductImperialFavorRequest)(nil),               // 51: settlement.v1.DeductImperialFavorRequest
+	(*DeductImperialFavorResponse)(nil),              // 52: settlement.v1.DeductImperialFavorResponse
+	(*ListImperialFavorLogsRequest)(nil),             // 53: settlement.v1.ListImperialFavorLogsRequest
+	(*ListImperialFavorLogsResponse)(nil),            // 54: settlement.v1.ListImperialFavorLogsResponse
+	(*TransferImperialFavorRequest)(nil),             // 55: settlement.v1.TransferImperialFavorRequest
+	(*TransferImperialFavorResponse)(nil),            // 56: settlement.v1.TransferImperialFavorResponse
+	(*Treasury)(nil),                                 // 57: settlement.v1.Treasury
+	(*TreasuryEntry)(nil),                            // 58: settlement.v1.TreasuryEntry
+	(*GetTreasuryRequest)(nil),                       // 59: settlement.v1.GetTreasuryRequest
+	(*GetTreasuryResponse)(nil),                      // 60: settlement.v1.GetTreasuryResponse
+	(*DepositToTreasuryRequest)(nil),                 // 61: settlement.v1.DepositToTreasuryRequest
+	(*DepositToTreasuryResponse)(nil),                // 62: settlement.v1.DepositToTreasuryResponse
+	(*WithdrawFromTreasuryRequest)(nil),              // 63: settlement.v1.WithdrawFromTreasuryRequest
+	(*WithdrawFromTreasuryResponse)(nil),             // 64: settlement.v1.WithdrawFromTreasuryResponse
+	(*BuyShopItemFromTreasuryRequest)(nil),           // 65: settlement.v1.BuyShopItemFromTreasuryRequest
+	(*BuyShopItemFromTreasuryResponse)(nil),          // 66: settlement.v1.BuyShopItemFromTreasuryResponse
+	(*ListTreasuryLedgerRequest)(nil),                // 67: settlement.v1.ListTreasuryLedgerRequest
+	(*ListTreasuryLedgerResponse)(nil),               // 68: settlement.v1.ListTreasuryLedgerResponse
+	(*AssignTreasurerRequest)(nil),                   // 69: settlement.v1.AssignTreasurerRequest
+	(*AssignTreasurerResponse)(nil),                  // 70: settlement.v1.AssignTreasurerResponse
+	(*RevokeTreasurerRequest)(nil),                   // 71: settlement.v1.RevokeTreasurerRequest
+	(*RevokeTreasurerResponse)(nil),                  // 72: settlement.v1.RevokeTreasurerResponse
+	(*SetActivityExemptionRequest)(nil),              // 73: settlement.v1.SetActivityExemptionRequest
+	(*SetActivityExemptionResponse)(nil),             // 74: settlement.v1.SetActivityExemptionResponse
+	(*TalentNodeRef)(nil),                            // 75: settlement.v1.TalentNodeRef
+	(*LevelUpRequirements)(nil),                      // 76: settlement.v1.LevelUpRequirements
+	(*RequirementProgress)(nil),                      // 77: settlement.v1.RequirementProgress
+	(*GetLevelUpRequirementsRequest)(nil),            // 78: settlement.v1.GetLevelUpRequirementsRequest
+	(*GetLevelUpRequirementsResponse)(nil),           // 79: settlement.v1.GetLevelUpRequirementsResponse
+	(*SetLevelUpRequirementsRequest)(nil),            // 80: settlement.v1.SetLevelUpRequirementsRequest
+	(*SetLevelUpRequirementsResponse)(nil),           // 81: settlement.v1.SetLevelUpRequirementsResponse
+	(*ListLevelUpRequirementsRequest)(nil),           // 82: settlement.v1.ListLevelUpRequirementsRequest
+	(*ListLevelUpRequirementsResponse)(nil),          // 83: settlement.v1.ListLevelUpRequirementsResponse
+	(*BoardPost)(nil),                                // 84: settlement.v1.BoardPost
+	(*CreateBoardPostRequest)(nil),                   // 85: settlement.v1.CreateBoardPostRequest
+	(*CreateBoardPostResponse)(nil),                  // 86: settlement.v1.CreateBoardPostResponse
+	(*ListBoardPostsRequest)(nil),                    // 87: settlement.v1.ListBoardPostsRequest
+	(*ListBoardPostsResponse)(nil),                   // 88: settlement.v1.ListBoardPostsResponse
+	(*PinBoardPostRequest)(nil),                      // 89: settlement.v1.PinBoardPostRequest
+	(*PinBoardPostResponse)(nil),                     // 90: settlement.v1.PinBoardPostResponse
+	(*DeleteBoardPostRequest)(nil),                   // 91: settlement.v1.DeleteBoardPostRequest
+	(*DeleteBoardPostResponse)(nil),                  // 92: settlement.v1.DeleteBoardPostResponse
+	(*TakeDownBoardPostRequest)(nil),                 // 93: settlement.v1.TakeDownBoardPostRequest
+	(*TakeDownBoardPostResponse)(nil),                // 94: settlement.v1.TakeDownBoardPostResponse
+	(*AssignHeraldRequest)(nil),                      // 95: settlement.v1.AssignHeraldRequest
+	(*AssignHeraldResponse)(nil),                     // 96: settlement.v1.AssignHeraldResponse
+	(*RevokeHeraldRequest)(nil),                      // 97: settlement.v1.RevokeHeraldRequest
+	(*RevokeHeraldResponse)(nil),                     // 98: settlement.v1.RevokeHeraldResponse
+	(*SubmitRequest_SubmitAttachment)(nil),           // 99: settlement.v1.SubmitRequest.SubmitAttachment
+	(*UpdateSettlementRequest_UpdateAttachment)(nil), // 100: settlement.v1.UpdateSettlementRequest.UpdateAttachment
+	(*TagReference)(nil),                             // 101: settlement.v1.TagReference
 }
 var file_settlement_v1_settlement_proto_depIdxs = []int32{
-	30, // 0: settlement.v1.GetUserInvitationsResponse.invitations:type_name -> settlement.v1.Invitation
-	0,  // 1: settlement.v1.Settlement.type:type_name -> settlement.v1.SettlementType
-	9,  // 2: settlement.v1.Settlement.leader:type_name -> settlement.v1.Member
-	9,  // 3: settlement.v1.Settlement.members:type_name -> settlement.v1.Member
-	12, // 4: settlement.v1.Settlement.attachments:type_name -> settlement.v1.Attachment
-	11, // 5: settlement.v1.Settlement.coordinates:type_name -> settlement.v1.Vector2
-	56, // 6: settlement.v1.Settlement.tags:type_name -> settlement.v1.TagReference
-	1,  // 7: settlement.v1.SubmitRequest.type:type_name -> settlement.v1.SubmitRequest.Type
-	11, // 8: settlement.v1.SubmitRequest.coordinates:type_name -> settlement.v1.Vector2
-	54, // 9: settlement.v1.SubmitRequest.attachments:type_name -> settlement.v1.SubmitRequest.SubmitAttachment
-	8,  // 10: settlement.v1.GetResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 11: settlement.v1.ListResponse.settlements:type_name -> settlement.v1.Settlement
-	8,  // 12: settlement.v1.ListPendingResponse.settlements:type_name -> settlement.v1.Settlement
-	30, // 13: settlement.v1.GetInvitationsResponse.invitations:type_name -> settlement.v1.Invitation
-	8,  // 14: settlement.v1.GetByUserIdResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 15: settlement.v1.AddTagToSettlementResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 16: settlement.v1.RemoveTagFromSettlementResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 17: settlement.v1.AdminUpdateSettlementResponse.settlement:type_name -> settlement.v1.Settlement
-	55, // 18: settlement.v1.UpdateSettlementRequest.attachments:type_name -> settlement.v1.UpdateSettlementRequest.UpdateAttachment
-	8,  // 19: settlement.v1.UpdateSettlementResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 20: settlement.v1.AddImperialFavorResponse.settlement:type_name -> settlement.v1.Settlement
-	8,  // 21: settlement.v1.DeductImperialFavorResponse.settlement:type_name -> settlement.v1.Settlement
-	45, // 22: settlement.v1.ListImperialFavorLogsResponse.logs:type_name -> settlement.v1.ImperialFavorLog
-	8,  // 23: settlement.v1.TransferImperialFavorResponse.from_settlement:type_name -> settlement.v1.Settlement
-	8,  // 24: settlement.v1.TransferImperialFavorResponse.to_settlement:type_name -> settlement.v1.Settlement
-	10, // 25: settlement.v1.SettlementService.Submit:input_type -> settlement.v1.SubmitRequest
-	14, // 26: settlement.v1.SettlementService.Get:input_type -> settlement.v1.GetRequest
-	33, // 27: settlement.v1.SettlementService.GetByUserId:input_type -> settlement.v1.GetByUserIdRequest
-	16, // 28: settlement.v1.SettlementService.List:input_type -> settlement.v1.ListRequest
-	18, // 29: settlement.v1.SettlementService.ListPending:input_type -> settlement.v1.ListPendingRequest
-	20, // 30: settlement.v1.SettlementService.Approve:input_type -> settlement.v1.ApproveRequest
-	22, // 31: settlement.v1.SettlementService.Reject:input_type -> settlement.v1.RejectRequest
-	35, // 32: settlement.v1.SettlementService.VerificationStatus:input_type -> settlement.v1.VerificationStatusRequest
-	24, // 33: settlement.v1.SettlementService.RemoveMember:input_type -> settlement.v1.RemoveMemberRequest
-	28, // 34: settlement.v1.SettlementService.GetInvitations:input_type -> settlement.v1.GetInvitationsRequest
-	2,  // 35: settlement.v1.SettlementService.GetUserInvitations:input_type -> settlement.v1.GetUserInvitationsRequest
-	4,  // 36: settlement.v1.SettlementService.AcceptInvitation:input_type -> settlement.v1.AcceptInvitationRequest
-	6,  // 37: settlement.v1.SettlementService.RejectInvitation:input_type -> settlement.v1.RejectInvitationRequest
-	26, // 38: settlement.v1.SettlementService.InviteMember:input_type -> settlement.v1.InviteMemberRequest
-	31, // 39: settlement.v1.SettlementService.RevokeInvitation:input_type -> settlement.v1.RevokeInvitationRequest
-	43, // 40: settlement.v1.SettlementService.UpdateSettlement:input_type -> settlement.v1.UpdateSettlementRequest
-	41, // 41: settlement.v1.SettlementService.AdminUpdateSettlement:input_type -> settlement.v1.AdminUpdateSettlementRequest
-	46, // 42: settlement.v1.SettlementService.AddImperialFavor:input_type -> settlement.v1.AddImperialFavorRequest
-	48, // 43: settlement.v1.SettlementService.DeductImperialFavor:input_type -> settlement.v1.DeductImperialFavorRequest
-	50, // 44: settlement.v1.SettlementService.ListImperialFavorLogs:input_type -> settlement.v1.ListImperialFavorLogsRequest
-	52, // 45: settlement.v1.SettlementService.TransferImperialFavor:input_type -> settlement.v1.TransferImperialFavorRequest
-	37, // 46: settlement.v1.SettlementService.AddTagToSettlement:input_type -> settlement.v1.AddTagToSettlementRequest
-	39, // 47: settlement.v1.SettlementService.RemoveTagFromSettlement:input_type -> settlement.v1.RemoveTagFromSettlementRequest
-	13, // 48: settlement.v1.SettlementService.Submit:output_type -> settlement.v1.SubmitResponse
-	15, // 49: settlement.v1.SettlementService.Get:output_type -> settlement.v1.GetResponse
-	34, // 50: settlement.v1.SettlementService.GetByUserId:output_type -> settlement.v1.GetByUserIdResponse
-	17, // 51: settlement.v1.SettlementService.List:output_type -> settlement.v1.ListResponse
-	19, // 52: settlement.v1.SettlementService.ListPending:output_type -> settlement.v1.ListPendingResponse
-	21, // 53: settlement.v1.SettlementService.Approve:output_type -> settlement.v1.ApproveResponse
-	23, // 54: settlement.v1.SettlementService.Reject:output_type -> settlement.v1.RejectResponse
-	36, // 55: settlement.v1.SettlementService.VerificationStatus:output_type -> settlement.v1.VerificationStatusResponse
-	25, // 56: settlement.v1.SettlementService.RemoveMember:output_type -> settlement.v1.RemoveMemberResponse
-	29, // 57: settlement.v1.SettlementService.GetInvitations:output_type -> settlement.v1.GetInvitationsResponse
-	3,  // 58: settlement.v1.SettlementService.GetUserInvitations:output_type -> settlement.v1.GetUserInvitationsResponse
-	5,  // 59: settlement.v1.SettlementService.AcceptInvitation:output_type -> settlement.v1.AcceptInvitationResponse
-	7,  // 60: settlement.v1.SettlementService.RejectInvitation:output_type -> settlement.v1.RejectInvitationResponse
-	27, // 61: settlement.v1.SettlementService.InviteMember:output_type -> settlement.v1.InviteMemberResponse
-	32, // 62: settlement.v1.SettlementService.RevokeInvitation:output_type -> settlement.v1.RevokeInvitationResponse
-	44, // 63: settlement.v1.SettlementService.UpdateSettlement:output_type -> settlement.v1.UpdateSettlementResponse
-	42, // 64: settlement.v1.SettlementService.AdminUpdateSettlement:output_type -> settlement.v1.AdminUpdateSettlementResponse
-	47, // 65: settlement.v1.SettlementService.AddImperialFavor:output_type -> settlement.v1.AddImperialFavorResponse
-	49, // 66: settlement.v1.SettlementService.DeductImperialFavor:output_type -> settlement.v1.DeductImperialFavorResponse
-	51, // 67: settlement.v1.SettlementService.ListImperialFavorLogs:output_type -> settlement.v1.ListImperialFavorLogsResponse
-	53, // 68: settlement.v1.SettlementService.TransferImperialFavor:output_type -> settlement.v1.TransferImperialFavorResponse
-	38, // 69: settlement.v1.SettlementService.AddTagToSettlement:output_type -> settlement.v1.AddTagToSettlementResponse
-	40, // 70: settlement.v1.SettlementService.RemoveTagFromSettlement:output_type -> settlement.v1.RemoveTagFromSettlementResponse
-	48, // [48:71] is the sub-list for method output_type
-	25, // [25:48] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	33,  // 0: settlement.v1.GetUserInvitationsResponse.invitations:type_name -> settlement.v1.Invitation
+	0,   // 1: settlement.v1.Settlement.type:type_name -> settlement.v1.SettlementType
+	12,  // 2: settlement.v1.Settlement.leader:type_name -> settlement.v1.Member
+	12,  // 3: settlement.v1.Settlement.members:type_name -> settlement.v1.Member
+	15,  // 4: settlement.v1.Settlement.attachments:type_name -> settlement.v1.Attachment
+	14,  // 5: settlement.v1.Settlement.coordinates:type_name -> settlement.v1.Vector2
+	101, // 6: settlement.v1.Settlement.tags:type_name -> settlement.v1.TagReference
+	1,   // 7: settlement.v1.SubmitRequest.type:type_name -> settlement.v1.SubmitRequest.Type
+	14,  // 8: settlement.v1.SubmitRequest.coordinates:type_name -> settlement.v1.Vector2
+	99,  // 9: settlement.v1.SubmitRequest.attachments:type_name -> settlement.v1.SubmitRequest.SubmitAttachment
+	11,  // 10: settlement.v1.GetResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 11: settlement.v1.ListResponse.settlements:type_name -> settlement.v1.Settlement
+	11,  // 12: settlement.v1.ListPendingResponse.settlements:type_name -> settlement.v1.Settlement
+	33,  // 13: settlement.v1.GetInvitationsResponse.invitations:type_name -> settlement.v1.Invitation
+	11,  // 14: settlement.v1.GetByUserIdResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 15: settlement.v1.AddTagToSettlementResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 16: settlement.v1.RemoveTagFromSettlementResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 17: settlement.v1.AdminUpdateSettlementResponse.settlement:type_name -> settlement.v1.Settlement
+	100, // 18: settlement.v1.UpdateSettlementRequest.attachments:type_name -> settlement.v1.UpdateSettlementRequest.UpdateAttachment
+	11,  // 19: settlement.v1.UpdateSettlementResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 20: settlement.v1.AddImperialFavorResponse.settlement:type_name -> settlement.v1.Settlement
+	11,  // 21: settlement.v1.DeductImperialFavorResponse.settlement:type_name -> settlement.v1.Settlement
+	48,  // 22: settlement.v1.ListImperialFavorLogsResponse.logs:type_name -> settlement.v1.ImperialFavorLog
+	11,  // 23: settlement.v1.TransferImperialFavorResponse.from_settlement:type_name -> settlement.v1.Settlement
+	11,  // 24: settlement.v1.TransferImperialFavorResponse.to_settlement:type_name -> settlement.v1.Settlement
+	2,   // 25: settlement.v1.TreasuryEntry.kind:type_name -> settlement.v1.TreasuryEntry.Kind
+	57,  // 26: settlement.v1.GetTreasuryResponse.treasury:type_name -> settlement.v1.Treasury
+	57,  // 27: settlement.v1.DepositToTreasuryResponse.treasury:type_name -> settlement.v1.Treasury
+	57,  // 28: settlement.v1.WithdrawFromTreasuryResponse.treasury:type_name -> settlement.v1.Treasury
+	57,  // 29: settlement.v1.BuyShopItemFromTreasuryResponse.treasury:type_name -> settlement.v1.Treasury
+	58,  // 30: settlement.v1.ListTreasuryLedgerResponse.entries:type_name -> settlement.v1.TreasuryEntry
+	57,  // 31: settlement.v1.AssignTreasurerResponse.treasury:type_name -> settlement.v1.Treasury
+	57,  // 32: settlement.v1.RevokeTreasurerResponse.treasury:type_name -> settlement.v1.Treasury
+	11,  // 33: settlement.v1.SetActivityExemptionResponse.settlement:type_name -> settlement.v1.Settlement
+	0,   // 34: settlement.v1.LevelUpRequirements.type:type_name -> settlement.v1.SettlementType
+	75,  // 35: settlement.v1.LevelUpRequirements.required_nodes:type_name -> settlement.v1.TalentNodeRef
+	3,   // 36: settlement.v1.RequirementProgress.kind:type_name -> settlement.v1.RequirementProgress.Kind
+	75,  // 37: settlement.v1.RequirementProgress.node:type_name -> settlement.v1.TalentNodeRef
+	0,   // 38: settlement.v1.GetLevelUpRequirementsResponse.current_type:type_name -> settlement.v1.SettlementType
+	0,   // 39: settlement.v1.GetLevelUpRequirementsResponse.next_type:type_name -> settlement.v1.SettlementType
+	77,  // 40: settlement.v1.GetLevelUpRequirementsResponse.requirements:type_name -> settlement.v1.RequirementProgress
+	76,  // 41: settlement.v1.SetLevelUpRequirementsRequest.requirements:type_name -> settlement.v1.LevelUpRequirements
+	76,  // 42: settlement.v1.SetLevelUpRequirementsResponse.requirements:type_name -> settlement.v1.LevelUpRequirements
+	76,  // 43: settlement.v1.ListLevelUpRequirementsResponse.requirements:type_name -> settlement.v1.LevelUpRequirements
+	15,  // 44: settlement.v1.BoardPost.attachments:type_name -> settlement.v1.Attachment
+	4,   // 45: settlement.v1.BoardPost.visibility:type_name -> settlement.v1.BoardPost.Visibility
+	15,  // 46: settlement.v1.CreateBoardPostRequest.attachments:type_name -> settlement.v1.Attachment
+	4,   // 47: settlement.v1.CreateBoardPostRequest.visibility:type_name -> settlement.v1.BoardPost.Visibility
+	84,  // 48: settlement.v1.CreateBoardPostResponse.post:type_name -> settlement.v1.BoardPost
+	84,  // 49: settlement.v1.ListBoardPostsResponse.pinned:type_name -> settlement.v1.BoardPost
+	84,  // 50: settlement.v1.ListBoardPostsResponse.posts:type_name -> settlement.v1.BoardPost
+	84,  // 51: settlement.v1.PinBoardPostResponse.post:type_name -> settlement.v1.BoardPost
+	13,  // 52: settlement.v1.SettlementService.Submit:input_type -> settlement.v1.SubmitRequest
+	17,  // 53: settlement.v1.SettlementService.Get:input_type -> settlement.v1.GetRequest
+	36,  // 54: settlement.v1.SettlementService.GetByUserId:input_type -> settlement.v1.GetByUserIdRequest
+	19,  // 55: settlement.v1.SettlementService.List:input_type -> settlement.v1.ListRequest
+	21,  // 56: settlement.v1.SettlementService.ListPending:input_type -> settlement.v1.ListPendingRequest
+	23,  // 57: settlement.v1.SettlementService.Approve:input_type -> settlement.v1.ApproveRequest
+	25,  // 58: settlement.v1.SettlementService.Reject:input_type -> settlement.v1.RejectRequest
+	38,  // 59: settlement.v1.SettlementService.VerificationStatus:input_type -> settlement.v1.VerificationStatusRequest
+	27,  // 60: settlement.v1.SettlementService.RemoveMember:input_type -> settlement.v1.RemoveMemberRequest
+	31,  // 61: settlement.v1.SettlementService.GetInvitations:input_type -> settlement.v1.GetInvitationsRequest
+	5,   // 62: settlement.v1.SettlementService.GetUserInvitations:input_type -> settlement.v1.GetUserInvitationsRequest
+	7,   // 63: settlement.v1.SettlementService.AcceptInvitation:input_type -> settlement.v1.AcceptInvitationRequest
+	9,   // 64: settlement.v1.SettlementService.RejectInvitation:input_type -> settlement.v1.RejectInvitationRequest
+	29,  // 65: settlement.v1.SettlementService.InviteMember:input_type -> settlement.v1.InviteMemberRequest
+	34,  // 66: settlement.v1.SettlementService.RevokeInvitation:input_type -> settlement.v1.RevokeInvitationRequest
+	46,  // 67: settlement.v1.SettlementService.UpdateSettlement:input_type -> settlement.v1.UpdateSettlementRequest
+	44,  // 68: settlement.v1.SettlementService.AdminUpdateSettlement:input_type -> settlement.v1.AdminUpdateSettlementRequest
+	49,  // 69: settlement.v1.SettlementService.AddImperialFavor:input_type -> settlement.v1.AddImperialFavorRequest
+	51,  // 70: settlement.v1.SettlementService.DeductImperialFavor:input_type -> settlement.v1.DeductImperialFavorRequest
+	53,  // 71: settlement.v1.SettlementService.ListImperialFavorLogs:input_type -> settlement.v1.ListImperialFavorLogsRequest
+	55,  // 72: settlement.v1.SettlementService.TransferImperialFavor:input_type -> settlement.v1.TransferImperialFavorRequest
+	40,  // 73: settlement.v1.SettlementService.AddTagToSettlement:input_type -> settlement.v1.AddTagToSettlementRequest
+	42,  // 74: settlement.v1.SettlementService.RemoveTagFromSettlement:input_type -> settlement.v1.RemoveTagFromSettlementRequest
+	78,  // 75: settlement.v1.SettlementService.GetLevelUpRequirements:input_type -> settlement.v1.GetLevelUpRequirementsRequest
+	80,  // 76: settlement.v1.SettlementService.SetLevelUpRequirements:input_type -> settlement.v1.SetLevelUpRequirementsRequest
+	82,  // 77: settlement.v1.SettlementService.ListLevelUpRequirements:input_type -> settlement.v1.ListLevelUpRequirementsRequest
+	73,  // 78: settlement.v1.SettlementService.SetActivityExemption:input_type -> settlement.v1.SetActivityExemptionRequest
+	59,  // 79: settlement.v1.SettlementService.GetTreasury:input_type -> settlement.v1.GetTreasuryRequest
+	61,  // 80: settlement.v1.SettlementService.DepositToTreasury:input_type -> settlement.v1.DepositToTreasuryRequest
+	63,  // 81: settlement.v1.SettlementService.WithdrawFromTreasury:input_type -> settlement.v1.WithdrawFromTreasuryRequest
+	65,  // 82: settlement.v1.SettlementService.BuyShopItemFromTreasury:input_type -> settlement.v1.BuyShopItemFromTreasuryRequest
+	67,  // 83: settlement.v1.SettlementService.ListTreasuryLedger:input_type -> settlement.v1.ListTreasuryLedgerRequest
+	69,  // 84: settlement.v1.SettlementService.AssignTreasurer:input_type -> settlement.v1.AssignTreasurerRequest
+	71,  // 85: settlement.v1.SettlementService.RevokeTreasurer:input_type -> settlement.v1.RevokeTreasurerRequest
+	85,  // 86: settlement.v1.SettlementService.CreateBoardPost:input_type -> settlement.v1.CreateBoardPostRequest
+	87,  // 87: settlement.v1.SettlementService.ListBoardPosts:input_type -> settlement.v1.ListBoardPostsRequest
+	87,  // 88: settlement.v1.SettlementService.ListPublicBoardPosts:input_type -> settlement.v1.ListBoardPostsRequest
+	89,  // 89: settlement.v1.SettlementService.PinBoardPost:input_type -> settlement.v1.PinBoardPostRequest
+	91,  // 90: settlement.v1.SettlementService.DeleteBoardPost:input_type -> settlement.v1.DeleteBoardPostRequest
+	93,  // 91: settlement.v1.SettlementService.TakeDownBoardPost:input_type -> settlement.v1.TakeDownBoardPostRequest
+	95,  // 92: settlement.v1.SettlementService.AssignHerald:input_type -> settlement.v1.AssignHeraldRequest
+	97,  // 93: settlement.v1.SettlementService.RevokeHerald:input_type -> settlement.v1.RevokeHeraldRequest
+	16,  // 94: settlement.v1.SettlementService.Submit:output_type -> settlement.v1.SubmitResponse
+	18,  // 95: settlement.v1.SettlementService.Get:output_type -> settlement.v1.GetResponse
+	37,  // 96: settlement.v1.SettlementService.GetByUserId:output_type -> settlement.v1.GetByUserIdResponse
+	20,  // 97: settlement.v1.SettlementService.List:output_type -> settlement.v1.ListResponse
+	22,  // 98: settlement.v1.SettlementService.ListPending:output_type -> settlement.v1.ListPendingResponse
+	24,  // 99: settlement.v1.SettlementService.Approve:output_type -> settlement.v1.ApproveResponse
+	26,  // 100: settlement.v1.SettlementService.Reject:output_type -> settlement.v1.RejectResponse
+	39,  // 101: settlement.v1.SettlementService.VerificationStatus:output_type -> settlement.v1.VerificationStatusResponse
+	28,  // 102: settlement.v1.SettlementService.RemoveMember:output_type -> settlement.v1.RemoveMemberResponse
+	32,  // 103: settlement.v1.SettlementService.GetInvitations:output_type -> settlement.v1.GetInvitationsResponse
+	6,   // 104: settlement.v1.SettlementService.GetUserInvitations:output_type -> settlement.v1.GetUserInvitationsResponse
+	8,   // 105: settlement.v1.SettlementService.AcceptInvitation:output_type -> settlement.v1.AcceptInvitationResponse
+	10,  // 106: settlement.v1.SettlementService.RejectInvitation:output_type -> settlement.v1.RejectInvitationResponse
+	30,  // 107: settlement.v1.SettlementService.InviteMember:output_type -> settlement.v1.InviteMemberResponse
+	35,  // 108: settlement.v1.SettlementService.RevokeInvitation:output_type -> settlement.v1.RevokeInvitationResponse
+	47,  // 109: settlement.v1.SettlementService.UpdateSettlement:output_type -> settlement.v1.UpdateSettlementResponse
+	45,  // 110: settlement.v1.SettlementService.AdminUpdateSettlement:output_type -> settlement.v1.AdminUpdateSettlementResponse
+	50,  // 111: settlement.v1.SettlementService.AddImperialFavor:output_type -> settlement.v1.AddImperialFavorResponse
+	52,  // 112: settlement.v1.SettlementService.DeductImperialFavor:output_type -> settlement.v1.DeductImperialFavorResponse
+	54,  // 113: settlement.v1.SettlementService.ListImperialFavorLogs:output_type -> settlement.v1.ListImperialFavorLogsResponse
+	56,  // 114: settlement.v1.SettlementService.TransferImperialFavor:output_type -> settlement.v1.TransferImperialFavorResponse
+	41,  // 115: settlement.v1.SettlementService.AddTagToSettlement:output_type -> settlement.v1.AddTagToSettlementResponse
+	43,  // 116: settlement.v1.SettlementService.RemoveTagFromSettlement:output_type -> settlement.v1.RemoveTagFromSettlementResponse
+	79,  // 117: settlement.v1.SettlementService.GetLevelUpRequirements:output_type -> settlement.v1.GetLevelUpRequirementsResponse
+	81,  // 118: settlement.v1.SettlementService.SetLevelUpRequirements:output_type -> settlement.v1.SetLevelUpRequirementsResponse
+	83,  // 119: settlement.v1.SettlementService.ListLevelUpRequirements:output_type -> settlement.v1.ListLevelUpRequirementsResponse
+	74,  // 120: settlement.v1.SettlementService.SetActivityExemption:output_type -> settlement.v1.SetActivityExemptionResponse
+	60,  // 121: settlement.v1.SettlementService.GetTreasury:output_type -> settlement.v1.GetTreasuryResponse
+	62,  // 122: settlement.v1.SettlementService.DepositToTreasury:output_type -> settlement.v1.DepositToTreasuryResponse
+	64,  // 123: settlement.v1.SettlementService.WithdrawFromTreasury:output_type -> settlement.v1.WithdrawFromTreasuryResponse
+	66,  // 124: settlement.v1.SettlementService.BuyShopItemFromTreasury:output_type -> settlement.v1.BuyShopItemFromTreasuryResponse
+	68,  // 125: settlement.v1.SettlementService.ListTreasuryLedger:output_type -> settlement.v1.ListTreasuryLedgerResponse
+	70,  // 126: settlement.v1.SettlementService.AssignTreasurer:output_type -> settlement.v1.AssignTreasurerResponse
+	72,  // 127: settlement.v1.SettlementService.RevokeTreasurer:output_type -> settlement.v1.RevokeTreasurerResponse
+	86,  // 128: settlement.v1.SettlementService.CreateBoardPost:output_type -> settlement.v1.CreateBoardPostResponse
+	88,  // 129: settlement.v1.SettlementService.ListBoardPosts:output_type -> settlement.v1.ListBoardPostsResponse
+	88,  // 130: settlement.v1.SettlementService.ListPublicBoardPosts:output_type -> settlement.v1.ListBoardPostsResponse
+	90,  // 131: settlement.v1.SettlementService.PinBoardPost:output_type -> settlement.v1.PinBoardPostResponse
+	92,  // 132: settlement.v1.SettlementService.DeleteBoardPost:output_type -> settlement.v1.DeleteBoardPostResponse
+	94,  // 133: settlement.v1.SettlementService.TakeDownBoardPost:output_type -> settlement.v1.TakeDownBoardPostResponse
+	96,  // 134: settlement.v1.SettlementService.AssignHerald:output_type -> settlement.v1.AssignHeraldResponse
+	98,  // 135: settlement.v1.SettlementService.RevokeHerald:output_type -> settlement.v1.RevokeHeraldResponse
+	94,  // [94:136] is the sub-list for method output_type
+	52,  // [52:94] is the sub-list for method input_type
+	52,  // [52:52] is the sub-list for extension type_name
+	52,  // [52:52] is the sub-list for extension extendee
+	0,   // [0:52] is the sub-list for field type_name
 }
 
 func init() { file_settlement_v1_settlement_proto_init() }
@@ -3570,8 +6090,8 @@ func file_settlement_v1_settlement_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_settlement_v1_settlement_proto_rawDesc), len(file_settlement_v1_settlement_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   54,
+			NumEnums:      5,
+			NumMessages:   96,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
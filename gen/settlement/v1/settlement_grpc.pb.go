@@ -42,6 +42,25 @@ const (
 	SettlementService_TransferImperialFavor_FullMethodName   = "/settlement.v1.SettlementService/TransferImperialFavor"
 	SettlementService_AddTagToSettlement_FullMethodName      = "/settlement.v1.SettlementService/AddTagToSettlement"
 	SettlementService_RemoveTagFromSettlement_FullMethodName = "/settlement.v1.SettlementService/RemoveTagFromSettlement"
+	SettlementService_GetLevelUpRequirements_FullMethodName  = "/settlement.v1.SettlementService/GetLevelUpRequirements"
+	SettlementService_SetLevelUpRequirements_FullMethodName  = "/settlement.v1.SettlementService/SetLevelUpRequirements"
+	SettlementService_ListLevelUpRequirements_FullMethodName = "/settlement.v1.SettlementService/ListLevelUpRequirements"
+	SettlementService_SetActivityExemption_FullMethodName    = "/settlement.v1.SettlementService/SetActivityExemption"
+	SettlementService_GetTreasury_FullMethodName             = "/settlement.v1.SettlementService/GetTreasury"
+	SettlementService_DepositToTreasury_FullMethodName       = "/settlement.v1.SettlementService/DepositToTreasury"
+	SettlementService_WithdrawFromTreasury_FullMethodName    = "/settlement.v1.SettlementService/WithdrawFromTreasury"
+	SettlementService_BuyShopItemFromTreasury_FullMethodName = "/settlement.v1.SettlementService/BuyShopItemFromTreasury"
+	SettlementService_ListTreasuryLedger_FullMethodName      = "/settlement.v1.SettlementService/ListTreasuryLedger"
+	SettlementService_AssignTreasurer_FullMethodName         = "/settlement.v1.SettlementService/AssignTreasurer"
+	SettlementService_RevokeTreasurer_FullMethodName         = "/settlement.v1.SettlementService/RevokeTreasurer"
+	SettlementService_CreateBoardPost_FullMethodName         = "/settlement.v1.SettlementService/CreateBoardPost"
+	SettlementService_ListBoardPosts_FullMethodName          = "/settlement.v1.SettlementService/ListBoardPosts"
+	SettlementService_ListPublicBoardPosts_FullMethodName    = "/settlement.v1.SettlementService/ListPublicBoardPosts"
+	SettlementService_PinBoardPost_FullMethodName            = "/settlement.v1.SettlementService/PinBoardPost"
+	SettlementService_DeleteBoardPost_FullMethodName         = "/settlement.v1.SettlementService/DeleteBoardPost"
+	SettlementService_TakeDownBoardPost_FullMethodName       = "/settlement.v1.SettlementService/TakeDownBoardPost"
+	SettlementService_AssignHerald_FullMethodName            = "/settlement.v1.SettlementService/AssignHerald"
+	SettlementService_RevokeHerald_FullMethodName            = "/settlement.v1.SettlementService/RevokeHerald"
 )
 
 // SettlementServiceClient is the client API for SettlementService service.
@@ -53,9 +72,14 @@ type SettlementServiceClient interface {
 	// Submit or level-up a settlement request.
 	//
 	// On first call creates a new request; on subsequent calls upgrades an approved settlement.
+	// A level-up is refused while any requirement configured for the next type is unmet.
 	//
 	// Errors:
 	//   - INVALID_ARGUMENT (400): attachments is empty; invalid settlement type
+	//   - FAILED_PRECONDITION (400): level-up requirements unmet; the status carries a
+	//     google.rpc.PreconditionFailure with one violation per unmet requirement, whose
+	//     type is the requirement kind (e.g. "members") and subject the talent node as
+	//     "tree_id/node_id" for node requirements
 	//   - ALREADY_EXISTS (409): settlement request is already pending
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): storage upload or database failure
@@ -131,6 +155,7 @@ type SettlementServiceClient interface {
 	//
 	// Errors:
 	//   - NOT_FOUND (404): invitation not found
+	//   - RESOURCE_EXHAUSTED (429): settlement has reached its member cap
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*AcceptInvitationResponse, error)
@@ -146,6 +171,7 @@ type SettlementServiceClient interface {
 	// Errors:
 	//   - PERMISSION_DENIED (403): caller is not the settlement leader
 	//   - ALREADY_EXISTS (409): user is already a member of the settlement
+	//   - RESOURCE_EXHAUSTED (429): settlement has reached its member cap
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*InviteMemberResponse, error)
@@ -202,12 +228,17 @@ type SettlementServiceClient interface {
 	// Transfer Imperial Favor from one settlement to another.
 	// Caller must be the leader of from_settlement_id.
 	//
+	// The transfer is journaled before either balance moves. A transfer interrupted
+	// between debit and credit is finished in the background, or the source refunded
+	// if the target no longer exists.
+	//
 	// Errors:
 	//   - INVALID_ARGUMENT (400): amount <= 0 or missing fields
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): caller is not the leader of from_settlement_id
+	//   - NOT_FOUND (404): target settlement not found; the source is refunded
 	//   - FAILED_PRECONDITION (412): insufficient imperial favor balance
-	//   - INTERNAL (500): database failure
+	//   - INTERNAL (500): database failure; the transfer is finished in the background
 	TransferImperialFavor(ctx context.Context, in *TransferImperialFavorRequest, opts ...grpc.CallOption) (*TransferImperialFavorResponse, error)
 	// Add a tag to a settlement. Requires tags:manage privilege.
 	//
@@ -227,6 +258,179 @@ type SettlementServiceClient interface {
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	RemoveTagFromSettlement(ctx context.Context, in *RemoveTagFromSettlementRequest, opts ...grpc.CallOption) (*RemoveTagFromSettlementResponse, error)
+	// Show the settlement's progress toward its next level. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetLevelUpRequirements(ctx context.Context, in *GetLevelUpRequirementsRequest, opts ...grpc.CallOption) (*GetLevelUpRequirementsResponse, error)
+	// Configure the requirements for leveling up to a type, replacing any set before.
+	// Fees are charged when the level-up is approved. Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): type is camp or unspecified; negative value; incomplete node reference
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	SetLevelUpRequirements(ctx context.Context, in *SetLevelUpRequirementsRequest, opts ...grpc.CallOption) (*SetLevelUpRequirementsResponse, error)
+	// List the configured level-up requirements. Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListLevelUpRequirements(ctx context.Context, in *ListLevelUpRequirementsRequest, opts ...grpc.CallOption) (*ListLevelUpRequirementsResponse, error)
+	// Exempt a settlement from inactivity decay, or lift the exemption.
+	// Exempting clears any inactive flag and unhides the settlement.
+	// Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	SetActivityExemption(ctx context.Context, in *SetActivityExemptionRequest, opts ...grpc.CallOption) (*SetActivityExemptionResponse, error)
+	// Get the settlement treasury: balance and treasurers. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetTreasury(ctx context.Context, in *GetTreasuryRequest, opts ...grpc.CallOption) (*GetTreasuryResponse, error)
+	// Deposit donate coins from the caller's wallet into the settlement treasury.
+	// Caller must be a member.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): amount must be positive
+	//   - NOT_FOUND (404): settlement or caller's wallet not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - FAILED_PRECONDITION (412): insufficient funds in the caller's wallet
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	DepositToTreasury(ctx context.Context, in *DepositToTreasuryRequest, opts ...grpc.CallOption) (*DepositToTreasuryResponse, error)
+	// Withdraw coins from the treasury into a member's donate wallet.
+	// Caller must be the leader or a treasurer.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): amount must be positive; recipient is not a member
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a treasurer
+	//   - FAILED_PRECONDITION (412): insufficient treasury balance
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	WithdrawFromTreasury(ctx context.Context, in *WithdrawFromTreasuryRequest, opts ...grpc.CallOption) (*WithdrawFromTreasuryResponse, error)
+	// Buy a shop item for the settlement with treasury coins. The purchase is
+	// issued to the caller, who must be the leader or a treasurer.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or shop item not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a treasurer
+	//   - FAILED_PRECONDITION (412): insufficient treasury balance
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	BuyShopItemFromTreasury(ctx context.Context, in *BuyShopItemFromTreasuryRequest, opts ...grpc.CallOption) (*BuyShopItemFromTreasuryResponse, error)
+	// List treasury ledger entries, newest first. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListTreasuryLedger(ctx context.Context, in *ListTreasuryLedgerRequest, opts ...grpc.CallOption) (*ListTreasuryLedgerResponse, error)
+	// Grant a member the treasurer role. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a member; user is already a treasurer
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	AssignTreasurer(ctx context.Context, in *AssignTreasurerRequest, opts ...grpc.CallOption) (*AssignTreasurerResponse, error)
+	// Revoke the treasurer role from a member. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a treasurer
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	RevokeTreasurer(ctx context.Context, in *RevokeTreasurerRequest, opts ...grpc.CallOption) (*RevokeTreasurerResponse, error)
+	// Publish a post on the settlement's bulletin board. Caller must be the
+	// leader or a herald. Announcements are pinned and notify every member.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): empty or too long title or body; too many
+	//     attachments; invalid attachment url; unspecified visibility
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a herald
+	//   - RESOURCE_EXHAUSTED (429): caller posted too often, try again later
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	CreateBoardPost(ctx context.Context, in *CreateBoardPostRequest, opts ...grpc.CallOption) (*CreateBoardPostResponse, error)
+	// List board posts, newest first. Members see every post; other callers
+	// see public posts only. Pinned posts come with the first page.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - NOT_FOUND (404): settlement not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListBoardPosts(ctx context.Context, in *ListBoardPostsRequest, opts ...grpc.CallOption) (*ListBoardPostsResponse, error)
+	// List public board posts, newest first. No authentication required.
+	// Pinned posts come with the first page.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - NOT_FOUND (404): settlement not found
+	//   - INTERNAL (500): database failure
+	ListPublicBoardPosts(ctx context.Context, in *ListBoardPostsRequest, opts ...grpc.CallOption) (*ListBoardPostsResponse, error)
+	// Pin or unpin a board post. Caller must be the leader.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or post not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	PinBoardPost(ctx context.Context, in *PinBoardPostRequest, opts ...grpc.CallOption) (*PinBoardPostResponse, error)
+	// Delete a board post. Caller must be its author or the leader.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or post not found
+	//   - PERMISSION_DENIED (403): caller is neither the author nor the leader
+	//   - FAILED_PRECONDITION (412): post is already removed
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	DeleteBoardPost(ctx context.Context, in *DeleteBoardPostRequest, opts ...grpc.CallOption) (*DeleteBoardPostResponse, error)
+	// Take a board post down as a moderator. Requires settlements:moderate.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): post not found
+	//   - FAILED_PRECONDITION (412): post is already removed
+	//   - PERMISSION_DENIED (403): missing settlements:moderate scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	TakeDownBoardPost(ctx context.Context, in *TakeDownBoardPostRequest, opts ...grpc.CallOption) (*TakeDownBoardPostResponse, error)
+	// Grant a member the right to post on the board. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a member; user is already a herald
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	AssignHerald(ctx context.Context, in *AssignHeraldRequest, opts ...grpc.CallOption) (*AssignHeraldResponse, error)
+	// Revoke the right to post on the board. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a herald
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	RevokeHerald(ctx context.Context, in *RevokeHeraldRequest, opts ...grpc.CallOption) (*RevokeHeraldResponse, error)
 }
 
 type settlementServiceClient struct {
@@ -467,6 +671,196 @@ func (c *settlementServiceClient) RemoveTagFromSettlement(ctx context.Context, i
 	return out, nil
 }
 
+func (c *settlementServiceClient) GetLevelUpRequirements(ctx context.Context, in *GetLevelUpRequirementsRequest, opts ...grpc.CallOption) (*GetLevelUpRequirementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLevelUpRequirementsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_GetLevelUpRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) SetLevelUpRequirements(ctx context.Context, in *SetLevelUpRequirementsRequest, opts ...grpc.CallOption) (*SetLevelUpRequirementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetLevelUpRequirementsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_SetLevelUpRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListLevelUpRequirements(ctx context.Context, in *ListLevelUpRequirementsRequest, opts ...grpc.CallOption) (*ListLevelUpRequirementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLevelUpRequirementsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_ListLevelUpRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) SetActivityExemption(ctx context.Context, in *SetActivityExemptionRequest, opts ...grpc.CallOption) (*SetActivityExemptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetActivityExemptionResponse)
+	err := c.cc.Invoke(ctx, SettlementService_SetActivityExemption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) GetTreasury(ctx context.Context, in *GetTreasuryRequest, opts ...grpc.CallOption) (*GetTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTreasuryResponse)
+	err := c.cc.Invoke(ctx, SettlementService_GetTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) DepositToTreasury(ctx context.Context, in *DepositToTreasuryRequest, opts ...grpc.CallOption) (*DepositToTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DepositToTreasuryResponse)
+	err := c.cc.Invoke(ctx, SettlementService_DepositToTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) WithdrawFromTreasury(ctx context.Context, in *WithdrawFromTreasuryRequest, opts ...grpc.CallOption) (*WithdrawFromTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawFromTreasuryResponse)
+	err := c.cc.Invoke(ctx, SettlementService_WithdrawFromTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) BuyShopItemFromTreasury(ctx context.Context, in *BuyShopItemFromTreasuryRequest, opts ...grpc.CallOption) (*BuyShopItemFromTreasuryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuyShopItemFromTreasuryResponse)
+	err := c.cc.Invoke(ctx, SettlementService_BuyShopItemFromTreasury_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListTreasuryLedger(ctx context.Context, in *ListTreasuryLedgerRequest, opts ...grpc.CallOption) (*ListTreasuryLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTreasuryLedgerResponse)
+	err := c.cc.Invoke(ctx, SettlementService_ListTreasuryLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) AssignTreasurer(ctx context.Context, in *AssignTreasurerRequest, opts ...grpc.CallOption) (*AssignTreasurerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignTreasurerResponse)
+	err := c.cc.Invoke(ctx, SettlementService_AssignTreasurer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) RevokeTreasurer(ctx context.Context, in *RevokeTreasurerRequest, opts ...grpc.CallOption) (*RevokeTreasurerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTreasurerResponse)
+	err := c.cc.Invoke(ctx, SettlementService_RevokeTreasurer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) CreateBoardPost(ctx context.Context, in *CreateBoardPostRequest, opts ...grpc.CallOption) (*CreateBoardPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBoardPostResponse)
+	err := c.cc.Invoke(ctx, SettlementService_CreateBoardPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListBoardPosts(ctx context.Context, in *ListBoardPostsRequest, opts ...grpc.CallOption) (*ListBoardPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardPostsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_ListBoardPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) ListPublicBoardPosts(ctx context.Context, in *ListBoardPostsRequest, opts ...grpc.CallOption) (*ListBoardPostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBoardPostsResponse)
+	err := c.cc.Invoke(ctx, SettlementService_ListPublicBoardPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) PinBoardPost(ctx context.Context, in *PinBoardPostRequest, opts ...grpc.CallOption) (*PinBoardPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinBoardPostResponse)
+	err := c.cc.Invoke(ctx, SettlementService_PinBoardPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) DeleteBoardPost(ctx context.Context, in *DeleteBoardPostRequest, opts ...grpc.CallOption) (*DeleteBoardPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBoardPostResponse)
+	err := c.cc.Invoke(ctx, SettlementService_DeleteBoardPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) TakeDownBoardPost(ctx context.Context, in *TakeDownBoardPostRequest, opts ...grpc.CallOption) (*TakeDownBoardPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakeDownBoardPostResponse)
+	err := c.cc.Invoke(ctx, SettlementService_TakeDownBoardPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) AssignHerald(ctx context.Context, in *AssignHeraldRequest, opts ...grpc.CallOption) (*AssignHeraldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignHeraldResponse)
+	err := c.cc.Invoke(ctx, SettlementService_AssignHerald_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settlementServiceClient) RevokeHerald(ctx context.Context, in *RevokeHeraldRequest, opts ...grpc.CallOption) (*RevokeHeraldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeHeraldResponse)
+	err := c.cc.Invoke(ctx, SettlementService_RevokeHerald_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SettlementServiceServer is the server API for SettlementService service.
 // All implementations should embed UnimplementedSettlementServiceServer
 // for forward compatibility.
@@ -476,9 +870,14 @@ type SettlementServiceServer interface {
 	// Submit or level-up a settlement request.
 	//
 	// On first call creates a new request; on subsequent calls upgrades an approved settlement.
+	// A level-up is refused while any requirement configured for the next type is unmet.
 	//
 	// Errors:
 	//   - INVALID_ARGUMENT (400): attachments is empty; invalid settlement type
+	//   - FAILED_PRECONDITION (400): level-up requirements unmet; the status carries a
+	//     google.rpc.PreconditionFailure with one violation per unmet requirement, whose
+	//     type is the requirement kind (e.g. "members") and subject the talent node as
+	//     "tree_id/node_id" for node requirements
 	//   - ALREADY_EXISTS (409): settlement request is already pending
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): storage upload or database failure
@@ -554,6 +953,7 @@ type SettlementServiceServer interface {
 	//
 	// Errors:
 	//   - NOT_FOUND (404): invitation not found
+	//   - RESOURCE_EXHAUSTED (429): settlement has reached its member cap
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*AcceptInvitationResponse, error)
@@ -569,6 +969,7 @@ type SettlementServiceServer interface {
 	// Errors:
 	//   - PERMISSION_DENIED (403): caller is not the settlement leader
 	//   - ALREADY_EXISTS (409): user is already a member of the settlement
+	//   - RESOURCE_EXHAUSTED (429): settlement has reached its member cap
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	InviteMember(context.Context, *InviteMemberRequest) (*InviteMemberResponse, error)
@@ -625,12 +1026,17 @@ type SettlementServiceServer interface {
 	// Transfer Imperial Favor from one settlement to another.
 	// Caller must be the leader of from_settlement_id.
 	//
+	// The transfer is journaled before either balance moves. A transfer interrupted
+	// between debit and credit is finished in the background, or the source refunded
+	// if the target no longer exists.
+	//
 	// Errors:
 	//   - INVALID_ARGUMENT (400): amount <= 0 or missing fields
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - PERMISSION_DENIED (403): caller is not the leader of from_settlement_id
+	//   - NOT_FOUND (404): target settlement not found; the source is refunded
 	//   - FAILED_PRECONDITION (412): insufficient imperial favor balance
-	//   - INTERNAL (500): database failure
+	//   - INTERNAL (500): database failure; the transfer is finished in the background
 	TransferImperialFavor(context.Context, *TransferImperialFavorRequest) (*TransferImperialFavorResponse, error)
 	// Add a tag to a settlement. Requires tags:manage privilege.
 	//
@@ -650,34 +1056,207 @@ type SettlementServiceServer interface {
 	//   - UNAUTHENTICATED (401): missing or invalid auth token
 	//   - INTERNAL (500): database failure
 	RemoveTagFromSettlement(context.Context, *RemoveTagFromSettlementRequest) (*RemoveTagFromSettlementResponse, error)
-}
-
-// UnimplementedSettlementServiceServer should be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSettlementServiceServer struct{}
-
-func (UnimplementedSettlementServiceServer) Submit(context.Context, *SubmitRequest) (*SubmitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
-}
-func (UnimplementedSettlementServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (UnimplementedSettlementServiceServer) GetByUserId(context.Context, *GetByUserIdRequest) (*GetByUserIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetByUserId not implemented")
-}
-func (UnimplementedSettlementServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (UnimplementedSettlementServiceServer) ListPending(context.Context, *ListPendingRequest) (*ListPendingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPending not implemented")
-}
-func (UnimplementedSettlementServiceServer) Approve(context.Context, *ApproveRequest) (*ApproveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
-}
-func (UnimplementedSettlementServiceServer) Reject(context.Context, *RejectRequest) (*RejectResponse, error) {
+	// Show the settlement's progress toward its next level. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetLevelUpRequirements(context.Context, *GetLevelUpRequirementsRequest) (*GetLevelUpRequirementsResponse, error)
+	// Configure the requirements for leveling up to a type, replacing any set before.
+	// Fees are charged when the level-up is approved. Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): type is camp or unspecified; negative value; incomplete node reference
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	SetLevelUpRequirements(context.Context, *SetLevelUpRequirementsRequest) (*SetLevelUpRequirementsResponse, error)
+	// List the configured level-up requirements. Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListLevelUpRequirements(context.Context, *ListLevelUpRequirementsRequest) (*ListLevelUpRequirementsResponse, error)
+	// Exempt a settlement from inactivity decay, or lift the exemption.
+	// Exempting clears any inactive flag and unhides the settlement.
+	// Requires settlements:manage scope.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): missing settlements:manage scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	SetActivityExemption(context.Context, *SetActivityExemptionRequest) (*SetActivityExemptionResponse, error)
+	// Get the settlement treasury: balance and treasurers. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	GetTreasury(context.Context, *GetTreasuryRequest) (*GetTreasuryResponse, error)
+	// Deposit donate coins from the caller's wallet into the settlement treasury.
+	// Caller must be a member.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): amount must be positive
+	//   - NOT_FOUND (404): settlement or caller's wallet not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - FAILED_PRECONDITION (412): insufficient funds in the caller's wallet
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	DepositToTreasury(context.Context, *DepositToTreasuryRequest) (*DepositToTreasuryResponse, error)
+	// Withdraw coins from the treasury into a member's donate wallet.
+	// Caller must be the leader or a treasurer.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): amount must be positive; recipient is not a member
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a treasurer
+	//   - FAILED_PRECONDITION (412): insufficient treasury balance
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	WithdrawFromTreasury(context.Context, *WithdrawFromTreasuryRequest) (*WithdrawFromTreasuryResponse, error)
+	// Buy a shop item for the settlement with treasury coins. The purchase is
+	// issued to the caller, who must be the leader or a treasurer.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or shop item not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a treasurer
+	//   - FAILED_PRECONDITION (412): insufficient treasury balance
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	BuyShopItemFromTreasury(context.Context, *BuyShopItemFromTreasuryRequest) (*BuyShopItemFromTreasuryResponse, error)
+	// List treasury ledger entries, newest first. Caller must be a member.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not a member of the settlement
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListTreasuryLedger(context.Context, *ListTreasuryLedgerRequest) (*ListTreasuryLedgerResponse, error)
+	// Grant a member the treasurer role. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a member; user is already a treasurer
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	AssignTreasurer(context.Context, *AssignTreasurerRequest) (*AssignTreasurerResponse, error)
+	// Revoke the treasurer role from a member. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a treasurer
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	RevokeTreasurer(context.Context, *RevokeTreasurerRequest) (*RevokeTreasurerResponse, error)
+	// Publish a post on the settlement's bulletin board. Caller must be the
+	// leader or a herald. Announcements are pinned and notify every member.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): empty or too long title or body; too many
+	//     attachments; invalid attachment url; unspecified visibility
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader or a herald
+	//   - RESOURCE_EXHAUSTED (429): caller posted too often, try again later
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	CreateBoardPost(context.Context, *CreateBoardPostRequest) (*CreateBoardPostResponse, error)
+	// List board posts, newest first. Members see every post; other callers
+	// see public posts only. Pinned posts come with the first page.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - NOT_FOUND (404): settlement not found
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	ListBoardPosts(context.Context, *ListBoardPostsRequest) (*ListBoardPostsResponse, error)
+	// List public board posts, newest first. No authentication required.
+	// Pinned posts come with the first page.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): invalid page token
+	//   - NOT_FOUND (404): settlement not found
+	//   - INTERNAL (500): database failure
+	ListPublicBoardPosts(context.Context, *ListBoardPostsRequest) (*ListBoardPostsResponse, error)
+	// Pin or unpin a board post. Caller must be the leader.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or post not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	PinBoardPost(context.Context, *PinBoardPostRequest) (*PinBoardPostResponse, error)
+	// Delete a board post. Caller must be its author or the leader.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): settlement or post not found
+	//   - PERMISSION_DENIED (403): caller is neither the author nor the leader
+	//   - FAILED_PRECONDITION (412): post is already removed
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	DeleteBoardPost(context.Context, *DeleteBoardPostRequest) (*DeleteBoardPostResponse, error)
+	// Take a board post down as a moderator. Requires settlements:moderate.
+	//
+	// Errors:
+	//   - NOT_FOUND (404): post not found
+	//   - FAILED_PRECONDITION (412): post is already removed
+	//   - PERMISSION_DENIED (403): missing settlements:moderate scope
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	TakeDownBoardPost(context.Context, *TakeDownBoardPostRequest) (*TakeDownBoardPostResponse, error)
+	// Grant a member the right to post on the board. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a member; user is already a herald
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	AssignHerald(context.Context, *AssignHeraldRequest) (*AssignHeraldResponse, error)
+	// Revoke the right to post on the board. Caller must be the leader.
+	//
+	// Errors:
+	//   - INVALID_ARGUMENT (400): user is not a herald
+	//   - NOT_FOUND (404): settlement not found
+	//   - PERMISSION_DENIED (403): caller is not the leader
+	//   - UNAUTHENTICATED (401): missing or invalid auth token
+	//   - INTERNAL (500): database failure
+	RevokeHerald(context.Context, *RevokeHeraldRequest) (*RevokeHeraldResponse, error)
+}
+
+// UnimplementedSettlementServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettlementServiceServer struct{}
+
+func (UnimplementedSettlementServiceServer) Submit(context.Context, *SubmitRequest) (*SubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedSettlementServiceServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSettlementServiceServer) GetByUserId(context.Context, *GetByUserIdRequest) (*GetByUserIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByUserId not implemented")
+}
+func (UnimplementedSettlementServiceServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListPending(context.Context, *ListPendingRequest) (*ListPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPending not implemented")
+}
+func (UnimplementedSettlementServiceServer) Approve(context.Context, *ApproveRequest) (*ApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedSettlementServiceServer) Reject(context.Context, *RejectRequest) (*RejectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reject not implemented")
 }
 func (UnimplementedSettlementServiceServer) VerificationStatus(context.Context, *VerificationStatusRequest) (*VerificationStatusResponse, error) {
@@ -728,6 +1307,63 @@ func (UnimplementedSettlementServiceServer) AddTagToSettlement(context.Context,
 func (UnimplementedSettlementServiceServer) RemoveTagFromSettlement(context.Context, *RemoveTagFromSettlementRequest) (*RemoveTagFromSettlementResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTagFromSettlement not implemented")
 }
+func (UnimplementedSettlementServiceServer) GetLevelUpRequirements(context.Context, *GetLevelUpRequirementsRequest) (*GetLevelUpRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLevelUpRequirements not implemented")
+}
+func (UnimplementedSettlementServiceServer) SetLevelUpRequirements(context.Context, *SetLevelUpRequirementsRequest) (*SetLevelUpRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLevelUpRequirements not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListLevelUpRequirements(context.Context, *ListLevelUpRequirementsRequest) (*ListLevelUpRequirementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLevelUpRequirements not implemented")
+}
+func (UnimplementedSettlementServiceServer) SetActivityExemption(context.Context, *SetActivityExemptionRequest) (*SetActivityExemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetActivityExemption not implemented")
+}
+func (UnimplementedSettlementServiceServer) GetTreasury(context.Context, *GetTreasuryRequest) (*GetTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTreasury not implemented")
+}
+func (UnimplementedSettlementServiceServer) DepositToTreasury(context.Context, *DepositToTreasuryRequest) (*DepositToTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositToTreasury not implemented")
+}
+func (UnimplementedSettlementServiceServer) WithdrawFromTreasury(context.Context, *WithdrawFromTreasuryRequest) (*WithdrawFromTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFromTreasury not implemented")
+}
+func (UnimplementedSettlementServiceServer) BuyShopItemFromTreasury(context.Context, *BuyShopItemFromTreasuryRequest) (*BuyShopItemFromTreasuryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyShopItemFromTreasury not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListTreasuryLedger(context.Context, *ListTreasuryLedgerRequest) (*ListTreasuryLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTreasuryLedger not implemented")
+}
+func (UnimplementedSettlementServiceServer) AssignTreasurer(context.Context, *AssignTreasurerRequest) (*AssignTreasurerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTreasurer not implemented")
+}
+func (UnimplementedSettlementServiceServer) RevokeTreasurer(context.Context, *RevokeTreasurerRequest) (*RevokeTreasurerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTreasurer not implemented")
+}
+func (UnimplementedSettlementServiceServer) CreateBoardPost(context.Context, *CreateBoardPostRequest) (*CreateBoardPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoardPost not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListBoardPosts(context.Context, *ListBoardPostsRequest) (*ListBoardPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBoardPosts not implemented")
+}
+func (UnimplementedSettlementServiceServer) ListPublicBoardPosts(context.Context, *ListBoardPostsRequest) (*ListBoardPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicBoardPosts not implemented")
+}
+func (UnimplementedSettlementServiceServer) PinBoardPost(context.Context, *PinBoardPostRequest) (*PinBoardPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinBoardPost not implemented")
+}
+func (UnimplementedSettlementServiceServer) DeleteBoardPost(context.Context, *DeleteBoardPostRequest) (*DeleteBoardPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBoardPost not implemented")
+}
+func (UnimplementedSettlementServiceServer) TakeDownBoardPost(context.Context, *TakeDownBoardPostRequest) (*TakeDownBoardPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeDownBoardPost not implemented")
+}
+func (UnimplementedSettlementServiceServer) AssignHerald(context.Context, *AssignHeraldRequest) (*AssignHeraldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignHerald not implemented")
+}
+func (UnimplementedSettlementServiceServer) RevokeHerald(context.Context, *RevokeHeraldRequest) (*RevokeHeraldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeHerald not implemented")
+}
 func (UnimplementedSettlementServiceServer) testEmbeddedByValue() {}
 
 // UnsafeSettlementServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1162,6 +1798,348 @@ func _SettlementService_RemoveTagFromSettlement_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SettlementService_GetLevelUpRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLevelUpRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GetLevelUpRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GetLevelUpRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GetLevelUpRequirements(ctx, req.(*GetLevelUpRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_SetLevelUpRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLevelUpRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).SetLevelUpRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_SetLevelUpRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).SetLevelUpRequirements(ctx, req.(*SetLevelUpRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListLevelUpRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLevelUpRequirementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListLevelUpRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListLevelUpRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListLevelUpRequirements(ctx, req.(*ListLevelUpRequirementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_SetActivityExemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetActivityExemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).SetActivityExemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_SetActivityExemption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).SetActivityExemption(ctx, req.(*SetActivityExemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_GetTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).GetTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_GetTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).GetTreasury(ctx, req.(*GetTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_DepositToTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositToTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).DepositToTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_DepositToTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).DepositToTreasury(ctx, req.(*DepositToTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_WithdrawFromTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFromTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).WithdrawFromTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_WithdrawFromTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).WithdrawFromTreasury(ctx, req.(*WithdrawFromTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_BuyShopItemFromTreasury_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyShopItemFromTreasuryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).BuyShopItemFromTreasury(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_BuyShopItemFromTreasury_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).BuyShopItemFromTreasury(ctx, req.(*BuyShopItemFromTreasuryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListTreasuryLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTreasuryLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListTreasuryLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListTreasuryLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListTreasuryLedger(ctx, req.(*ListTreasuryLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_AssignTreasurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTreasurerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).AssignTreasurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_AssignTreasurer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).AssignTreasurer(ctx, req.(*AssignTreasurerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_RevokeTreasurer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTreasurerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).RevokeTreasurer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_RevokeTreasurer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).RevokeTreasurer(ctx, req.(*RevokeTreasurerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_CreateBoardPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoardPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).CreateBoardPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_CreateBoardPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).CreateBoardPost(ctx, req.(*CreateBoardPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListBoardPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListBoardPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListBoardPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListBoardPosts(ctx, req.(*ListBoardPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_ListPublicBoardPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBoardPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).ListPublicBoardPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_ListPublicBoardPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).ListPublicBoardPosts(ctx, req.(*ListBoardPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_PinBoardPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinBoardPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).PinBoardPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_PinBoardPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).PinBoardPost(ctx, req.(*PinBoardPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_DeleteBoardPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBoardPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).DeleteBoardPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_DeleteBoardPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).DeleteBoardPost(ctx, req.(*DeleteBoardPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_TakeDownBoardPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeDownBoardPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).TakeDownBoardPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_TakeDownBoardPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).TakeDownBoardPost(ctx, req.(*TakeDownBoardPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_AssignHerald_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignHeraldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).AssignHerald(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_AssignHerald_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).AssignHerald(ctx, req.(*AssignHeraldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettlementService_RevokeHerald_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeHeraldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettlementServiceServer).RevokeHerald(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettlementService_RevokeHerald_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettlementServiceServer).RevokeHerald(ctx, req.(*RevokeHeraldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SettlementService_ServiceDesc is the grpc.ServiceDesc for SettlementService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1261,6 +2239,82 @@ var SettlementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTagFromSettlement",
 			Handler:    _SettlementService_RemoveTagFromSettlement_Handler,
 		},
+		{
+			MethodName: "GetLevelUpRequirements",
+			Handler:    _SettlementService_GetLevelUpRequirements_Handler,
+		},
+		{
+			MethodName: "SetLevelUpRequirements",
+			Handler:    _SettlementService_SetLevelUpRequirements_Handler,
+		},
+		{
+			MethodName: "ListLevelUpRequirements",
+			Handler:    _SettlementService_ListLevelUpRequirements_Handler,
+		},
+		{
+			MethodName: "SetActivityExemption",
+			Handler:    _SettlementService_SetActivityExemption_Handler,
+		},
+		{
+			MethodName: "GetTreasury",
+			Handler:    _SettlementService_GetTreasury_Handler,
+		},
+		{
+			MethodName: "DepositToTreasury",
+			Handler:    _SettlementService_DepositToTreasury_Handler,
+		},
+		{
+			MethodName: "WithdrawFromTreasury",
+			Handler:    _SettlementService_WithdrawFromTreasury_Handler,
+		},
+		{
+			MethodName: "BuyShopItemFromTreasury",
+			Handler:    _SettlementService_BuyShopItemFromTreasury_Handler,
+		},
+		{
+			MethodName: "ListTreasuryLedger",
+			Handler:    _SettlementService_ListTreasuryLedger_Handler,
+		},
+		{
+			MethodName: "AssignTreasurer",
+			Handler:    _SettlementService_AssignTreasurer_Handler,
+		},
+		{
+			MethodName: "RevokeTreasurer",
+			Handler:    _SettlementService_RevokeTreasurer_Handler,
+		},
+		{
+			MethodName: "CreateBoardPost",
+			Handler:    _SettlementService_CreateBoardPost_Handler,
+		},
+		{
+			MethodName: "ListBoardPosts",
+			Handler:    _SettlementService_ListBoardPosts_Handler,
+		},
+		{
+			MethodName: "ListPublicBoardPosts",
+			Handler:    _SettlementService_ListPublicBoardPosts_Handler,
+		},
+		{
+			MethodName: "PinBoardPost",
+			Handler:    _SettlementService_PinBoardPost_Handler,
+		},
+		{
+			MethodName: "DeleteBoardPost",
+			Handler:    _SettlementService_DeleteBoardPost_Handler,
+		},
+		{
+			MethodName: "TakeDownBoardPost",
+			Handler:    _SettlementService_TakeDownBoardPost_Handler,
+		},
+		{
+			MethodName: "AssignHerald",
+			Handler:    _SettlementService_AssignHerald_Handler,
+		},
+		{
+			MethodName: "RevokeHerald",
+			Handler:    _SettlementService_RevokeHerald_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "settlement/v1/settlement.proto",
@@ -3,6 +3,7 @@ package donateuc
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"go.uber.org/fx"
 )
@@ -10,12 +11,27 @@ import (
 // ErrNonPositiveAmount is returned when a caller credits a non-positive amount.
 var ErrNonPositiveAmount = errors.New("amount must be positive")
 
+// ErrMissingOpKey is returned when an idempotent operation is called without
+// an op key.
+var ErrMissingOpKey = errors.New("op key is required")
+
+// ErrLedgerNotRecorded wraps a ledger write failure that happened after the
+// wallet already moved. Callers that compensate on failure must check for it
+// with errors.Is: the coins did move, so undoing the caller's side would be wrong.
+var ErrLedgerNotRecorded = errors.New("wallet updated but ledger entry not recorded")
+
 // WalletRepo is the donate-side write port used by other domains. It is
 // deliberately primitive-typed so donate's internal model and DTO types never
 // cross this seam. Bound to the donate Mongo repository in internal/donate/fx.go.
 type WalletRepo interface {
 	AddCoinsToWallet(ctx context.Context, playerID, playerName string, amount int64) (int64, error)
 	CreateCreditTransaction(ctx context.Context, playerID string, amount int64, reason string) error
+	// CreditWalletOnce adds amount unless the credit for opKey was already
+	// applied, reporting whether this call applied it.
+	CreditWalletOnce(ctx context.Context, playerID, playerName string, amount int64, opKey string) (bool, error)
+	// CreateCreditTransactionOnce records the ledger entry for opKey unless
+	// it already exists.
+	CreateCreditTransactionOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error
 }
 
 type Opts struct {
@@ -56,11 +72,39 @@ func (uc *AddCoinsUseCase) AddCoins(ctx context.Context, playerID, playerName st
 // transaction ledger, so a cross-domain reward is a single call rather than two
 // a caller must remember to pair. The wallet increment is the operation that
 // must not be lost: if the ledger write fails the coins stay credited and the
-// error is returned so the caller can log it.
+// error, wrapped in ErrLedgerNotRecorded, is returned so the caller can log it.
 func (uc *AddCoinsUseCase) Credit(ctx context.Context, playerID, playerName string, amount int64, reason string) error {
 	if err := uc.AddCoins(ctx, playerID, playerName, amount); err != nil {
 		return err
 	}
 
-	return uc.repo.CreateCreditTransaction(ctx, playerID, amount, reason)
+	if err := uc.repo.CreateCreditTransaction(ctx, playerID, amount, reason); err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerNotRecorded, err)
+	}
+	return nil
+}
+
+// CreditOnce is Credit made safe to retry: the wallet moves and the ledger
+// entry is written at most once per opKey, however often it is called. A
+// caller retrying after any error, ErrLedgerNotRecorded included, completes
+// the credit without paying it twice. opKey must be deterministic for the
+// reward it pays, e.g. derived from the season and player.
+func (uc *AddCoinsUseCase) CreditOnce(ctx context.Context, playerID, playerName string, amount int64, reason, opKey string) error {
+	if amount <= 0 {
+		return ErrNonPositiveAmount
+	}
+	if opKey == "" {
+		return ErrMissingOpKey
+	}
+
+	if _, err := uc.repo.CreditWalletOnce(ctx, playerID, playerName, amount, opKey); err != nil {
+		return err
+	}
+
+	// Written even when the wallet already held the key: the earlier
+	// attempt may have stopped between the two writes.
+	if err := uc.repo.CreateCreditTransactionOnce(ctx, playerID, amount, reason, opKey); err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerNotRecorded, err)
+	}
+	return nil
 }
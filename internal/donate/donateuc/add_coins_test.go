@@ -14,6 +14,7 @@ import (
 type fakeWalletRepo struct {
 	coins map[string]int64
 	names map[string]string
+	ops   map[string]bool
 	txs   []creditTx
 
 	addErr error
@@ -24,12 +25,14 @@ type creditTx struct {
 	playerID string
 	amount   int64
 	reason   string
+	opKey    string
 }
 
 func newFakeWalletRepo() *fakeWalletRepo {
 	return &fakeWalletRepo{
 		coins: map[string]int64{},
 		names: map[string]string{},
+		ops:   map[string]bool{},
 	}
 }
 
@@ -52,6 +55,30 @@ func (f *fakeWalletRepo) CreateCreditTransaction(_ context.Context, playerID str
 	return nil
 }
 
+func (f *fakeWalletRepo) CreditWalletOnce(ctx context.Context, playerID, playerName string, amount int64, opKey string) (bool, error) {
+	if f.ops[opKey] {
+		return false, nil
+	}
+	if _, err := f.AddCoinsToWallet(ctx, playerID, playerName, amount); err != nil {
+		return false, err
+	}
+	f.ops[opKey] = true
+	return true, nil
+}
+
+func (f *fakeWalletRepo) CreateCreditTransactionOnce(_ context.Context, playerID string, amount int64, reason, opKey string) error {
+	if f.txErr != nil {
+		return f.txErr
+	}
+	for _, tx := range f.txs {
+		if tx.opKey == opKey {
+			return nil
+		}
+	}
+	f.txs = append(f.txs, creditTx{playerID: playerID, amount: amount, reason: reason, opKey: opKey})
+	return nil
+}
+
 func newUC(repo donateuc.WalletRepo) *donateuc.AddCoinsUseCase {
 	return donateuc.NewAddCoinsUseCase(donateuc.Opts{Repo: repo})
 }
@@ -117,8 +144,8 @@ func TestCreditKeepsCoinsWhenLedgerFails(t *testing.T) {
 	repo.txErr = errors.New("insert failed")
 
 	err := newUC(repo).Credit(context.Background(), "p1", "Bob", 7, "reward")
-	if err == nil {
-		t.Fatal("Credit: got nil error, want the ledger error")
+	if !errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+		t.Fatalf("Credit: got %v, want ErrLedgerNotRecorded", err)
 	}
 	if got := repo.coins["p1"]; got != 7 {
 		t.Fatalf("coins = %d, want the wallet increment to survive a ledger failure", got)
@@ -136,3 +163,40 @@ func TestCreditSkipsLedgerWhenWalletFails(t *testing.T) {
 		t.Fatalf("transactions = %+v, want none when the wallet write failed", repo.txs)
 	}
 }
+
+// A retried CreditOnce — including one retried because the ledger write
+// failed — pays the wallet and writes the ledger exactly once.
+func TestCreditOncePaysOnceAcrossRetries(t *testing.T) {
+	repo := newFakeWalletRepo()
+	uc := newUC(repo)
+	ctx := context.Background()
+
+	repo.txErr = errors.New("insert failed")
+	if err := uc.CreditOnce(ctx, "p1", "Bob", 7, "reward", "season:s1:p1"); !errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+		t.Fatalf("first CreditOnce: got %v, want ErrLedgerNotRecorded", err)
+	}
+	repo.txErr = nil
+	for range 2 {
+		if err := uc.CreditOnce(ctx, "p1", "Bob", 7, "reward", "season:s1:p1"); err != nil {
+			t.Fatalf("retried CreditOnce: %v", err)
+		}
+	}
+
+	if got := repo.coins["p1"]; got != 7 {
+		t.Fatalf("coins = %d, want 7", got)
+	}
+	if len(repo.txs) != 1 {
+		t.Fatalf("transactions = %+v, want exactly one", repo.txs)
+	}
+}
+
+func TestCreditOnceRequiresOpKey(t *testing.T) {
+	repo := newFakeWalletRepo()
+
+	if err := newUC(repo).CreditOnce(context.Background(), "p1", "Bob", 7, "reward", ""); !errors.Is(err, donateuc.ErrMissingOpKey) {
+		t.Fatalf("CreditOnce: got %v, want ErrMissingOpKey", err)
+	}
+	if len(repo.coins) != 0 {
+		t.Fatalf("coins = %v, want no credit without an op key", repo.coins)
+	}
+}
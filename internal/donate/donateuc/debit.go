@@ -0,0 +1,86 @@
+package donateuc
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/fx"
+)
+
+// DebitRepo is the donate-side port for taking coins out of a wallet on behalf
+// of another domain. Primitive-typed for the same reason as WalletRepo. Bound to
+// the donate Mongo repository in internal/donate/fx.go.
+type DebitRepo interface {
+	// WithdrawFromWallet atomically decrements the wallet by amount, refusing
+	// (insufficient funds) rather than going negative.
+	WithdrawFromWallet(ctx context.Context, playerID string, amount int64) (int64, error)
+	CreateDebitTransaction(ctx context.Context, playerID string, amount int64, reason string) error
+	// WithdrawFromWalletOnce is WithdrawFromWallet applied at most once per
+	// opKey; it reports whether this call applied it.
+	WithdrawFromWalletOnce(ctx context.Context, playerID string, amount int64, opKey string) (bool, error)
+	HasWalletDebit(ctx context.Context, playerID, opKey string) (bool, error)
+	CreateDebitTransactionOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error
+}
+
+type DebitOpts struct {
+	fx.In
+	Repo DebitRepo
+}
+
+type DebitUseCase struct {
+	repo DebitRepo
+}
+
+func NewDebitUseCase(opts DebitOpts) *DebitUseCase {
+	return &DebitUseCase{repo: opts.Repo}
+}
+
+// Debit takes amount coins from playerID's wallet and records a debit entry in
+// donate's ledger. It mirrors Credit: the withdrawal is what matters, so if the
+// ledger write fails the coins stay withdrawn and the error, wrapped in
+// ErrLedgerNotRecorded, is returned for the caller to log. A missing wallet reports not found, a short one insufficient
+// funds; both are domain errors the interceptor maps to gRPC codes.
+func (uc *DebitUseCase) Debit(ctx context.Context, playerID string, amount int64, reason string) error {
+	if amount <= 0 {
+		return ErrNonPositiveAmount
+	}
+
+	if _, err := uc.repo.WithdrawFromWallet(ctx, playerID, amount); err != nil {
+		return err
+	}
+
+	if err := uc.repo.CreateDebitTransaction(ctx, playerID, amount, reason); err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerNotRecorded, err)
+	}
+	return nil
+}
+
+// DebitOnce is Debit made safe to retry: the wallet moves and the ledger
+// entry is written at most once per opKey. A caller retrying after any
+// error, ErrLedgerNotRecorded included, completes the debit without taking
+// it twice.
+func (uc *DebitUseCase) DebitOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error {
+	if amount <= 0 {
+		return ErrNonPositiveAmount
+	}
+	if opKey == "" {
+		return ErrMissingOpKey
+	}
+
+	if _, err := uc.repo.WithdrawFromWalletOnce(ctx, playerID, amount, opKey); err != nil {
+		return err
+	}
+
+	// Written even when the wallet already held the key: the earlier
+	// attempt may have stopped between the two writes.
+	if err := uc.repo.CreateDebitTransactionOnce(ctx, playerID, amount, reason, opKey); err != nil {
+		return fmt.Errorf("%w: %w", ErrLedgerNotRecorded, err)
+	}
+	return nil
+}
+
+// Debited reports whether the debit for opKey was taken from playerID's
+// wallet.
+func (uc *DebitUseCase) Debited(ctx context.Context, playerID, opKey string) (bool, error) {
+	return uc.repo.HasWalletDebit(ctx, playerID, opKey)
+}
@@ -0,0 +1,137 @@
+package donateuc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+)
+
+// fakeDebitRepo is a hand-written stand-in for donateuc.DebitRepo that refuses
+// to take a wallet below zero, like the guarded $inc in donate's repository.
+type fakeDebitRepo struct {
+	coins map[string]int64
+	txs   []creditTx
+	ops   map[string]bool
+
+	txErr error
+}
+
+var errShort = errors.New("insufficient funds")
+
+func (f *fakeDebitRepo) WithdrawFromWallet(_ context.Context, playerID string, amount int64) (int64, error) {
+	if f.coins[playerID] < amount {
+		return 0, errShort
+	}
+	f.coins[playerID] -= amount
+	return f.coins[playerID], nil
+}
+
+func (f *fakeDebitRepo) CreateDebitTransaction(_ context.Context, playerID string, amount int64, reason string) error {
+	if f.txErr != nil {
+		return f.txErr
+	}
+	f.txs = append(f.txs, creditTx{playerID: playerID, amount: amount, reason: reason})
+	return nil
+}
+
+func (f *fakeDebitRepo) WithdrawFromWalletOnce(ctx context.Context, playerID string, amount int64, opKey string) (bool, error) {
+	if f.ops[opKey] {
+		return false, nil
+	}
+	if _, err := f.WithdrawFromWallet(ctx, playerID, amount); err != nil {
+		return false, err
+	}
+	if f.ops == nil {
+		f.ops = make(map[string]bool)
+	}
+	f.ops[opKey] = true
+	return true, nil
+}
+
+func (f *fakeDebitRepo) HasWalletDebit(_ context.Context, _, opKey string) (bool, error) {
+	return f.ops[opKey], nil
+}
+
+func (f *fakeDebitRepo) CreateDebitTransactionOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error {
+	for _, tx := range f.txs {
+		if tx.opKey == opKey {
+			return nil
+		}
+	}
+	if err := f.CreateDebitTransaction(ctx, playerID, amount, reason); err != nil {
+		return err
+	}
+	f.txs[len(f.txs)-1].opKey = opKey
+	return nil
+}
+
+func newDebitUC(repo donateuc.DebitRepo) *donateuc.DebitUseCase {
+	return donateuc.NewDebitUseCase(donateuc.DebitOpts{Repo: repo})
+}
+
+func TestDebitRecordsLedgerEntry(t *testing.T) {
+	repo := &fakeDebitRepo{coins: map[string]int64{"p1": 10}}
+
+	if err := newDebitUC(repo).Debit(context.Background(), "p1", 4, "treasury deposit"); err != nil {
+		t.Fatalf("Debit: %v", err)
+	}
+	if got := repo.coins["p1"]; got != 6 {
+		t.Fatalf("coins = %d, want 6", got)
+	}
+	if len(repo.txs) != 1 || repo.txs[0] != (creditTx{playerID: "p1", amount: 4, reason: "treasury deposit"}) {
+		t.Fatalf("transactions = %+v, want one debit of 4", repo.txs)
+	}
+}
+
+func TestDebitSkipsLedgerWhenWithdrawalFails(t *testing.T) {
+	repo := &fakeDebitRepo{coins: map[string]int64{"p1": 3}}
+
+	if err := newDebitUC(repo).Debit(context.Background(), "p1", 4, "treasury deposit"); !errors.Is(err, errShort) {
+		t.Fatalf("Debit: got %v, want the withdrawal error", err)
+	}
+	if repo.coins["p1"] != 3 || len(repo.txs) != 0 {
+		t.Fatalf("coins = %d, transactions = %+v, want nothing written", repo.coins["p1"], repo.txs)
+	}
+}
+
+func TestDebitKeepsWithdrawalWhenLedgerFails(t *testing.T) {
+	repo := &fakeDebitRepo{coins: map[string]int64{"p1": 10}, txErr: errors.New("insert failed")}
+
+	err := newDebitUC(repo).Debit(context.Background(), "p1", 4, "treasury deposit")
+	if !errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+		t.Fatalf("Debit: got %v, want ErrLedgerNotRecorded", err)
+	}
+	if got := repo.coins["p1"]; got != 6 {
+		t.Fatalf("coins = %d, want the withdrawal to survive a ledger failure", got)
+	}
+}
+
+func TestDebitRejectsNonPositiveAmount(t *testing.T) {
+	repo := &fakeDebitRepo{coins: map[string]int64{"p1": 3}}
+
+	if err := newDebitUC(repo).Debit(context.Background(), "p1", 0, "x"); !errors.Is(err, donateuc.ErrNonPositiveAmount) {
+		t.Fatalf("Debit: got %v, want ErrNonPositiveAmount", err)
+	}
+}
+
+func TestDebitOnceTakesCoinsOnce(t *testing.T) {
+	repo := &fakeDebitRepo{coins: map[string]int64{"p1": 10}}
+	uc := newDebitUC(repo)
+
+	for range 2 {
+		if err := uc.DebitOnce(context.Background(), "p1", 4, "treasury deposit", "op-1"); err != nil {
+			t.Fatalf("DebitOnce: %v", err)
+		}
+	}
+	if got := repo.coins["p1"]; got != 6 || len(repo.txs) != 1 {
+		t.Fatalf("coins = %d, transactions = %+v, want one debit of 4", got, repo.txs)
+	}
+	if debited, _ := uc.Debited(context.Background(), "p1", "op-1"); !debited {
+		t.Fatal("Debited(op-1) = false after the debit")
+	}
+	if err := uc.DebitOnce(context.Background(), "p1", 4, "x", ""); !errors.Is(err, donateuc.ErrMissingOpKey) {
+		t.Fatalf("DebitOnce without key: got %v, want ErrMissingOpKey", err)
+	}
+}
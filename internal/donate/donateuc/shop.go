@@ -0,0 +1,49 @@
+package donateuc
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/donate/internal/usecase"
+)
+
+// ItemQuote is a shop item's price at the moment it was quoted.
+type ItemQuote struct {
+	ItemID string
+	Name   string
+	Price  int64
+}
+
+// ShopUseCase lets other domains hand out shop items that were paid for outside
+// a player's wallet, such as settlement treasury spending or season rewards.
+type ShopUseCase struct {
+	purchases *usecase.Purchases
+}
+
+func NewShopUseCase(purchases *usecase.Purchases) *ShopUseCase {
+	return &ShopUseCase{purchases: purchases}
+}
+
+// Quote returns the current effective price of an available item.
+func (uc *ShopUseCase) Quote(ctx context.Context, itemID string) (ItemQuote, error) {
+	item, price, err := uc.purchases.Quote(ctx, itemID)
+	if err != nil {
+		return ItemQuote{}, err
+	}
+	return ItemQuote{ItemID: item.Id, Name: item.Name, Price: price}, nil
+}
+
+// GrantOnce records a purchase of itemID for playerID without charging the
+// player's wallet, at most once per opKey, and returns the purchase id;
+// repeating a grant returns the first purchase. pricePaid is what the caller
+// charged elsewhere; zero for a free grant. opKey must be deterministic for
+// the grant so a retry after a crash reuses it.
+func (uc *ShopUseCase) GrantOnce(ctx context.Context, playerID, itemID string, pricePaid int64, opKey string) (string, error) {
+	if opKey == "" {
+		return "", ErrMissingOpKey
+	}
+	p, err := uc.purchases.GrantOnce(ctx, playerID, itemID, pricePaid, opKey)
+	if err != nil {
+		return "", err
+	}
+	return p.Id, nil
+}
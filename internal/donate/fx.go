@@ -35,6 +35,7 @@ var App = fx.Options(
 				repository.New,
 				fx.As(new(service.DonateRepository)),
 				fx.As(new(donateuc.WalletRepo)),
+				fx.As(new(donateuc.DebitRepo)),
 				fx.As(new(usecase.PurchaseRepo)),
 				fx.As(new(usecase.Sequence)),
 			),
@@ -47,6 +48,8 @@ var App = fx.Options(
 
 		fx.Provide(
 			donateuc.NewAddCoinsUseCase,
+			donateuc.NewDebitUseCase,
+			donateuc.NewShopUseCase,
 		),
 
 		fx.Provide(
@@ -20,6 +20,7 @@ type Purchase struct {
 	RefundedAt      *time.Time `bson:"refunded_at,omitempty"`
 	IssuedAt        *time.Time `bson:"issued_at,omitempty"`
 	IssuedBy        *string    `bson:"issued_by,omitempty"`
+	OpKey           string     `bson:"op_key,omitempty"`
 }
 
 // Id satisfies pagination.Identifiable for cursor-based pagination.
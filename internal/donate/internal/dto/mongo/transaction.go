@@ -9,4 +9,5 @@ type Transaction struct {
 	Type         string `bson:"type"`
 	Reason       string `bson:"reason"`
 	PurchaseID   string `bson:"purchase_id,omitempty"`
+	OpKey        string `bson:"op_key,omitempty"`
 }
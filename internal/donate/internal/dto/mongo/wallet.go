@@ -10,6 +10,12 @@ type Wallet struct {
 	PlayerID     string `bson:"player_id"`
 	PlayerName   string `bson:"player_name"`
 	Coins        int64  `bson:"coins"`
+	// CreditOps are the op keys of the latest idempotent credits, capped at
+	// the repository's walletOpsKept.
+	CreditOps []string `bson:"credit_ops,omitempty"`
+	// DebitOps are the op keys of the latest idempotent debits, capped at
+	// the repository's walletOpsKept.
+	DebitOps []string `bson:"debit_ops,omitempty"`
 }
 
 func (w Wallet) Id() bson.ObjectID { return w.Model.Id }
@@ -7,4 +7,5 @@ var (
 	ErrInsufficientFunds   = ierror.FailedPrecondition("insufficient funds")
 	ErrAlreadyRefunded     = ierror.FailedPrecondition("purchase already refunded")
 	ErrCannotIssueRefunded = ierror.FailedPrecondition("cannot mark refunded purchase as issued")
+	ErrOpKeyTaken          = ierror.AlreadyExists("operation already applied")
 )
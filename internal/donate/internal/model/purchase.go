@@ -24,6 +24,9 @@ type Purchase struct {
 	RefundedAt      *time.Time
 	IssuedAt        *time.Time
 	IssuedBy        *string
+	// OpKey identifies the grant that created the purchase, so a retried
+	// grant finds it instead of granting twice; empty for bought items.
+	OpKey string
 }
 
 func NewPurchase(playerID, playerName, itemID, itemName string, pricePaid, basePrice int64, discountPercent int32) *Purchase {
@@ -39,6 +42,14 @@ func NewPurchase(playerID, playerName, itemID, itemName string, pricePaid, baseP
 	}
 }
 
+// NewGrant builds a purchase paid for outside the player's wallet, carrying
+// the op key of the grant that records it.
+func NewGrant(playerID, playerName, itemID, itemName string, pricePaid, basePrice int64, discountPercent int32, opKey string) *Purchase {
+	p := NewPurchase(playerID, playerName, itemID, itemName, pricePaid, basePrice, discountPercent)
+	p.OpKey = opKey
+	return p
+}
+
 // ReconstitutePurchase rebuilds a Purchase from persisted state. Repository use only.
 func ReconstitutePurchase(
 	id, playerID, playerName, itemID, itemName string,
@@ -48,6 +59,7 @@ func ReconstitutePurchase(
 	createdAt time.Time,
 	refundedAt, issuedAt *time.Time,
 	issuedBy *string,
+	opKey string,
 ) *Purchase {
 	return &Purchase{
 		Id:              id,
@@ -63,6 +75,7 @@ func ReconstitutePurchase(
 		RefundedAt:      refundedAt,
 		IssuedAt:        issuedAt,
 		IssuedBy:        issuedBy,
+		OpKey:           opKey,
 	}
 }
 
@@ -11,8 +11,14 @@ type Wallet struct {
 	PlayerID   string
 	PlayerName string
 	Coins      int64
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// CreditOps holds the op keys of the latest idempotent credits, so a
+	// retried credit is recognised; it is carried through every rewrite.
+	CreditOps []string
+	// DebitOps holds the op keys of the latest idempotent debits, so a
+	// retried debit is recognised; it is carried through every rewrite.
+	DebitOps  []string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 func NewWallet(playerID, playerName string) *Wallet {
@@ -24,12 +30,14 @@ func NewWallet(playerID, playerName string) *Wallet {
 }
 
 // ReconstituteWallet rebuilds a Wallet from persisted state. Repository use only.
-func ReconstituteWallet(id, playerID, playerName string, coins int64, createdAt, updatedAt time.Time) *Wallet {
+func ReconstituteWallet(id, playerID, playerName string, coins int64, creditOps, debitOps []string, createdAt, updatedAt time.Time) *Wallet {
 	return &Wallet{
 		Id:         id,
 		PlayerID:   playerID,
 		PlayerName: playerName,
 		Coins:      coins,
+		CreditOps:  creditOps,
+		DebitOps:   debitOps,
 		CreatedAt:  createdAt,
 		UpdatedAt:  updatedAt,
 	}
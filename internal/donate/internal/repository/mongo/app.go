@@ -87,13 +87,25 @@ func setupIndexes(log logger.Logger, walletColl, shopColl, purchColl, txColl *mg
 	createIndex(purchColl, mgo.IndexModel{
 		Keys: bson.D{{Key: "status", Value: 1}, {Key: "_id", Value: -1}},
 	})
+	// One purchase per idempotent grant
+	createIndex(purchColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "op_key", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"op_key": bson.M{"$exists": true}}),
+	})
 	createIndex(txColl, mgo.IndexModel{
 		Keys: bson.D{{Key: "player_id", Value: 1}},
 	})
+	// One ledger entry per idempotent credit or debit
+	createIndex(txColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "op_key", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"op_key": bson.M{"$exists": true}}),
+	})
 }
 
 func walletFromDTO(d dto.Wallet) *model.Wallet {
-	return model.ReconstituteWallet(d.Model.Id.Hex(), d.PlayerID, d.PlayerName, d.Coins, d.CreatedAt, d.UpdatedAt)
+	return model.ReconstituteWallet(d.Model.Id.Hex(), d.PlayerID, d.PlayerName, d.Coins, d.CreditOps, d.DebitOps, d.CreatedAt, d.UpdatedAt)
 }
 
 // walletToDTO builds a BSON-ready Wallet DTO from a domain model. The mongox.Model
@@ -103,6 +115,8 @@ func walletToDTO(m *model.Wallet) dto.Wallet {
 		PlayerID:   m.PlayerID,
 		PlayerName: m.PlayerName,
 		Coins:      m.Coins,
+		CreditOps:  m.CreditOps,
+		DebitOps:   m.DebitOps,
 	}
 }
 
@@ -186,7 +200,7 @@ func purchaseFromDTO(d dto.Purchase) *model.Purchase {
 	return model.ReconstitutePurchase(
 		d.Model.Id.Hex(), d.PlayerID, d.PlayerName, d.ItemID, d.ItemName,
 		d.PricePaid, d.BasePrice, d.DiscountPercent,
-		model.PurchaseStatus(d.Status), d.CreatedAt, d.RefundedAt, d.IssuedAt, d.IssuedBy,
+		model.PurchaseStatus(d.Status), d.CreatedAt, d.RefundedAt, d.IssuedAt, d.IssuedBy, d.OpKey,
 	)
 }
 
@@ -205,6 +219,7 @@ func purchaseToDTO(p *model.Purchase) dto.Purchase {
 		RefundedAt:      p.RefundedAt,
 		IssuedAt:        p.IssuedAt,
 		IssuedBy:        p.IssuedBy,
+		OpKey:           p.OpKey,
 	}
 }
 
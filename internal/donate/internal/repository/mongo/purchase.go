@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 
 	dto "github.com/lasthearth/vsservice/internal/donate/internal/dto/mongo"
 	"github.com/lasthearth/vsservice/internal/donate/internal/ierror"
@@ -9,6 +10,7 @@ import (
 	"github.com/lasthearth/vsservice/internal/pkg/mongox"
 	"github.com/lasthearth/vsservice/internal/pkg/mongox/pagination"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	mgo "go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/zap"
 )
 
@@ -19,6 +21,9 @@ func (r *Repository) CreatePurchase(ctx context.Context, purchase *model.Purchas
 
 	result, err := r.purchColl.InsertOne(ctx, d)
 	if err != nil {
+		if d.OpKey != "" && mgo.IsDuplicateKeyError(err) {
+			return nil, ierror.ErrOpKeyTaken
+		}
 		return nil, err
 	}
 
@@ -31,6 +36,18 @@ func (r *Repository) CreatePurchase(ctx context.Context, purchase *model.Purchas
 	return purchase, nil
 }
 
+func (r *Repository) GetPurchaseByOpKey(ctx context.Context, opKey string) (*model.Purchase, error) {
+	var d dto.Purchase
+	if err := r.purchColl.FindOne(ctx, bson.M{"op_key": opKey}).Decode(&d); err != nil {
+		if errors.Is(err, mgo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		r.log.Error("GetPurchaseByOpKey: find failed", zap.Error(err))
+		return nil, err
+	}
+	return purchaseFromDTO(d), nil
+}
+
 func (r *Repository) UpdatePurchase(
 	ctx context.Context,
 	id string,
@@ -7,6 +7,7 @@ import (
 	"github.com/lasthearth/vsservice/internal/donate/internal/model"
 	"github.com/lasthearth/vsservice/internal/pkg/mongox"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	mgo "go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/zap"
 )
 
@@ -46,6 +47,58 @@ func (r *Repository) CreateCreditTransaction(ctx context.Context, playerID strin
 	return err
 }
 
+// CreateCreditTransactionOnce records a credit entry for opKey unless one
+// already exists, so a retried credit leaves a single ledger entry.
+func (r *Repository) CreateCreditTransactionOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error {
+	m := mongox.NewModel()
+	d := dto.Transaction{
+		Model:    m,
+		PlayerID: playerID,
+		Amount:   amount,
+		Type:     string(model.TxTypeCredit),
+		Reason:   reason,
+		OpKey:    opKey,
+	}
+
+	if _, err := r.txColl.InsertOne(ctx, d); err != nil {
+		if mgo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		r.log.Error("CreateCreditTransactionOnce: insert failed", zap.String("player_id", playerID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// CreateDebitTransaction records a debit entry in the ledger; the
+// donateuc.DebitRepo counterpart of CreateCreditTransaction.
+func (r *Repository) CreateDebitTransaction(ctx context.Context, playerID string, amount int64, reason string) error {
+	_, err := r.CreateTransaction(ctx, model.NewDebitTransaction(playerID, amount, reason))
+	return err
+}
+
+// CreateDebitTransactionOnce records a debit entry for opKey unless one
+// already exists, so a retried debit leaves a single ledger entry.
+func (r *Repository) CreateDebitTransactionOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error {
+	d := dto.Transaction{
+		Model:    mongox.NewModel(),
+		PlayerID: playerID,
+		Amount:   amount,
+		Type:     string(model.TxTypeDebit),
+		Reason:   reason,
+		OpKey:    opKey,
+	}
+
+	if _, err := r.txColl.InsertOne(ctx, d); err != nil {
+		if mgo.IsDuplicateKeyError(err) {
+			return nil
+		}
+		r.log.Error("CreateDebitTransactionOnce: insert failed", zap.String("player_id", playerID), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
 func (r *Repository) ListTransactionsByPlayerID(ctx context.Context, playerID string) ([]*model.Transaction, error) {
 	l := r.log.With(zap.String("method", "ListTransactionsByPlayerID"), zap.String("player_id", playerID))
 
@@ -74,6 +74,113 @@ func (r *Repository) AddCoinsToWallet(ctx context.Context, playerID, playerName
 	return d.Coins, nil
 }
 
+// walletOpsKept bounds the op keys remembered per wallet. A retry comes
+// long before this many newer operations, so older keys are safe to drop.
+const walletOpsKept = 200
+
+// CreditWalletOnce adds amount to playerID's wallet unless the credit for
+// opKey was already applied, creating the wallet if needed. The increment
+// and the op key are written in one update, so a crash never leaves one
+// without the other. Reports whether this call applied the credit.
+func (r *Repository) CreditWalletOnce(ctx context.Context, playerID, playerName string, amount int64, opKey string) (bool, error) {
+	l := r.log.With(zap.String("method", "CreditWalletOnce"), zap.String("player_id", playerID))
+
+	// A zero increment creates a missing wallet with the usual name rules,
+	// so the guarded update below never has to upsert.
+	if _, err := r.AddCoinsToWallet(ctx, playerID, playerName, 0); err != nil {
+		return false, err
+	}
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "coins", Value: amount}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+		{Key: "$push", Value: bson.D{{Key: "credit_ops", Value: bson.D{
+			{Key: "$each", Value: bson.A{opKey}},
+			{Key: "$slice", Value: -walletOpsKept},
+		}}}},
+	}
+	res, err := r.walletColl.UpdateOne(ctx, bson.M{"player_id": playerID, "credit_ops": bson.M{"$ne": opKey}}, update)
+	if err != nil {
+		l.Error("failed to credit coins", zap.Error(err))
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// WithdrawFromWallet atomically decrements coins by amount, guarded by a
+// balance filter so the wallet never goes negative. Returns ierror.ErrNotFound
+// when the player has no wallet and ierror.ErrInsufficientFunds when it holds
+// less than amount.
+func (r *Repository) WithdrawFromWallet(ctx context.Context, playerID string, amount int64) (int64, error) {
+	l := r.log.With(zap.String("method", "WithdrawFromWallet"), zap.String("player_id", playerID))
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "coins", Value: -amount}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var d dto.Wallet
+	err := r.walletColl.FindOneAndUpdate(ctx, bson.M{"player_id": playerID, "coins": bson.M{"$gte": amount}}, update, opts).Decode(&d)
+	if err == nil {
+		return d.Coins, nil
+	}
+	if !errors.Is(err, mgo.ErrNoDocuments) {
+		l.Error("failed to withdraw coins", zap.Error(err))
+		return 0, err
+	}
+
+	if _, err := r.GetWalletByPlayerID(ctx, playerID); err != nil {
+		return 0, err
+	}
+	return 0, ierror.ErrInsufficientFunds
+}
+
+// WithdrawFromWalletOnce is WithdrawFromWallet applied at most once per
+// opKey; the decrement and the op key are written in one update. Reports
+// whether this call applied the debit.
+func (r *Repository) WithdrawFromWalletOnce(ctx context.Context, playerID string, amount int64, opKey string) (bool, error) {
+	l := r.log.With(zap.String("method", "WithdrawFromWalletOnce"), zap.String("player_id", playerID))
+
+	update := bson.D{
+		{Key: "$inc", Value: bson.D{{Key: "coins", Value: -amount}}},
+		{Key: "$set", Value: bson.D{{Key: "updated_at", Value: time.Now()}}},
+		{Key: "$push", Value: bson.D{{Key: "debit_ops", Value: bson.D{
+			{Key: "$each", Value: bson.A{opKey}},
+			{Key: "$slice", Value: -walletOpsKept},
+		}}}},
+	}
+	filter := bson.M{"player_id": playerID, "coins": bson.M{"$gte": amount}, "debit_ops": bson.M{"$ne": opKey}}
+	res, err := r.walletColl.UpdateOne(ctx, filter, update)
+	if err != nil {
+		l.Error("failed to withdraw coins", zap.Error(err))
+		return false, err
+	}
+	if res.ModifiedCount == 1 {
+		return true, nil
+	}
+
+	debited, err := r.HasWalletDebit(ctx, playerID, opKey)
+	if err != nil || debited {
+		return false, err
+	}
+	if _, err := r.GetWalletByPlayerID(ctx, playerID); err != nil {
+		return false, err
+	}
+	return false, ierror.ErrInsufficientFunds
+}
+
+// HasWalletDebit reports whether the debit for opKey was applied to
+// playerID's wallet.
+func (r *Repository) HasWalletDebit(ctx context.Context, playerID, opKey string) (bool, error) {
+	n, err := r.walletColl.CountDocuments(ctx, bson.M{"player_id": playerID, "debit_ops": opKey}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.Error("HasWalletDebit: count failed", zap.String("player_id", playerID), zap.Error(err))
+		return false, err
+	}
+	return n > 0, nil
+}
+
 // ListWallets returns all wallets sorted by coins DESC, cursor-paginated.
 func (r *Repository) ListWallets(ctx context.Context, pageToken string, limit int64) ([]*model.Wallet, string, error) {
 	l := r.log.With(zap.String("method", "ListWallets"))
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/donate/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/donate/internal/model"
+)
+
+// Quote returns an available item together with the price Buy would charge for
+// it right now. ErrNotFound when the item is missing or not on sale.
+func (uc *Purchases) Quote(ctx context.Context, itemID string) (*model.ShopItem, int64, error) {
+	item, err := uc.repo.GetShopItem(ctx, itemID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !item.IsAvailable {
+		return nil, 0, ierror.ErrNotFound
+	}
+	return item, item.EffectivePriceAt(time.Now()), nil
+}
+
+// GrantOnce records a purchase of itemID for playerID that was paid for outside
+// the player's wallet — by a settlement treasury, or not at all for a reward —
+// at most once per opKey, and returns it. The wallet is not touched and no
+// ledger entry is written, because the player's balance did not move;
+// pricePaid is kept on the purchase so a refund returns what was actually
+// paid.
+//
+// When opKey was already granted the purchase recorded first is returned,
+// even if the item has since gone off sale. The purchase carries opKey, so a
+// grant interrupted after its write is found, not repeated.
+func (uc *Purchases) GrantOnce(ctx context.Context, playerID, itemID string, pricePaid int64, opKey string) (*model.Purchase, error) {
+	existing, err := uc.repo.GetPurchaseByOpKey(ctx, opKey)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, ierror.ErrNotFound) {
+		return nil, err
+	}
+
+	purchase, err := uc.newGrant(ctx, playerID, itemID, pricePaid, opKey)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.CreatePurchase(ctx, purchase)
+	if errors.Is(err, ierror.ErrOpKeyTaken) {
+		// A concurrent grant with the same key won the insert.
+		return uc.repo.GetPurchaseByOpKey(ctx, opKey)
+	}
+	return created, err
+}
+
+// newGrant builds the purchase a grant records, priced as the item is now.
+func (uc *Purchases) newGrant(ctx context.Context, playerID, itemID string, pricePaid int64, opKey string) (*model.Purchase, error) {
+	item, price, err := uc.Quote(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	playerName := ""
+	if wallet, werr := uc.repo.GetWalletByPlayerID(ctx, playerID); werr == nil {
+		playerName = wallet.PlayerName
+	}
+
+	discountPercent := int32(0)
+	if price != item.Price {
+		discountPercent = item.DiscountPercent
+	}
+
+	return model.NewGrant(
+		playerID, playerName, item.Id, item.Name, pricePaid, item.Price, discountPercent, opKey,
+	), nil
+}
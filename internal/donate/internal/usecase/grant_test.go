@@ -0,0 +1,65 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/donate/internal/ierror"
+)
+
+func TestGrantLeavesWalletAndLedgerAlone(t *testing.T) {
+	repo := newFakeRepo().withWallet("p1", "Bob", 100)
+	repo.withItem("i1", "Banner", 40)
+
+	p, err := newPurchases(repo).GrantOnce(context.Background(), "p1", "i1", 40, "treasury-spend:1")
+	if err != nil {
+		t.Fatalf("GrantOnce: %v", err)
+	}
+
+	if p.PricePaid != 40 || p.PlayerName != "Bob" || p.ItemName != "Banner" {
+		t.Fatalf("purchase = %+v, want Banner for Bob at 40", p)
+	}
+	if got := repo.wallets["p1"].Coins; got != 100 {
+		t.Fatalf("coins = %d, want the wallet untouched", got)
+	}
+	if repo.walletCalls != 0 || len(repo.txs) != 0 {
+		t.Fatalf("wallet calls = %d, transactions = %d, want none", repo.walletCalls, len(repo.txs))
+	}
+}
+
+func TestGrantRejectsUnavailableItem(t *testing.T) {
+	repo := newFakeRepo()
+	takeOffSale(repo.withItem("i1", "Banner", 40))
+
+	if _, err := newPurchases(repo).GrantOnce(context.Background(), "p1", "i1", 0, "season:s1:item:p1:0"); !errors.Is(err, ierror.ErrNotFound) {
+		t.Fatalf("GrantOnce: got %v, want ErrNotFound", err)
+	}
+	if len(repo.purchases) != 0 {
+		t.Fatalf("purchases = %d, want none", len(repo.purchases))
+	}
+}
+
+func TestGrantOnceGrantsOncePerKey(t *testing.T) {
+	repo := newFakeRepo().withWallet("p1", "Bob", 100)
+	repo.withItem("i1", "Banner", 40)
+	uc := newPurchases(repo)
+
+	first, err := uc.GrantOnce(context.Background(), "p1", "i1", 0, "season:s1:item:p1:0")
+	if err != nil {
+		t.Fatalf("GrantOnce: %v", err)
+	}
+	if first.PricePaid != 0 || first.OpKey != "season:s1:item:p1:0" {
+		t.Fatalf("purchase = %+v, want a free grant carrying its op key", first)
+	}
+
+	// The item going off sale does not fail a repeated grant.
+	takeOffSale(repo.items["i1"])
+	again, err := uc.GrantOnce(context.Background(), "p1", "i1", 0, "season:s1:item:p1:0")
+	if err != nil {
+		t.Fatalf("repeated GrantOnce: %v", err)
+	}
+	if again.Id != first.Id || len(repo.purchases) != 1 {
+		t.Fatalf("repeat made purchase %s (%d total), want %s only", again.Id, len(repo.purchases), first.Id)
+	}
+}
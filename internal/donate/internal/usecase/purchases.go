@@ -19,7 +19,10 @@ type PurchaseRepo interface {
 		updateFn func(ctx context.Context, wallet *model.Wallet) (*model.Wallet, error),
 	) error
 	AddCoinsToWallet(ctx context.Context, playerID, playerName string, amount int64) (int64, error)
+	// CreatePurchase returns ierror.ErrOpKeyTaken when a purchase with the
+	// same non-empty OpKey exists.
 	CreatePurchase(ctx context.Context, purchase *model.Purchase) (*model.Purchase, error)
+	GetPurchaseByOpKey(ctx context.Context, opKey string) (*model.Purchase, error)
 	UpdatePurchase(
 		ctx context.Context,
 		id string,
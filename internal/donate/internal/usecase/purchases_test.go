@@ -105,11 +105,25 @@ func (f *fakeRepo) CreatePurchase(_ context.Context, p *model.Purchase) (*model.
 	if f.createPurErr != nil {
 		return nil, f.createPurErr
 	}
+	if p.OpKey != "" {
+		if _, err := f.GetPurchaseByOpKey(context.Background(), p.OpKey); err == nil {
+			return nil, ierror.ErrOpKeyTaken
+		}
+	}
 	p.MarkCreated(f.id(), time.Now())
 	f.purchases[p.Id] = p
 	return p, nil
 }
 
+func (f *fakeRepo) GetPurchaseByOpKey(_ context.Context, opKey string) (*model.Purchase, error) {
+	for _, p := range f.purchases {
+		if p.OpKey == opKey {
+			return p, nil
+		}
+	}
+	return nil, ierror.ErrNotFound
+}
+
 func (f *fakeRepo) UpdatePurchase(
 	ctx context.Context,
 	id string,
@@ -141,7 +155,7 @@ func (f *fakeRepo) CreateTransaction(_ context.Context, tx *model.Transaction) (
 
 // withWallet seeds a wallet holding coins.
 func (f *fakeRepo) withWallet(playerID, playerName string, coins int64) *fakeRepo {
-	f.wallets[playerID] = model.ReconstituteWallet("w-"+playerID, playerID, playerName, coins, time.Time{}, time.Time{})
+	f.wallets[playerID] = model.ReconstituteWallet("w-"+playerID, playerID, playerName, coins, nil, nil, time.Time{}, time.Time{})
 	return f
 }
 
@@ -153,6 +167,25 @@ func (f *fakeRepo) withItem(id, name string, price int64) *model.ShopItem {
 	return item
 }
 
+// takeOffSale marks item unavailable, leaving the rest of it as it was.
+func takeOffSale(item *model.ShopItem) {
+	item.Apply(model.ShopItemUpdate{
+		Code:             item.Code,
+		Name:             item.Name,
+		Description:      item.Description,
+		ImageURL:         item.ImageURL,
+		Price:            item.Price,
+		IsAvailable:      false,
+		Type:             item.Type,
+		Entries:          item.Entries,
+		HasDiscount:      item.HasDiscount,
+		DiscountPercent:  item.DiscountPercent,
+		Privileges:       item.Privileges,
+		DiscountStartsAt: item.DiscountStartsAt,
+		DiscountEndsAt:   item.DiscountEndsAt,
+	})
+}
+
 // withPurchase seeds a stored purchase and returns it.
 func (f *fakeRepo) withPurchase(id, playerID, playerName string, pricePaid int64) *model.Purchase {
 	p := model.NewPurchase(playerID, playerName, "item-1", "Sword", pricePaid, pricePaid, 0)
@@ -28,7 +28,13 @@ func TestWiring(t *testing.T) {
 		fx.Supply(fx.Annotate(l, fx.As(new(logger.Logger)))),
 		fx.Supply(&mongo.Database{}, &mongo.Client{}, mediaurl.New(config.Config{})),
 		donate.App,
-		fx.Invoke(func(donatev1.DonateServiceServer, *donateuc.AddCoinsUseCase) {}),
+		fx.Invoke(func(
+			donatev1.DonateServiceServer,
+			*donateuc.AddCoinsUseCase,
+			*donateuc.DebitUseCase,
+			*donateuc.ShopUseCase,
+		) {
+		}),
 	)
 	if err != nil {
 		t.Fatal(err)
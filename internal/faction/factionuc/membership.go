@@ -0,0 +1,53 @@
+package factionuc
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+)
+
+var (
+	ErrFactionNotFound = ierror.ErrFactionNotFound
+	ErrNotMember       = ierror.ErrNotMember
+)
+
+type MembershipRepository interface {
+	GetFaction(ctx context.Context, id string) (*model.Faction, error)
+	MemberFaction(ctx context.Context, settlementId string) (string, error)
+}
+
+// Side is a faction as the side it takes on imperial points.
+type Side struct {
+	FactionId string
+	// PointLimit is how many points the side may control at once; 0 means
+	// no limit.
+	PointLimit int
+}
+
+// Membership answers faction questions for other domains.
+type Membership struct {
+	repo MembershipRepository
+}
+
+func NewMembership(repo MembershipRepository) *Membership {
+	return &Membership{repo: repo}
+}
+
+// MemberSide returns the side of factionID, checking that settlementID is one
+// of its members. It returns ErrFactionNotFound for an unknown faction and
+// ErrNotMember if the settlement is not in it.
+func (m *Membership) MemberSide(ctx context.Context, factionID, settlementID string) (Side, error) {
+	f, err := m.repo.GetFaction(ctx, factionID)
+	if err != nil {
+		return Side{}, err
+	}
+	current, err := m.repo.MemberFaction(ctx, settlementID)
+	if err != nil {
+		return Side{}, err
+	}
+	if current != f.Id {
+		return Side{}, ErrNotMember
+	}
+	return Side{FactionId: f.Id, PointLimit: f.PointLimit}, nil
+}
@@ -0,0 +1,57 @@
+package faction
+
+import (
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/faction/internal/repository"
+	"github.com/lasthearth/vsservice/internal/faction/internal/repository/repomapper"
+	"github.com/lasthearth/vsservice/internal/faction/internal/service"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.uber.org/fx"
+)
+
+const module = "faction"
+
+var App = fx.Options(
+	fx.Module(
+		module,
+		fx.Decorate(
+			func(l logger.Logger) logger.Logger {
+				return l.WithScope(module)
+			},
+		),
+
+		fx.Provide(
+			fx.Private,
+			fx.Annotate(
+				func() *repomapper.MapperImpl {
+					return &repomapper.MapperImpl{}
+				},
+				fx.As(new(repository.Mapper)),
+			),
+			fx.Annotate(
+				repository.New,
+				fx.As(new(service.Repository)),
+				fx.As(new(factionuc.MembershipRepository)),
+			),
+			func(f *settlementuc.FavorOps) service.LeaderChecker { return f },
+		),
+
+		// Read side shared with the progression domain.
+		fx.Provide(factionuc.NewMembership),
+
+		fx.Provide(service.New),
+
+		fx.Provide(
+			fx.Annotate(
+				func(s *service.Service) factionv1.FactionServiceServer { return s },
+			),
+			fx.Annotate(
+				func(s *service.Service) interceptor.Scoper { return s },
+				fx.ResultTags(`group:"scopers"`),
+			),
+		),
+	),
+)
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// Faction is keyed by its slug, the side stored on imperial points.
+type Faction struct {
+	Id          string    `bson:"_id"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	PointLimit  int       `bson:"point_limit"`
+	CreatedAt   time.Time `bson:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+
+	// SettlementIds are the members, read from faction_members.
+	SettlementIds []string `bson:"-"`
+}
+
+// FactionMember is keyed by the settlement, so a settlement belongs to one
+// faction at most.
+type FactionMember struct {
+	SettlementId bson.ObjectID `bson:"_id"`
+	FactionId    string        `bson:"faction_id"`
+	JoinedAt     time.Time     `bson:"joined_at"`
+}
+
+type JoinRequest struct {
+	mongox.Model `bson:",inline"`
+	FactionId    string        `bson:"faction_id"`
+	SettlementId bson.ObjectID `bson:"settlement_id"`
+	RequestedBy  string        `bson:"requested_by"`
+	Status       string        `bson:"status"`
+	DecidedBy    string        `bson:"decided_by,omitempty"`
+	DecidedAt    time.Time     `bson:"decided_at,omitempty"`
+	Reason       string        `bson:"reason,omitempty"`
+}
@@ -0,0 +1,17 @@
+package ierror
+
+import "github.com/lasthearth/vsservice/internal/pkg/ierror"
+
+var (
+	ErrFactionNotFound    = ierror.NotFound("faction not found")
+	ErrFactionExists      = ierror.AlreadyExists("faction already exists")
+	ErrInvalidFactionId   = ierror.InvalidArgument("faction id must be a lowercase slug of up to 32 letters, digits and dashes")
+	ErrEmptyName          = ierror.InvalidArgument("faction name is empty")
+	ErrNegativePointLimit = ierror.InvalidArgument("point limit is negative")
+	ErrNotMember          = ierror.NotFound("settlement is not a member of the faction")
+	ErrAlreadyInFaction   = ierror.FailedPrecondition("settlement already belongs to a faction")
+	ErrRequestNotFound    = ierror.NotFound("join request not found")
+	ErrRequestPending     = ierror.AlreadyExists("settlement already has a pending join request")
+	ErrRequestDecided     = ierror.FailedPrecondition("join request is already decided")
+	ErrNotLeader          = ierror.PermissionDenied("caller is not the leader of the settlement")
+)
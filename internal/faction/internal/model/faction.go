@@ -0,0 +1,56 @@
+package model
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+)
+
+var factionIdPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{0,31}$`)
+
+// Faction is a side settlements fight for on imperial points. Its Id is the
+// side stored on point control and point talent progress.
+type Faction struct {
+	Id          string
+	Name        string
+	Description string
+	// PointLimit is how many imperial points the faction may control at
+	// once; 0 means no limit.
+	PointLimit    int
+	SettlementIds []string
+	CreatedAt     time.Time
+}
+
+func NewFaction(id, name, description string, pointLimit int) (*Faction, error) {
+	if !factionIdPattern.MatchString(id) {
+		return nil, ierror.ErrInvalidFactionId
+	}
+	f := &Faction{Id: id}
+	if err := f.Update(name, description, pointLimit); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Update changes the faction's details.
+func (f *Faction) Update(name, description string, pointLimit int) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ierror.ErrEmptyName
+	}
+	if pointLimit < 0 {
+		return ierror.ErrNegativePointLimit
+	}
+	f.Name = name
+	f.Description = strings.TrimSpace(description)
+	f.PointLimit = pointLimit
+	return nil
+}
+
+// HasMember reports whether the settlement belongs to the faction.
+func (f *Faction) HasMember(settlementId string) bool {
+	return slices.Contains(f.SettlementIds, settlementId)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+)
+
+func TestNewFactionValidates(t *testing.T) {
+	cases := []struct {
+		name       string
+		id, title  string
+		pointLimit int
+		wantErr    error
+	}{
+		{name: "valid", id: "east", title: "Восток", pointLimit: 2},
+		{name: "no limit", id: "free-cities", title: "Вольные города"},
+		{name: "uppercase id", id: "East", title: "Восток", wantErr: ierror.ErrInvalidFactionId},
+		{name: "id starts with digit", id: "1st", title: "Первые", wantErr: ierror.ErrInvalidFactionId},
+		{name: "blank name", id: "east", title: "  ", wantErr: ierror.ErrEmptyName},
+		{name: "negative limit", id: "east", title: "Восток", pointLimit: -1, wantErr: ierror.ErrNegativePointLimit},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewFaction(tc.id, tc.title, "", tc.pointLimit)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestJoinRequestIsDecidedOnce(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewJoinRequest("east", "s1", "leader", now)
+
+	if err := r.Reject("admin", "не сейчас", now.Add(time.Hour)); err != nil {
+		t.Fatalf("Reject: %v", err)
+	}
+	if r.Status != JoinRequestRejected || r.DecidedBy != "admin" || r.Reason != "не сейчас" {
+		t.Fatalf("request = %+v, want rejected by admin", r)
+	}
+	if err := r.Approve("admin", now.Add(2*time.Hour)); !errors.Is(err, ierror.ErrRequestDecided) {
+		t.Fatalf("Approve after Reject err = %v, want ErrRequestDecided", err)
+	}
+	if r.Status != JoinRequestRejected {
+		t.Fatalf("status = %s, want rejected", r.Status)
+	}
+}
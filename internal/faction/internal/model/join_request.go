@@ -0,0 +1,64 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+)
+
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestRejected JoinRequestStatus = "rejected"
+)
+
+// JoinRequest is a settlement leader's request for the settlement to join a
+// faction, decided by an admin.
+type JoinRequest struct {
+	Id           string
+	FactionId    string
+	SettlementId string
+	RequestedBy  string
+	Status       JoinRequestStatus
+	CreatedAt    time.Time
+	DecidedBy    string
+	DecidedAt    time.Time
+	// Reason is the admin's note on a rejection.
+	Reason string
+}
+
+func NewJoinRequest(factionId, settlementId, requestedBy string, now time.Time) *JoinRequest {
+	return &JoinRequest{
+		FactionId:    factionId,
+		SettlementId: settlementId,
+		RequestedBy:  requestedBy,
+		Status:       JoinRequestPending,
+		CreatedAt:    now,
+	}
+}
+
+// AssignID records the persisted identity.
+func (r *JoinRequest) AssignID(id string) { r.Id = id }
+
+// Approve marks the pending request approved by adminId.
+func (r *JoinRequest) Approve(adminId string, now time.Time) error {
+	return r.decide(JoinRequestApproved, adminId, "", now)
+}
+
+// Reject marks the pending request rejected by adminId.
+func (r *JoinRequest) Reject(adminId, reason string, now time.Time) error {
+	return r.decide(JoinRequestRejected, adminId, reason, now)
+}
+
+func (r *JoinRequest) decide(status JoinRequestStatus, adminId, reason string, now time.Time) error {
+	if r.Status != JoinRequestPending {
+		return ierror.ErrRequestDecided
+	}
+	r.Status = status
+	r.DecidedBy = adminId
+	r.DecidedAt = now
+	r.Reason = reason
+	return nil
+}
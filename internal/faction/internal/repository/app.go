@@ -0,0 +1,110 @@
+//go:generate go tool goverter gen github.com/lasthearth/vsservice/internal/faction/internal/repository
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/dto"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+type Opts struct {
+	fx.In
+
+	Log      logger.Logger
+	Database *mongo.Database
+	Mapper   Mapper
+}
+
+// goverter:converter
+// goverter:output:file repomapper/mapper.go
+// goverter:extend github.com/lasthearth/vsservice/internal/pkg/goverter:ObjectIdToString
+// goverter:extend github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToTime
+type Mapper interface {
+	ToFaction(d dto.Faction) model.Faction
+
+	// goverter:autoMap Model
+	// goverter:map Model.Id Id
+	ToJoinRequest(d dto.JoinRequest) model.JoinRequest
+}
+
+type Repository struct {
+	log          logger.Logger
+	mapper       Mapper
+	factionsColl *mongo.Collection
+	membersColl  *mongo.Collection
+	requestsColl *mongo.Collection
+}
+
+func New(opts Opts) *Repository {
+	r := &Repository{
+		log:          opts.Log,
+		mapper:       opts.Mapper,
+		factionsColl: opts.Database.Collection("factions"),
+		membersColl:  opts.Database.Collection("faction_members"),
+		requestsColl: opts.Database.Collection("faction_join_requests"),
+	}
+	r.setup()
+	return r
+}
+
+// defaultFactions are the sides imperial points were fought over before
+// factions existed, kept under their old names.
+var defaultFactions = []struct {
+	id, name string
+}{
+	{"east", "Восток"},
+	{"west", "Запад"},
+}
+
+// defaultPointLimit is the limit the default factions start with, the one
+// that used to apply to every side.
+const defaultPointLimit = 2
+
+func (r *Repository) setup() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := r.membersColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "faction_id", Value: 1}},
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.membersColl.Name()), zap.Error(err))
+	}
+
+	// One pending request per settlement.
+	_, err = r.requestsColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "settlement_id", Value: 1}},
+		Options: options.Index().
+			SetUnique(true).
+			SetPartialFilterExpression(bson.M{"status": "pending"}),
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.requestsColl.Name()), zap.Error(err))
+	}
+
+	now := time.Now()
+	for _, f := range defaultFactions {
+		_, err := r.factionsColl.UpdateOne(ctx,
+			bson.M{"_id": f.id},
+			bson.M{"$setOnInsert": bson.M{
+				"name":        f.name,
+				"description": "",
+				"point_limit": defaultPointLimit,
+				"created_at":  now,
+				"updated_at":  now,
+			}},
+			options.UpdateOne().SetUpsert(true),
+		)
+		if err != nil {
+			r.log.Error("failed to seed faction", zap.String("faction_id", f.id), zap.Error(err))
+		}
+	}
+}
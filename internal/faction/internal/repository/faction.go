@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/dto"
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func (r *Repository) CreateFaction(ctx context.Context, f model.Faction) (*model.Faction, error) {
+	now := time.Now()
+	d := dto.Faction{
+		Id:          f.Id,
+		Name:        f.Name,
+		Description: f.Description,
+		PointLimit:  f.PointLimit,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if _, err := r.factionsColl.InsertOne(ctx, d); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ierror.ErrFactionExists
+		}
+		return nil, err
+	}
+	created := r.mapper.ToFaction(d)
+	return &created, nil
+}
+
+func (r *Repository) UpdateFaction(ctx context.Context, f model.Faction) (*model.Faction, error) {
+	res, err := r.factionsColl.UpdateOne(ctx,
+		bson.M{"_id": f.Id},
+		bson.M{"$set": bson.M{
+			"name":        f.Name,
+			"description": f.Description,
+			"point_limit": f.PointLimit,
+			"updated_at":  time.Now(),
+		}},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res.MatchedCount == 0 {
+		return nil, ierror.ErrFactionNotFound
+	}
+	return r.GetFaction(ctx, f.Id)
+}
+
+func (r *Repository) GetFaction(ctx context.Context, id string) (*model.Faction, error) {
+	var d dto.Faction
+	if err := r.factionsColl.FindOne(ctx, bson.M{"_id": id}).Decode(&d); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ierror.ErrFactionNotFound
+		}
+		return nil, err
+	}
+	members, err := r.listMembers(ctx, bson.M{"faction_id": id})
+	if err != nil {
+		return nil, err
+	}
+	d.SettlementIds = members[id]
+	f := r.mapper.ToFaction(d)
+	return &f, nil
+}
+
+func (r *Repository) ListFactions(ctx context.Context) ([]model.Faction, error) {
+	cur, err := r.factionsColl.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var ds []dto.Faction
+	if err := cur.All(ctx, &ds); err != nil {
+		return nil, err
+	}
+	members, err := r.listMembers(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	factions := make([]model.Faction, 0, len(ds))
+	for _, d := range ds {
+		d.SettlementIds = members[d.Id]
+		factions = append(factions, r.mapper.ToFaction(d))
+	}
+	return factions, nil
+}
+
+// listMembers returns the settlement ids of matching members by faction.
+func (r *Repository) listMembers(ctx context.Context, filter bson.M) (map[string][]string, error) {
+	cur, err := r.membersColl.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "joined_at", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var ds []dto.FactionMember
+	if err := cur.All(ctx, &ds); err != nil {
+		return nil, err
+	}
+	members := make(map[string][]string)
+	for _, d := range ds {
+		members[d.FactionId] = append(members[d.FactionId], d.SettlementId.Hex())
+	}
+	return members, nil
+}
+
+// AddMember makes the settlement a member of the faction. It returns
+// ErrAlreadyInFaction if the settlement belongs to any faction already.
+func (r *Repository) AddMember(ctx context.Context, factionId, settlementId string) error {
+	oid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return err
+	}
+	_, err = r.membersColl.InsertOne(ctx, dto.FactionMember{
+		SettlementId: oid,
+		FactionId:    factionId,
+		JoinedAt:     time.Now(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return ierror.ErrAlreadyInFaction
+	}
+	return err
+}
+
+func (r *Repository) RemoveMember(ctx context.Context, factionId, settlementId string) error {
+	oid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return ierror.ErrNotMember
+	}
+	res, err := r.membersColl.DeleteOne(ctx, bson.M{"_id": oid, "faction_id": factionId})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ierror.ErrNotMember
+	}
+	return nil
+}
+
+// MemberFaction returns the id of the faction the settlement belongs to, or
+// ErrNotMember.
+func (r *Repository) MemberFaction(ctx context.Context, settlementId string) (string, error) {
+	oid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return "", ierror.ErrNotMember
+	}
+	var d dto.FactionMember
+	if err := r.membersColl.FindOne(ctx, bson.M{"_id": oid}).Decode(&d); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return "", ierror.ErrNotMember
+		}
+		return "", err
+	}
+	return d.FactionId, nil
+}
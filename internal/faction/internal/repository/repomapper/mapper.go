@@ -0,0 +1,41 @@
+// Code generated by github.com/jmattheis/goverter, DO NOT EDIT.
+//go:build !goverter
+
+package repomapper
+
+import (
+	dto "github.com/lasthearth/vsservice/internal/faction/internal/dto"
+	model "github.com/lasthearth/vsservice/internal/faction/internal/model"
+	goverter "github.com/lasthearth/vsservice/internal/pkg/goverter"
+)
+
+type MapperImpl struct{}
+
+func (c *MapperImpl) ToFaction(source dto.Faction) model.Faction {
+	var modelFaction model.Faction
+	modelFaction.Id = source.Id
+	modelFaction.Name = source.Name
+	modelFaction.Description = source.Description
+	modelFaction.PointLimit = source.PointLimit
+	if source.SettlementIds != nil {
+		modelFaction.SettlementIds = make([]string, len(source.SettlementIds))
+		for i := 0; i < len(source.SettlementIds); i++ {
+			modelFaction.SettlementIds[i] = source.SettlementIds[i]
+		}
+	}
+	modelFaction.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return modelFaction
+}
+func (c *MapperImpl) ToJoinRequest(source dto.JoinRequest) model.JoinRequest {
+	var modelJoinRequest model.JoinRequest
+	modelJoinRequest.Id = goverter.ObjectIdToString(source.Model.Id)
+	modelJoinRequest.FactionId = source.FactionId
+	modelJoinRequest.SettlementId = goverter.ObjectIdToString(source.SettlementId)
+	modelJoinRequest.RequestedBy = source.RequestedBy
+	modelJoinRequest.Status = model.JoinRequestStatus(source.Status)
+	modelJoinRequest.CreatedAt = goverter.TimeToTime(source.Model.CreatedAt)
+	modelJoinRequest.DecidedBy = source.DecidedBy
+	modelJoinRequest.DecidedAt = goverter.TimeToTime(source.DecidedAt)
+	modelJoinRequest.Reason = source.Reason
+	return modelJoinRequest
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/dto"
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// CreateJoinRequest stores a pending request. It returns ErrRequestPending if
+// the settlement has one already.
+func (r *Repository) CreateJoinRequest(ctx context.Context, req model.JoinRequest) (*model.JoinRequest, error) {
+	oid, err := mongox.ParseObjectID(req.SettlementId)
+	if err != nil {
+		return nil, err
+	}
+	d := dto.JoinRequest{
+		Model:        mongox.NewModel(),
+		FactionId:    req.FactionId,
+		SettlementId: oid,
+		RequestedBy:  req.RequestedBy,
+		Status:       string(req.Status),
+	}
+	d.CreatedAt = req.CreatedAt
+	if _, err := r.requestsColl.InsertOne(ctx, d); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ierror.ErrRequestPending
+		}
+		return nil, err
+	}
+	out := r.mapper.ToJoinRequest(d)
+	return &out, nil
+}
+
+func (r *Repository) GetJoinRequest(ctx context.Context, id string) (*model.JoinRequest, error) {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return nil, ierror.ErrRequestNotFound
+	}
+	var d dto.JoinRequest
+	if err := r.requestsColl.FindOne(ctx, bson.M{"_id": oid}).Decode(&d); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ierror.ErrRequestNotFound
+		}
+		return nil, err
+	}
+	out := r.mapper.ToJoinRequest(d)
+	return &out, nil
+}
+
+// ListJoinRequests returns requests newest first; empty filters match all.
+func (r *Repository) ListJoinRequests(ctx context.Context, factionId string, status model.JoinRequestStatus) ([]model.JoinRequest, error) {
+	filter := bson.M{}
+	if factionId != "" {
+		filter["faction_id"] = factionId
+	}
+	if status != "" {
+		filter["status"] = string(status)
+	}
+	cur, err := r.requestsColl.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	var ds []dto.JoinRequest
+	if err := cur.All(ctx, &ds); err != nil {
+		return nil, err
+	}
+	requests := make([]model.JoinRequest, 0, len(ds))
+	for _, d := range ds {
+		requests = append(requests, r.mapper.ToJoinRequest(d))
+	}
+	return requests, nil
+}
+
+// DecideJoinRequest saves the decision on a request that is still pending,
+// and returns ErrRequestDecided otherwise.
+func (r *Repository) DecideJoinRequest(ctx context.Context, req model.JoinRequest) error {
+	oid, err := mongox.ParseObjectID(req.Id)
+	if err != nil {
+		return ierror.ErrRequestNotFound
+	}
+	res, err := r.requestsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "status": string(model.JoinRequestPending)},
+		bson.M{"$set": bson.M{
+			"status":     string(req.Status),
+			"decided_by": req.DecidedBy,
+			"decided_at": req.DecidedAt,
+			"reason":     req.Reason,
+			"updated_at": req.DecidedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ierror.ErrRequestDecided
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"go.uber.org/fx"
+)
+
+var _ factionv1.FactionServiceServer = (*Service)(nil)
+
+type Opts struct {
+	fx.In
+
+	Log     logger.Logger
+	Repo    Repository
+	Leaders LeaderChecker
+}
+
+type Service struct {
+	log     logger.Logger
+	repo    Repository
+	leaders LeaderChecker
+}
+
+func New(opts Opts) *Service {
+	return &Service{
+		log:     opts.Log,
+		repo:    opts.Repo,
+		leaders: opts.Leaders,
+	}
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) CreateFaction(ctx context.Context, req *factionv1.CreateFactionRequest) (*factionv1.Faction, error) {
+	f, err := model.NewFaction(req.GetId(), req.GetName(), req.GetDescription(), int(req.GetPointLimit()))
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.repo.CreateFaction(ctx, *f)
+	if err != nil {
+		return nil, err
+	}
+	return factionToProto(created), nil
+}
+
+func (s *Service) UpdateFaction(ctx context.Context, req *factionv1.UpdateFactionRequest) (*factionv1.Faction, error) {
+	f, err := s.repo.GetFaction(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Update(req.GetName(), req.GetDescription(), int(req.GetPointLimit())); err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.UpdateFaction(ctx, *f)
+	if err != nil {
+		return nil, err
+	}
+	return factionToProto(updated), nil
+}
+
+func (s *Service) GetFaction(ctx context.Context, req *factionv1.GetFactionRequest) (*factionv1.Faction, error) {
+	f, err := s.repo.GetFaction(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return factionToProto(f), nil
+}
+
+func (s *Service) ListFactions(ctx context.Context, _ *factionv1.ListFactionsRequest) (*factionv1.ListFactionsResponse, error) {
+	factions, err := s.repo.ListFactions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &factionv1.ListFactionsResponse{Factions: make([]*factionv1.Faction, 0, len(factions))}
+	for i := range factions {
+		resp.Factions = append(resp.Factions, factionToProto(&factions[i]))
+	}
+	return resp, nil
+}
+
+// RemoveFactionMember takes a settlement out of its faction. Points it holds
+// keep their side until control changes.
+func (s *Service) RemoveFactionMember(ctx context.Context, req *factionv1.RemoveFactionMemberRequest) (*emptypb.Empty, error) {
+	if _, err := s.repo.GetFaction(ctx, req.GetFactionId()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.RemoveMember(ctx, req.GetFactionId(), req.GetSettlementId()); err != nil {
+		return nil, err
+	}
+	s.log.WithMethod("RemoveFactionMember").Info("settlement removed from faction",
+		zap.String("faction_id", req.GetFactionId()),
+		zap.String("settlement_id", req.GetSettlementId()),
+	)
+	return &emptypb.Empty{}, nil
+}
+
+func factionToProto(f *model.Faction) *factionv1.Faction {
+	return &factionv1.Faction{
+		Id:            f.Id,
+		Name:          f.Name,
+		Description:   f.Description,
+		PointLimit:    int32(f.PointLimit),
+		SettlementIds: f.SettlementIds,
+		CreatedAt:     timestamppb.New(f.CreatedAt),
+	}
+}
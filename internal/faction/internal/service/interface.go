@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+)
+
+// Repository is the data access interface consumed by Service.
+type Repository interface {
+	CreateFaction(ctx context.Context, f model.Faction) (*model.Faction, error)
+	UpdateFaction(ctx context.Context, f model.Faction) (*model.Faction, error)
+	GetFaction(ctx context.Context, id string) (*model.Faction, error)
+	ListFactions(ctx context.Context) ([]model.Faction, error)
+
+	AddMember(ctx context.Context, factionId, settlementId string) error
+	RemoveMember(ctx context.Context, factionId, settlementId string) error
+	MemberFaction(ctx context.Context, settlementId string) (string, error)
+
+	CreateJoinRequest(ctx context.Context, req model.JoinRequest) (*model.JoinRequest, error)
+	GetJoinRequest(ctx context.Context, id string) (*model.JoinRequest, error)
+	ListJoinRequests(ctx context.Context, factionId string, status model.JoinRequestStatus) ([]model.JoinRequest, error)
+	DecideJoinRequest(ctx context.Context, req model.JoinRequest) error
+}
+
+// LeaderChecker checks settlement leadership.
+// Implemented by settlementuc.FavorOps, injected via fx.
+type LeaderChecker interface {
+	IsLeader(ctx context.Context, settlementID, playerID string) error
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) RequestJoinFaction(ctx context.Context, req *factionv1.RequestJoinFactionRequest) (*factionv1.FactionJoinRequest, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.leaders.IsLeader(ctx, req.GetSettlementId(), callerID); err != nil {
+		return nil, ierror.ErrNotLeader
+	}
+	if _, err := s.repo.GetFaction(ctx, req.GetFactionId()); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.MemberFaction(ctx, req.GetSettlementId()); !errors.Is(err, ierror.ErrNotMember) {
+		if err != nil {
+			return nil, err
+		}
+		return nil, ierror.ErrAlreadyInFaction
+	}
+
+	created, err := s.repo.CreateJoinRequest(ctx,
+		*model.NewJoinRequest(req.GetFactionId(), req.GetSettlementId(), callerID, time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	return joinRequestToProto(created), nil
+}
+
+func (s *Service) ListJoinRequests(ctx context.Context, req *factionv1.ListJoinRequestsRequest) (*factionv1.ListJoinRequestsResponse, error) {
+	requests, err := s.repo.ListJoinRequests(ctx, req.GetFactionId(), statusFromProto(req.GetStatus()))
+	if err != nil {
+		return nil, err
+	}
+	resp := &factionv1.ListJoinRequestsResponse{Requests: make([]*factionv1.FactionJoinRequest, 0, len(requests))}
+	for i := range requests {
+		resp.Requests = append(resp.Requests, joinRequestToProto(&requests[i]))
+	}
+	return resp, nil
+}
+
+// ApproveJoinRequest adds the settlement to the faction, then marks the
+// request approved. A retry after a failure between the two finds the
+// settlement already a member and only marks the request.
+func (s *Service) ApproveJoinRequest(ctx context.Context, req *factionv1.ApproveJoinRequestRequest) (*factionv1.FactionJoinRequest, error) {
+	l := s.log.WithMethod("ApproveJoinRequest").With(zap.String("request_id", req.GetId()))
+
+	adminID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := s.repo.GetJoinRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := jr.Approve(adminID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	joined := true
+	if err := s.repo.AddMember(ctx, jr.FactionId, jr.SettlementId); err != nil {
+		if !errors.Is(err, ierror.ErrAlreadyInFaction) {
+			return nil, err
+		}
+		current, merr := s.repo.MemberFaction(ctx, jr.SettlementId)
+		if merr != nil {
+			return nil, merr
+		}
+		if current != jr.FactionId {
+			return nil, err
+		}
+		joined = false
+	}
+
+	if err := s.repo.DecideJoinRequest(ctx, *jr); err != nil {
+		// Rejected meanwhile: undo the membership this call added.
+		if errors.Is(err, ierror.ErrRequestDecided) && joined {
+			if rerr := s.repo.RemoveMember(ctx, jr.FactionId, jr.SettlementId); rerr != nil {
+				l.Error("failed to undo membership of a decided request", zap.Error(rerr))
+			}
+		}
+		return nil, err
+	}
+	l.Info("settlement joined faction",
+		zap.String("faction_id", jr.FactionId),
+		zap.String("settlement_id", jr.SettlementId),
+	)
+	return joinRequestToProto(jr), nil
+}
+
+func (s *Service) RejectJoinRequest(ctx context.Context, req *factionv1.RejectJoinRequestRequest) (*factionv1.FactionJoinRequest, error) {
+	adminID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jr, err := s.repo.GetJoinRequest(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := jr.Reject(adminID, req.GetReason(), time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DecideJoinRequest(ctx, *jr); err != nil {
+		return nil, err
+	}
+	return joinRequestToProto(jr), nil
+}
+
+func joinRequestToProto(r *model.JoinRequest) *factionv1.FactionJoinRequest {
+	p := &factionv1.FactionJoinRequest{
+		Id:           r.Id,
+		FactionId:    r.FactionId,
+		SettlementId: r.SettlementId,
+		RequestedBy:  r.RequestedBy,
+		Status:       statusToProto(r.Status),
+		CreatedAt:    timestamppb.New(r.CreatedAt),
+		DecidedBy:    r.DecidedBy,
+		Reason:       r.Reason,
+	}
+	if !r.DecidedAt.IsZero() {
+		p.DecidedAt = timestamppb.New(r.DecidedAt)
+	}
+	return p
+}
+
+func statusToProto(s model.JoinRequestStatus) factionv1.JoinRequestStatus {
+	switch s {
+	case model.JoinRequestPending:
+		return factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_PENDING
+	case model.JoinRequestApproved:
+		return factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED
+	case model.JoinRequestRejected:
+		return factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED
+	default:
+		return factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+func statusFromProto(s factionv1.JoinRequestStatus) model.JoinRequestStatus {
+	switch s {
+	case factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_PENDING:
+		return model.JoinRequestPending
+	case factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED:
+		return model.JoinRequestApproved
+	case factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_REJECTED:
+		return model.JoinRequestRejected
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/faction/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/faction/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	settlA = "652f1c1d1c1d1c1d1c1d1c3f"
+	settlB = "652f1c1d1c1d1c1d1c1d1c4a"
+)
+
+// fakeRepo keeps factions, memberships and requests in memory; the embedded
+// interface panics on anything the tests do not exercise.
+type fakeRepo struct {
+	Repository
+
+	factions map[string]*model.Faction
+	members  map[string]string
+	requests map[string]*model.JoinRequest
+
+	// decideErr, if set, is returned by DecideJoinRequest.
+	decideErr error
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		factions: map[string]*model.Faction{
+			"east": {Id: "east", Name: "Восток", PointLimit: 2},
+			"west": {Id: "west", Name: "Запад", PointLimit: 2},
+		},
+		members:  map[string]string{},
+		requests: map[string]*model.JoinRequest{},
+	}
+}
+
+func (r *fakeRepo) GetFaction(_ context.Context, id string) (*model.Faction, error) {
+	f, ok := r.factions[id]
+	if !ok {
+		return nil, ierror.ErrFactionNotFound
+	}
+	return f, nil
+}
+
+func (r *fakeRepo) AddMember(_ context.Context, factionId, settlementId string) error {
+	if _, ok := r.members[settlementId]; ok {
+		return ierror.ErrAlreadyInFaction
+	}
+	r.members[settlementId] = factionId
+	return nil
+}
+
+func (r *fakeRepo) RemoveMember(_ context.Context, factionId, settlementId string) error {
+	if r.members[settlementId] != factionId {
+		return ierror.ErrNotMember
+	}
+	delete(r.members, settlementId)
+	return nil
+}
+
+func (r *fakeRepo) MemberFaction(_ context.Context, settlementId string) (string, error) {
+	id, ok := r.members[settlementId]
+	if !ok {
+		return "", ierror.ErrNotMember
+	}
+	return id, nil
+}
+
+func (r *fakeRepo) CreateJoinRequest(_ context.Context, req model.JoinRequest) (*model.JoinRequest, error) {
+	for _, existing := range r.requests {
+		if existing.SettlementId == req.SettlementId && existing.Status == model.JoinRequestPending {
+			return nil, ierror.ErrRequestPending
+		}
+	}
+	req.AssignID("r" + req.SettlementId)
+	r.requests[req.Id] = &req
+	return &req, nil
+}
+
+func (r *fakeRepo) GetJoinRequest(_ context.Context, id string) (*model.JoinRequest, error) {
+	req, ok := r.requests[id]
+	if !ok {
+		return nil, ierror.ErrRequestNotFound
+	}
+	out := *req
+	return &out, nil
+}
+
+func (r *fakeRepo) DecideJoinRequest(_ context.Context, req model.JoinRequest) error {
+	if r.decideErr != nil {
+		return r.decideErr
+	}
+	if r.requests[req.Id].Status != model.JoinRequestPending {
+		return ierror.ErrRequestDecided
+	}
+	r.requests[req.Id] = &req
+	return nil
+}
+
+// leaders makes "leader" the leader of every settlement.
+type leaders struct{}
+
+func (leaders) IsLeader(_ context.Context, _, playerID string) error {
+	if playerID != "leader" {
+		return errors.New("not the leader")
+	}
+	return nil
+}
+
+func newTestService(t *testing.T, repo Repository) *Service {
+	t.Helper()
+	zc := zap.NewProductionConfig()
+	zc.Level = zap.NewAtomicLevelAt(zapcore.FatalLevel)
+	l, err := logger.New(&zc)
+	if err != nil {
+		t.Fatalf("logger.New: %v", err)
+	}
+	return New(Opts{Log: l, Repo: repo, Leaders: leaders{}})
+}
+
+func TestJoinRequestApproval(t *testing.T) {
+	repo := newFakeRepo()
+	svc := newTestService(t, repo)
+	leaderCtx := interceptor.ContextWithUserID(context.Background(), "leader")
+	adminCtx := interceptor.ContextWithUserID(context.Background(), "admin")
+
+	if _, err := svc.RequestJoinFaction(interceptor.ContextWithUserID(context.Background(), "member"),
+		&factionv1.RequestJoinFactionRequest{FactionId: "east", SettlementId: settlA}); !errors.Is(err, ierror.ErrNotLeader) {
+		t.Fatalf("request by a member err = %v, want ErrNotLeader", err)
+	}
+	jr, err := svc.RequestJoinFaction(leaderCtx, &factionv1.RequestJoinFactionRequest{FactionId: "east", SettlementId: settlA})
+	if err != nil {
+		t.Fatalf("RequestJoinFaction: %v", err)
+	}
+	if _, err := svc.RequestJoinFaction(leaderCtx,
+		&factionv1.RequestJoinFactionRequest{FactionId: "west", SettlementId: settlA}); !errors.Is(err, ierror.ErrRequestPending) {
+		t.Fatalf("second request err = %v, want ErrRequestPending", err)
+	}
+
+	approved, err := svc.ApproveJoinRequest(adminCtx, &factionv1.ApproveJoinRequestRequest{Id: jr.GetId()})
+	if err != nil {
+		t.Fatalf("ApproveJoinRequest: %v", err)
+	}
+	if approved.GetStatus() != factionv1.JoinRequestStatus_JOIN_REQUEST_STATUS_APPROVED || approved.GetDecidedBy() != "admin" {
+		t.Fatalf("request = %v, want approved by admin", approved)
+	}
+	if repo.members[settlA] != "east" {
+		t.Fatalf("settlement faction = %q, want east", repo.members[settlA])
+	}
+
+	if _, err := svc.ApproveJoinRequest(adminCtx, &factionv1.ApproveJoinRequestRequest{Id: jr.GetId()}); !errors.Is(err, ierror.ErrRequestDecided) {
+		t.Fatalf("second approval err = %v, want ErrRequestDecided", err)
+	}
+	if _, err := svc.RequestJoinFaction(leaderCtx,
+		&factionv1.RequestJoinFactionRequest{FactionId: "west", SettlementId: settlA}); !errors.Is(err, ierror.ErrAlreadyInFaction) {
+		t.Fatalf("request by a member settlement err = %v, want ErrAlreadyInFaction", err)
+	}
+}
+
+func TestApproveUndoesMembershipWhenRequestWasDecided(t *testing.T) {
+	repo := newFakeRepo()
+	svc := newTestService(t, repo)
+	jr, err := svc.RequestJoinFaction(interceptor.ContextWithUserID(context.Background(), "leader"),
+		&factionv1.RequestJoinFactionRequest{FactionId: "east", SettlementId: settlB})
+	if err != nil {
+		t.Fatalf("RequestJoinFaction: %v", err)
+	}
+
+	// Rejected by another admin between reading and saving the decision.
+	repo.decideErr = ierror.ErrRequestDecided
+	_, err = svc.ApproveJoinRequest(interceptor.ContextWithUserID(context.Background(), "admin"),
+		&factionv1.ApproveJoinRequestRequest{Id: jr.GetId()})
+	if !errors.Is(err, ierror.ErrRequestDecided) {
+		t.Fatalf("err = %v, want ErrRequestDecided", err)
+	}
+	if _, ok := repo.members[settlB]; ok {
+		t.Fatal("settlement kept the membership of a request it was not granted")
+	}
+}
@@ -0,0 +1,17 @@
+package service
+
+import "github.com/lasthearth/vsservice/internal/server/interceptor"
+
+// Scope declares required JWT scopes for FactionService.
+func (s *Service) Scope() map[interceptor.Method]interceptor.Scope {
+	svc := "/faction.v1.FactionService/"
+	return map[interceptor.Method]interceptor.Scope{
+		interceptor.Method(svc + "CreateFaction"):       interceptor.Scope("faction:write"),
+		interceptor.Method(svc + "UpdateFaction"):       interceptor.Scope("faction:write"),
+		interceptor.Method(svc + "RequestJoinFaction"):  interceptor.Scope(""),
+		interceptor.Method(svc + "ListJoinRequests"):    interceptor.Scope("faction:write"),
+		interceptor.Method(svc + "ApproveJoinRequest"):  interceptor.Scope("faction:write"),
+		interceptor.Method(svc + "RejectJoinRequest"):   interceptor.Scope("faction:write"),
+		interceptor.Method(svc + "RemoveFactionMember"): interceptor.Scope("faction:write"),
+	}
+}
@@ -0,0 +1,31 @@
+package faction_test
+
+import (
+	"testing"
+
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
+	"github.com/lasthearth/vsservice/internal/faction"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+func TestWiring(t *testing.T) {
+	zc := zap.NewProductionConfig()
+	l, err := logger.New(&zc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = fx.ValidateApp(
+		fx.Supply(fx.Annotate(l, fx.As(new(logger.Logger)))),
+		fx.Supply(&mongo.Database{}, &settlementuc.FavorOps{}),
+		faction.App,
+		fx.Invoke(func(factionv1.FactionServiceServer, *factionuc.Membership) {}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -1,9 +1,16 @@
 package hungergames
 
 import (
+	"context"
+
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/event"
 	repository "github.com/lasthearth/vsservice/internal/hungergames/internal/repository/mongo"
 	"github.com/lasthearth/vsservice/internal/hungergames/internal/service"
+	"github.com/lasthearth/vsservice/internal/kit/kituc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/server/interceptor"
 	"go.uber.org/fx"
@@ -26,16 +33,50 @@ var App = fx.Options(
 				repository.New,
 				fx.As(new(service.Repository)),
 			),
+			func(uc *notificationuc.Create) service.Notifier { return uc },
+			func(uc *kituc.AssignUseCase) service.KitAssigner { return uc },
+			func(uc *donateuc.ShopUseCase) service.ItemGranter { return uc },
 		),
 
+		// Single *Service instance shared by the gRPC server, the scope
+		// registry and the season scheduler.
+		fx.Provide(fx.Private, service.New),
+
 		fx.Provide(
-			fx.Annotate(service.New,
-				fx.As(new(hgv1.HungerGamesServiceServer)),
+			fx.Annotate(
+				func(s *service.Service) hgv1.HungerGamesServiceServer { return s },
 			),
-			fx.Annotate(service.New,
-				fx.As(new(interceptor.Scoper)),
+			fx.Annotate(
+				func(s *service.Service) interceptor.Scoper { return s },
 				fx.ResultTags(`group:"scopers"`),
 			),
 		),
+
+		// Match results reported by the game server over JetStream.
+		fx.Provide(
+			fx.Private,
+			func(s *service.Service) event.MatchRecorder { return s },
+			event.NewEventManagerFx,
+		),
+
+		fx.Invoke(
+			func(lc fx.Lifecycle, bus *event.Bus) {
+				lc.Append(fx.StartStopHook(bus.Subscribe, bus.Unsubscribe))
+			},
+		),
+
+		fx.Invoke(func(lc fx.Lifecycle, svc *service.Service, cfg config.Config) {
+			ctx, cancel := context.WithCancel(context.Background())
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					go svc.RunSeasonScheduler(ctx, cfg.HungerGamesSeasonInterval, cfg.HungerGamesSeasonEndNotice)
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					cancel()
+					return nil
+				},
+			})
+		}),
 	),
 )
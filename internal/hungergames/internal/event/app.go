@@ -0,0 +1,85 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging/mjetstream"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"go.uber.org/fx"
+)
+
+const (
+	streamName            = "hungergames-events"
+	matchFinishedSubject  = "hungergames.match.finished"
+	matchFinishedConsumer = "hungergames-match-finished-consumer"
+	matchFinishedGroup    = "hungergames-match-finished-group"
+
+	// Events that can never be recorded are parked here for inspection.
+	deadLetterStream  = "hungergames-dead-letter"
+	deadLetterSubject = "hungergames.match.finished.dead"
+)
+
+// MatchRecorder records a finished match under the game server's match id.
+// Implemented by service.Service.
+type MatchRecorder interface {
+	RecordFinishedMatch(
+		ctx context.Context,
+		matchID string,
+		mode model.MatchMode,
+		duration time.Duration,
+		players []*hgv1.PlayerMatchResult,
+	) error
+}
+
+type Opts struct {
+	fx.In
+	NC       *nats.Conn
+	Log      logger.Logger
+	Recorder MatchRecorder
+}
+
+type Bus struct {
+	log           logger.Logger
+	recorder      MatchRecorder
+	matchFinished messaging.Subscriber[MatchFinishedEvent]
+}
+
+func NewEventManagerFx(opts Opts) (*Bus, error) {
+	return NewEventManager(opts.NC, opts.Log, opts.Recorder)
+}
+
+func NewEventManager(
+	nc *nats.Conn,
+	log logger.Logger,
+	recorder MatchRecorder,
+) (*Bus, error) {
+	js, err := jetstream.New(nc)
+	if err != nil {
+		return nil, err
+	}
+
+	matchFinished, err := mjetstream.NewSubscriber[MatchFinishedEvent](
+		js,
+		streamName,
+		matchFinishedSubject,
+		matchFinishedConsumer,
+		matchFinishedGroup,
+		log,
+		mjetstream.WithDeadLetter(deadLetterStream, deadLetterSubject),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bus{
+		log:           log.WithComponent("hungergames-event-bus"),
+		recorder:      recorder,
+		matchFinished: matchFinished,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package event
+
+// MatchFinishedEvent is reported by the game server when a hunger-games
+// match ends.
+type MatchFinishedEvent struct {
+	// MatchID is assigned by the game server and identifies the match across
+	// redeliveries.
+	MatchID string `json:"match_id"`
+	// Mode is "solo" or "team"; solo when empty.
+	Mode string `json:"mode,omitempty"`
+	// DurationSeconds is how long the match lasted; 0 when unknown.
+	DurationSeconds int                 `json:"duration_seconds,omitempty"`
+	Players         []MatchPlayerResult `json:"players"`
+}
+
+type MatchPlayerResult struct {
+	PlayerID   string `json:"player_id"`
+	PlayerName string `json:"player_name"`
+	// TeamID is the player's team in team matches.
+	TeamID string `json:"team_id,omitempty"`
+	// Place is 1-based; 1 is the winner. In team matches, the team's place.
+	Place int `json:"place"`
+	Kills int `json:"kills"`
+}
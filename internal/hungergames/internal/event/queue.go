@@ -0,0 +1,62 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b *Bus) Subscribe() {
+	if err := b.matchFinished.Subscribe(b.onMatchFinished); err != nil {
+		b.log.WithMethod("subscribe").Error(
+			"failed to subscribe to match finished queue",
+			zap.Error(err),
+		)
+	}
+}
+
+func (b *Bus) Unsubscribe() {
+	if err := b.matchFinished.Unsubscribe(); err != nil {
+		b.log.Error("failed to unsubscribe matchFinished", zap.Error(err))
+	}
+}
+
+// onMatchFinished records the match. Results the service rejects as invalid
+// are dead-lettered; any other failure, including there being no active
+// season yet, is retried.
+func (b *Bus) onMatchFinished(ctx context.Context, event MatchFinishedEvent) error {
+	b.log.WithMethod("onMatchFinished").Debug("received match finished event",
+		zap.String("match_id", event.MatchID),
+		zap.Int("players", len(event.Players)),
+	)
+
+	mode := model.MatchMode(event.Mode).OrDefault()
+	if mode != model.ModeSolo && mode != model.ModeTeam {
+		return fmt.Errorf("%w: unknown match mode %q", messaging.ErrInvalidEvent, event.Mode)
+	}
+
+	players := lo.Map(event.Players, func(p MatchPlayerResult, _ int) *hgv1.PlayerMatchResult {
+		return &hgv1.PlayerMatchResult{
+			PlayerId:   p.PlayerID,
+			PlayerName: p.PlayerName,
+			TeamId:     p.TeamID,
+			Place:      int32(p.Place),
+			Kills:      int32(p.Kills),
+		}
+	})
+
+	duration := time.Duration(event.DurationSeconds) * time.Second
+	err := b.recorder.RecordFinishedMatch(ctx, event.MatchID, mode, duration, players)
+	if status.Code(err) == codes.InvalidArgument {
+		return fmt.Errorf("%w: %s", messaging.ErrInvalidEvent, status.Convert(err).Message())
+	}
+	return err
+}
@@ -0,0 +1,135 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeRecorder struct {
+	err      error
+	matchID  string
+	mode     model.MatchMode
+	duration time.Duration
+	players  []*hgv1.PlayerMatchResult
+}
+
+func (f *fakeRecorder) RecordFinishedMatch(
+	_ context.Context,
+	matchID string,
+	mode model.MatchMode,
+	duration time.Duration,
+	players []*hgv1.PlayerMatchResult,
+) error {
+	f.matchID = matchID
+	f.mode = mode
+	f.duration = duration
+	f.players = players
+	return f.err
+}
+
+func newTestBus(t *testing.T, rec MatchRecorder) *Bus {
+	t.Helper()
+	zc := zap.NewDevelopmentConfig()
+	l, err := logger.New(&zc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Bus{log: l, recorder: rec}
+}
+
+func TestOnMatchFinished_PassesResults(t *testing.T) {
+	rec := &fakeRecorder{}
+	b := newTestBus(t, rec)
+
+	err := b.onMatchFinished(context.Background(), MatchFinishedEvent{
+		MatchID:         "m-1",
+		DurationSeconds: 300,
+		Players: []MatchPlayerResult{
+			{PlayerID: "a", PlayerName: "A", Place: 1, Kills: 3},
+			{PlayerID: "b", PlayerName: "B", Place: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.matchID != "m-1" {
+		t.Errorf("matchID = %q, want m-1", rec.matchID)
+	}
+	if rec.mode != model.ModeSolo {
+		t.Errorf("mode = %q, want solo", rec.mode)
+	}
+	if rec.duration != 5*time.Minute {
+		t.Errorf("duration = %v, want 5m", rec.duration)
+	}
+	if len(rec.players) != 2 || rec.players[0].GetPlace() != 1 || rec.players[0].GetKills() != 3 {
+		t.Errorf("players not mapped: %v", rec.players)
+	}
+}
+
+func TestOnMatchFinished_TeamMode(t *testing.T) {
+	rec := &fakeRecorder{}
+	b := newTestBus(t, rec)
+
+	err := b.onMatchFinished(context.Background(), MatchFinishedEvent{
+		MatchID: "m-2",
+		Mode:    "team",
+		Players: []MatchPlayerResult{
+			{PlayerID: "a", TeamID: "red", Place: 1},
+			{PlayerID: "b", TeamID: "blue", Place: 2},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.mode != model.ModeTeam || rec.players[1].GetTeamId() != "blue" {
+		t.Errorf("team match not mapped: mode %q, players %v", rec.mode, rec.players)
+	}
+}
+
+func TestOnMatchFinished_UnknownModeIsInvalid(t *testing.T) {
+	rec := &fakeRecorder{}
+	b := newTestBus(t, rec)
+
+	err := b.onMatchFinished(context.Background(), MatchFinishedEvent{MatchID: "m-3", Mode: "duel"})
+	if !errors.Is(err, messaging.ErrInvalidEvent) {
+		t.Fatalf("err = %v, want ErrInvalidEvent", err)
+	}
+	if rec.matchID != "" {
+		t.Error("recorder called for an unknown mode")
+	}
+}
+
+func TestOnMatchFinished_ErrorKinds(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantInvalid bool
+	}{
+		{"rejected result is invalid", status.Error(codes.InvalidArgument, "duplicate places not allowed"), true},
+		{"storage failure is retried", status.Error(codes.Internal, "failed to save match"), false},
+		{"no active season is retried", status.Error(codes.FailedPrecondition, "no active season"), false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newTestBus(t, &fakeRecorder{err: tc.err})
+			err := b.onMatchFinished(context.Background(), MatchFinishedEvent{MatchID: "m-1"})
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := errors.Is(err, messaging.ErrInvalidEvent); got != tc.wantInvalid {
+				t.Errorf("errors.Is(err, ErrInvalidEvent) = %v, want %v", got, tc.wantInvalid)
+			}
+		})
+	}
+}
@@ -6,4 +6,17 @@ var (
 	ErrNotFound           = ierror.NotFound("not found")
 	ErrNoActiveSeason     = ierror.NotFound("no active season")
 	ErrActiveSeasonExists = ierror.AlreadyExists("active season already exists")
+	ErrSeasonClosed       = ierror.FailedPrecondition("season already closed")
+	ErrInvalidSchedule    = ierror.InvalidArgument("season must end after it starts")
+	ErrInvalidRewards     = ierror.InvalidArgument("reward tiers need non-overlapping ranks from 1 or a percent up to 100, and complete rewards")
+	ErrScheduleOverlap    = ierror.AlreadyExists("schedule overlaps another season")
+	ErrResetExists        = ierror.AlreadyExists("season reset already started")
+	ErrResetInProgress    = ierror.FailedPrecondition("season reset is in progress")
+	ErrResetCompleted     = ierror.FailedPrecondition("season reset already completed")
+	ErrResetChanged       = ierror.FailedPrecondition("season reset was changed concurrently")
+	ErrMatchExists        = ierror.AlreadyExists("match already recorded")
+	ErrMatchNotFlagged    = ierror.FailedPrecondition("match is not awaiting review")
+	ErrMatchVoided        = ierror.FailedPrecondition("match already voided")
+	ErrMatchChanged       = ierror.FailedPrecondition("match review was changed concurrently")
+	ErrMatchRecording     = ierror.FailedPrecondition("match is still being recorded")
 )
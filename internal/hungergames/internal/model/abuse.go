@@ -0,0 +1,125 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// AbuseFlag names a heuristic that found a match suspicious.
+type AbuseFlag string
+
+const (
+	// FlagRepeatedGroup is set when the same small group of players keeps
+	// playing each other.
+	FlagRepeatedGroup AbuseFlag = "repeated_group"
+	// FlagShortMatch is set when a match ended implausibly fast.
+	FlagShortMatch AbuseFlag = "short_match"
+	// FlagWinStreak is set when a player keeps beating the same opponent in
+	// small matches.
+	FlagWinStreak AbuseFlag = "win_streak"
+)
+
+// SmallMatchPlayers is the most players a match may have for the group
+// heuristics to apply; farming rating needs few accounts.
+const SmallMatchPlayers = 4
+
+// AbuseRules are the thresholds of the rating-farming heuristics. A zero
+// threshold disables its heuristic.
+type AbuseRules struct {
+	// Window is how far back earlier matches are compared.
+	Window time.Duration
+	// RepeatedGroup is how many matches of the same small group within
+	// Window, this one included, are suspicious.
+	RepeatedGroup int
+	// MinDuration is the shortest plausible match.
+	MinDuration time.Duration
+	// WinStreak is how many small matches in a row one player may win
+	// with the same opponent placed below them within Window.
+	WinStreak int
+	// FreezeRatings freezes the rating of every player of a flagged match
+	// until its review is resolved.
+	FreezeRatings bool
+}
+
+// Detect returns the heuristics match trips given the earlier, non-voided
+// matches its players played within the window, oldest first.
+func (r AbuseRules) Detect(match *Match, recent []*Match) []AbuseFlag {
+	var flags []AbuseFlag
+	if r.MinDuration > 0 && match.Duration > 0 && match.Duration < r.MinDuration {
+		flags = append(flags, FlagShortMatch)
+	}
+	if !match.isSmall() {
+		return flags
+	}
+	recent = slices.DeleteFunc(slices.Clone(recent), func(m *Match) bool {
+		return !m.isSmall() || match.PlayedAt.Sub(m.PlayedAt) > r.Window
+	})
+	if r.RepeatedGroup > 0 && r.countSameGroup(match, recent)+1 >= r.RepeatedGroup {
+		flags = append(flags, FlagRepeatedGroup)
+	}
+	if r.WinStreak > 0 && r.hasWinStreak(match, recent) {
+		flags = append(flags, FlagWinStreak)
+	}
+	return flags
+}
+
+func (r AbuseRules) countSameGroup(match *Match, recent []*Match) int {
+	group := match.playerSet()
+	n := 0
+	for _, m := range recent {
+		if slices.Equal(m.playerSet(), group) {
+			n++
+		}
+	}
+	return n
+}
+
+// hasWinStreak reports whether the winner of match has now beaten one of
+// the other players in WinStreak consecutive small matches they shared.
+func (r AbuseRules) hasWinStreak(match *Match, recent []*Match) bool {
+	for _, w := range match.Participants {
+		if w.Place != 1 {
+			continue
+		}
+		for _, o := range match.Participants {
+			if !match.beat(w, o) {
+				continue
+			}
+			streak := 1
+			for i := len(recent) - 1; i >= 0 && streak < r.WinStreak; i-- {
+				pw, po := recent[i].Participant(w.PlayerID), recent[i].Participant(o.PlayerID)
+				if pw == nil || po == nil {
+					continue
+				}
+				if pw.Place != 1 || !recent[i].beat(*pw, *po) {
+					break
+				}
+				streak++
+			}
+			if streak >= r.WinStreak {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m *Match) isSmall() bool {
+	return len(m.Participants) <= SmallMatchPlayers
+}
+
+// playerSet returns the sorted ids of the match's players.
+func (m *Match) playerSet() []string {
+	ids := m.PlayerIDs()
+	slices.Sort(ids)
+	return ids
+}
+
+// beat reports whether a placed ahead of b as an opponent rather than a
+// teammate.
+func (m *Match) beat(a, b MatchParticipant) bool {
+	if m.Mode == ModeTeam && a.TeamID == b.TeamID {
+		return false
+	}
+	return a.Place < b.Place
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+var testRules = AbuseRules{
+	Window:        24 * time.Hour,
+	RepeatedGroup: 3,
+	MinDuration:   2 * time.Minute,
+	WinStreak:     3,
+}
+
+func playedMatch(at time.Time, places ...string) *Match {
+	parts := make([]MatchParticipant, len(places))
+	for i, id := range places {
+		parts[i] = MatchParticipant{PlayerID: id, Place: i + 1}
+	}
+	m := NewMatch("s1", ModeSolo, "", 10*time.Minute, parts)
+	m.PlayedAt = at
+	return m
+}
+
+func TestAbuseRules_ShortMatch(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	m := playedMatch(now, "a", "b")
+	m.Duration = 30 * time.Second
+
+	if flags := testRules.Detect(m, nil); !slices.Contains(flags, FlagShortMatch) {
+		t.Errorf("flags = %v, want short_match", flags)
+	}
+	m.Duration = 0
+	if flags := testRules.Detect(m, nil); len(flags) != 0 {
+		t.Errorf("flags = %v, want none when the duration is unknown", flags)
+	}
+}
+
+func TestAbuseRules_RepeatedGroup(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	recent := []*Match{
+		playedMatch(now.Add(-2*time.Hour), "b", "a"),
+		playedMatch(now.Add(-time.Hour), "a", "c"),
+		playedMatch(now.Add(-30*time.Minute), "a", "b"),
+	}
+
+	if flags := testRules.Detect(playedMatch(now, "a", "b"), recent); !slices.Contains(flags, FlagRepeatedGroup) {
+		t.Errorf("flags = %v, want repeated_group", flags)
+	}
+	// Outside the window the earlier games no longer count.
+	if flags := testRules.Detect(playedMatch(now.Add(25*time.Hour), "a", "b"), recent); slices.Contains(flags, FlagRepeatedGroup) {
+		t.Errorf("flags = %v, want no repeated_group after the window", flags)
+	}
+	// Big lobbies are never a farming group.
+	big := playedMatch(now, "a", "b", "c", "d", "e")
+	if flags := testRules.Detect(big, []*Match{playedMatch(now, "a", "b", "c", "d", "e")}); len(flags) != 0 {
+		t.Errorf("flags = %v, want none for a big lobby", flags)
+	}
+}
+
+func TestAbuseRules_WinStreak(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	streak := []*Match{
+		playedMatch(now.Add(-3*time.Hour), "a", "b", "x"),
+		playedMatch(now.Add(-2*time.Hour), "a", "y", "b"),
+	}
+	if flags := testRules.Detect(playedMatch(now, "a", "b"), streak); !slices.Contains(flags, FlagWinStreak) {
+		t.Errorf("flags = %v, want win_streak", flags)
+	}
+
+	broken := append(slices.Clone(streak), playedMatch(now.Add(-time.Hour), "b", "a"))
+	if flags := testRules.Detect(playedMatch(now, "a", "b"), broken); slices.Contains(flags, FlagWinStreak) {
+		t.Errorf("flags = %v, want no win_streak after a loss", flags)
+	}
+}
@@ -0,0 +1,246 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+// MatchMode is how players compete in a match.
+type MatchMode string
+
+const (
+	// ModeSolo is a free-for-all: every player has a place of their own.
+	ModeSolo MatchMode = "solo"
+	// ModeTeam groups players in teams; teammates share their team's place.
+	ModeTeam MatchMode = "team"
+)
+
+// OrDefault returns the mode, or solo for matches and stats recorded before
+// matches had one.
+func (m MatchMode) OrDefault() MatchMode {
+	if m == "" {
+		return ModeSolo
+	}
+	return m
+}
+
+// MatchParticipant is a player's result in a match and the ELO it moved them
+// from and to.
+type MatchParticipant struct {
+	PlayerID   string
+	PlayerName string
+	// TeamID is the player's team in a team match; empty in solo.
+	TeamID    string
+	Place     int
+	Kills     int
+	EloBefore int
+	EloAfter  int
+	// RatingBefore and RatingAfter are the full rating states around the
+	// match, kept so a voided match can be rolled back; zero on matches
+	// recorded before they were kept.
+	RatingBefore RatingState
+	RatingAfter  RatingState
+}
+
+// RecordRating records the player's Elo and rating state before and after
+// the match.
+func (p *MatchParticipant) RecordRating(eloBefore int, before RatingState, eloAfter int, after RatingState) {
+	p.EloBefore, p.RatingBefore = eloBefore, before
+	p.EloAfter, p.RatingAfter = eloAfter, after
+}
+
+// RatingDelta is how far the match moved the player's rating.
+func (p MatchParticipant) RatingDelta() float64 {
+	if p.RatingBefore.IsZero() || p.RatingAfter.IsZero() {
+		return float64(p.EloAfter - p.EloBefore)
+	}
+	return p.RatingAfter.Mu - p.RatingBefore.Mu
+}
+
+// ReviewStatus is where an admin review of a match stands.
+type ReviewStatus string
+
+const (
+	// ReviewNone is a match nobody has to look at.
+	ReviewNone ReviewStatus = ""
+	// ReviewPending is a flagged match awaiting an admin.
+	ReviewPending ReviewStatus = "pending"
+	// ReviewCleared is a flagged match an admin found legitimate.
+	ReviewCleared ReviewStatus = "cleared"
+	// ReviewVoided is a match an admin voided; its rating changes are
+	// rolled back.
+	ReviewVoided ReviewStatus = "voided"
+)
+
+// Match is a recorded match of a season.
+type Match struct {
+	ID       string
+	SeasonID string
+	// ExternalID is the id the game server gave the match; empty for
+	// matches reported without one. A match is recorded once per id.
+	ExternalID string
+	Mode       MatchMode
+	PlayedAt   time.Time
+	// Duration is how long the match lasted; zero when not reported.
+	Duration     time.Duration
+	Participants []MatchParticipant
+	// StatsApplied is set once every player's stats count the match. A
+	// match is stored before its stats are saved, so until then a repeated
+	// report of it finishes the stats instead of being ignored.
+	StatsApplied bool
+	// Flags are the abuse heuristics the match tripped when recorded.
+	Flags  []AbuseFlag
+	Review ReviewStatus
+	// ReviewedBy and ReviewedAt record the admin who cleared or voided the
+	// match.
+	ReviewedBy string
+	ReviewedAt *time.Time
+	VoidReason string
+}
+
+// NewMatch records a match played now, its participants ordered by place.
+// externalID is empty and duration zero when the game server did not report
+// them.
+func NewMatch(seasonID string, mode MatchMode, externalID string, duration time.Duration, participants []MatchParticipant) *Match {
+	participants = slices.Clone(participants)
+	slices.SortFunc(participants, func(a, b MatchParticipant) int { return a.Place - b.Place })
+	return &Match{
+		SeasonID:     seasonID,
+		ExternalID:   externalID,
+		Mode:         mode,
+		PlayedAt:     time.Now(),
+		Duration:     duration,
+		Participants: participants,
+	}
+}
+
+// ReconstituteMatch rebuilds a Match from persisted state. Repository use only.
+func ReconstituteMatch(
+	id, seasonID, externalID string,
+	mode MatchMode,
+	playedAt time.Time,
+	duration time.Duration,
+	participants []MatchParticipant,
+	statsApplied bool,
+	flags []AbuseFlag,
+	review ReviewStatus,
+	reviewedBy string,
+	reviewedAt *time.Time,
+	voidReason string,
+) *Match {
+	return &Match{
+		ID:           id,
+		SeasonID:     seasonID,
+		ExternalID:   externalID,
+		Mode:         mode,
+		PlayedAt:     playedAt,
+		Duration:     duration,
+		Participants: participants,
+		StatsApplied: statsApplied,
+		Flags:        flags,
+		Review:       review,
+		ReviewedBy:   reviewedBy,
+		ReviewedAt:   reviewedAt,
+		VoidReason:   voidReason,
+	}
+}
+
+// AssignID records the persisted identity.
+func (m *Match) AssignID(id string) { m.ID = id }
+
+// Flag puts the match up for admin review for the given heuristics.
+func (m *Match) Flag(flags []AbuseFlag) {
+	if len(flags) == 0 {
+		return
+	}
+	m.Flags = flags
+	m.Review = ReviewPending
+}
+
+// MarkStatsApplied records that every player's stats count the match.
+func (m *Match) MarkStatsApplied() { m.StatsApplied = true }
+
+// FreezesRatings reports whether recording the match freezes the ratings
+// of its players under rules.
+func (m *Match) FreezesRatings(rules AbuseRules) bool {
+	return m.Review == ReviewPending && rules.FreezeRatings
+}
+
+// IsVoided reports whether the match no longer counts.
+func (m *Match) IsVoided() bool {
+	return m.Review == ReviewVoided
+}
+
+// Clear resolves a pending review: the match was legitimate and stands.
+func (m *Match) Clear(adminID string, now time.Time) error {
+	if m.Review != ReviewPending {
+		return ierror.ErrMatchNotFlagged
+	}
+	m.resolve(ReviewCleared, adminID, now)
+	return nil
+}
+
+// Void voids the match, flagged or not. The caller rolls back its rating
+// changes, so a match whose stats are not all applied yet cannot be voided.
+func (m *Match) Void(adminID, reason string, now time.Time) error {
+	if m.IsVoided() {
+		return ierror.ErrMatchVoided
+	}
+	if !m.StatsApplied {
+		return ierror.ErrMatchRecording
+	}
+	m.resolve(ReviewVoided, adminID, now)
+	m.VoidReason = reason
+	return nil
+}
+
+func (m *Match) resolve(status ReviewStatus, adminID string, now time.Time) {
+	m.Review = status
+	m.ReviewedBy = adminID
+	m.ReviewedAt = &now
+}
+
+// PlayerIDs returns the ids of the match's players, in place order.
+func (m *Match) PlayerIDs() []string {
+	ids := make([]string, len(m.Participants))
+	for i, p := range m.Participants {
+		ids[i] = p.PlayerID
+	}
+	return ids
+}
+
+// Participant returns the player's result in the match, or nil if they did
+// not take part.
+func (m *Match) Participant(playerID string) *MatchParticipant {
+	for i := range m.Participants {
+		if m.Participants[i].PlayerID == playerID {
+			return &m.Participants[i]
+		}
+	}
+	return nil
+}
+
+// RatingPoint is a player's ELO right after a match.
+type RatingPoint struct {
+	MatchID  string
+	PlayedAt time.Time
+	Elo      int
+}
+
+// RatingHistory returns the player's ELO after each of matches, in the order
+// given. Matches the player did not take part in and voided matches are
+// skipped.
+func RatingHistory(playerID string, matches []*Match) []RatingPoint {
+	points := make([]RatingPoint, 0, len(matches))
+	for _, m := range matches {
+		if m.IsVoided() {
+			continue
+		}
+		if p := m.Participant(playerID); p != nil {
+			points = append(points, RatingPoint{MatchID: m.ID, PlayedAt: m.PlayedAt, Elo: p.EloAfter})
+		}
+	}
+	return points
+}
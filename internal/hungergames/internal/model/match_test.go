@@ -0,0 +1,69 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+func TestNewMatchOrdersByPlace(t *testing.T) {
+	m := NewMatch("s1", ModeSolo, "", 0, []MatchParticipant{
+		{PlayerID: "b", Place: 2},
+		{PlayerID: "c", Place: 3},
+		{PlayerID: "a", Place: 1},
+	})
+
+	for i, want := range []string{"a", "b", "c"} {
+		if got := m.Participants[i].PlayerID; got != want {
+			t.Fatalf("participant %d = %q, want %q", i, got, want)
+		}
+	}
+	if m.Participant("c") == nil || m.Participant("d") != nil {
+		t.Fatal("Participant does not find exactly the players of the match")
+	}
+}
+
+func TestRatingHistory(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	matches := []*Match{
+		{ID: "m1", PlayedAt: t0, Participants: []MatchParticipant{{PlayerID: "a", EloBefore: 1000, EloAfter: 1016}}},
+		{ID: "m2", PlayedAt: t0.Add(time.Hour), Participants: []MatchParticipant{{PlayerID: "b", EloAfter: 990}}},
+		{ID: "m3", PlayedAt: t0.Add(2 * time.Hour), Participants: []MatchParticipant{{PlayerID: "a", EloBefore: 1016, EloAfter: 1004}}},
+	}
+
+	got := RatingHistory("a", matches)
+	if len(got) != 2 || got[0].MatchID != "m1" || got[0].Elo != 1016 || got[1].MatchID != "m3" || got[1].Elo != 1004 {
+		t.Fatalf("RatingHistory = %+v, want m1 at 1016 then m3 at 1004", got)
+	}
+}
+
+func TestMatch_ReviewAndVoid(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMatch("s1", ModeSolo, "", 0, []MatchParticipant{{PlayerID: "a", Place: 1}, {PlayerID: "b", Place: 2}})
+
+	if err := m.Clear("admin", now); !errors.Is(err, ierror.ErrMatchNotFlagged) {
+		t.Errorf("Clear(unflagged) = %v, want ErrMatchNotFlagged", err)
+	}
+	m.Flag([]AbuseFlag{FlagShortMatch})
+	if m.Review != ReviewPending {
+		t.Fatalf("Review = %q, want pending", m.Review)
+	}
+	if err := m.Void("admin", "farming", now); !errors.Is(err, ierror.ErrMatchRecording) {
+		t.Errorf("Void(stats not applied) = %v, want ErrMatchRecording", err)
+	}
+	m.MarkStatsApplied()
+	if err := m.Void("admin", "farming", now); err != nil {
+		t.Fatalf("Void: %v", err)
+	}
+	if !m.IsVoided() || m.ReviewedBy != "admin" || m.VoidReason != "farming" {
+		t.Errorf("match not voided: %+v", m)
+	}
+	if err := m.Void("admin", "", now); !errors.Is(err, ierror.ErrMatchVoided) {
+		t.Errorf("Void(voided) = %v, want ErrMatchVoided", err)
+	}
+	if got := RatingHistory("a", []*Match{m}); len(got) != 0 {
+		t.Errorf("RatingHistory = %+v, want voided match left out", got)
+	}
+}
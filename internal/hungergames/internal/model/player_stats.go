@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"math"
+	"slices"
+	"time"
+)
 
 const (
 	InitialELO = 1000
@@ -14,11 +18,29 @@ type PlayerStats struct {
 	PlayerID   string
 	PlayerName string
 	Elo        int
-	Wins       int
-	Kills      int
-	SeasonID   string
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	// Wins counts solo wins; team wins are kept apart in TeamWins.
+	Wins     int
+	TeamWins int
+	Kills    int
+	// SoloMatches and TeamMatches count the matches played in each mode.
+	SoloMatches int
+	TeamMatches int
+	SeasonID    string
+	// Rating is the state of the season's rating system; Elo shows its Mu.
+	Rating RatingState
+	// Conservative is a rating the player is very likely above, used to
+	// rank players whose rating is still uncertain.
+	Conservative int
+	// RatingFrozen keeps the rating where it is while a match the player
+	// took part in awaits abuse review; results are still counted.
+	RatingFrozen bool
+	// AppliedMatches are the matches already counted whose recording is
+	// not yet finished, so a redelivered match is never counted twice.
+	AppliedMatches []string
+	// RevertedMatches are the voided matches already rolled back.
+	RevertedMatches []string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func NewPlayerStats(playerID, playerName, seasonID string) *PlayerStats {
@@ -34,17 +56,34 @@ func NewPlayerStats(playerID, playerName, seasonID string) *PlayerStats {
 }
 
 // ReconstitutePlayerStats rebuilds PlayerStats from persisted state. Repository use only.
-func ReconstitutePlayerStats(id, playerID, playerName string, elo, wins, kills int, seasonID string, createdAt, updatedAt time.Time) *PlayerStats {
+func ReconstitutePlayerStats(
+	id, playerID, playerName string,
+	elo, wins, teamWins, kills, soloMatches, teamMatches int,
+	seasonID string,
+	rating RatingState,
+	conservative int,
+	ratingFrozen bool,
+	appliedMatches, revertedMatches []string,
+	createdAt, updatedAt time.Time,
+) *PlayerStats {
 	return &PlayerStats{
-		ID:         id,
-		PlayerID:   playerID,
-		PlayerName: playerName,
-		Elo:        elo,
-		Wins:       wins,
-		Kills:      kills,
-		SeasonID:   seasonID,
-		CreatedAt:  createdAt,
-		UpdatedAt:  updatedAt,
+		ID:              id,
+		PlayerID:        playerID,
+		PlayerName:      playerName,
+		Elo:             elo,
+		Wins:            wins,
+		TeamWins:        teamWins,
+		Kills:           kills,
+		SoloMatches:     soloMatches,
+		TeamMatches:     teamMatches,
+		SeasonID:        seasonID,
+		Rating:          rating,
+		Conservative:    conservative,
+		RatingFrozen:    ratingFrozen,
+		AppliedMatches:  appliedMatches,
+		RevertedMatches: revertedMatches,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
 	}
 }
 
@@ -56,14 +95,140 @@ func (p *PlayerStats) SetELO(newELO int) {
 	p.Elo = newELO
 }
 
+// EloOf is the ELO shown for a rating state.
+func EloOf(state RatingState) int {
+	return max(int(math.Round(state.Mu)), MinELO)
+}
+
+// SetRating records the player's new rating state and the conservative
+// rating derived from it; Elo follows the state's Mu.
+func (p *PlayerStats) SetRating(state RatingState, conservative float64) {
+	p.Rating = state
+	p.Elo = EloOf(state)
+	p.Conservative = int(math.Round(conservative))
+}
+
+// FreezeRating stops the rating from moving while a match of the player
+// awaits abuse review.
+func (p *PlayerStats) FreezeRating() {
+	p.RatingFrozen = true
+}
+
+// UnfreezeRating lets the rating move again.
+func (p *PlayerStats) UnfreezeRating() {
+	p.RatingFrozen = false
+}
+
 // RecordWin increments the player's win counter.
 func (p *PlayerStats) RecordWin() {
 	p.Wins++
 }
 
+// RecordResult counts a match the player played in mode, and their win if
+// they (or their team) placed first.
+func (p *PlayerStats) RecordResult(mode MatchMode, won bool) {
+	switch mode.OrDefault() {
+	case ModeTeam:
+		p.TeamMatches++
+		if won {
+			p.TeamWins++
+		}
+	default:
+		p.SoloMatches++
+		if won {
+			p.RecordWin()
+		}
+	}
+}
+
 // AddKills accumulates kills from a single match.
 func (p *PlayerStats) AddKills(kills int) {
 	if kills > 0 {
 		p.Kills += kills
 	}
 }
+
+// ApplyMatch counts the player's part in a recorded match: its result,
+// kills and, unless the rating is frozen, its rating change. freeze freezes
+// the rating first. The rating moves to part.RatingAfter when it is still
+// where the match found it; if a later match moved it since, only Mu moves
+// by the match's change. It reports false if the match was already applied.
+func (p *PlayerStats) ApplyMatch(
+	matchID string,
+	mode MatchMode,
+	part MatchParticipant,
+	freeze bool,
+	conservative func(RatingState) float64,
+) bool {
+	if slices.Contains(p.AppliedMatches, matchID) {
+		return false
+	}
+
+	if freeze {
+		p.FreezeRating()
+	}
+	if !p.RatingFrozen {
+		switch {
+		case p.Rating.IsZero() || p.Rating == part.RatingBefore:
+			p.SetRating(part.RatingAfter, conservative(part.RatingAfter))
+		case part.RatingDelta() != 0:
+			next := p.Rating
+			next.Mu += part.RatingDelta()
+			p.SetRating(next, conservative(next))
+		}
+	}
+	p.AddKills(part.Kills)
+	p.RecordResult(mode, part.Place == 1)
+
+	p.AppliedMatches = append(p.AppliedMatches, matchID)
+	return true
+}
+
+// RevertMatch undoes the player's part in a voided match: the rating moves
+// back by what the match changed and its result and kills are uncounted.
+// The earlier rating state is restored exactly when no later match moved
+// the rating; otherwise later changes stand and only Mu moves back. It
+// reports false if the match was already reverted.
+func (p *PlayerStats) RevertMatch(
+	matchID string,
+	mode MatchMode,
+	part MatchParticipant,
+	conservative func(RatingState) float64,
+) bool {
+	if slices.Contains(p.RevertedMatches, matchID) {
+		return false
+	}
+
+	switch {
+	case !part.RatingAfter.IsZero() && p.Rating == part.RatingAfter:
+		p.SetRating(part.RatingBefore, conservative(part.RatingBefore))
+	case part.RatingDelta() != 0:
+		next := p.Rating
+		if next.IsZero() {
+			// Plain ELO stats from before ratings had state.
+			next = RatingState{Mu: float64(p.Elo)}
+		}
+		next.Mu -= part.RatingDelta()
+		p.SetRating(next, conservative(next))
+	}
+
+	won := part.Place == 1
+	switch mode.OrDefault() {
+	case ModeTeam:
+		p.TeamMatches = max(p.TeamMatches-1, 0)
+		if won {
+			p.TeamWins = max(p.TeamWins-1, 0)
+		}
+	default:
+		p.SoloMatches = max(p.SoloMatches-1, 0)
+		if won {
+			p.Wins = max(p.Wins-1, 0)
+		}
+	}
+	if part.Kills > 0 {
+		p.Kills = max(p.Kills-part.Kills, 0)
+	}
+
+	p.RevertedMatches = append(p.RevertedMatches, matchID)
+	return true
+}
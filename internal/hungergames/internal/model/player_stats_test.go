@@ -102,3 +102,109 @@ func TestPlayerStats_AddKills_Accumulates(t *testing.T) {
 		t.Errorf("Kills = %d, want 8", s.Kills)
 	}
 }
+
+func TestPlayerStats_SetRating(t *testing.T) {
+	s := model.NewPlayerStats("u", "p", "s")
+	s.SetRating(model.RatingState{Mu: 1234.6, Sigma: 80, Volatility: 0.06}, 1074.4)
+
+	if s.Elo != 1235 {
+		t.Errorf("Elo = %d, want 1235", s.Elo)
+	}
+	if s.Conservative != 1074 {
+		t.Errorf("Conservative = %d, want 1074", s.Conservative)
+	}
+	if s.Rating.Sigma != 80 {
+		t.Errorf("Rating.Sigma = %v, want 80", s.Rating.Sigma)
+	}
+}
+
+func TestPlayerStats_RecordResult(t *testing.T) {
+	s := model.NewPlayerStats("u", "p", "s")
+	s.RecordResult(model.ModeSolo, true)
+	s.RecordResult("", false)
+	s.RecordResult(model.ModeTeam, true)
+	s.RecordResult(model.ModeTeam, true)
+	s.RecordResult(model.ModeTeam, false)
+
+	if s.Wins != 1 || s.SoloMatches != 2 {
+		t.Errorf("solo: Wins = %d, SoloMatches = %d, want 1 and 2", s.Wins, s.SoloMatches)
+	}
+	if s.TeamWins != 2 || s.TeamMatches != 3 {
+		t.Errorf("team: TeamWins = %d, TeamMatches = %d, want 2 and 3", s.TeamWins, s.TeamMatches)
+	}
+}
+
+func TestPlayerStats_RevertMatch(t *testing.T) {
+	ident := func(s model.RatingState) float64 { return s.Mu }
+	before := model.RatingState{Mu: 1000, Sigma: 300}
+	after := model.RatingState{Mu: 1040, Sigma: 280}
+	part := model.MatchParticipant{Place: 1, Kills: 2, RatingBefore: before, RatingAfter: after}
+
+	s := model.NewPlayerStats("a", "A", "s1")
+	s.SetRating(after, after.Mu)
+	s.RecordResult(model.ModeSolo, true)
+	s.AddKills(2)
+
+	if !s.RevertMatch("m1", model.ModeSolo, part, ident) {
+		t.Fatal("RevertMatch reported the match as already reverted")
+	}
+	if s.Rating != before || s.Elo != 1000 || s.Wins != 0 || s.SoloMatches != 0 || s.Kills != 0 {
+		t.Errorf("stats after revert = %+v, want the state before the match", s)
+	}
+	if s.RevertMatch("m1", model.ModeSolo, part, ident) {
+		t.Error("RevertMatch reverted the same match twice")
+	}
+}
+
+func TestPlayerStats_RevertMatchAfterLaterMatches(t *testing.T) {
+	ident := func(s model.RatingState) float64 { return s.Mu }
+	part := model.MatchParticipant{
+		Place:        2,
+		RatingBefore: model.RatingState{Mu: 1000, Sigma: 300},
+		RatingAfter:  model.RatingState{Mu: 980, Sigma: 290},
+	}
+
+	s := model.NewPlayerStats("a", "A", "s1")
+	// A later match moved the rating on from the voided one.
+	s.SetRating(model.RatingState{Mu: 1010, Sigma: 270}, 1010)
+
+	s.RevertMatch("m1", model.ModeSolo, part, ident)
+	if s.Rating.Mu != 1030 || s.Rating.Sigma != 270 {
+		t.Errorf("rating = %+v, want mu 1030 with the later sigma kept", s.Rating)
+	}
+}
+
+func TestPlayerStats_ApplyMatch(t *testing.T) {
+	ident := func(s model.RatingState) float64 { return s.Mu }
+	part := model.MatchParticipant{
+		Place:        1,
+		Kills:        3,
+		RatingBefore: model.RatingState{Mu: 1000, Sigma: 300},
+		RatingAfter:  model.RatingState{Mu: 1040, Sigma: 280},
+	}
+
+	s := model.NewPlayerStats("a", "A", "s1")
+	if !s.ApplyMatch("m1", model.ModeSolo, part, false, ident) {
+		t.Fatal("ApplyMatch reported the match as already applied")
+	}
+	if s.Rating != part.RatingAfter || s.Elo != 1040 || s.Wins != 1 || s.SoloMatches != 1 || s.Kills != 3 {
+		t.Errorf("stats after apply = %+v, want the match counted", s)
+	}
+	if s.ApplyMatch("m1", model.ModeSolo, part, false, ident) || s.Wins != 1 || s.Kills != 3 {
+		t.Errorf("redelivered match counted twice: %+v", s)
+	}
+
+	// A later match moved the rating before a redelivery reached the player.
+	s = model.NewPlayerStats("a", "A", "s1")
+	s.SetRating(model.RatingState{Mu: 1010, Sigma: 270}, 1010)
+	s.ApplyMatch("m1", model.ModeSolo, part, false, ident)
+	if s.Rating.Mu != 1050 || s.Rating.Sigma != 270 {
+		t.Errorf("rating = %+v, want mu 1050 with the later sigma kept", s.Rating)
+	}
+
+	s = model.NewPlayerStats("a", "A", "s1")
+	s.ApplyMatch("m1", model.ModeSolo, part, true, ident)
+	if !s.RatingFrozen || s.Elo != model.InitialELO || s.Wins != 1 {
+		t.Errorf("stats after frozen apply = %+v, want the result counted and the rating kept", s)
+	}
+}
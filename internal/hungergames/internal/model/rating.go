@@ -0,0 +1,54 @@
+package model
+
+// RatingSystem names the algorithm that rates the players of a season.
+type RatingSystem string
+
+const (
+	// RatingELO is pairwise ELO, normalised by lobby size.
+	RatingELO RatingSystem = "elo"
+	// RatingGlicko2 is Glicko-2: a rating, its deviation and a volatility.
+	RatingGlicko2 RatingSystem = "glicko2"
+	// RatingOpenSkill is the Weng-Lin Plackett-Luce model used by OpenSkill,
+	// built for free-for-all lobbies.
+	RatingOpenSkill RatingSystem = "openskill"
+)
+
+// OrDefault returns the system, or ELO for seasons stored before seasons
+// had one.
+func (r RatingSystem) OrDefault() RatingSystem {
+	if r == "" {
+		return RatingELO
+	}
+	return r
+}
+
+// RatingState is a player's state in the season's rating system, on the
+// same scale as Elo.
+type RatingState struct {
+	// Mu is the rating itself (ELO, Glicko-2 r, OpenSkill μ).
+	Mu float64
+	// Sigma is the uncertainty of Mu (Glicko-2 RD, OpenSkill σ); zero for ELO.
+	Sigma float64
+	// Volatility is the Glicko-2 σ; zero for the other systems.
+	Volatility float64
+}
+
+// IsZero reports whether the state was never set, e.g. stats recorded
+// before ratings had state.
+func (s RatingState) IsZero() bool {
+	return s == RatingState{}
+}
+
+// Shifted returns s moved the way its team moved from before to after: the
+// same change of Mu and Volatility, and Sigma scaled by the same factor.
+func (s RatingState) Shifted(before, after RatingState) RatingState {
+	out := RatingState{
+		Mu:         s.Mu + after.Mu - before.Mu,
+		Sigma:      s.Sigma,
+		Volatility: s.Volatility + after.Volatility - before.Volatility,
+	}
+	if before.Sigma > 0 {
+		out.Sigma *= after.Sigma / before.Sigma
+	}
+	return out
+}
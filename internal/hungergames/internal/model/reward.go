@@ -0,0 +1,148 @@
+package model
+
+import (
+	"strings"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+// RewardKind is what a season reward hands out.
+type RewardKind string
+
+const (
+	// RewardCoins credits Coins to the player's wallet.
+	RewardCoins RewardKind = "coins"
+	// RewardKit assigns the kit named by Ref through the kit module.
+	RewardKit RewardKind = "kit"
+	// RewardItem grants the shop item Ref as a free purchase.
+	RewardItem RewardKind = "item"
+	// RewardTitle awards the title Ref, shown with the season results.
+	RewardTitle RewardKind = "title"
+	// RewardBadge awards the badge Ref, shown with the season results.
+	RewardBadge RewardKind = "badge"
+)
+
+// Reward is a single thing handed out when a season closes.
+type Reward struct {
+	Kind  RewardKind
+	Coins int64
+	// Ref is the kit name, shop item id, title or badge; unused for coins.
+	Ref string
+}
+
+// Delivered reports whether the reward has to be handed out by another
+// module. Titles and badges only live on the archived result.
+func (r Reward) Delivered() bool {
+	switch r.Kind {
+	case RewardCoins, RewardKit, RewardItem:
+		return true
+	}
+	return false
+}
+
+func (r Reward) valid() bool {
+	switch r.Kind {
+	case RewardCoins:
+		return r.Coins > 0 && r.Ref == ""
+	case RewardKit, RewardItem, RewardTitle, RewardBadge:
+		return r.Coins == 0 && strings.TrimSpace(r.Ref) != ""
+	}
+	return false
+}
+
+// RewardTier hands Rewards either to the ranks FromRank through ToRank or,
+// when TopPercent is set, to the best TopPercent of ranked players.
+type RewardTier struct {
+	FromRank   int
+	ToRank     int
+	TopPercent int
+	Rewards    []Reward
+}
+
+// RankCoins is the tier paying coins to a single rank, the shape of the
+// original exact-rank reward table. Zero coins pays nothing.
+func RankCoins(rank int, coins int64) RewardTier {
+	t := RewardTier{FromRank: rank, ToRank: rank}
+	if coins != 0 {
+		t.Rewards = []Reward{{Kind: RewardCoins, Coins: coins}}
+	}
+	return t
+}
+
+// IsPercentile reports whether the tier covers a share of the standings
+// rather than a range of ranks.
+func (t RewardTier) IsPercentile() bool { return t.TopPercent > 0 }
+
+// covers reports whether rank falls into the tier out of ranked players.
+func (t RewardTier) covers(rank, ranked int) bool {
+	if t.IsPercentile() {
+		// Rounded up, so a top tier always covers at least the winner.
+		return rank <= (ranked*t.TopPercent+99)/100
+	}
+	return rank >= t.FromRank && rank <= t.ToRank
+}
+
+// ValidateRewards checks that every tier is either a 1-based rank range or a
+// percentage between 1 and 100, that rank ranges do not overlap, and that
+// every reward is complete for its kind.
+func ValidateRewards(tiers []RewardTier) error {
+	var ranges []RewardTier
+	for _, t := range tiers {
+		if t.IsPercentile() {
+			if t.TopPercent > 100 || t.FromRank != 0 || t.ToRank != 0 {
+				return ierror.ErrInvalidRewards
+			}
+		} else {
+			if t.TopPercent < 0 || t.FromRank < 1 || t.ToRank < t.FromRank {
+				return ierror.ErrInvalidRewards
+			}
+			for _, o := range ranges {
+				if t.FromRank <= o.ToRank && o.FromRank <= t.ToRank {
+					return ierror.ErrInvalidRewards
+				}
+			}
+			ranges = append(ranges, t)
+		}
+		for _, r := range t.Rewards {
+			if !r.valid() {
+				return ierror.ErrInvalidRewards
+			}
+		}
+	}
+	return nil
+}
+
+// RewardsFor returns the rewards earned at rank out of ranked players. A
+// player gets a single tier: a rank range covering the rank wins over any
+// percentile, and among percentiles the narrowest one applies, so the order
+// tiers are listed in does not matter.
+func RewardsFor(tiers []RewardTier, rank, ranked int) []Reward {
+	var best *RewardTier
+	for i := range tiers {
+		t := &tiers[i]
+		if !t.covers(rank, ranked) {
+			continue
+		}
+		if !t.IsPercentile() {
+			return t.Rewards
+		}
+		if best == nil || t.TopPercent < best.TopPercent {
+			best = t
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.Rewards
+}
+
+// CoinsOf sums the coin rewards.
+func CoinsOf(rewards []Reward) int64 {
+	var total int64
+	for _, r := range rewards {
+		if r.Kind == RewardCoins {
+			total += r.Coins
+		}
+	}
+	return total
+}
@@ -0,0 +1,65 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+func TestValidateRewards(t *testing.T) {
+	coins := []Reward{{Kind: RewardCoins, Coins: 10}}
+	cases := []struct {
+		name  string
+		tiers []RewardTier
+		ok    bool
+	}{
+		{"rank range", []RewardTier{{FromRank: 2, ToRank: 3, Rewards: coins}}, true},
+		{"percentile", []RewardTier{{TopPercent: 10, Rewards: coins}}, true},
+		{"every kind", []RewardTier{{FromRank: 1, ToRank: 1, Rewards: []Reward{
+			{Kind: RewardCoins, Coins: 5},
+			{Kind: RewardKit, Ref: "champion"},
+			{Kind: RewardItem, Ref: "item-1"},
+			{Kind: RewardTitle, Ref: "Чемпион"},
+			{Kind: RewardBadge, Ref: "season-1"},
+		}}}, true},
+		{"overlapping ranges", []RewardTier{{FromRank: 1, ToRank: 3}, {FromRank: 3, ToRank: 10}}, false},
+		{"reversed range", []RewardTier{{FromRank: 4, ToRank: 2}}, false},
+		{"range and percent", []RewardTier{{FromRank: 1, ToRank: 1, TopPercent: 10}}, false},
+		{"percent above 100", []RewardTier{{TopPercent: 101}}, false},
+		{"kit without name", []RewardTier{{FromRank: 1, ToRank: 1, Rewards: []Reward{{Kind: RewardKit}}}}, false},
+		{"zero coins", []RewardTier{{FromRank: 1, ToRank: 1, Rewards: []Reward{{Kind: RewardCoins}}}}, false},
+		{"unknown kind", []RewardTier{{FromRank: 1, ToRank: 1, Rewards: []Reward{{Kind: "gems", Ref: "x"}}}}, false},
+	}
+	for _, tc := range cases {
+		err := ValidateRewards(tc.tiers)
+		if tc.ok && err != nil {
+			t.Errorf("%s: ValidateRewards = %v, want nil", tc.name, err)
+		}
+		if !tc.ok && !errors.Is(err, ierror.ErrInvalidRewards) {
+			t.Errorf("%s: ValidateRewards = %v, want ErrInvalidRewards", tc.name, err)
+		}
+	}
+}
+
+func TestRewardsFor(t *testing.T) {
+	reward := func(coins int64) []Reward { return []Reward{{Kind: RewardCoins, Coins: coins}} }
+	tiers := []RewardTier{
+		{TopPercent: 50, Rewards: reward(1)},
+		{TopPercent: 10, Rewards: reward(5)},
+		{FromRank: 1, ToRank: 1, Rewards: reward(100)},
+		{FromRank: 2, ToRank: 3, Rewards: reward(50)},
+	}
+
+	// 40 ranked players: the top 10% is ranks 1-4, the top 50% ranks 1-20.
+	want := map[int]int64{1: 100, 2: 50, 3: 50, 4: 5, 5: 1, 20: 1, 21: 0}
+	for rank, coins := range want {
+		if got := CoinsOf(RewardsFor(tiers, rank, 40)); got != coins {
+			t.Errorf("rank %d: coins = %d, want %d", rank, got, coins)
+		}
+	}
+	// With 3 players the top 10% still covers the winner.
+	if got := CoinsOf(RewardsFor(tiers[:2], 1, 3)); got != 5 {
+		t.Errorf("winner of 3: coins = %d, want 5", got)
+	}
+}
@@ -1,6 +1,11 @@
 package model
 
-import "time"
+import (
+	"slices"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
 
 // Season represents a competitive season of the Hunger Games game mode.
 type Season struct {
@@ -8,28 +13,64 @@ type Season struct {
 	Number    int
 	StartedAt time.Time
 	EndedAt   *time.Time
+	// EndsAt is when the scheduler closes the season; nil leaves it open
+	// until an admin resets it.
+	EndsAt *time.Time
+	// Rewards are the reward tiers handed out when the season closes.
+	Rewards []RewardTier
+	// EndNoticeSent records that players were warned the season is ending.
+	EndNoticeSent bool
+	// RatingSystem rates the season's matches; empty means ELO.
+	RatingSystem RatingSystem
 }
 
-func NewSeason(number int) *Season {
+func NewSeason(number int, ratingSystem RatingSystem) *Season {
 	return &Season{
-		Number:    number,
-		StartedAt: time.Now(),
+		Number:       number,
+		StartedAt:    time.Now(),
+		RatingSystem: ratingSystem,
 	}
 }
 
 // ReconstituteSeason rebuilds a Season from persisted state. Repository use only.
-func ReconstituteSeason(id string, number int, startedAt time.Time, endedAt *time.Time) *Season {
+func ReconstituteSeason(
+	id string,
+	number int,
+	startedAt time.Time,
+	endedAt, endsAt *time.Time,
+	rewards []RewardTier,
+	endNoticeSent bool,
+	ratingSystem RatingSystem,
+) *Season {
 	return &Season{
-		ID:        id,
-		Number:    number,
-		StartedAt: startedAt,
-		EndedAt:   endedAt,
+		ID:            id,
+		Number:        number,
+		StartedAt:     startedAt,
+		EndedAt:       endedAt,
+		EndsAt:        endsAt,
+		Rewards:       rewards,
+		EndNoticeSent: endNoticeSent,
+		RatingSystem:  ratingSystem,
 	}
 }
 
 // AssignID records the persisted identity.
 func (s *Season) AssignID(id string) { s.ID = id }
 
+// SetSchedule sets when the season ends and the rewards it pays. A nil endsAt
+// leaves the season open until it is reset by hand.
+func (s *Season) SetSchedule(endsAt *time.Time, rewards []RewardTier) error {
+	if endsAt != nil && !endsAt.After(s.StartedAt) {
+		return ierror.ErrInvalidSchedule
+	}
+	if err := ValidateRewards(rewards); err != nil {
+		return err
+	}
+	s.EndsAt = endsAt
+	s.Rewards = slices.Clone(rewards)
+	return nil
+}
+
 // End closes the season by recording the end timestamp.
 func (s *Season) End() {
 	now := time.Now()
@@ -40,3 +81,40 @@ func (s *Season) End() {
 func (s *Season) IsActive() bool {
 	return s.EndedAt == nil
 }
+
+// IsDue reports whether the season is still open past its scheduled end.
+func (s *Season) IsDue(now time.Time) bool {
+	return s.IsActive() && s.EndsAt != nil && !now.Before(*s.EndsAt)
+}
+
+// NeedsEndNotice reports whether players should now be warned that the
+// season ends within window.
+func (s *Season) NeedsEndNotice(now time.Time, window time.Duration) bool {
+	if !s.IsActive() || s.EndsAt == nil || s.EndNoticeSent {
+		return false
+	}
+	return now.Before(*s.EndsAt) && !now.Before(s.EndsAt.Add(-window))
+}
+
+// ClosedOnSchedule reports whether the season was closed at its scheduled
+// end rather than reset early by hand.
+func (s *Season) ClosedOnSchedule() bool {
+	return s.EndedAt != nil && s.EndsAt != nil && !s.EndedAt.Before(*s.EndsAt)
+}
+
+// Rollover returns the season that follows s when nothing else is scheduled:
+// it starts at now, lasts as long as s, pays the same rewards and uses the
+// same rating system. It returns nil for a season without a scheduled end.
+func (s *Season) Rollover(number int, now time.Time) *Season {
+	if s.EndsAt == nil {
+		return nil
+	}
+	endsAt := now.Add(s.EndsAt.Sub(s.StartedAt))
+	return &Season{
+		Number:       number,
+		StartedAt:    now,
+		EndsAt:       &endsAt,
+		Rewards:      slices.Clone(s.Rewards),
+		RatingSystem: s.RatingSystem,
+	}
+}
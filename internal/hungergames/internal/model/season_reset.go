@@ -0,0 +1,242 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+// ResetStep is the next step a season reset has to run.
+type ResetStep string
+
+const (
+	// ResetStepClose closes the season and snapshots its standings.
+	ResetStepClose ResetStep = "close"
+	// ResetStepPayRewards credits the reward of every ranked player.
+	ResetStepPayRewards ResetStep = "pay_rewards"
+	// ResetStepArchive saves the standings as the season's results.
+	ResetStepArchive ResetStep = "archive_results"
+	// ResetStepClearStats deletes the season's live player stats.
+	ResetStepClearStats ResetStep = "clear_stats"
+	// ResetStepDone is reached once every step has run.
+	ResetStepDone ResetStep = "done"
+)
+
+// ResetStatus is where a season reset stands.
+type ResetStatus string
+
+const (
+	ResetStatusRunning   ResetStatus = "running"
+	ResetStatusFailed    ResetStatus = "failed"
+	ResetStatusCompleted ResetStatus = "completed"
+)
+
+// ResetStaleAfter is how long a running reset may go without progress before
+// it is treated as abandoned by a crashed process and may be resumed.
+const ResetStaleAfter = 5 * time.Minute
+
+// SeasonReset is the persisted job that ends a season. Each step is recorded
+// once it succeeds, so a reset that fails or is interrupted resumes at the
+// step it stopped at instead of starting over.
+type SeasonReset struct {
+	SeasonID     string
+	SeasonNumber int
+	Rewards      []RewardTier
+	// Standings is the final ranking, frozen when the season closes so every
+	// attempt pays and archives the same ranks.
+	Standings []*SeasonResult
+	// standingsUnsaved is set once the standings change until they are
+	// saved, so progress saves leave them out otherwise.
+	standingsUnsaved bool
+	// PaidThrough counts the standings whose reward has been credited.
+	PaidThrough int
+	Step        ResetStep
+	Status      ResetStatus
+	LastError   string
+	Attempts    int
+	// Version guards every save against a concurrent runner.
+	Version     int
+	StartedAt   time.Time
+	UpdatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// ReconstituteSeasonReset rebuilds a SeasonReset from persisted state, its
+// standings saved. Repository use only.
+func ReconstituteSeasonReset(
+	seasonID string,
+	seasonNumber int,
+	rewards []RewardTier,
+	standings []*SeasonResult,
+	paidThrough int,
+	step ResetStep,
+	status ResetStatus,
+	lastError string,
+	attempts, version int,
+	startedAt, updatedAt time.Time,
+	completedAt *time.Time,
+) *SeasonReset {
+	return &SeasonReset{
+		SeasonID:     seasonID,
+		SeasonNumber: seasonNumber,
+		Rewards:      rewards,
+		Standings:    standings,
+		PaidThrough:  paidThrough,
+		Step:         step,
+		Status:       status,
+		LastError:    lastError,
+		Attempts:     attempts,
+		Version:      version,
+		StartedAt:    startedAt,
+		UpdatedAt:    updatedAt,
+		CompletedAt:  completedAt,
+	}
+}
+
+// NewSeasonReset starts the reset of season, paying rewards.
+func NewSeasonReset(season *Season, rewards []RewardTier, now time.Time) *SeasonReset {
+	return &SeasonReset{
+		SeasonID:     season.ID,
+		SeasonNumber: season.Number,
+		Rewards:      rewards,
+		Step:         ResetStepClose,
+		Status:       ResetStatusRunning,
+		Attempts:     1,
+		StartedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+// SetStandings freezes the final ranking from stats, ordered by ELO
+// descending, and moves on to paying rewards.
+func (r *SeasonReset) SetStandings(stats []*PlayerStats, now time.Time) {
+	r.Standings = make([]*SeasonResult, len(stats))
+	for i, st := range stats {
+		rank := i + 1
+		rewards := slices.Clone(RewardsFor(r.Rewards, rank, len(stats)))
+		r.Standings[i] = &SeasonResult{
+			SeasonID:    r.SeasonID,
+			PlayerID:    st.PlayerID,
+			PlayerName:  st.PlayerName,
+			Elo:         st.Elo,
+			Wins:        st.Wins,
+			TeamWins:    st.TeamWins,
+			Kills:       st.Kills,
+			Rank:        rank,
+			Rewards:     rewards,
+			RewardCoins: CoinsOf(rewards),
+		}
+	}
+	r.PaidThrough = 0
+	r.standingsUnsaved = true
+	r.advance(ResetStepPayRewards, now)
+}
+
+// StandingsUnsaved reports whether the standings changed since they were
+// last saved.
+func (r *SeasonReset) StandingsUnsaved() bool {
+	return r.standingsUnsaved
+}
+
+// MarkSaved records a save of the reset: its version advances and the
+// standings count as saved.
+func (r *SeasonReset) MarkSaved() {
+	r.Version++
+	r.standingsUnsaved = false
+}
+
+// RewardOpKey is the idempotency key of the coins credited for result. It
+// depends only on the season and player, so every attempt reuses it.
+func (r *SeasonReset) RewardOpKey(result *SeasonResult) string {
+	return fmt.Sprintf("hungergames:season:%s:reward:%s", r.SeasonID, result.PlayerID)
+}
+
+// GrantOpKey is the idempotency key of the i-th reward of result when it is
+// a kit or a shop item. Standings are frozen, so the index is stable.
+func (r *SeasonReset) GrantOpKey(result *SeasonResult, i int) string {
+	return fmt.Sprintf("%s:%s:%d", r.RewardOpKey(result), result.Rewards[i].Kind, i)
+}
+
+// RewardReason is the ledger reason of the reward credited for result.
+func (r *SeasonReset) RewardReason(result *SeasonResult) string {
+	return fmt.Sprintf("Season %d reward, rank %d", r.SeasonNumber, result.Rank)
+}
+
+// MarkPaid records that the rewards of the standings up to and including
+// index i are delivered.
+func (r *SeasonReset) MarkPaid(i int, now time.Time) {
+	r.PaidThrough = i + 1
+	r.UpdatedAt = now
+}
+
+// RewardCounts returns how many ranked players are owed a reward that has
+// to be delivered and how many of them have been paid.
+func (r *SeasonReset) RewardCounts() (paid, total int) {
+	for i, res := range r.Standings {
+		if !res.OwesDelivery() {
+			continue
+		}
+		total++
+		if i < r.PaidThrough {
+			paid++
+		}
+	}
+	return paid, total
+}
+
+// FinishRewards moves on to archiving once every reward is paid.
+func (r *SeasonReset) FinishRewards(now time.Time) {
+	r.PaidThrough = len(r.Standings)
+	r.advance(ResetStepArchive, now)
+}
+
+// FinishArchive moves on to clearing the live stats.
+func (r *SeasonReset) FinishArchive(now time.Time) {
+	r.advance(ResetStepClearStats, now)
+}
+
+// Complete marks the reset done.
+func (r *SeasonReset) Complete(now time.Time) {
+	r.advance(ResetStepDone, now)
+	r.Status = ResetStatusCompleted
+	r.CompletedAt = &now
+}
+
+// Fail records that the current step failed; the reset stays at that step.
+func (r *SeasonReset) Fail(err error, now time.Time) {
+	r.Status = ResetStatusFailed
+	r.LastError = err.Error()
+	r.UpdatedAt = now
+}
+
+// IsRunning reports whether a step is still to be run in this attempt.
+func (r *SeasonReset) IsRunning() bool {
+	return r.Status == ResetStatusRunning
+}
+
+// Resume starts another attempt at the step the reset stopped at. A failed
+// reset can always be resumed; a running one only once it has stalled for
+// ResetStaleAfter, since its runner is then presumed dead.
+func (r *SeasonReset) Resume(now time.Time) error {
+	switch r.Status {
+	case ResetStatusCompleted:
+		return ierror.ErrResetCompleted
+	case ResetStatusRunning:
+		if now.Sub(r.UpdatedAt) < ResetStaleAfter {
+			return ierror.ErrResetInProgress
+		}
+	}
+	r.Status = ResetStatusRunning
+	r.LastError = ""
+	r.Attempts++
+	r.UpdatedAt = now
+	return nil
+}
+
+func (r *SeasonReset) advance(step ResetStep, now time.Time) {
+	r.Step = step
+	r.LastError = ""
+	r.UpdatedAt = now
+}
@@ -0,0 +1,107 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+func newTestReset(now time.Time) *SeasonReset {
+	season := &Season{ID: "s1", Number: 2}
+	return NewSeasonReset(season, []RewardTier{RankCoins(1, 100), RankCoins(3, 10)}, now)
+}
+
+func TestSeasonReset_SetStandings(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	r := newTestReset(now)
+
+	r.SetStandings([]*PlayerStats{
+		{PlayerID: "a", Elo: 1300},
+		{PlayerID: "b", Elo: 1200},
+		{PlayerID: "c", Elo: 1100},
+	}, now)
+
+	if r.Step != ResetStepPayRewards {
+		t.Errorf("Step = %q, want %q", r.Step, ResetStepPayRewards)
+	}
+	wantCoins := []int64{100, 0, 10}
+	for i, res := range r.Standings {
+		if res.Rank != i+1 || res.RewardCoins != wantCoins[i] || res.SeasonID != "s1" {
+			t.Errorf("standing %d = %+v, want rank %d paying %d", i, res, i+1, wantCoins[i])
+		}
+	}
+	if paid, total := r.RewardCounts(); paid != 0 || total != 2 {
+		t.Errorf("RewardCounts = %d/%d, want 0/2", paid, total)
+	}
+	if !r.StandingsUnsaved() {
+		t.Error("new standings not marked unsaved")
+	}
+	r.MarkSaved()
+
+	r.MarkPaid(0, now)
+	if paid, _ := r.RewardCounts(); paid != 1 {
+		t.Errorf("paid after first credit = %d, want 1", paid)
+	}
+	if r.StandingsUnsaved() {
+		t.Error("paying a reward marked the standings unsaved")
+	}
+}
+
+func TestSeasonReset_RewardOpKeyIsStable(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	first, second := newTestReset(now), newTestReset(now.Add(time.Hour))
+	res := &SeasonResult{PlayerID: "a", Rank: 1}
+
+	if first.RewardOpKey(res) != second.RewardOpKey(res) {
+		t.Error("op key differs between attempts of the same season reset")
+	}
+	other := NewSeasonReset(&Season{ID: "s2"}, nil, now)
+	if first.RewardOpKey(res) == other.RewardOpKey(res) {
+		t.Error("op key shared between seasons")
+	}
+}
+
+func TestSeasonReset_FailAndResume(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	r := newTestReset(now)
+	r.SetStandings(nil, now)
+
+	if err := r.Resume(now.Add(time.Minute)); !errors.Is(err, ierror.ErrResetInProgress) {
+		t.Errorf("Resume of a fresh running reset = %v, want ErrResetInProgress", err)
+	}
+
+	r.Fail(errors.New("wallet unavailable"), now)
+	if r.IsRunning() || r.Step != ResetStepPayRewards || r.LastError == "" {
+		t.Fatalf("failed reset = %+v, want failed at pay_rewards with the error", r)
+	}
+	if err := r.Resume(now.Add(time.Second)); err != nil {
+		t.Fatalf("Resume of a failed reset: %v", err)
+	}
+	if !r.IsRunning() || r.Attempts != 2 || r.LastError != "" || r.Step != ResetStepPayRewards {
+		t.Errorf("resumed reset = %+v, want running attempt 2 at pay_rewards", r)
+	}
+
+	r.FinishRewards(now)
+	r.FinishArchive(now)
+	r.Complete(now)
+	if r.Status != ResetStatusCompleted || r.Step != ResetStepDone || r.CompletedAt == nil {
+		t.Errorf("completed reset = %+v", r)
+	}
+	if err := r.Resume(now.Add(time.Hour)); !errors.Is(err, ierror.ErrResetCompleted) {
+		t.Errorf("Resume of a completed reset = %v, want ErrResetCompleted", err)
+	}
+}
+
+func TestSeasonReset_ResumeStalledRun(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	r := newTestReset(now)
+
+	if err := r.Resume(now.Add(ResetStaleAfter)); err != nil {
+		t.Fatalf("Resume of a stalled reset: %v", err)
+	}
+	if r.Step != ResetStepClose || r.Attempts != 2 {
+		t.Errorf("resumed reset = %+v, want attempt 2 at close", r)
+	}
+}
@@ -5,14 +5,29 @@ import "time"
 // SeasonResult is the archived snapshot of a player's final standing
 // in a completed season.
 type SeasonResult struct {
-	ID          string
-	SeasonID    string
-	PlayerID    string
-	PlayerName  string
-	Elo         int
-	Wins        int
-	Kills       int
-	Rank        int
+	ID         string
+	SeasonID   string
+	PlayerID   string
+	PlayerName string
+	Elo        int
+	Wins       int
+	TeamWins   int
+	Kills      int
+	Rank       int
+	// Rewards is everything the player earned at Rank; RewardCoins sums
+	// its coins.
+	Rewards     []Reward
 	RewardCoins int64
 	CreatedAt   time.Time
 }
+
+// OwesDelivery reports whether any of the rewards has to be handed out by
+// another module.
+func (r *SeasonResult) OwesDelivery() bool {
+	for _, rw := range r.Rewards {
+		if rw.Delivered() {
+			return true
+		}
+	}
+	return false
+}
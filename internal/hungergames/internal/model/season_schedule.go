@@ -0,0 +1,88 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+// SeasonSchedule is an upcoming season: the scheduler opens it at StartsAt
+// and closes it at EndsAt, paying Rewards.
+type SeasonSchedule struct {
+	ID       string
+	StartsAt time.Time
+	EndsAt   time.Time
+	Rewards  []RewardTier
+	// RatingSystem rates the season's matches; empty means ELO.
+	RatingSystem RatingSystem
+	CreatedAt    time.Time
+}
+
+// NewSeasonSchedule validates and returns a schedule entry. Entries must not
+// overlap each other, which the caller checks with Overlaps.
+func NewSeasonSchedule(startsAt, endsAt time.Time, rewards []RewardTier, ratingSystem RatingSystem) (*SeasonSchedule, error) {
+	if startsAt.IsZero() || !endsAt.After(startsAt) {
+		return nil, ierror.ErrInvalidSchedule
+	}
+	if err := ValidateRewards(rewards); err != nil {
+		return nil, err
+	}
+	return &SeasonSchedule{
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Rewards:      slices.Clone(rewards),
+		RatingSystem: ratingSystem,
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// ReconstituteSeasonSchedule rebuilds a SeasonSchedule from persisted state.
+// Repository use only.
+func ReconstituteSeasonSchedule(
+	id string,
+	startsAt, endsAt time.Time,
+	rewards []RewardTier,
+	ratingSystem RatingSystem,
+	createdAt time.Time,
+) *SeasonSchedule {
+	return &SeasonSchedule{
+		ID:           id,
+		StartsAt:     startsAt,
+		EndsAt:       endsAt,
+		Rewards:      rewards,
+		RatingSystem: ratingSystem,
+		CreatedAt:    createdAt,
+	}
+}
+
+// AssignID records the persisted identity.
+func (s *SeasonSchedule) AssignID(id string) { s.ID = id }
+
+// Overlaps reports whether the two entries share any moment.
+func (s *SeasonSchedule) Overlaps(o *SeasonSchedule) bool {
+	return s.StartsAt.Before(o.EndsAt) && o.StartsAt.Before(s.EndsAt)
+}
+
+// IsDue reports whether the entry should be opened at now.
+func (s *SeasonSchedule) IsDue(now time.Time) bool {
+	return !now.Before(s.StartsAt)
+}
+
+// IsExpired reports whether the entry's whole window has passed, e.g. while
+// the scheduler was down; such an entry is dropped rather than opened.
+func (s *SeasonSchedule) IsExpired(now time.Time) bool {
+	return !now.Before(s.EndsAt)
+}
+
+// Open returns the season the entry describes, started at now.
+func (s *SeasonSchedule) Open(number int, now time.Time) *Season {
+	endsAt := s.EndsAt
+	return &Season{
+		Number:       number,
+		StartedAt:    now,
+		EndsAt:       &endsAt,
+		Rewards:      slices.Clone(s.Rewards),
+		RatingSystem: s.RatingSystem,
+	}
+}
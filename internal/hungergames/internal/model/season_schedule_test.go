@@ -0,0 +1,73 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+)
+
+func TestNewSeasonSchedule(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := NewSeasonSchedule(start, start, nil, ""); !errors.Is(err, ierror.ErrInvalidSchedule) {
+		t.Errorf("empty window: err = %v, want ErrInvalidSchedule", err)
+	}
+	if _, err := NewSeasonSchedule(start, start.Add(time.Hour), []RewardTier{RankCoins(0, 1)}, ""); !errors.Is(err, ierror.ErrInvalidRewards) {
+		t.Errorf("rank 0: err = %v, want ErrInvalidRewards", err)
+	}
+	if _, err := NewSeasonSchedule(start, start.Add(time.Hour), []RewardTier{RankCoins(1, -1)}, ""); !errors.Is(err, ierror.ErrInvalidRewards) {
+		t.Errorf("negative coins: err = %v, want ErrInvalidRewards", err)
+	}
+}
+
+func TestSeasonSchedule_Overlaps(t *testing.T) {
+	day := 24 * time.Hour
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	a, _ := NewSeasonSchedule(start, start.Add(7*day), nil, "")
+
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       bool
+	}{
+		{"back to back", start.Add(7 * day), start.Add(14 * day), false},
+		{"before", start.Add(-7 * day), start, false},
+		{"inside", start.Add(day), start.Add(2 * day), true},
+		{"straddles end", start.Add(6 * day), start.Add(8 * day), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			b, _ := NewSeasonSchedule(tc.start, tc.end, nil, "")
+			if got := a.Overlaps(b); got != tc.want {
+				t.Errorf("Overlaps = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSeasonSchedule_Open(t *testing.T) {
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	sch, _ := NewSeasonSchedule(start, end, []RewardTier{RankCoins(1, 50)}, "")
+
+	if sch.IsDue(start.Add(-time.Second)) {
+		t.Error("due before it starts")
+	}
+	if !sch.IsDue(start) || sch.IsExpired(start) {
+		t.Error("not open-able at its start")
+	}
+	if !sch.IsExpired(end) {
+		t.Error("not expired at its end")
+	}
+
+	late := start.Add(time.Hour)
+	season := sch.Open(3, late)
+	if season.Number != 3 || !season.StartedAt.Equal(late) || !season.EndsAt.Equal(end) {
+		t.Errorf("Open = %+v", season)
+	}
+	if len(season.Rewards) != 1 || CoinsOf(season.Rewards[0].Rewards) != 50 {
+		t.Errorf("Open rewards = %+v", season.Rewards)
+	}
+}
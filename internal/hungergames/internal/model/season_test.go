@@ -1,13 +1,16 @@
 package model
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
 )
 
 func TestNewSeason(t *testing.T) {
 	before := time.Now()
-	s := NewSeason(3)
+	s := NewSeason(3, "")
 	after := time.Now()
 
 	if s.Number != 3 {
@@ -42,7 +45,7 @@ func TestSeason_IsActive(t *testing.T) {
 }
 
 func TestSeason_End(t *testing.T) {
-	s := NewSeason(1)
+	s := NewSeason(1, "")
 
 	if !s.IsActive() {
 		t.Fatal("season should be active before End()")
@@ -64,9 +67,90 @@ func TestSeason_End(t *testing.T) {
 }
 
 func TestSeason_End_Idempotent(t *testing.T) {
-	s := NewSeason(1)
+	s := NewSeason(1, "")
 	s.End()
 
 	time.Sleep(time.Millisecond)
 	s.End() // second End() should not panic
 }
+
+func TestSeason_SetSchedule(t *testing.T) {
+	s := NewSeason(1, "")
+
+	past := s.StartedAt.Add(-time.Hour)
+	if err := s.SetSchedule(&past, nil); !errors.Is(err, ierror.ErrInvalidSchedule) {
+		t.Errorf("SetSchedule(past) = %v, want ErrInvalidSchedule", err)
+	}
+	end := s.StartedAt.Add(time.Hour)
+	dup := []RewardTier{RankCoins(1, 10), RankCoins(1, 5)}
+	if err := s.SetSchedule(&end, dup); !errors.Is(err, ierror.ErrInvalidRewards) {
+		t.Errorf("SetSchedule(duplicate ranks) = %v, want ErrInvalidRewards", err)
+	}
+	if err := s.SetSchedule(&end, []RewardTier{RankCoins(1, 10)}); err != nil {
+		t.Fatalf("SetSchedule: %v", err)
+	}
+	if s.EndsAt == nil || !s.EndsAt.Equal(end) || len(s.Rewards) != 1 {
+		t.Errorf("schedule not stored: %+v", s)
+	}
+}
+
+func TestSeason_DueAndNotice(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(30 * 24 * time.Hour)
+	s := &Season{StartedAt: start, EndsAt: &end}
+
+	if s.NeedsEndNotice(end.Add(-48*time.Hour), 24*time.Hour) {
+		t.Error("notice due two days before the end with a one-day window")
+	}
+	if !s.NeedsEndNotice(end.Add(-time.Hour), 24*time.Hour) {
+		t.Error("notice not due an hour before the end")
+	}
+	s.EndNoticeSent = true
+	if s.NeedsEndNotice(end.Add(-time.Hour), 24*time.Hour) {
+		t.Error("notice due again after it was sent")
+	}
+
+	if s.IsDue(end.Add(-time.Second)) {
+		t.Error("season due before its end")
+	}
+	if !s.IsDue(end) {
+		t.Error("season not due at its end")
+	}
+	if (&Season{StartedAt: start}).IsDue(end) {
+		t.Error("season without an end is due")
+	}
+}
+
+func TestSeason_Rollover(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(7 * 24 * time.Hour)
+	s := &Season{Number: 4, StartedAt: start, EndsAt: &end, Rewards: []RewardTier{RankCoins(1, 100)}}
+
+	if s.ClosedOnSchedule() {
+		t.Error("open season reported closed on schedule")
+	}
+	early := end.Add(-time.Hour)
+	s.EndedAt = &early
+	if s.ClosedOnSchedule() {
+		t.Error("season reset early reported closed on schedule")
+	}
+	s.EndedAt = &end
+	if !s.ClosedOnSchedule() {
+		t.Error("season closed at its end not reported closed on schedule")
+	}
+
+	now := end.Add(time.Minute)
+	next := s.Rollover(5, now)
+	if next.Number != 5 || !next.StartedAt.Equal(now) {
+		t.Errorf("Rollover = %+v, want number 5 starting at %v", next, now)
+	}
+	if want := now.Add(7 * 24 * time.Hour); !next.EndsAt.Equal(want) {
+		t.Errorf("Rollover EndsAt = %v, want %v", next.EndsAt, want)
+	}
+	if len(next.Rewards) != 1 || CoinsOf(next.Rewards[0].Rewards) != 100 {
+		t.Errorf("Rollover rewards = %+v", next.Rewards)
+	}
+	if (&Season{StartedAt: start}).Rollover(2, now) != nil {
+		t.Error("season without an end rolled over")
+	}
+}
@@ -19,6 +19,9 @@ const (
 	playerStatsCollName   = "hg_player_stats"
 	seasonsCollName       = "hg_seasons"
 	seasonResultsCollName = "hg_season_results"
+	matchesCollName       = "hg_matches"
+	schedulesCollName     = "hg_season_schedule"
+	resetsCollName        = "hg_season_resets"
 )
 
 var _ service.Repository = (*Repository)(nil)
@@ -29,6 +32,9 @@ type Repository struct {
 	playerStatsColl  *mgo.Collection
 	seasonsColl      *mgo.Collection
 	seasonResultColl *mgo.Collection
+	matchesColl      *mgo.Collection
+	schedulesColl    *mgo.Collection
+	resetsColl       *mgo.Collection
 }
 
 type Opts struct {
@@ -44,18 +50,27 @@ func New(opts Opts) *Repository {
 	playerStatsColl := opts.Database.Collection(playerStatsCollName)
 	seasonsColl := opts.Database.Collection(seasonsCollName)
 	seasonResultColl := opts.Database.Collection(seasonResultsCollName)
+	matchesColl := opts.Database.Collection(matchesCollName)
+	schedulesColl := opts.Database.Collection(schedulesCollName)
+	resetsColl := opts.Database.Collection(resetsCollName)
 
-	setupIndexes(log, playerStatsColl, seasonsColl, seasonResultColl)
+	setupIndexes(log, playerStatsColl, seasonsColl, seasonResultColl, matchesColl, schedulesColl, resetsColl)
 
 	return &Repository{
 		log:              log,
 		playerStatsColl:  playerStatsColl,
 		seasonsColl:      seasonsColl,
 		seasonResultColl: seasonResultColl,
+		matchesColl:      matchesColl,
+		schedulesColl:    schedulesColl,
+		resetsColl:       resetsColl,
 	}
 }
 
-func setupIndexes(log logger.Logger, playerStatsColl, seasonsColl, seasonResultColl *mgo.Collection) {
+func setupIndexes(
+	log logger.Logger,
+	playerStatsColl, seasonsColl, seasonResultColl, matchesColl, schedulesColl, resetsColl *mgo.Collection,
+) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -74,6 +89,13 @@ func setupIndexes(log logger.Logger, playerStatsColl, seasonsColl, seasonResultC
 	createIndex(playerStatsColl, mgo.IndexModel{
 		Keys: bson.D{{Key: "elo", Value: -1}},
 	})
+	createIndex(playerStatsColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "conservative_rating", Value: -1}},
+	})
+	// Season standings at reset
+	createIndex(playerStatsColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "season_id", Value: 1}, {Key: "elo", Value: -1}},
+	})
 
 	// Only one active season at a time
 	createIndex(seasonsColl, mgo.IndexModel{
@@ -92,6 +114,45 @@ func setupIndexes(log logger.Logger, playerStatsColl, seasonsColl, seasonResultC
 		Keys:    bson.D{{Key: "season_id", Value: 1}, {Key: "player_id", Value: 1}},
 		Options: options.Index().SetUnique(true),
 	})
+
+	// Match history, newest first, per player and per season
+	createIndex(matchesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "participants.player_id", Value: 1}, {Key: "_id", Value: -1}},
+	})
+	createIndex(matchesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "season_id", Value: 1}, {Key: "_id", Value: -1}},
+	})
+	// One match per game-server match id
+	createIndex(matchesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "external_id", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"external_id": bson.M{"$exists": true}}),
+	})
+
+	// Abuse heuristics compare a player's recent matches
+	createIndex(matchesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "participants.player_id", Value: 1}, {Key: "played_at", Value: 1}},
+	})
+	// Matches awaiting admin review
+	createIndex(matchesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "review", Value: 1}, {Key: "_id", Value: -1}},
+		Options: options.Index().
+			SetPartialFilterExpression(bson.M{"review": bson.M{"$exists": true}}),
+	})
+
+	// Upcoming seasons, earliest first
+	createIndex(schedulesColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "starts_at", Value: 1}},
+	})
+
+	// One reset job per season
+	createIndex(resetsColl, mgo.IndexModel{
+		Keys:    bson.D{{Key: "season_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	createIndex(resetsColl, mgo.IndexModel{
+		Keys: bson.D{{Key: "status", Value: 1}, {Key: "started_at", Value: -1}},
+	})
 }
 
 // newModel is a local alias to avoid repeating the package path everywhere.
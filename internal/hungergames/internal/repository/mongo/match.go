@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox/pagination"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mgo "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+type matchParticipantDTO struct {
+	PlayerID   string `bson:"player_id"`
+	PlayerName string `bson:"player_name"`
+	TeamID     string `bson:"team_id,omitempty"`
+	Place      int    `bson:"place"`
+	Kills      int    `bson:"kills"`
+	EloBefore  int    `bson:"elo_before"`
+	EloAfter   int    `bson:"elo_after"`
+	// Absent on matches recorded before rating states were kept.
+	RatingBefore *ratingStateDTO `bson:"rating_before,omitempty"`
+	RatingAfter  *ratingStateDTO `bson:"rating_after,omitempty"`
+}
+
+type ratingStateDTO struct {
+	Mu         float64 `bson:"mu"`
+	Sigma      float64 `bson:"sigma,omitempty"`
+	Volatility float64 `bson:"volatility,omitempty"`
+}
+
+type matchDTO struct {
+	mongox.Model `bson:",inline"`
+	SeasonID     string                `bson:"season_id"`
+	ExternalID   string                `bson:"external_id,omitempty"`
+	Mode         string                `bson:"mode,omitempty"`
+	PlayedAt     time.Time             `bson:"played_at"`
+	DurationMs   int64                 `bson:"duration_ms,omitempty"`
+	Participants []matchParticipantDTO `bson:"participants"`
+	// Absent on matches recorded before it was kept, whose stats were
+	// saved with them.
+	StatsApplied *bool      `bson:"stats_applied,omitempty"`
+	Flags        []string   `bson:"flags,omitempty"`
+	Review       string     `bson:"review,omitempty"`
+	ReviewedBy   string     `bson:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `bson:"reviewed_at,omitempty"`
+	VoidReason   string     `bson:"void_reason,omitempty"`
+}
+
+func (d matchDTO) Id() bson.ObjectID { return d.Model.Id }
+
+func (r *Repository) CreateMatch(ctx context.Context, match *model.Match) error {
+	m := newModel()
+	d := matchToDTO(match)
+	d.Model = m
+
+	if _, err := r.matchesColl.InsertOne(ctx, d); err != nil {
+		if mgo.IsDuplicateKeyError(err) {
+			return ierror.ErrMatchExists
+		}
+		r.log.Error("CreateMatch: insert failed", zap.Error(err))
+		return err
+	}
+	match.AssignID(m.Id.Hex())
+	return nil
+}
+
+func (r *Repository) GetMatch(ctx context.Context, id string) (*model.Match, error) {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return nil, ierror.ErrNotFound
+	}
+
+	var d matchDTO
+	if err := r.matchesColl.FindOne(ctx, bson.M{"_id": oid}).Decode(&d); err != nil {
+		if errors.Is(err, mgo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		r.log.Error("GetMatch: find failed", zap.Error(err))
+		return nil, err
+	}
+	return matchFromDTO(d), nil
+}
+
+func (r *Repository) GetMatchByExternalID(ctx context.Context, externalID string) (*model.Match, error) {
+	var d matchDTO
+	if err := r.matchesColl.FindOne(ctx, bson.M{"external_id": externalID}).Decode(&d); err != nil {
+		if errors.Is(err, mgo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		r.log.Error("GetMatchByExternalID: find failed", zap.Error(err))
+		return nil, err
+	}
+	return matchFromDTO(d), nil
+}
+
+func (r *Repository) MarkMatchStatsApplied(ctx context.Context, match *model.Match) error {
+	oid, err := mongox.ParseObjectID(match.ID)
+	if err != nil {
+		return ierror.ErrNotFound
+	}
+
+	_, err = r.matchesColl.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{
+		"stats_applied": true,
+		"updated_at":    time.Now(),
+	}})
+	if err != nil {
+		r.log.Error("MarkMatchStatsApplied: update failed", zap.Error(err))
+		return err
+	}
+
+	// The players' stats no longer need to remember the match. Failing to
+	// forget it only leaves a stale id behind, so it is not an error.
+	_, err = r.playerStatsColl.UpdateMany(ctx,
+		bson.M{"season_id": match.SeasonID, "player_id": bson.M{"$in": match.PlayerIDs()}},
+		bson.M{"$pull": bson.M{"applied_matches": match.ID}},
+	)
+	if err != nil {
+		r.log.Warn("MarkMatchStatsApplied: pull applied match failed", zap.Error(err))
+	}
+	return nil
+}
+
+// ListRecentMatches returns the season's latest matches played since with any
+// of the players, oldest first. Voided matches are left out.
+func (r *Repository) ListRecentMatches(
+	ctx context.Context,
+	seasonID string,
+	playerIDs []string,
+	since time.Time,
+) ([]*model.Match, error) {
+	filter := bson.M{
+		"season_id":              seasonID,
+		"participants.player_id": bson.M{"$in": playerIDs},
+		"played_at":              bson.M{"$gte": since},
+		"review":                 bson.M{"$ne": string(model.ReviewVoided)},
+	}
+	// Newest first, so the limit keeps the latest matches, then put back in
+	// play order.
+	matches, err := r.findMatches(ctx, "ListRecentMatches", filter, options.Find().
+		SetSort(bson.D{{Key: "played_at", Value: -1}}).
+		SetLimit(recentMatchesLimit))
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(matches)
+	return matches, nil
+}
+
+// recentMatchesLimit bounds the matches the abuse heuristics compare.
+const recentMatchesLimit = 500
+
+func (r *Repository) ListMatchesForReview(ctx context.Context, seasonID, next string, limit int) ([]*model.Match, string, error) {
+	filter := bson.M{"review": string(model.ReviewPending)}
+	if seasonID != "" {
+		filter["season_id"] = seasonID
+	}
+
+	matches, nextToken, err := pagination.List(ctx, r.matchesColl, next, int64(limit), matchFromDTO,
+		pagination.WithFilter(filter),
+	)
+	if err != nil {
+		r.log.Error("ListMatchesForReview: find failed", zap.Error(err))
+		return nil, "", err
+	}
+	return matches, nextToken, nil
+}
+
+func (r *Repository) HasPendingReview(ctx context.Context, seasonID, playerID string) (bool, error) {
+	n, err := r.matchesColl.CountDocuments(ctx, bson.M{
+		"season_id":              seasonID,
+		"participants.player_id": playerID,
+		"review":                 string(model.ReviewPending),
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.Error("HasPendingReview: count failed", zap.Error(err))
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *Repository) SaveMatchReview(ctx context.Context, match *model.Match, from model.ReviewStatus) error {
+	oid, err := mongox.ParseObjectID(match.ID)
+	if err != nil {
+		return ierror.ErrNotFound
+	}
+	// Guarded by the status the review moves from, so two admins cannot
+	// both resolve it.
+	filter := bson.M{"_id": oid, "review": string(from)}
+	if from == model.ReviewNone {
+		filter["review"] = bson.M{"$exists": false}
+	}
+
+	d := matchToDTO(match)
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "review", Value: d.Review},
+		{Key: "reviewed_by", Value: d.ReviewedBy},
+		{Key: "reviewed_at", Value: d.ReviewedAt},
+		{Key: "void_reason", Value: d.VoidReason},
+		{Key: "updated_at", Value: time.Now()},
+	}}}
+
+	res, err := r.matchesColl.UpdateOne(ctx, filter, update)
+	if err != nil {
+		r.log.Error("SaveMatchReview: update failed", zap.Error(err))
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ierror.ErrMatchChanged
+	}
+	return nil
+}
+
+func (r *Repository) ListMatches(ctx context.Context, playerID, seasonID, next string, limit int) ([]*model.Match, string, error) {
+	filter := bson.M{}
+	if playerID != "" {
+		filter["participants.player_id"] = playerID
+	}
+	if seasonID != "" {
+		filter["season_id"] = seasonID
+	}
+
+	matches, nextToken, err := pagination.List(ctx, r.matchesColl, next, int64(limit), matchFromDTO,
+		pagination.WithFilter(filter),
+	)
+	if err != nil {
+		r.log.Error("ListMatches: find failed", zap.Error(err))
+		return nil, "", err
+	}
+	return matches, nextToken, nil
+}
+
+func (r *Repository) ListPlayerSeasonMatches(ctx context.Context, seasonID, playerID string) ([]*model.Match, error) {
+	return r.findMatches(ctx, "ListPlayerSeasonMatches",
+		bson.M{"season_id": seasonID, "participants.player_id": playerID},
+		options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}),
+	)
+}
+
+func (r *Repository) findMatches(
+	ctx context.Context,
+	method string,
+	filter bson.M,
+	opts *options.FindOptionsBuilder,
+) ([]*model.Match, error) {
+	cursor, err := r.matchesColl.Find(ctx, filter, opts)
+	if err != nil {
+		r.log.Error(method+": find failed", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			r.log.Error("cursor close failed", zap.Error(err))
+		}
+	}()
+
+	var dtos []matchDTO
+	if err := cursor.All(ctx, &dtos); err != nil {
+		return nil, err
+	}
+	out := make([]*model.Match, len(dtos))
+	for i, d := range dtos {
+		out[i] = matchFromDTO(d)
+	}
+	return out, nil
+}
+
+func matchToDTO(match *model.Match) matchDTO {
+	d := matchDTO{
+		SeasonID:     match.SeasonID,
+		ExternalID:   match.ExternalID,
+		Mode:         string(match.Mode),
+		PlayedAt:     match.PlayedAt,
+		DurationMs:   match.Duration.Milliseconds(),
+		Participants: make([]matchParticipantDTO, len(match.Participants)),
+		StatsApplied: &match.StatsApplied,
+		Review:       string(match.Review),
+		ReviewedBy:   match.ReviewedBy,
+		ReviewedAt:   match.ReviewedAt,
+		VoidReason:   match.VoidReason,
+	}
+	for i, p := range match.Participants {
+		d.Participants[i] = matchParticipantDTO{
+			PlayerID:     p.PlayerID,
+			PlayerName:   p.PlayerName,
+			TeamID:       p.TeamID,
+			Place:        p.Place,
+			Kills:        p.Kills,
+			EloBefore:    p.EloBefore,
+			EloAfter:     p.EloAfter,
+			RatingBefore: ratingStateToDTO(p.RatingBefore),
+			RatingAfter:  ratingStateToDTO(p.RatingAfter),
+		}
+	}
+	for _, f := range match.Flags {
+		d.Flags = append(d.Flags, string(f))
+	}
+	return d
+}
+
+func matchFromDTO(d matchDTO) *model.Match {
+	participants := make([]model.MatchParticipant, len(d.Participants))
+	for i, p := range d.Participants {
+		participants[i] = model.MatchParticipant{
+			PlayerID:     p.PlayerID,
+			PlayerName:   p.PlayerName,
+			TeamID:       p.TeamID,
+			Place:        p.Place,
+			Kills:        p.Kills,
+			EloBefore:    p.EloBefore,
+			EloAfter:     p.EloAfter,
+			RatingBefore: ratingStateFromDTO(p.RatingBefore),
+			RatingAfter:  ratingStateFromDTO(p.RatingAfter),
+		}
+	}
+	flags := make([]model.AbuseFlag, len(d.Flags))
+	for i, f := range d.Flags {
+		flags[i] = model.AbuseFlag(f)
+	}
+	statsApplied := d.StatsApplied == nil || *d.StatsApplied
+	return model.ReconstituteMatch(
+		d.Model.Id.Hex(),
+		d.SeasonID,
+		d.ExternalID,
+		model.MatchMode(d.Mode).OrDefault(),
+		d.PlayedAt,
+		time.Duration(d.DurationMs)*time.Millisecond,
+		participants,
+		statsApplied,
+		flags,
+		model.ReviewStatus(d.Review),
+		d.ReviewedBy,
+		d.ReviewedAt,
+		d.VoidReason,
+	)
+}
+
+func ratingStateToDTO(s model.RatingState) *ratingStateDTO {
+	if s.IsZero() {
+		return nil
+	}
+	return &ratingStateDTO{Mu: s.Mu, Sigma: s.Sigma, Volatility: s.Volatility}
+}
+
+func ratingStateFromDTO(d *ratingStateDTO) model.RatingState {
+	if d == nil {
+		return model.RatingState{}
+	}
+	return model.RatingState{Mu: d.Mu, Sigma: d.Sigma, Volatility: d.Volatility}
+}
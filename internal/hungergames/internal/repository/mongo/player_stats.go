@@ -20,8 +20,21 @@ type playerStatsDTO struct {
 	PlayerName   string `bson:"player_name"`
 	Elo          int    `bson:"elo"`
 	Wins         int    `bson:"wins"`
+	TeamWins     int    `bson:"team_wins"`
 	Kills        int    `bson:"kills"`
-	SeasonID     string `bson:"season_id"`
+	// Absent on stats recorded before team modes; those count solo only.
+	SoloMatches *int   `bson:"solo_matches,omitempty"`
+	TeamMatches int    `bson:"team_matches"`
+	SeasonID    string `bson:"season_id"`
+	// Rating state of the season's system; absent on stats recorded before
+	// ratings had state, which are seeded from elo.
+	RatingMu           float64  `bson:"rating_mu,omitempty"`
+	RatingSigma        float64  `bson:"rating_sigma,omitempty"`
+	RatingVolatility   float64  `bson:"rating_volatility,omitempty"`
+	ConservativeRating int      `bson:"conservative_rating"`
+	RatingFrozen       bool     `bson:"rating_frozen,omitempty"`
+	AppliedMatches     []string `bson:"applied_matches,omitempty"`
+	RevertedMatches    []string `bson:"reverted_matches,omitempty"`
 }
 
 func (r *Repository) GetPlayerStats(ctx context.Context, seasonID, playerID string) (*model.PlayerStats, error) {
@@ -73,8 +86,18 @@ func (r *Repository) SavePlayerStats(ctx context.Context, stats *model.PlayerSta
 		{Key: "$set", Value: bson.D{
 			{Key: "player_name", Value: stats.PlayerName},
 			{Key: "elo", Value: stats.Elo},
+			{Key: "rating_mu", Value: stats.Rating.Mu},
+			{Key: "rating_sigma", Value: stats.Rating.Sigma},
+			{Key: "rating_volatility", Value: stats.Rating.Volatility},
+			{Key: "conservative_rating", Value: stats.Conservative},
 			{Key: "wins", Value: stats.Wins},
+			{Key: "team_wins", Value: stats.TeamWins},
+			{Key: "solo_matches", Value: stats.SoloMatches},
+			{Key: "team_matches", Value: stats.TeamMatches},
 			{Key: "kills", Value: stats.Kills},
+			{Key: "rating_frozen", Value: stats.RatingFrozen},
+			{Key: "applied_matches", Value: stats.AppliedMatches},
+			{Key: "reverted_matches", Value: stats.RevertedMatches},
 			{Key: "updated_at", Value: now},
 		}},
 		{Key: "$setOnInsert", Value: bson.D{
@@ -92,14 +115,35 @@ func (r *Repository) SavePlayerStats(ctx context.Context, stats *model.PlayerSta
 	return err
 }
 
-func (r *Repository) ListPlayerStatsByELO(ctx context.Context, limit int) ([]*model.PlayerStats, error) {
+func (r *Repository) ListPlayerStatsByRating(
+	ctx context.Context,
+	limit int,
+	conservative bool,
+	mode model.MatchMode,
+) ([]*model.PlayerStats, error) {
+	sortKey := "elo"
+	if conservative {
+		sortKey = "conservative_rating"
+	}
 	opts := options.Find().
-		SetSort(bson.D{{Key: "elo", Value: -1}}).
+		SetSort(bson.D{{Key: sortKey, Value: -1}}).
 		SetLimit(int64(limit))
 
-	cursor, err := r.playerStatsColl.Find(ctx, bson.M{}, opts)
+	filter := bson.M{}
+	switch mode {
+	case model.ModeSolo:
+		// Stats from before team modes have no counter and are all solo.
+		filter["$or"] = bson.A{
+			bson.M{"solo_matches": bson.M{"$gt": 0}},
+			bson.M{"solo_matches": bson.M{"$exists": false}},
+		}
+	case model.ModeTeam:
+		filter["team_matches"] = bson.M{"$gt": 0}
+	}
+
+	cursor, err := r.playerStatsColl.Find(ctx, filter, opts)
 	if err != nil {
-		r.log.Error("ListPlayerStatsByELO: find failed", zap.Error(err))
+		r.log.Error("ListPlayerStatsByRating: find failed", zap.Error(err))
 		return nil, err
 	}
 	defer func() {
@@ -116,12 +160,13 @@ func (r *Repository) ListPlayerStatsByELO(ctx context.Context, limit int) ([]*mo
 	return playerStatsSliceFromDTOs(dtos), nil
 }
 
-func (r *Repository) ListAllPlayerStatsByELO(ctx context.Context) ([]*model.PlayerStats, error) {
-	opts := options.Find().SetSort(bson.D{{Key: "elo", Value: -1}})
+func (r *Repository) ListSeasonPlayerStatsByELO(ctx context.Context, seasonID string) ([]*model.PlayerStats, error) {
+	// _id breaks ELO ties so every attempt of a reset ranks players the same.
+	opts := options.Find().SetSort(bson.D{{Key: "elo", Value: -1}, {Key: "_id", Value: 1}})
 
-	cursor, err := r.playerStatsColl.Find(ctx, bson.M{}, opts)
+	cursor, err := r.playerStatsColl.Find(ctx, bson.M{"season_id": seasonID}, opts)
 	if err != nil {
-		r.log.Error("ListAllPlayerStatsByELO: find failed", zap.Error(err))
+		r.log.Error("ListSeasonPlayerStatsByELO: find failed", zap.Error(err))
 		return nil, err
 	}
 	defer func() {
@@ -138,17 +183,32 @@ func (r *Repository) ListAllPlayerStatsByELO(ctx context.Context) ([]*model.Play
 	return playerStatsSliceFromDTOs(dtos), nil
 }
 
-func (r *Repository) DeleteAllPlayerStats(ctx context.Context) error {
-	_, err := r.playerStatsColl.DeleteMany(ctx, bson.M{})
+func (r *Repository) DeleteSeasonPlayerStats(ctx context.Context, seasonID string) error {
+	_, err := r.playerStatsColl.DeleteMany(ctx, bson.M{"season_id": seasonID})
 	if err != nil {
-		r.log.Error("DeleteAllPlayerStats: delete failed", zap.Error(err))
+		r.log.Error("DeleteSeasonPlayerStats: delete failed", zap.Error(err))
 	}
 	return err
 }
 
 func playerStatsFromDTO(d playerStatsDTO) *model.PlayerStats {
+	rating := model.RatingState{Mu: d.RatingMu, Sigma: d.RatingSigma, Volatility: d.RatingVolatility}
+	conservative := d.ConservativeRating
+	if rating.IsZero() {
+		// Plain ELO stats: the rating is its own conservative estimate.
+		conservative = d.Elo
+	}
+	// Stats from before team modes were created by a solo match and count
+	// their wins; that is the closest known lower bound.
+	soloMatches := max(d.Wins, 1)
+	if d.SoloMatches != nil {
+		soloMatches = *d.SoloMatches
+	}
 	return model.ReconstitutePlayerStats(
-		d.Id.Hex(), d.PlayerID, d.PlayerName, d.Elo, d.Wins, d.Kills, d.SeasonID, d.CreatedAt, d.UpdatedAt,
+		d.Id.Hex(), d.PlayerID, d.PlayerName,
+		d.Elo, d.Wins, d.TeamWins, d.Kills, soloMatches, d.TeamMatches,
+		d.SeasonID, rating, conservative, d.RatingFrozen, d.AppliedMatches, d.RevertedMatches,
+		d.CreatedAt, d.UpdatedAt,
 	)
 }
 
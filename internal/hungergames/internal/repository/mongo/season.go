@@ -20,6 +20,31 @@ type seasonDTO struct {
 	Number       int        `bson:"number"`
 	StartedAt    time.Time  `bson:"started_at"`
 	EndedAt      *time.Time `bson:"ended_at,omitempty"`
+	EndsAt       *time.Time `bson:"ends_at,omitempty"`
+	// Rewards is the legacy exact-rank coin table, read only.
+	Rewards      []rewardDTO     `bson:"rewards,omitempty"`
+	RewardTiers  []rewardTierDTO `bson:"reward_tiers,omitempty"`
+	EndNotified  bool            `bson:"end_notified,omitempty"`
+	RatingSystem string          `bson:"rating_system,omitempty"`
+}
+
+// rewardDTO is a legacy reward table entry paying coins to one rank.
+type rewardDTO struct {
+	Rank  int   `bson:"rank"`
+	Coins int64 `bson:"coins"`
+}
+
+type rewardTierDTO struct {
+	FromRank   int               `bson:"from_rank,omitempty"`
+	ToRank     int               `bson:"to_rank,omitempty"`
+	TopPercent int               `bson:"top_percent,omitempty"`
+	Rewards    []earnedRewardDTO `bson:"rewards,omitempty"`
+}
+
+type earnedRewardDTO struct {
+	Kind  string `bson:"kind"`
+	Coins int64  `bson:"coins,omitempty"`
+	Ref   string `bson:"ref,omitempty"`
 }
 
 func (d seasonDTO) Id() bson.ObjectID { return d.Model.Id }
@@ -57,12 +82,20 @@ func (r *Repository) GetSeasonByID(ctx context.Context, id string) (*model.Seaso
 func (r *Repository) CreateSeason(ctx context.Context, season *model.Season) (*model.Season, error) {
 	m := newModel()
 	d := seasonDTO{
-		Model:     m,
-		Number:    season.Number,
-		StartedAt: season.StartedAt,
+		Model:       m,
+		Number:      season.Number,
+		StartedAt:   season.StartedAt,
+		EndsAt:      season.EndsAt,
+		RewardTiers: tiersToDTO(season.Rewards),
+		// Stored explicitly so a change of default never re-rates a season.
+		RatingSystem: string(season.RatingSystem.OrDefault()),
 	}
 
 	if _, err := r.seasonsColl.InsertOne(ctx, d); err != nil {
+		// Season numbers are unique: a concurrent open took this number.
+		if mgo.IsDuplicateKeyError(err) {
+			return nil, ierror.ErrActiveSeasonExists
+		}
 		r.log.Error("CreateSeason: insert failed", zap.Error(err))
 		return nil, err
 	}
@@ -77,16 +110,39 @@ func (r *Repository) CloseSeason(ctx context.Context, id string) error {
 		return ierror.ErrNotFound
 	}
 
+	// Only an open season is closed, so a scheduler replica and an admin
+	// reset racing on the same season cannot both go on to pay its rewards.
 	now := time.Now()
-	_, err = r.seasonsColl.UpdateOne(ctx,
-		bson.M{"_id": oid},
+	res, err := r.seasonsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "ended_at": bson.M{"$exists": false}},
 		bson.D{{Key: "$set", Value: bson.D{{Key: "ended_at", Value: now}}}},
 		options.UpdateOne(),
 	)
 	if err != nil {
 		r.log.Error("CloseSeason: update failed", zap.Error(err))
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ierror.ErrSeasonClosed
 	}
-	return err
+	return nil
+}
+
+func (r *Repository) MarkSeasonEndNotified(ctx context.Context, id string) (bool, error) {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return false, ierror.ErrNotFound
+	}
+
+	res, err := r.seasonsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "end_notified": bson.M{"$ne": true}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "end_notified", Value: true}}}},
+	)
+	if err != nil {
+		r.log.Error("MarkSeasonEndNotified: update failed", zap.Error(err))
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
 }
 
 func (r *Repository) CountSeasons(ctx context.Context) (int, error) {
@@ -111,5 +167,70 @@ func (r *Repository) ListSeasons(ctx context.Context, next string, limit int) ([
 }
 
 func seasonFromDTO(d seasonDTO) *model.Season {
-	return model.ReconstituteSeason(d.Model.Id.Hex(), d.Number, d.StartedAt, d.EndedAt)
+	return model.ReconstituteSeason(
+		d.Model.Id.Hex(), d.Number, d.StartedAt, d.EndedAt, d.EndsAt, tiersFromDTO(d.RewardTiers, d.Rewards), d.EndNotified,
+		model.RatingSystem(d.RatingSystem),
+	)
+}
+
+func tiersToDTO(tiers []model.RewardTier) []rewardTierDTO {
+	out := make([]rewardTierDTO, len(tiers))
+	for i, t := range tiers {
+		out[i] = rewardTierDTO{
+			FromRank:   t.FromRank,
+			ToRank:     t.ToRank,
+			TopPercent: t.TopPercent,
+			Rewards:    earnedToDTO(t.Rewards),
+		}
+	}
+	return out
+}
+
+// tiersFromDTO reads the stored tiers, falling back to the legacy reward
+// table of documents written before tiers existed.
+func tiersFromDTO(tiers []rewardTierDTO, legacy []rewardDTO) []model.RewardTier {
+	if len(tiers) == 0 && len(legacy) > 0 {
+		out := make([]model.RewardTier, len(legacy))
+		for i, r := range legacy {
+			out[i] = model.RankCoins(r.Rank, r.Coins)
+		}
+		return out
+	}
+	out := make([]model.RewardTier, len(tiers))
+	for i, t := range tiers {
+		out[i] = model.RewardTier{
+			FromRank:   t.FromRank,
+			ToRank:     t.ToRank,
+			TopPercent: t.TopPercent,
+			Rewards:    earnedFromDTO(t.Rewards, 0),
+		}
+	}
+	return out
+}
+
+func earnedToDTO(rewards []model.Reward) []earnedRewardDTO {
+	if len(rewards) == 0 {
+		return nil
+	}
+	out := make([]earnedRewardDTO, len(rewards))
+	for i, r := range rewards {
+		out[i] = earnedRewardDTO{Kind: string(r.Kind), Coins: r.Coins, Ref: r.Ref}
+	}
+	return out
+}
+
+// earnedFromDTO reads stored rewards. legacyCoins is the coin amount of a
+// result archived before rewards were stored, kept as a coins reward.
+func earnedFromDTO(rewards []earnedRewardDTO, legacyCoins int64) []model.Reward {
+	if len(rewards) == 0 {
+		if legacyCoins > 0 {
+			return []model.Reward{{Kind: model.RewardCoins, Coins: legacyCoins}}
+		}
+		return nil
+	}
+	out := make([]model.Reward, len(rewards))
+	for i, r := range rewards {
+		out[i] = model.Reward{Kind: model.RewardKind(r.Kind), Coins: r.Coins, Ref: r.Ref}
+	}
+	return out
 }
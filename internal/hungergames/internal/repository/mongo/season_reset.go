@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mgo "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+type standingDTO struct {
+	PlayerID    string            `bson:"player_id"`
+	PlayerName  string            `bson:"player_name"`
+	Elo         int               `bson:"elo"`
+	Wins        int               `bson:"wins"`
+	TeamWins    int               `bson:"team_wins"`
+	Kills       int               `bson:"kills"`
+	Rank        int               `bson:"rank"`
+	RewardCoins int64             `bson:"reward_coins"`
+	Rewards     []earnedRewardDTO `bson:"rewards,omitempty"`
+}
+
+type seasonResetDTO struct {
+	mongox.Model `bson:",inline"`
+	SeasonID     string `bson:"season_id"`
+	SeasonNumber int    `bson:"season_number"`
+	// Rewards is the legacy exact-rank coin table, read only.
+	Rewards     []rewardDTO     `bson:"rewards,omitempty"`
+	RewardTiers []rewardTierDTO `bson:"reward_tiers,omitempty"`
+	Standings   []standingDTO   `bson:"standings,omitempty"`
+	PaidThrough int             `bson:"paid_through"`
+	Step        string          `bson:"step"`
+	Status      string          `bson:"status"`
+	LastError   string          `bson:"last_error,omitempty"`
+	Attempts    int             `bson:"attempts"`
+	Version     int             `bson:"version"`
+	StartedAt   time.Time       `bson:"started_at"`
+	CompletedAt *time.Time      `bson:"completed_at,omitempty"`
+}
+
+func (r *Repository) CreateSeasonReset(ctx context.Context, reset *model.SeasonReset) error {
+	d := seasonResetToDTO(reset)
+	d.Model = newModel()
+	d.Model.UpdatedAt = reset.UpdatedAt
+
+	if _, err := r.resetsColl.InsertOne(ctx, d); err != nil {
+		if mgo.IsDuplicateKeyError(err) {
+			return ierror.ErrResetExists
+		}
+		r.log.Error("CreateSeasonReset: insert failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) GetSeasonReset(ctx context.Context, seasonID string) (*model.SeasonReset, error) {
+	var d seasonResetDTO
+	if err := r.resetsColl.FindOne(ctx, bson.M{"season_id": seasonID}).Decode(&d); err != nil {
+		if errors.Is(err, mgo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		r.log.Error("GetSeasonReset: find failed", zap.Error(err))
+		return nil, err
+	}
+	return seasonResetFromDTO(d), nil
+}
+
+// SaveSeasonReset saves the job's progress. The standings are written only
+// when they changed, at the close step, not on every per-player save.
+func (r *Repository) SaveSeasonReset(ctx context.Context, reset *model.SeasonReset) error {
+	d := seasonResetToDTO(reset)
+	set := bson.D{
+		{Key: "paid_through", Value: d.PaidThrough},
+		{Key: "step", Value: d.Step},
+		{Key: "status", Value: d.Status},
+		{Key: "last_error", Value: d.LastError},
+		{Key: "attempts", Value: d.Attempts},
+		{Key: "completed_at", Value: d.CompletedAt},
+		{Key: "updated_at", Value: reset.UpdatedAt},
+	}
+	if reset.StandingsUnsaved() {
+		set = append(set, bson.E{Key: "standings", Value: d.Standings})
+	}
+	update := bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$inc", Value: bson.D{{Key: "version", Value: 1}}},
+	}
+
+	res, err := r.resetsColl.UpdateOne(ctx, bson.M{"season_id": reset.SeasonID, "version": reset.Version}, update)
+	if err != nil {
+		r.log.Error("SaveSeasonReset: update failed", zap.Error(err))
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ierror.ErrResetChanged
+	}
+	reset.MarkSaved()
+	return nil
+}
+
+func (r *Repository) ListSeasonResets(ctx context.Context, unfinishedOnly bool) ([]*model.SeasonReset, error) {
+	filter := bson.M{}
+	if unfinishedOnly {
+		filter["status"] = bson.M{"$ne": string(model.ResetStatusCompleted)}
+	}
+
+	cursor, err := r.resetsColl.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "started_at", Value: -1}}))
+	if err != nil {
+		r.log.Error("ListSeasonResets: find failed", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			r.log.Error("cursor close failed", zap.Error(err))
+		}
+	}()
+
+	var dtos []seasonResetDTO
+	if err := cursor.All(ctx, &dtos); err != nil {
+		return nil, err
+	}
+	out := make([]*model.SeasonReset, len(dtos))
+	for i, d := range dtos {
+		out[i] = seasonResetFromDTO(d)
+	}
+	return out, nil
+}
+
+func seasonResetToDTO(m *model.SeasonReset) seasonResetDTO {
+	standings := make([]standingDTO, len(m.Standings))
+	for i, res := range m.Standings {
+		standings[i] = standingDTO{
+			PlayerID:    res.PlayerID,
+			PlayerName:  res.PlayerName,
+			Elo:         res.Elo,
+			Wins:        res.Wins,
+			TeamWins:    res.TeamWins,
+			Kills:       res.Kills,
+			Rank:        res.Rank,
+			RewardCoins: res.RewardCoins,
+			Rewards:     earnedToDTO(res.Rewards),
+		}
+	}
+	return seasonResetDTO{
+		SeasonID:     m.SeasonID,
+		SeasonNumber: m.SeasonNumber,
+		RewardTiers:  tiersToDTO(m.Rewards),
+		Standings:    standings,
+		PaidThrough:  m.PaidThrough,
+		Step:         string(m.Step),
+		Status:       string(m.Status),
+		LastError:    m.LastError,
+		Attempts:     m.Attempts,
+		Version:      m.Version,
+		StartedAt:    m.StartedAt,
+		CompletedAt:  m.CompletedAt,
+	}
+}
+
+func seasonResetFromDTO(d seasonResetDTO) *model.SeasonReset {
+	standings := make([]*model.SeasonResult, len(d.Standings))
+	for i, st := range d.Standings {
+		standings[i] = &model.SeasonResult{
+			SeasonID:    d.SeasonID,
+			PlayerID:    st.PlayerID,
+			PlayerName:  st.PlayerName,
+			Elo:         st.Elo,
+			Wins:        st.Wins,
+			TeamWins:    st.TeamWins,
+			Kills:       st.Kills,
+			Rank:        st.Rank,
+			RewardCoins: st.RewardCoins,
+			Rewards:     earnedFromDTO(st.Rewards, st.RewardCoins),
+		}
+	}
+	return model.ReconstituteSeasonReset(
+		d.SeasonID,
+		d.SeasonNumber,
+		tiersFromDTO(d.RewardTiers, d.Rewards),
+		standings,
+		d.PaidThrough,
+		model.ResetStep(d.Step),
+		model.ResetStatus(d.Status),
+		d.LastError,
+		d.Attempts,
+		d.Version,
+		d.StartedAt,
+		d.UpdatedAt,
+		d.CompletedAt,
+	)
+}
@@ -16,15 +16,17 @@ import (
 
 type seasonResultDTO struct {
 	mongox.Model `bson:",inline"`
-	SeasonID     string    `bson:"season_id"`
-	PlayerID     string    `bson:"player_id"`
-	PlayerName   string    `bson:"player_name"`
-	Elo          int       `bson:"elo"`
-	Wins         int       `bson:"wins"`
-	Kills        int       `bson:"kills"`
-	Rank         int       `bson:"rank"`
-	RewardCoins  int64     `bson:"reward_coins"`
-	CreatedAt    time.Time `bson:"created_at"`
+	SeasonID     string            `bson:"season_id"`
+	PlayerID     string            `bson:"player_id"`
+	PlayerName   string            `bson:"player_name"`
+	Elo          int               `bson:"elo"`
+	Wins         int               `bson:"wins"`
+	TeamWins     int               `bson:"team_wins"`
+	Kills        int               `bson:"kills"`
+	Rank         int               `bson:"rank"`
+	RewardCoins  int64             `bson:"reward_coins"`
+	Rewards      []earnedRewardDTO `bson:"rewards,omitempty"`
+	CreatedAt    time.Time         `bson:"created_at"`
 }
 
 func (r *Repository) CreateSeasonResults(ctx context.Context, results []*model.SeasonResult) error {
@@ -38,20 +40,43 @@ func (r *Repository) CreateSeasonResults(ctx context.Context, results []*model.S
 			PlayerName:  res.PlayerName,
 			Elo:         res.Elo,
 			Wins:        res.Wins,
+			TeamWins:    res.TeamWins,
 			Kills:       res.Kills,
 			Rank:        res.Rank,
 			RewardCoins: res.RewardCoins,
+			Rewards:     earnedToDTO(res.Rewards),
 			CreatedAt:   m.CreatedAt,
 		}
 	}
 
-	if _, err := r.seasonResultColl.InsertMany(ctx, docs); err != nil {
+	// Unordered, so a retry inserts whatever an earlier attempt missed; the
+	// unique (season_id, player_id) index rejects the rest.
+	_, err := r.seasonResultColl.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil && !onlyDuplicateKeys(err) {
 		r.log.Error("CreateSeasonResults: insert failed", zap.Error(err))
 		return err
 	}
 	return nil
 }
 
+// duplicateKeyCode is the server error code of a unique index violation.
+const duplicateKeyCode = 11000
+
+// onlyDuplicateKeys reports whether every write of a bulk insert that failed
+// was rejected as a duplicate.
+func onlyDuplicateKeys(err error) bool {
+	var bwe mgo.BulkWriteException
+	if !errors.As(err, &bwe) || bwe.WriteConcernError != nil || len(bwe.WriteErrors) == 0 {
+		return false
+	}
+	for _, we := range bwe.WriteErrors {
+		if we.Code != duplicateKeyCode {
+			return false
+		}
+	}
+	return true
+}
+
 func (r *Repository) ListSeasonResults(ctx context.Context, seasonID string) ([]*model.SeasonResult, error) {
 	opts := options.Find().SetSort(bson.D{{Key: "rank", Value: 1}}).SetLimit(10)
 
@@ -99,9 +124,11 @@ func seasonResultFromDTO(d seasonResultDTO) *model.SeasonResult {
 		PlayerName:  d.PlayerName,
 		Elo:         d.Elo,
 		Wins:        d.Wins,
+		TeamWins:    d.TeamWins,
 		Kills:       d.Kills,
 		Rank:        d.Rank,
 		RewardCoins: d.RewardCoins,
+		Rewards:     earnedFromDTO(d.Rewards, d.RewardCoins),
 		CreatedAt:   d.CreatedAt,
 	}
 }
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	mgo "go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+type seasonScheduleDTO struct {
+	mongox.Model `bson:",inline"`
+	StartsAt     time.Time `bson:"starts_at"`
+	EndsAt       time.Time `bson:"ends_at"`
+	// Rewards is the legacy exact-rank coin table, read only.
+	Rewards      []rewardDTO     `bson:"rewards,omitempty"`
+	RewardTiers  []rewardTierDTO `bson:"reward_tiers,omitempty"`
+	RatingSystem string          `bson:"rating_system,omitempty"`
+}
+
+func (r *Repository) CreateSeasonSchedule(ctx context.Context, schedule *model.SeasonSchedule) error {
+	m := newModel()
+	d := seasonScheduleDTO{
+		Model:        m,
+		StartsAt:     schedule.StartsAt,
+		EndsAt:       schedule.EndsAt,
+		RewardTiers:  tiersToDTO(schedule.Rewards),
+		RatingSystem: string(schedule.RatingSystem),
+	}
+
+	if _, err := r.schedulesColl.InsertOne(ctx, d); err != nil {
+		r.log.Error("CreateSeasonSchedule: insert failed", zap.Error(err))
+		return err
+	}
+	schedule.AssignID(m.Id.Hex())
+	return nil
+}
+
+func (r *Repository) ListSeasonSchedules(ctx context.Context) ([]*model.SeasonSchedule, error) {
+	cursor, err := r.schedulesColl.Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "starts_at", Value: 1}}),
+	)
+	if err != nil {
+		r.log.Error("ListSeasonSchedules: find failed", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := cursor.Close(ctx); err != nil {
+			r.log.Error("cursor close failed", zap.Error(err))
+		}
+	}()
+
+	var dtos []seasonScheduleDTO
+	if err := cursor.All(ctx, &dtos); err != nil {
+		return nil, err
+	}
+	out := make([]*model.SeasonSchedule, len(dtos))
+	for i, d := range dtos {
+		out[i] = seasonScheduleFromDTO(d)
+	}
+	return out, nil
+}
+
+func (r *Repository) NextSeasonSchedule(ctx context.Context) (*model.SeasonSchedule, error) {
+	var d seasonScheduleDTO
+	err := r.schedulesColl.FindOne(ctx, bson.M{},
+		options.FindOne().SetSort(bson.D{{Key: "starts_at", Value: 1}}),
+	).Decode(&d)
+	if err != nil {
+		if errors.Is(err, mgo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		r.log.Error("NextSeasonSchedule: find failed", zap.Error(err))
+		return nil, err
+	}
+	return seasonScheduleFromDTO(d), nil
+}
+
+func (r *Repository) DeleteSeasonSchedule(ctx context.Context, id string) error {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return ierror.ErrNotFound
+	}
+
+	res, err := r.schedulesColl.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		r.log.Error("DeleteSeasonSchedule: delete failed", zap.Error(err))
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return ierror.ErrNotFound
+	}
+	return nil
+}
+
+func seasonScheduleFromDTO(d seasonScheduleDTO) *model.SeasonSchedule {
+	return model.ReconstituteSeasonSchedule(
+		d.Model.Id.Hex(),
+		d.StartsAt,
+		d.EndsAt,
+		tiersFromDTO(d.RewardTiers, d.Rewards),
+		model.RatingSystem(d.RatingSystem),
+		d.CreatedAt,
+	)
+}
@@ -1,10 +1,14 @@
 package service
 
 import (
+	"context"
 	"errors"
 
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
 	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	pkgerr "github.com/lasthearth/vsservice/internal/pkg/ierror"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"go.uber.org/fx"
@@ -17,7 +21,30 @@ var _ hgv1.HungerGamesServiceServer = (*Service)(nil)
 type Service struct {
 	repo     Repository
 	donateUC *donateuc.AddCoinsUseCase
+	notifier Notifier
+	kits     KitAssigner
+	items    ItemGranter
 	log      logger.Logger
+
+	abuseRules model.AbuseRules
+}
+
+// Notifier sends player notifications.
+// Implemented by notificationuc.Create, injected via fx.
+type Notifier interface {
+	CreateNotification(ctx context.Context, title, message string, opts ...notificationuc.NotificationOpts) error
+}
+
+// KitAssigner hands out kits, at most once per op key.
+// Implemented by kituc.AssignUseCase, injected via fx.
+type KitAssigner interface {
+	AssignOnce(ctx context.Context, userID, userGameName, kitName, assignedBy, opKey string) (string, error)
+}
+
+// ItemGranter grants shop items as free purchases, at most once per op key.
+// Implemented by donateuc.ShopUseCase, injected via fx.
+type ItemGranter interface {
+	GrantOnce(ctx context.Context, playerID, itemID string, pricePaid int64, opKey string) (string, error)
 }
 
 // Opts are the fx-injected dependencies for the service.
@@ -26,14 +53,29 @@ type Opts struct {
 
 	Repo     Repository
 	DonateUC *donateuc.AddCoinsUseCase
+	Notifier Notifier
+	Kits     KitAssigner
+	Items    ItemGranter
 	Logger   logger.Logger
+	Config   config.Config
 }
 
 func New(opts Opts) *Service {
 	return &Service{
 		repo:     opts.Repo,
 		donateUC: opts.DonateUC,
+		notifier: opts.Notifier,
+		kits:     opts.Kits,
+		items:    opts.Items,
 		log:      opts.Logger,
+
+		abuseRules: model.AbuseRules{
+			Window:        opts.Config.HungerGamesAbuseWindow,
+			RepeatedGroup: opts.Config.HungerGamesAbuseRepeatedGroup,
+			MinDuration:   opts.Config.HungerGamesAbuseMinDuration,
+			WinStreak:     opts.Config.HungerGamesAbuseWinStreak,
+			FreezeRatings: opts.Config.HungerGamesAbuseAutoFreeze,
+		},
 	}
 }
 
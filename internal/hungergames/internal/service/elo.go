@@ -1,6 +1,10 @@
 package service
 
-import "math"
+import (
+	"math"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+)
 
 const (
 	eloKFactor = 32
@@ -69,11 +73,38 @@ func CalculateELO(players []PlayerPlacement) []ELOResult {
 	results := make([]ELOResult, n)
 	for i, p := range players {
 		delta := deltas[p.PlayerID] / norm
-		newELO := max(p.CurrentELO+int(math.Round(delta)), minELO)
+		newELO := max(p.CurrentELO+int(math.Round(delta)), model.MinELO)
 		results[i] = ELOResult{PlayerID: p.PlayerID, NewELO: newELO}
 	}
 	return results
 }
 
-// minELO mirrors model.MinELO without importing the model package from elo.go.
-const minELO = 100
+// eloSystem is RatingSystem over CalculateELO. Its state is the integer
+// rating alone, so it is its own conservative estimate.
+type eloSystem struct{}
+
+func (eloSystem) Seed(elo int) model.RatingState {
+	return model.RatingState{Mu: float64(elo)}
+}
+
+func (eloSystem) Rate(players []RatedPlacement) []model.RatingState {
+	placements := make([]PlayerPlacement, len(players))
+	for i, p := range players {
+		placements[i] = PlayerPlacement{
+			PlayerID:   p.PlayerID,
+			Place:      p.Place,
+			CurrentELO: int(math.Round(p.State.Mu)),
+		}
+	}
+
+	results := CalculateELO(placements)
+	states := make([]model.RatingState, len(results))
+	for i, r := range results {
+		states[i] = model.RatingState{Mu: float64(r.NewELO)}
+	}
+	return states
+}
+
+func (eloSystem) Conservative(state model.RatingState) float64 {
+	return state.Mu
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"math"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+)
+
+const (
+	// glickoScale converts between the Glicko rating scale and Glicko-2's
+	// internal one; glickoCenter is the rating that maps to μ = 0.
+	glickoScale  = 173.7178
+	glickoCenter = 1500.0
+
+	glickoInitialRD         = 350.0
+	glickoMinRD             = 30.0
+	glickoInitialVolatility = 0.06
+	// glickoTau constrains how fast volatility changes.
+	glickoTau     = 0.5
+	glickoEpsilon = 1e-6
+)
+
+// glicko2System is Glicko-2 (Glickman, 2012). A match is one rating period in
+// which every player played every other, scoring 1 against those placed
+// below, 0 against those above and 0.5 on a tie. The rating deviation makes
+// a newcomer's first matches count for a lot and shrinks as they play.
+type glicko2System struct{}
+
+func (glicko2System) Seed(elo int) model.RatingState {
+	return model.RatingState{Mu: float64(elo), Sigma: glickoInitialRD, Volatility: glickoInitialVolatility}
+}
+
+func (glicko2System) Conservative(state model.RatingState) float64 {
+	return state.Mu - 2*state.Sigma
+}
+
+func (glicko2System) Rate(players []RatedPlacement) []model.RatingState {
+	states := make([]model.RatingState, len(players))
+	for i, p := range players {
+		states[i] = p.State
+		if len(players) < 2 {
+			continue
+		}
+		states[i] = glicko2Update(p, players)
+	}
+	return states
+}
+
+// glicko2Update rates p against every other player of the match.
+func glicko2Update(p RatedPlacement, players []RatedPlacement) model.RatingState {
+	mu := (p.State.Mu - glickoCenter) / glickoScale
+	phi := p.State.Sigma / glickoScale
+
+	var invV, sum float64
+	for _, o := range players {
+		if o.PlayerID == p.PlayerID {
+			continue
+		}
+		muJ := (o.State.Mu - glickoCenter) / glickoScale
+		g := glickoG(o.State.Sigma / glickoScale)
+		e := 1 / (1 + math.Exp(-g*(mu-muJ)))
+		invV += g * g * e * (1 - e)
+		sum += g * (placementScore(p.Place, o.Place) - e)
+	}
+	v := 1 / invV
+	delta := v * sum
+
+	volatility := glickoVolatility(phi, p.State.Volatility, v, delta)
+	phiStar := math.Sqrt(phi*phi + volatility*volatility)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*sum
+
+	return model.RatingState{
+		Mu:         newMu*glickoScale + glickoCenter,
+		Sigma:      min(max(newPhi*glickoScale, glickoMinRD), glickoInitialRD),
+		Volatility: volatility,
+	}
+}
+
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// glickoVolatility is step 5 of Glicko-2: the Illinois iteration for the
+// new volatility.
+func glickoVolatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(glickoTau*glickoTau)
+	}
+
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*glickoTau) < 0 {
+			k++
+		}
+		B = a - k*glickoTau
+	}
+
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > glickoEpsilon {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	return math.Exp(A / 2)
+}
+
+// placementScore is the result of a player placed at place against one at
+// other: 1 for finishing ahead, 0 behind, 0.5 level.
+func placementScore(place, other int) float64 {
+	switch {
+	case place < other:
+		return 1
+	case place > other:
+		return 0
+	default:
+		return 0.5
+	}
+}
@@ -19,7 +19,16 @@ func (s *Service) ListLeaderboard(ctx context.Context, req *hgv1.ListLeaderboard
 		limit = defaultLeaderboardLimit
 	}
 
-	entries, err := s.repo.ListPlayerStatsByELO(ctx, limit)
+	// Unspecified lists players of every mode.
+	var mode model.MatchMode
+	if req.GetMode() != hgv1.MatchMode_MATCH_MODE_UNSPECIFIED {
+		var ok bool
+		if mode, ok = matchModeFromProto(req.GetMode()); !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown match mode")
+		}
+	}
+
+	entries, err := s.repo.ListPlayerStatsByRating(ctx, limit, req.GetConservative(), mode)
 	if err != nil {
 		l.Error("failed to list leaderboard", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list leaderboard")
@@ -34,6 +43,10 @@ func (s *Service) ListLeaderboard(ctx context.Context, req *hgv1.ListLeaderboard
 				Wins:       int32(e.Wins),
 				Kills:      int32(e.Kills),
 				Rank:       int32(i + 1),
+
+				ConservativeRating: int32(e.Conservative),
+				TeamWins:           int32(e.TeamWins),
+				RatingFrozen:       e.RatingFrozen,
 			}
 		}),
 	}, nil
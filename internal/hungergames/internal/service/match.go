@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"time"
 
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
 	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const defaultLeaderboardLimit = 25
@@ -15,32 +20,85 @@ const defaultLeaderboardLimit = 25
 func (s *Service) RecordMatch(ctx context.Context, req *hgv1.RecordMatchRequest) (*hgv1.RecordMatchResponse, error) {
 	l := s.log.With(zap.String("method", "RecordMatch"))
 
-	if len(req.GetPlayers()) < 2 {
-		return nil, status.Error(codes.InvalidArgument, "at least 2 players required")
+	mode, ok := matchModeFromProto(req.GetMode())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown match mode")
 	}
 
-	places := make(map[int32]bool, len(req.GetPlayers()))
-	for _, p := range req.GetPlayers() {
-		if p.GetPlace() < 1 {
-			return nil, status.Error(codes.InvalidArgument, "place must be >= 1")
+	var duration time.Duration
+	if req.GetDuration() != nil {
+		duration = req.GetDuration().AsDuration()
+	}
+
+	match, err := s.recordMatch(ctx, l, "", mode, duration, req.GetPlayers())
+	if err != nil {
+		return nil, err
+	}
+	return &hgv1.RecordMatchResponse{Match: toReviewedMatchProto(match)}, nil
+}
+
+// RecordFinishedMatch records a match the game server reported under its own
+// match id. Reporting the same id again only finishes the stats an earlier
+// report left unsaved, so redelivered events never move ratings twice.
+func (s *Service) RecordFinishedMatch(
+	ctx context.Context,
+	matchID string,
+	mode model.MatchMode,
+	duration time.Duration,
+	players []*hgv1.PlayerMatchResult,
+) error {
+	l := s.log.With(zap.String("method", "RecordFinishedMatch"), zap.String("external_id", matchID))
+
+	if matchID == "" {
+		return status.Error(codes.InvalidArgument, "match id required")
+	}
+	_, err := s.recordMatch(ctx, l, matchID, mode, duration, players)
+	return err
+}
+
+// recordMatch rates the players of a match in the active season and stores
+// it. The match is written before any stats, so a repeated report of the same
+// externalID finds it before a rating moves; until every player's stats are
+// saved the match stays marked unapplied, and a repeated report finishes the
+// stats instead of being ignored. A match the abuse heuristics flag is stored
+// for review and, with automatic freezes on, does not move its players'
+// ratings.
+func (s *Service) recordMatch(
+	ctx context.Context,
+	l logger.Logger,
+	externalID string,
+	mode model.MatchMode,
+	duration time.Duration,
+	players []*hgv1.PlayerMatchResult,
+) (*model.Match, error) {
+	sides, err := groupSides(mode, players)
+	if err != nil {
+		return nil, err
+	}
+
+	if externalID != "" {
+		existing, err := s.repo.GetMatchByExternalID(ctx, externalID)
+		if err == nil {
+			l.Info("match already recorded", zap.String("match_id", existing.ID))
+			return existing, s.applyMatchStats(ctx, l, existing)
 		}
-		if places[p.GetPlace()] {
-			return nil, status.Error(codes.InvalidArgument, "duplicate places not allowed")
+		if !isDomainError(err, codes.NotFound) {
+			l.Error("failed to look up match", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to look up match")
 		}
-		places[p.GetPlace()] = true
 	}
 
 	season, err := s.repo.GetActiveSeason(ctx)
 	if err != nil {
 		if isDomainError(err, codes.NotFound) {
-			return nil, status.Error(codes.InvalidArgument, "no active season")
+			return nil, status.Error(codes.FailedPrecondition, "no active season")
 		}
 		l.Error("failed to get active season", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get active season")
 	}
 
-	playerIDs := make([]string, len(req.GetPlayers()))
-	for i, p := range req.GetPlayers() {
+	playerIDs := make([]string, len(players))
+	for i, p := range players {
 		playerIDs[i] = p.GetPlayerId()
 	}
 
@@ -55,40 +113,282 @@ func (s *Service) RecordMatch(ctx context.Context, req *hgv1.RecordMatchRequest)
 		statsMap[st.PlayerID] = st
 	}
 
-	placements := make([]PlayerPlacement, len(req.GetPlayers()))
-	for i, p := range req.GetPlayers() {
+	system, err := RatingSystemFor(season.RatingSystem)
+	if err != nil {
+		l.Error("season has no usable rating system", zap.String("season_id", season.ID), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to rate match")
+	}
+
+	stats := make([]*model.PlayerStats, len(players))
+	for i, p := range players {
 		st, ok := statsMap[p.GetPlayerId()]
 		if !ok {
 			st = model.NewPlayerStats(p.GetPlayerId(), p.GetPlayerName(), season.ID)
 		}
-		placements[i] = PlayerPlacement{
-			PlayerID:   p.GetPlayerId(),
-			Place:      int(p.GetPlace()),
-			CurrentELO: st.Elo,
+		stats[i] = st
+	}
+
+	participants := make([]model.MatchParticipant, 0, len(players))
+	for _, side := range sides {
+		for _, m := range side.members {
+			p := players[m]
+			participants = append(participants, model.MatchParticipant{
+				PlayerID:   p.GetPlayerId(),
+				PlayerName: p.GetPlayerName(),
+				TeamID:     p.GetTeamId(),
+				Place:      side.place,
+				Kills:      int(p.GetKills()),
+			})
 		}
 	}
+	match := model.NewMatch(season.ID, mode, externalID, duration, participants)
+	match.Flag(s.detectAbuse(ctx, l, match))
+	freeze := match.FreezesRatings(s.abuseRules)
 
-	eloResults := CalculateELO(placements)
-	newELOs := make(map[string]int, len(eloResults))
-	for _, r := range eloResults {
-		newELOs[r.PlayerID] = r.NewELO
+	teams := make([]RatedTeam, len(sides))
+	for i, side := range sides {
+		teams[i] = RatedTeam{ID: side.id, Place: side.place, Members: make([]model.RatingState, len(side.members))}
+		for j, m := range side.members {
+			teams[i].Members[j] = ratingState(system, stats[m])
+		}
 	}
 
-	for _, p := range req.GetPlayers() {
-		st, ok := statsMap[p.GetPlayerId()]
-		if !ok {
-			st = model.NewPlayerStats(p.GetPlayerId(), p.GetPlayerName(), season.ID)
+	newStates := RateTeams(system, teams)
+
+	for i, side := range sides {
+		for j, m := range side.members {
+			st := stats[m]
+			before := teams[i].Members[j]
+			// A frozen rating sits the match out: opponents are still rated
+			// against it, but it does not move.
+			if freeze || st.RatingFrozen {
+				match.Participant(st.PlayerID).RecordRating(st.Elo, before, st.Elo, before)
+				continue
+			}
+			match.Participant(st.PlayerID).RecordRating(st.Elo, before, model.EloOf(newStates[i][j]), newStates[i][j])
 		}
-		st.SetELO(newELOs[p.GetPlayerId()])
-		st.AddKills(int(p.GetKills()))
-		if p.GetPlace() == 1 {
-			st.RecordWin()
+	}
+
+	if err := s.repo.CreateMatch(ctx, match); err != nil {
+		if isDomainError(err, codes.AlreadyExists) {
+			l.Info("match recorded concurrently")
+			existing, err := s.repo.GetMatchByExternalID(ctx, externalID)
+			if err != nil {
+				l.Error("failed to look up match", zap.Error(err))
+				return nil, status.Error(codes.Internal, "failed to look up match")
+			}
+			return existing, s.applyMatchStats(ctx, l, existing)
+		}
+		l.Error("failed to save match", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save match")
+	}
+
+	if err := s.saveMatchStats(ctx, l, match, stats, system); err != nil {
+		return nil, err
+	}
+	return match, nil
+}
+
+// applyMatchStats finishes the stats of a stored match whose recording was
+// cut short. Players whose stats already count it are left as they are.
+func (s *Service) applyMatchStats(ctx context.Context, l logger.Logger, match *model.Match) error {
+	if match.StatsApplied {
+		return nil
+	}
+	l = l.With(zap.String("match_id", match.ID))
+	l.Info("finishing stats of a partly recorded match")
+
+	season, err := s.repo.GetSeasonByID(ctx, match.SeasonID)
+	if err != nil {
+		l.Error("failed to get match season", zap.Error(err))
+		return status.Error(codes.Internal, "failed to get season")
+	}
+	system, err := RatingSystemFor(season.RatingSystem)
+	if err != nil {
+		l.Error("season has no usable rating system", zap.String("season_id", season.ID), zap.Error(err))
+		return status.Error(codes.Internal, "failed to rate match")
+	}
+
+	existing, err := s.repo.GetPlayerStatsByIDs(ctx, match.SeasonID, match.PlayerIDs())
+	if err != nil {
+		l.Error("failed to fetch player stats", zap.Error(err))
+		return status.Error(codes.Internal, "failed to fetch player stats")
+	}
+	byPlayer := lo.KeyBy(existing, func(st *model.PlayerStats) string { return st.PlayerID })
+	stats := lo.Map(match.Participants, func(p model.MatchParticipant, _ int) *model.PlayerStats {
+		if st, ok := byPlayer[p.PlayerID]; ok {
+			return st
+		}
+		return model.NewPlayerStats(p.PlayerID, p.PlayerName, match.SeasonID)
+	})
+
+	return s.saveMatchStats(ctx, l, match, stats, system)
+}
+
+// saveMatchStats applies the stored match to each player's stats and saves
+// them, then marks the match applied. Each player's stats remember the match
+// until then, so a retry after a partial failure applies only the rest.
+func (s *Service) saveMatchStats(
+	ctx context.Context,
+	l logger.Logger,
+	match *model.Match,
+	stats []*model.PlayerStats,
+	system RatingSystem,
+) error {
+	freeze := match.FreezesRatings(s.abuseRules)
+	for _, st := range stats {
+		part := match.Participant(st.PlayerID)
+		if !st.ApplyMatch(match.ID, match.Mode, *part, freeze, system.Conservative) {
+			continue
 		}
 		if err := s.repo.SavePlayerStats(ctx, st); err != nil {
-			l.Error("failed to save player stats", zap.String("player_id", p.GetPlayerId()), zap.Error(err))
-			return nil, status.Error(codes.Internal, "failed to save player stats")
+			l.Error("failed to save player stats", zap.String("player_id", st.PlayerID), zap.Error(err))
+			return status.Error(codes.Internal, "failed to save player stats")
+		}
+	}
+
+	if err := s.repo.MarkMatchStatsApplied(ctx, match); err != nil {
+		l.Error("failed to mark match stats applied", zap.Error(err))
+		return status.Error(codes.Internal, "failed to save match")
+	}
+	match.MarkStatsApplied()
+	return nil
+}
+
+// detectAbuse runs the abuse heuristics over match and the recent matches of
+// its players. A failed lookup is logged and skips the heuristics that need
+// history, so a database hiccup never blocks recording.
+func (s *Service) detectAbuse(ctx context.Context, l logger.Logger, match *model.Match) []model.AbuseFlag {
+	var recent []*model.Match
+	if len(match.Participants) <= model.SmallMatchPlayers {
+		var err error
+		since := match.PlayedAt.Add(-s.abuseRules.Window)
+		recent, err = s.repo.ListRecentMatches(ctx, match.SeasonID, match.PlayerIDs(), since)
+		if err != nil {
+			l.Error("failed to list recent matches for abuse checks", zap.Error(err))
+		}
+	}
+
+	flags := s.abuseRules.Detect(match, recent)
+	if len(flags) > 0 {
+		l.Warn("match flagged for review",
+			zap.Any("flags", flags),
+			zap.Strings("player_ids", match.PlayerIDs()),
+		)
+	}
+	return flags
+}
+
+func (s *Service) ListMatches(ctx context.Context, req *hgv1.ListMatchesRequest) (*hgv1.ListMatchesResponse, error) {
+	l := s.log.With(zap.String("method", "ListMatches"))
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	matches, next, err := s.repo.ListMatches(ctx, req.GetPlayerId(), req.GetSeasonId(), req.GetNext(), limit)
+	if err != nil {
+		if isDomainError(err, codes.InvalidArgument) {
+			return nil, err
+		}
+		l.Error("failed to list matches", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list matches")
+	}
+
+	return &hgv1.ListMatchesResponse{
+		Matches: lo.Map(matches, func(m *model.Match, _ int) *hgv1.Match {
+			return toMatchProto(m)
+		}),
+		Next: next,
+	}, nil
+}
+
+func (s *Service) GetMatch(ctx context.Context, req *hgv1.GetMatchRequest) (*hgv1.Match, error) {
+	l := s.log.With(zap.String("method", "GetMatch"), zap.String("match_id", req.GetMatchId()))
+
+	match, err := s.getMatch(ctx, l, req.GetMatchId())
+	if err != nil {
+		return nil, err
+	}
+	return toMatchProto(match), nil
+}
+
+// GetRatingHistory returns the player's ELO after each of their matches in
+// the season, starting from model.InitialELO.
+func (s *Service) GetRatingHistory(ctx context.Context, req *hgv1.GetRatingHistoryRequest) (*hgv1.GetRatingHistoryResponse, error) {
+	l := s.log.With(zap.String("method", "GetRatingHistory"), zap.String("player_id", req.GetPlayerId()))
+
+	var (
+		season *model.Season
+		err    error
+	)
+	if req.GetSeasonId() == "" {
+		season, err = s.repo.GetActiveSeason(ctx)
+	} else {
+		season, err = s.repo.GetSeasonByID(ctx, req.GetSeasonId())
+	}
+	if err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return nil, status.Error(codes.NotFound, "season not found")
 		}
+		l.Error("failed to get season", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get season")
 	}
 
-	return &hgv1.RecordMatchResponse{}, nil
+	matches, err := s.repo.ListPlayerSeasonMatches(ctx, season.ID, req.GetPlayerId())
+	if err != nil {
+		l.Error("failed to list player matches", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list matches")
+	}
+
+	return &hgv1.GetRatingHistoryResponse{
+		SeasonId:   season.ID,
+		InitialElo: model.InitialELO,
+		Points: lo.Map(model.RatingHistory(req.GetPlayerId(), matches), func(p model.RatingPoint, _ int) *hgv1.RatingPoint {
+			return &hgv1.RatingPoint{MatchId: p.MatchID, PlayedAt: timestamppb.New(p.PlayedAt), Elo: int32(p.Elo)}
+		}),
+	}, nil
+}
+
+// toMatchProto maps a match for the public history. The review fields are
+// left out; see toReviewedMatchProto.
+func toMatchProto(m *model.Match) *hgv1.Match {
+	match := &hgv1.Match{
+		Id:       m.ID,
+		SeasonId: m.SeasonID,
+		PlayedAt: timestamppb.New(m.PlayedAt),
+		Mode:     toMatchModeProto(m.Mode),
+		Participants: lo.Map(m.Participants, func(p model.MatchParticipant, _ int) *hgv1.MatchParticipant {
+			return &hgv1.MatchParticipant{
+				PlayerId:   p.PlayerID,
+				PlayerName: p.PlayerName,
+				TeamId:     p.TeamID,
+				Place:      int32(p.Place),
+				Kills:      int32(p.Kills),
+				EloBefore:  int32(p.EloBefore),
+				EloAfter:   int32(p.EloAfter),
+			}
+		}),
+	}
+	if m.Duration > 0 {
+		match.Duration = durationpb.New(m.Duration)
+	}
+	return match
+}
+
+// toReviewedMatchProto maps a match for admin RPCs, with its abuse flags and
+// review.
+func toReviewedMatchProto(m *model.Match) *hgv1.Match {
+	match := toMatchProto(m)
+	match.Flags = lo.Map(m.Flags, func(f model.AbuseFlag, _ int) hgv1.AbuseFlag {
+		return abuseFlagsToProto[f]
+	})
+	match.Review = reviewStatusesToProto[m.Review]
+	match.ReviewedBy = m.ReviewedBy
+	match.VoidReason = m.VoidReason
+	if m.ReviewedAt != nil {
+		match.ReviewedAt = timestamppb.New(*m.ReviewedAt)
+	}
+	return match
 }
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"math"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+)
+
+// OpenSkill's defaults (μ = 25, σ = 25/3, β = σ/2, τ = μ/300) scaled so μ
+// starts at model.InitialELO; the model is invariant under that scaling.
+const (
+	openSkillSigma = model.InitialELO / 3.0
+	openSkillBeta  = openSkillSigma / 2
+	openSkillTau   = model.InitialELO / 300.0
+	// openSkillKappa keeps σ from collapsing to zero.
+	openSkillKappa = 0.0001
+)
+
+// openSkillSystem is the Plackett-Luce model of Weng and Lin (2011), as used
+// by OpenSkill, with every player a team of one. It rates a whole
+// free-for-all ranking at once instead of as pairwise games, and like
+// TrueSkill tracks an uncertainty σ that shrinks as a player plays.
+type openSkillSystem struct{}
+
+func (openSkillSystem) Seed(elo int) model.RatingState {
+	return model.RatingState{Mu: float64(elo), Sigma: openSkillSigma}
+}
+
+func (openSkillSystem) Conservative(state model.RatingState) float64 {
+	return state.Mu - 3*state.Sigma
+}
+
+func (openSkillSystem) Rate(players []RatedPlacement) []model.RatingState {
+	n := len(players)
+	states := make([]model.RatingState, n)
+	if n < 2 {
+		for i, p := range players {
+			states[i] = p.State
+		}
+		return states
+	}
+
+	// Additive dynamics: a little uncertainty is added before every match
+	// so σ never settles for good.
+	sigmaSq := make([]float64, n)
+	var cSq float64
+	for i, p := range players {
+		sigmaSq[i] = p.State.Sigma*p.State.Sigma + openSkillTau*openSkillTau
+		cSq += sigmaSq[i] + openSkillBeta*openSkillBeta
+	}
+	c := math.Sqrt(cSq)
+
+	// strength is exp(μ/c); sumQ[q] sums it over everyone placed level with
+	// or behind q, and ties[q] counts those placed level with q.
+	strength := make([]float64, n)
+	for i, p := range players {
+		strength[i] = math.Exp(p.State.Mu / c)
+	}
+	sumQ := make([]float64, n)
+	ties := make([]float64, n)
+	for q, pq := range players {
+		for s, ps := range players {
+			if ps.Place >= pq.Place {
+				sumQ[q] += strength[s]
+			}
+			if ps.Place == pq.Place {
+				ties[q]++
+			}
+		}
+	}
+
+	for i, pi := range players {
+		var omega, delta float64
+		for q, pq := range players {
+			if pq.Place > pi.Place {
+				continue
+			}
+			share := strength[i] / sumQ[q]
+			delta += share * (1 - share) / ties[q]
+			if q == i {
+				omega += (1 - share) / ties[q]
+			} else {
+				omega -= share / ties[q]
+			}
+		}
+		omega *= sigmaSq[i] / c
+		gamma := math.Sqrt(sigmaSq[i]) / c
+		delta *= gamma * sigmaSq[i] / cSq
+
+		states[i] = model.RatingState{
+			Mu:    pi.State.Mu + omega,
+			Sigma: math.Sqrt(sigmaSq[i] * max(1-delta, openSkillKappa)),
+		}
+	}
+	return states
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+)
+
+// RatedPlacement is one player's place in a match and their rating state
+// before it.
+type RatedPlacement struct {
+	PlayerID string
+	Place    int // 1-based; 1 = winner; equal places are a tie
+	State    model.RatingState
+}
+
+// RatingSystem updates player ratings from match results. A season picks
+// one system; every state it sees was produced by it or seeded by Seed.
+type RatingSystem interface {
+	// Seed returns the state of a player rated elo who has no history in
+	// this system: a new player, or stats recorded before ratings had state.
+	Seed(elo int) model.RatingState
+	// Rate returns every player's state after the match, in input order.
+	Rate(players []RatedPlacement) []model.RatingState
+	// Conservative returns a rating the player is very likely above; it
+	// ranks uncertain newcomers below proven players of the same rating.
+	Conservative(state model.RatingState) float64
+}
+
+// ratingSystems holds the stateless implementations by name.
+var ratingSystems = map[model.RatingSystem]RatingSystem{
+	model.RatingELO:       eloSystem{},
+	model.RatingGlicko2:   glicko2System{},
+	model.RatingOpenSkill: openSkillSystem{},
+}
+
+// RatingSystemFor returns the implementation of a season's rating system;
+// an empty name means ELO.
+func RatingSystemFor(name model.RatingSystem) (RatingSystem, error) {
+	sys, ok := ratingSystems[name.OrDefault()]
+	if !ok {
+		return nil, fmt.Errorf("unknown rating system %q", name)
+	}
+	return sys, nil
+}
+
+// ratingState returns the player's state in sys, seeding it from Elo when the
+// stats have none yet.
+func ratingState(sys RatingSystem, st *model.PlayerStats) model.RatingState {
+	if st.Rating.IsZero() {
+		return sys.Seed(st.Elo)
+	}
+	return st.Rating
+}
+
+// RatedTeam is one side of a match: its place and its members' states
+// before it. A solo player is a team of one.
+type RatedTeam struct {
+	ID      string
+	Place   int
+	Members []model.RatingState
+}
+
+// RateTeams rates a match between teams with any RatingSystem. Each team
+// plays as one player of the team's strength, the mean of its members'
+// states; every member then moves by their team's change in rating, and
+// their uncertainty shrinks in proportion to the team's. States are
+// returned per team and member, in input order. For teams of one this is
+// sys.Rate.
+func RateTeams(sys RatingSystem, teams []RatedTeam) [][]model.RatingState {
+	before := make([]model.RatingState, len(teams))
+	placements := make([]RatedPlacement, len(teams))
+	for i, t := range teams {
+		before[i] = teamStrength(t.Members)
+		placements[i] = RatedPlacement{PlayerID: t.ID, Place: t.Place, State: before[i]}
+	}
+	after := sys.Rate(placements)
+
+	out := make([][]model.RatingState, len(teams))
+	for i, t := range teams {
+		out[i] = make([]model.RatingState, len(t.Members))
+		for j, m := range t.Members {
+			if len(t.Members) == 1 {
+				out[i][j] = after[i]
+				continue
+			}
+			out[i][j] = m.Shifted(before[i], after[i])
+		}
+	}
+	return out
+}
+
+// teamStrength combines members' states: mean rating and volatility, and
+// the root mean square of their uncertainties.
+func teamStrength(members []model.RatingState) model.RatingState {
+	var mu, sigmaSq, volatility float64
+	for _, m := range members {
+		mu += m.Mu
+		sigmaSq += m.Sigma * m.Sigma
+		volatility += m.Volatility
+	}
+	n := float64(len(members))
+	return model.RatingState{
+		Mu:         mu / n,
+		Sigma:      math.Sqrt(sigmaSq / n),
+		Volatility: volatility / n,
+	}
+}
@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/service"
+)
+
+func ratedMatch(t *testing.T, sys service.RatingSystem, elos ...int) []model.RatingState {
+	t.Helper()
+	players := make([]service.RatedPlacement, len(elos))
+	for i, elo := range elos {
+		players[i] = service.RatedPlacement{
+			PlayerID: string(rune('a' + i)),
+			Place:    i + 1,
+			State:    sys.Seed(elo),
+		}
+	}
+	states := sys.Rate(players)
+	if len(states) != len(players) {
+		t.Fatalf("got %d states, want %d", len(states), len(players))
+	}
+	return states
+}
+
+func TestRatingSystemFor(t *testing.T) {
+	for _, name := range []model.RatingSystem{"", model.RatingELO, model.RatingGlicko2, model.RatingOpenSkill} {
+		if _, err := service.RatingSystemFor(name); err != nil {
+			t.Errorf("RatingSystemFor(%q): %v", name, err)
+		}
+	}
+	if _, err := service.RatingSystemFor("trueskill"); err == nil {
+		t.Error("expected an error for an unknown system")
+	}
+}
+
+func TestRatingSystems_WinnerGainsLoserLoses(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingELO, model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			before := sys.Seed(model.InitialELO)
+			states := ratedMatch(t, sys, model.InitialELO, model.InitialELO, model.InitialELO)
+
+			if states[0].Mu <= before.Mu {
+				t.Errorf("winner mu %.1f, want above %.1f", states[0].Mu, before.Mu)
+			}
+			if states[2].Mu >= before.Mu {
+				t.Errorf("last place mu %.1f, want below %.1f", states[2].Mu, before.Mu)
+			}
+		})
+	}
+}
+
+func TestRatingSystems_UncertaintyShrinks(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			before := sys.Seed(model.InitialELO)
+			states := ratedMatch(t, sys, model.InitialELO, model.InitialELO)
+
+			for i, st := range states {
+				if st.Sigma >= before.Sigma {
+					t.Errorf("player %d sigma %.1f, want below %.1f", i, st.Sigma, before.Sigma)
+				}
+			}
+		})
+	}
+}
+
+func TestRatingSystems_ConservativeBelowMean(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			seed := sys.Seed(model.InitialELO)
+			if c := sys.Conservative(seed); c >= seed.Mu {
+				t.Errorf("conservative %.1f, want below mu %.1f", c, seed.Mu)
+			}
+		})
+	}
+}
+
+func TestRatingSystems_UpsetMovesMore(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingELO, model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			expected := ratedMatch(t, sys, 1400, 1000)
+			upset := ratedMatch(t, sys, 1000, 1400)
+
+			gainExpected := expected[0].Mu - 1400
+			gainUpset := upset[0].Mu - 1000
+			if gainUpset <= gainExpected {
+				t.Errorf("upset gain %.1f, want above expected gain %.1f", gainUpset, gainExpected)
+			}
+		})
+	}
+}
+
+func TestRateTeams_SoloMatchesRate(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingELO, model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			a, b := sys.Seed(1100), sys.Seed(900)
+
+			solo := sys.Rate([]service.RatedPlacement{
+				{PlayerID: "a", Place: 2, State: a},
+				{PlayerID: "b", Place: 1, State: b},
+			})
+			teams := service.RateTeams(sys, []service.RatedTeam{
+				{ID: "a", Place: 2, Members: []model.RatingState{a}},
+				{ID: "b", Place: 1, Members: []model.RatingState{b}},
+			})
+
+			for i := range solo {
+				if teams[i][0] != solo[i] {
+					t.Errorf("team of one %d = %+v, want %+v", i, teams[i][0], solo[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRateTeams_MembersShareTeamChange(t *testing.T) {
+	for _, name := range []model.RatingSystem{model.RatingELO, model.RatingGlicko2, model.RatingOpenSkill} {
+		t.Run(string(name), func(t *testing.T) {
+			sys, _ := service.RatingSystemFor(name)
+			strong, weak := sys.Seed(1300), sys.Seed(900)
+
+			states := service.RateTeams(sys, []service.RatedTeam{
+				{ID: "red", Place: 1, Members: []model.RatingState{strong, weak}},
+				{ID: "blue", Place: 2, Members: []model.RatingState{sys.Seed(1100), sys.Seed(1100)}},
+			})
+
+			gainStrong := states[0][0].Mu - strong.Mu
+			gainWeak := states[0][1].Mu - weak.Mu
+			if gainStrong <= 0 || math.Abs(gainStrong-gainWeak) > 1e-9 {
+				t.Errorf("winners gained %.3f and %.3f, want the same positive change", gainStrong, gainWeak)
+			}
+			for j, st := range states[1] {
+				if st.Mu >= 1100 {
+					t.Errorf("loser %d mu %.1f, want below 1100", j, st.Mu)
+				}
+			}
+		})
+	}
+}
+
+func TestRateTeams_StrongerTeamGainsLess(t *testing.T) {
+	sys, _ := service.RatingSystemFor(model.RatingELO)
+	seeds := func(elos ...int) []model.RatingState {
+		out := make([]model.RatingState, len(elos))
+		for i, e := range elos {
+			out[i] = sys.Seed(e)
+		}
+		return out
+	}
+
+	favoured := service.RateTeams(sys, []service.RatedTeam{
+		{ID: "red", Place: 1, Members: seeds(1400, 1400)},
+		{ID: "blue", Place: 2, Members: seeds(1000, 1000)},
+	})
+	upset := service.RateTeams(sys, []service.RatedTeam{
+		{ID: "red", Place: 1, Members: seeds(1000, 1000)},
+		{ID: "blue", Place: 2, Members: seeds(1400, 1400)},
+	})
+
+	if gf, gu := favoured[0][0].Mu-1400, upset[0][0].Mu-1000; gu <= gf {
+		t.Errorf("upset gain %.1f, want above favoured gain %.1f", gu, gf)
+	}
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
 )
@@ -22,16 +23,18 @@ type Repository interface {
 	// SavePlayerStats upserts a player stats document (matched by PlayerID + SeasonID).
 	SavePlayerStats(ctx context.Context, stats *model.PlayerStats) error
 
-	// ListPlayerStatsByELO returns the top `limit` players in the current season
-	// ordered by ELO descending.
-	ListPlayerStatsByELO(ctx context.Context, limit int) ([]*model.PlayerStats, error)
+	// ListPlayerStatsByRating returns the top `limit` players in the current
+	// season ordered by ELO descending, or by conservative rating descending
+	// when conservative is set. A non-empty mode keeps only players who
+	// played a match in it.
+	ListPlayerStatsByRating(ctx context.Context, limit int, conservative bool, mode model.MatchMode) ([]*model.PlayerStats, error)
 
-	// ListAllPlayerStatsByELO returns all players in the current season
-	// ordered by ELO descending. Used during season reset.
-	ListAllPlayerStatsByELO(ctx context.Context) ([]*model.PlayerStats, error)
+	// ListSeasonPlayerStatsByELO returns all players of the season ordered by
+	// ELO descending, ties in a stable order. Used during season reset.
+	ListSeasonPlayerStatsByELO(ctx context.Context, seasonID string) ([]*model.PlayerStats, error)
 
-	// DeleteAllPlayerStats removes all current-season player stats (hard reset).
-	DeleteAllPlayerStats(ctx context.Context) error
+	// DeleteSeasonPlayerStats removes the season's live player stats.
+	DeleteSeasonPlayerStats(ctx context.Context, seasonID string) error
 
 	// Seasons
 
@@ -44,20 +47,65 @@ type Repository interface {
 	GetSeasonByID(ctx context.Context, id string) (*model.Season, error)
 
 	// CreateSeason inserts a new season and returns the created record.
+	// Returns ierror.ErrActiveSeasonExists if its number is already taken.
 	CreateSeason(ctx context.Context, season *model.Season) (*model.Season, error)
 
 	// CloseSeason sets ended_at on the season with the given ID.
+	// Returns ierror.ErrSeasonClosed if it was already closed, so only one
+	// caller goes on to pay the season's rewards.
 	CloseSeason(ctx context.Context, id string) error
 
+	// MarkSeasonEndNotified flags that players were warned the season ends.
+	// Reports false if it was already flagged.
+	MarkSeasonEndNotified(ctx context.Context, id string) (bool, error)
+
 	// CountSeasons returns the total number of seasons (for numbering the next one).
 	CountSeasons(ctx context.Context) (int, error)
 
 	// ListSeasons returns a paginated list of seasons ordered by number descending.
 	ListSeasons(ctx context.Context, next string, limit int) ([]*model.Season, string, error)
 
+	// Season schedule
+
+	// CreateSeasonSchedule inserts an upcoming season and assigns its ID.
+	CreateSeasonSchedule(ctx context.Context, schedule *model.SeasonSchedule) error
+
+	// ListSeasonSchedules returns all upcoming seasons, earliest first.
+	ListSeasonSchedules(ctx context.Context) ([]*model.SeasonSchedule, error)
+
+	// NextSeasonSchedule returns the earliest upcoming season.
+	// Returns ierror.ErrNotFound if the schedule is empty.
+	NextSeasonSchedule(ctx context.Context) (*model.SeasonSchedule, error)
+
+	// DeleteSeasonSchedule removes an upcoming season.
+	// Returns ierror.ErrNotFound if it does not exist.
+	DeleteSeasonSchedule(ctx context.Context, id string) error
+
+	// Season resets
+
+	// CreateSeasonReset inserts the reset job of a season.
+	// Returns ierror.ErrResetExists if the season already has one.
+	CreateSeasonReset(ctx context.Context, reset *model.SeasonReset) error
+
+	// GetSeasonReset returns the reset job of a season.
+	// Returns ierror.ErrNotFound if the season has none.
+	GetSeasonReset(ctx context.Context, seasonID string) (*model.SeasonReset, error)
+
+	// SaveSeasonReset persists the job's progress, guarded by its Version,
+	// and bumps the Version on success. The standings are written only when
+	// they changed since the last save.
+	// Returns ierror.ErrResetChanged if another runner saved it first.
+	SaveSeasonReset(ctx context.Context, reset *model.SeasonReset) error
+
+	// ListSeasonResets returns reset jobs, newest first, optionally only the
+	// ones that have not completed.
+	ListSeasonResets(ctx context.Context, unfinishedOnly bool) ([]*model.SeasonReset, error)
+
 	// Season results
 
 	// CreateSeasonResults inserts the archived standings for a completed season.
+	// Results already archived for a player are left as they are, so a retry
+	// only adds what an earlier attempt missed.
 	CreateSeasonResults(ctx context.Context, results []*model.SeasonResult) error
 
 	// ListSeasonResults returns all archived results for a season, ordered by rank.
@@ -66,4 +114,50 @@ type Repository interface {
 	// GetPlayerSeasonResult returns a single player's archived result for a season.
 	// Returns ierror.ErrNotFound if not found.
 	GetPlayerSeasonResult(ctx context.Context, seasonID, playerID string) (*model.SeasonResult, error)
+
+	// Matches
+
+	// CreateMatch inserts a recorded match and assigns its ID.
+	// Returns ierror.ErrMatchExists if a match with its ExternalID exists.
+	CreateMatch(ctx context.Context, match *model.Match) error
+
+	// MarkMatchStatsApplied records that every player's stats count the
+	// match.
+	MarkMatchStatsApplied(ctx context.Context, match *model.Match) error
+
+	// GetMatchByExternalID returns the match the game server reported under
+	// externalID.
+	// Returns ierror.ErrNotFound if not found.
+	GetMatchByExternalID(ctx context.Context, externalID string) (*model.Match, error)
+
+	// GetMatch returns a match by its ID.
+	// Returns ierror.ErrNotFound if not found.
+	GetMatch(ctx context.Context, id string) (*model.Match, error)
+
+	// ListMatches returns a page of matches, newest first, filtered by player
+	// and season when those are non-empty.
+	ListMatches(ctx context.Context, playerID, seasonID, next string, limit int) ([]*model.Match, string, error)
+
+	// ListPlayerSeasonMatches returns every match of the season the player
+	// took part in, oldest first.
+	ListPlayerSeasonMatches(ctx context.Context, seasonID, playerID string) ([]*model.Match, error)
+
+	// ListRecentMatches returns the season's latest non-voided matches played
+	// since with any of the players, oldest first, for the abuse heuristics.
+	ListRecentMatches(ctx context.Context, seasonID string, playerIDs []string, since time.Time) ([]*model.Match, error)
+
+	// Match review
+
+	// ListMatchesForReview returns a page of flagged matches awaiting review,
+	// newest first, filtered by season when seasonID is non-empty.
+	ListMatchesForReview(ctx context.Context, seasonID, next string, limit int) ([]*model.Match, string, error)
+
+	// HasPendingReview reports whether any of the player's matches in the
+	// season still awaits review.
+	HasPendingReview(ctx context.Context, seasonID, playerID string) (bool, error)
+
+	// SaveMatchReview persists the match's review and void fields, provided
+	// its review is still at from.
+	// Returns ierror.ErrMatchChanged if another admin resolved it first.
+	SaveMatchReview(ctx context.Context, match *model.Match, from model.ReviewStatus) error
 }
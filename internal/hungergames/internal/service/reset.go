@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetSeasonReset(ctx context.Context, req *hgv1.GetSeasonResetRequest) (*hgv1.SeasonResetJob, error) {
+	l := s.log.With(zap.String("method", "GetSeasonReset"), zap.String("season_id", req.GetSeasonId()))
+
+	reset, err := s.repo.GetSeasonReset(ctx, req.GetSeasonId())
+	if err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return nil, status.Error(codes.NotFound, "season reset not found")
+		}
+		l.Error("failed to get season reset", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get season reset")
+	}
+	return toSeasonResetProto(reset), nil
+}
+
+func (s *Service) ListSeasonResets(ctx context.Context, req *hgv1.ListSeasonResetsRequest) (*hgv1.ListSeasonResetsResponse, error) {
+	l := s.log.With(zap.String("method", "ListSeasonResets"))
+
+	resets, err := s.repo.ListSeasonResets(ctx, req.GetUnfinishedOnly())
+	if err != nil {
+		l.Error("failed to list season resets", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list season resets")
+	}
+
+	return &hgv1.ListSeasonResetsResponse{
+		Resets: lo.Map(resets, func(r *model.SeasonReset, _ int) *hgv1.SeasonResetJob {
+			return toSeasonResetProto(r)
+		}),
+	}, nil
+}
+
+func (s *Service) ResumeSeasonReset(ctx context.Context, req *hgv1.ResumeSeasonResetRequest) (*hgv1.SeasonResetJob, error) {
+	l := s.log.With(zap.String("method", "ResumeSeasonReset"), zap.String("season_id", req.GetSeasonId()))
+
+	reset, err := s.repo.GetSeasonReset(ctx, req.GetSeasonId())
+	if err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return nil, status.Error(codes.NotFound, "season reset not found")
+		}
+		l.Error("failed to get season reset", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get season reset")
+	}
+
+	if err := reset.Resume(time.Now()); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	// Saving claims the attempt: a concurrent resume loses on the version.
+	if err := s.repo.SaveSeasonReset(ctx, reset); err != nil {
+		if errors.Is(err, ierror.ErrResetChanged) {
+			return nil, status.Error(codes.Aborted, "season reset was resumed concurrently")
+		}
+		l.Error("failed to save season reset", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resume season reset")
+	}
+
+	if err := s.runSeasonReset(ctx, reset); err != nil {
+		return nil, resetFailedError(reset)
+	}
+	return toSeasonResetProto(reset), nil
+}
+
+// startSeasonReset creates the reset job of season and runs it. Creating the
+// job claims the season: a second caller gets ierror.ErrResetExists, so an
+// admin reset and the scheduler never both end the same season. On a failed
+// step the returned job records where it stopped.
+func (s *Service) startSeasonReset(ctx context.Context, season *model.Season, rewards []model.RewardTier) (*model.SeasonReset, error) {
+	reset := model.NewSeasonReset(season, rewards, time.Now())
+	if err := s.repo.CreateSeasonReset(ctx, reset); err != nil {
+		if !errors.Is(err, ierror.ErrResetExists) {
+			s.log.With(zap.String("method", "startSeasonReset"), zap.String("season_id", season.ID)).
+				Error("failed to create season reset", zap.Error(err))
+		}
+		return nil, err
+	}
+	return reset, s.runSeasonReset(ctx, reset)
+}
+
+// runSeasonReset runs the job's remaining steps, saving after each one. A
+// failed step is recorded on the job and left for ResumeSeasonReset; every
+// step is safe to repeat, so resuming after a crash mid-step is too.
+func (s *Service) runSeasonReset(ctx context.Context, reset *model.SeasonReset) error {
+	l := s.log.With(zap.String("method", "runSeasonReset"), zap.String("season_id", reset.SeasonID))
+
+	for reset.IsRunning() {
+		var err error
+		switch reset.Step {
+		case model.ResetStepClose:
+			err = s.closeResetSeason(ctx, reset)
+		case model.ResetStepPayRewards:
+			err = s.payResetRewards(ctx, reset)
+		case model.ResetStepArchive:
+			if err = s.repo.CreateSeasonResults(ctx, reset.Standings); err == nil {
+				reset.FinishArchive(time.Now())
+			}
+		case model.ResetStepClearStats:
+			if err = s.repo.DeleteSeasonPlayerStats(ctx, reset.SeasonID); err == nil {
+				reset.Complete(time.Now())
+			}
+		default:
+			err = fmt.Errorf("unknown reset step %q", reset.Step)
+		}
+
+		if err != nil {
+			// Another runner took the job over; its progress stands.
+			if errors.Is(err, ierror.ErrResetChanged) {
+				return err
+			}
+			l.Error("season reset step failed", zap.String("step", string(reset.Step)), zap.Error(err))
+			reset.Fail(err, time.Now())
+			if saveErr := s.repo.SaveSeasonReset(ctx, reset); saveErr != nil {
+				l.Error("failed to record season reset failure", zap.Error(saveErr))
+			}
+			return err
+		}
+		if err := s.repo.SaveSeasonReset(ctx, reset); err != nil {
+			l.Error("failed to save season reset", zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// closeResetSeason closes the season and freezes its standings. The season
+// may already be closed by an earlier attempt of this job.
+func (s *Service) closeResetSeason(ctx context.Context, reset *model.SeasonReset) error {
+	if err := s.repo.CloseSeason(ctx, reset.SeasonID); err != nil && !errors.Is(err, ierror.ErrSeasonClosed) {
+		return err
+	}
+
+	stats, err := s.repo.ListSeasonPlayerStatsByELO(ctx, reset.SeasonID)
+	if err != nil {
+		return err
+	}
+	reset.SetStandings(stats, time.Now())
+	return nil
+}
+
+// payResetRewards delivers every unpaid reward, saving progress after each
+// player. Each delivery carries the job's deterministic op key, so a reward
+// delivered just before a crash is not handed out again on resume.
+func (s *Service) payResetRewards(ctx context.Context, reset *model.SeasonReset) error {
+	for i := reset.PaidThrough; i < len(reset.Standings); i++ {
+		res := reset.Standings[i]
+		if !res.OwesDelivery() {
+			continue
+		}
+		if err := s.deliverRewards(ctx, reset, res); err != nil {
+			return err
+		}
+		reset.MarkPaid(i, time.Now())
+		if err := s.repo.SaveSeasonReset(ctx, reset); err != nil {
+			return err
+		}
+	}
+	reset.FinishRewards(time.Now())
+	return nil
+}
+
+// deliverRewards credits the player's coins as one ledger entry, assigns
+// their kits and grants their shop items. Titles and badges need no delivery.
+func (s *Service) deliverRewards(ctx context.Context, reset *model.SeasonReset, res *model.SeasonResult) error {
+	if res.RewardCoins > 0 {
+		err := s.donateUC.CreditOnce(ctx, res.PlayerID, res.PlayerName, res.RewardCoins,
+			reset.RewardReason(res), reset.RewardOpKey(res))
+		if err != nil {
+			return fmt.Errorf("credit reward of %s: %w", res.PlayerID, err)
+		}
+	}
+	for i, r := range res.Rewards {
+		var err error
+		switch r.Kind {
+		case model.RewardKit:
+			_, err = s.kits.AssignOnce(ctx, res.PlayerID, res.PlayerName, r.Ref, rewardAssigner, reset.GrantOpKey(res, i))
+		case model.RewardItem:
+			_, err = s.items.GrantOnce(ctx, res.PlayerID, r.Ref, 0, reset.GrantOpKey(res, i))
+		}
+		if err != nil {
+			return fmt.Errorf("grant %s %q to %s: %w", r.Kind, r.Ref, res.PlayerID, err)
+		}
+	}
+	return nil
+}
+
+// resetFailedError tells the caller where the reset stopped and how to go on.
+func resetFailedError(reset *model.SeasonReset) error {
+	if reset.Status != model.ResetStatusFailed {
+		return status.Error(codes.Aborted, "season reset was taken over by another runner")
+	}
+	return status.Errorf(codes.Internal,
+		"season reset failed at step %s: %s; inspect it with GetSeasonReset and continue with ResumeSeasonReset",
+		reset.Step, reset.LastError)
+}
+
+var (
+	resetSteps = map[model.ResetStep]hgv1.SeasonResetStep{
+		model.ResetStepClose:      hgv1.SeasonResetStep_SEASON_RESET_STEP_CLOSE,
+		model.ResetStepPayRewards: hgv1.SeasonResetStep_SEASON_RESET_STEP_PAY_REWARDS,
+		model.ResetStepArchive:    hgv1.SeasonResetStep_SEASON_RESET_STEP_ARCHIVE_RESULTS,
+		model.ResetStepClearStats: hgv1.SeasonResetStep_SEASON_RESET_STEP_CLEAR_STATS,
+		model.ResetStepDone:       hgv1.SeasonResetStep_SEASON_RESET_STEP_DONE,
+	}
+	resetStatuses = map[model.ResetStatus]hgv1.SeasonResetStatus{
+		model.ResetStatusRunning:   hgv1.SeasonResetStatus_SEASON_RESET_STATUS_RUNNING,
+		model.ResetStatusFailed:    hgv1.SeasonResetStatus_SEASON_RESET_STATUS_FAILED,
+		model.ResetStatusCompleted: hgv1.SeasonResetStatus_SEASON_RESET_STATUS_COMPLETED,
+	}
+)
+
+func toSeasonResetProto(r *model.SeasonReset) *hgv1.SeasonResetJob {
+	paid, total := r.RewardCounts()
+	job := &hgv1.SeasonResetJob{
+		SeasonId:     r.SeasonID,
+		SeasonNumber: int32(r.SeasonNumber),
+		Step:         resetSteps[r.Step],
+		Status:       resetStatuses[r.Status],
+		Rewards:      toSeasonRewardsProto(r.Rewards),
+		RewardTiers:  toRewardTiersProto(r.Rewards),
+		PlayersTotal: int32(len(r.Standings)),
+		RewardsPaid:  int32(paid),
+		RewardsTotal: int32(total),
+		LastError:    r.LastError,
+		Attempts:     int32(r.Attempts),
+		StartedAt:    timestamppb.New(r.StartedAt),
+		UpdatedAt:    timestamppb.New(r.UpdatedAt),
+	}
+	if r.CompletedAt != nil {
+		job.CompletedAt = timestamppb.New(*r.CompletedAt)
+	}
+	return job
+}
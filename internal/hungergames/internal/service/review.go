@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ListFlaggedMatches(ctx context.Context, req *hgv1.ListFlaggedMatchesRequest) (*hgv1.ListFlaggedMatchesResponse, error) {
+	l := s.log.With(zap.String("method", "ListFlaggedMatches"))
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+
+	matches, next, err := s.repo.ListMatchesForReview(ctx, req.GetSeasonId(), req.GetNext(), limit)
+	if err != nil {
+		if isDomainError(err, codes.InvalidArgument) {
+			return nil, err
+		}
+		l.Error("failed to list flagged matches", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list flagged matches")
+	}
+
+	return &hgv1.ListFlaggedMatchesResponse{
+		Matches: lo.Map(matches, func(m *model.Match, _ int) *hgv1.Match {
+			return toReviewedMatchProto(m)
+		}),
+		Next: next,
+	}, nil
+}
+
+func (s *Service) ClearMatchFlags(ctx context.Context, req *hgv1.ClearMatchFlagsRequest) (*hgv1.Match, error) {
+	l := s.log.With(zap.String("method", "ClearMatchFlags"), zap.String("match_id", req.GetMatchId()))
+
+	adminID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	match, err := s.getMatch(ctx, l, req.GetMatchId())
+	if err != nil {
+		return nil, err
+	}
+
+	from := match.Review
+	if err := match.Clear(adminID, time.Now()); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.saveMatchReview(ctx, l, match, from); err != nil {
+		return nil, err
+	}
+
+	s.unfreezeRatings(ctx, l, match)
+	return toReviewedMatchProto(match), nil
+}
+
+// VoidMatch voids the match and rolls back what it changed in its players'
+// stats. The match is marked voided first, so it stops counting even if a
+// rollback fails; voiding it again retries the players not yet rolled back.
+func (s *Service) VoidMatch(ctx context.Context, req *hgv1.VoidMatchRequest) (*hgv1.Match, error) {
+	l := s.log.With(zap.String("method", "VoidMatch"), zap.String("match_id", req.GetMatchId()))
+
+	adminID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	match, err := s.getMatch(ctx, l, req.GetMatchId())
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.repo.GetSeasonByID(ctx, match.SeasonID)
+	if err != nil {
+		l.Error("failed to get match season", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get season")
+	}
+	// Stats of an ended season are archived as results; they are final.
+	if !season.IsActive() {
+		return nil, status.Error(codes.FailedPrecondition, "the match's season has ended")
+	}
+
+	if !match.IsVoided() {
+		from := match.Review
+		if err := match.Void(adminID, req.GetReason(), time.Now()); err != nil {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if err := s.saveMatchReview(ctx, l, match, from); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.rollbackMatch(ctx, season, match); err != nil {
+		l.Error("failed to roll back match", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to roll back match ratings; void the match again to retry")
+	}
+
+	s.unfreezeRatings(ctx, l, match)
+	return toReviewedMatchProto(match), nil
+}
+
+func (s *Service) getMatch(ctx context.Context, l logger.Logger, id string) (*model.Match, error) {
+	match, err := s.repo.GetMatch(ctx, id)
+	if err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return nil, status.Error(codes.NotFound, "match not found")
+		}
+		l.Error("failed to get match", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get match")
+	}
+	return match, nil
+}
+
+func (s *Service) saveMatchReview(ctx context.Context, l logger.Logger, match *model.Match, from model.ReviewStatus) error {
+	if err := s.repo.SaveMatchReview(ctx, match, from); err != nil {
+		if isDomainError(err, codes.FailedPrecondition) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		l.Error("failed to save match review", zap.Error(err))
+		return status.Error(codes.Internal, "failed to save match review")
+	}
+	return nil
+}
+
+// rollbackMatch reverts the match in the stats of every player. Each
+// player's stats remember the matches reverted, so a retry after a partial
+// failure reverts only the rest.
+func (s *Service) rollbackMatch(ctx context.Context, season *model.Season, match *model.Match) error {
+	system, err := RatingSystemFor(season.RatingSystem)
+	if err != nil {
+		return err
+	}
+	stats, err := s.repo.GetPlayerStatsByIDs(ctx, match.SeasonID, match.PlayerIDs())
+	if err != nil {
+		return err
+	}
+
+	byPlayer := lo.KeyBy(stats, func(st *model.PlayerStats) string { return st.PlayerID })
+	for _, p := range match.Participants {
+		st, ok := byPlayer[p.PlayerID]
+		if !ok || !st.RevertMatch(match.ID, match.Mode, p, system.Conservative) {
+			continue
+		}
+		if err := s.repo.SavePlayerStats(ctx, st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unfreezeRatings lifts the freeze of the match's players who have no other
+// match awaiting review. Failures are only logged: the freeze then stays
+// until another review of the player is resolved.
+func (s *Service) unfreezeRatings(ctx context.Context, l logger.Logger, match *model.Match) {
+	stats, err := s.repo.GetPlayerStatsByIDs(ctx, match.SeasonID, match.PlayerIDs())
+	if err != nil {
+		l.Error("failed to fetch player stats to unfreeze", zap.Error(err))
+		return
+	}
+
+	for _, st := range stats {
+		if !st.RatingFrozen {
+			continue
+		}
+		pending, err := s.repo.HasPendingReview(ctx, match.SeasonID, st.PlayerID)
+		if err != nil {
+			l.Error("failed to check pending reviews", zap.String("player_id", st.PlayerID), zap.Error(err))
+			continue
+		}
+		if pending {
+			continue
+		}
+		st.UnfreezeRating()
+		if err := s.repo.SavePlayerStats(ctx, st); err != nil {
+			l.Error("failed to unfreeze rating", zap.String("player_id", st.PlayerID), zap.Error(err))
+		}
+	}
+}
+
+var (
+	abuseFlagsToProto = map[model.AbuseFlag]hgv1.AbuseFlag{
+		model.FlagRepeatedGroup: hgv1.AbuseFlag_ABUSE_FLAG_REPEATED_GROUP,
+		model.FlagShortMatch:    hgv1.AbuseFlag_ABUSE_FLAG_SHORT_MATCH,
+		model.FlagWinStreak:     hgv1.AbuseFlag_ABUSE_FLAG_WIN_STREAK,
+	}
+	reviewStatusesToProto = map[model.ReviewStatus]hgv1.MatchReviewStatus{
+		model.ReviewPending: hgv1.MatchReviewStatus_MATCH_REVIEW_STATUS_PENDING,
+		model.ReviewCleared: hgv1.MatchReviewStatus_MATCH_REVIEW_STATUS_CLEARED,
+		model.ReviewVoided:  hgv1.MatchReviewStatus_MATCH_REVIEW_STATUS_VOIDED,
+	}
+)
@@ -0,0 +1,77 @@
+package service
+
+import (
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rewardAssigner is recorded as the assigner of kits handed out as season
+// rewards.
+const rewardAssigner = "hungergames"
+
+var rewardKindsToProto = map[model.RewardKind]hgv1.RewardKind{
+	model.RewardCoins: hgv1.RewardKind_REWARD_KIND_COINS,
+	model.RewardKit:   hgv1.RewardKind_REWARD_KIND_KIT,
+	model.RewardItem:  hgv1.RewardKind_REWARD_KIND_ITEM,
+	model.RewardTitle: hgv1.RewardKind_REWARD_KIND_TITLE,
+	model.RewardBadge: hgv1.RewardKind_REWARD_KIND_BADGE,
+}
+
+// rewardTiersFromProto reads the requested reward tiers, converting the
+// deprecated exact-rank coin table into single-rank tiers. Unknown reward
+// kinds are left for model.ValidateRewards to reject.
+func rewardTiersFromProto(legacy []*hgv1.SeasonReward, tiers []*hgv1.RewardTier) ([]model.RewardTier, error) {
+	if len(legacy) > 0 && len(tiers) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "rewards and reward_tiers are mutually exclusive")
+	}
+	if len(legacy) > 0 {
+		return lo.Map(legacy, func(r *hgv1.SeasonReward, _ int) model.RewardTier {
+			return model.RankCoins(int(r.GetRank()), r.GetCoins())
+		}), nil
+	}
+	return lo.Map(tiers, func(t *hgv1.RewardTier, _ int) model.RewardTier {
+		return model.RewardTier{
+			FromRank:   int(t.GetFromRank()),
+			ToRank:     int(t.GetToRank()),
+			TopPercent: int(t.GetTopPercent()),
+			Rewards: lo.Map(t.GetRewards(), func(r *hgv1.Reward, _ int) model.Reward {
+				kind, _ := lo.FindKey(rewardKindsToProto, r.GetKind())
+				return model.Reward{Kind: kind, Coins: r.GetCoins(), Ref: r.GetRef()}
+			}),
+		}
+	}), nil
+}
+
+func toRewardTiersProto(tiers []model.RewardTier) []*hgv1.RewardTier {
+	return lo.Map(tiers, func(t model.RewardTier, _ int) *hgv1.RewardTier {
+		return &hgv1.RewardTier{
+			FromRank:   int32(t.FromRank),
+			ToRank:     int32(t.ToRank),
+			TopPercent: int32(t.TopPercent),
+			Rewards:    toRewardsProto(t.Rewards),
+		}
+	})
+}
+
+func toRewardsProto(rewards []model.Reward) []*hgv1.Reward {
+	return lo.Map(rewards, func(r model.Reward, _ int) *hgv1.Reward {
+		return &hgv1.Reward{Kind: rewardKindsToProto[r.Kind], Coins: r.Coins, Ref: r.Ref}
+	})
+}
+
+// toSeasonRewardsProto fills the deprecated exact-rank coin table for older
+// clients from the tiers that fit it: a single rank paying only coins.
+func toSeasonRewardsProto(tiers []model.RewardTier) []*hgv1.SeasonReward {
+	var out []*hgv1.SeasonReward
+	for _, t := range tiers {
+		if t.IsPercentile() || t.FromRank != t.ToRank ||
+			len(t.Rewards) != 1 || t.Rewards[0].Kind != model.RewardCoins {
+			continue
+		}
+		out = append(out, &hgv1.SeasonReward{Rank: int32(t.FromRank), Coins: t.Rewards[0].Coins})
+	}
+	return out
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"testing"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRewardTiersFromProto_Legacy(t *testing.T) {
+	tiers, err := rewardTiersFromProto([]*hgv1.SeasonReward{{Rank: 2, Coins: 50}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tiers) != 1 || tiers[0].FromRank != 2 || tiers[0].ToRank != 2 || model.CoinsOf(tiers[0].Rewards) != 50 {
+		t.Errorf("tiers = %+v, want rank 2 paying 50", tiers)
+	}
+	if back := toSeasonRewardsProto(tiers); len(back) != 1 || back[0].GetRank() != 2 || back[0].GetCoins() != 50 {
+		t.Errorf("legacy table = %v, want rank 2 paying 50", back)
+	}
+}
+
+func TestRewardTiersFromProto_Tiers(t *testing.T) {
+	tiers, err := rewardTiersFromProto(nil, []*hgv1.RewardTier{{
+		TopPercent: 10,
+		Rewards: []*hgv1.Reward{
+			{Kind: hgv1.RewardKind_REWARD_KIND_KIT, Ref: "champion"},
+			{Kind: hgv1.RewardKind_REWARD_KIND_UNSPECIFIED, Ref: "x"},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rewards := tiers[0].Rewards
+	if rewards[0].Kind != model.RewardKit || rewards[0].Ref != "champion" {
+		t.Errorf("reward = %+v, want kit champion", rewards[0])
+	}
+	if model.ValidateRewards(tiers) == nil {
+		t.Error("unspecified reward kind passed validation")
+	}
+	if back := toSeasonRewardsProto(tiers); len(back) != 0 {
+		t.Errorf("legacy table = %v, want empty for a percentile tier", back)
+	}
+}
+
+func TestRewardTiersFromProto_BothSet(t *testing.T) {
+	_, err := rewardTiersFromProto(
+		[]*hgv1.SeasonReward{{Rank: 1, Coins: 10}},
+		[]*hgv1.RewardTier{{FromRank: 1, ToRank: 1}},
+	)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
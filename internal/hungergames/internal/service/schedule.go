@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) ScheduleSeason(ctx context.Context, req *hgv1.ScheduleSeasonRequest) (*hgv1.ScheduleSeasonResponse, error) {
+	l := s.log.With(zap.String("method", "ScheduleSeason"))
+
+	rewards, err := rewardTiersFromProto(req.GetRewards(), req.GetRewardTiers())
+	if err != nil {
+		return nil, err
+	}
+	ratingSystem, ok := ratingSystemFromProto(req.GetRatingSystem())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown rating system")
+	}
+	schedule, err := model.NewSeasonSchedule(req.GetStartsAt().AsTime(), req.GetEndsAt().AsTime(), rewards, ratingSystem)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !schedule.StartsAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "season must start in the future")
+	}
+
+	active, err := s.repo.GetActiveSeason(ctx)
+	switch {
+	case err == nil:
+		if active.EndsAt != nil && schedule.StartsAt.Before(*active.EndsAt) {
+			return nil, status.Error(codes.AlreadyExists, "schedule overlaps the active season")
+		}
+	case !isDomainError(err, codes.NotFound):
+		l.Error("failed to get active season", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to schedule season")
+	}
+
+	existing, err := s.repo.ListSeasonSchedules(ctx)
+	if err != nil {
+		l.Error("failed to list season schedules", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to schedule season")
+	}
+	for _, other := range existing {
+		if schedule.Overlaps(other) {
+			return nil, status.Error(codes.AlreadyExists, "schedule overlaps another season")
+		}
+	}
+
+	if err := s.repo.CreateSeasonSchedule(ctx, schedule); err != nil {
+		l.Error("failed to create season schedule", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to schedule season")
+	}
+
+	return &hgv1.ScheduleSeasonResponse{Schedule: toSeasonScheduleProto(schedule)}, nil
+}
+
+func (s *Service) ListSeasonSchedules(ctx context.Context, _ *hgv1.ListSeasonSchedulesRequest) (*hgv1.ListSeasonSchedulesResponse, error) {
+	l := s.log.With(zap.String("method", "ListSeasonSchedules"))
+
+	schedules, err := s.repo.ListSeasonSchedules(ctx)
+	if err != nil {
+		l.Error("failed to list season schedules", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list season schedules")
+	}
+
+	return &hgv1.ListSeasonSchedulesResponse{
+		Schedules: lo.Map(schedules, func(schedule *model.SeasonSchedule, _ int) *hgv1.SeasonSchedule {
+			return toSeasonScheduleProto(schedule)
+		}),
+	}, nil
+}
+
+func (s *Service) CancelSeasonSchedule(ctx context.Context, req *hgv1.CancelSeasonScheduleRequest) (*hgv1.CancelSeasonScheduleResponse, error) {
+	l := s.log.With(zap.String("method", "CancelSeasonSchedule"), zap.String("schedule_id", req.GetScheduleId()))
+
+	if err := s.repo.DeleteSeasonSchedule(ctx, req.GetScheduleId()); err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return nil, status.Error(codes.NotFound, "schedule not found")
+		}
+		l.Error("failed to delete season schedule", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to cancel season schedule")
+	}
+
+	return &hgv1.CancelSeasonScheduleResponse{}, nil
+}
+
+func toSeasonScheduleProto(s *model.SeasonSchedule) *hgv1.SeasonSchedule {
+	return &hgv1.SeasonSchedule{
+		Id:       s.ID,
+		StartsAt: timestamppb.New(s.StartsAt),
+		EndsAt:   timestamppb.New(s.EndsAt),
+		Rewards:  toSeasonRewardsProto(s.Rewards),
+
+		RatingSystem: ratingSystemsToProto[s.RatingSystem.OrDefault()],
+		RewardTiers:  toRewardTiersProto(s.Rewards),
+	}
+}
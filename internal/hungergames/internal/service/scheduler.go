@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+)
+
+// RunSeasonScheduler advances the season schedule every interval until ctx
+// is cancelled. Players are warned endNotice before a season ends.
+func (s *Service) RunSeasonScheduler(ctx context.Context, interval, endNotice time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	l := s.log.With(zap.String("method", "RunSeasonScheduler"))
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.AdvanceSeasons(ctx, now, endNotice); err != nil {
+				l.Error("season scheduling failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// AdvanceSeasons warns players of a season ending within endNotice, closes
+// the active season once its end has passed and opens the next one: the
+// earliest due schedule entry or, with an empty schedule, a rollover of the
+// season that just ended on schedule.
+//
+// Every step is claimed in storage first, so replicas running at the same
+// time close, pay and open each season once.
+func (s *Service) AdvanceSeasons(ctx context.Context, now time.Time, endNotice time.Duration) error {
+	season, err := s.repo.GetActiveSeason(ctx)
+	if err != nil {
+		if isDomainError(err, codes.NotFound) {
+			return s.openNextSeason(ctx, now)
+		}
+		return err
+	}
+
+	if season.NeedsEndNotice(now, endNotice) {
+		s.notifySeasonEnding(ctx, season)
+	}
+	if !season.IsDue(now) {
+		return nil
+	}
+
+	// A failed reset is left for an admin to resume. Once its season is
+	// closed the next tick opens the following one anyway: every reset step
+	// only touches its own season.
+	if _, err := s.startSeasonReset(ctx, season, season.Rewards); err != nil {
+		if errors.Is(err, ierror.ErrResetExists) {
+			return nil
+		}
+		return err
+	}
+	return s.openNextSeason(ctx, now)
+}
+
+// openNextSeason opens the earliest schedule entry once it is due, dropping
+// entries whose window passed unopened. With an empty schedule it rolls the
+// last season over when that season ended on schedule; a season reset by hand
+// is not rolled over.
+func (s *Service) openNextSeason(ctx context.Context, now time.Time) error {
+	l := s.log.With(zap.String("method", "openNextSeason"))
+
+	for {
+		next, err := s.repo.NextSeasonSchedule(ctx)
+		if err != nil {
+			if !isDomainError(err, codes.NotFound) {
+				return err
+			}
+			break
+		}
+		if !next.IsDue(now) {
+			return nil
+		}
+
+		// Removing the entry claims it: a replica that loses the race sees
+		// NotFound and looks at the next one.
+		if err := s.repo.DeleteSeasonSchedule(ctx, next.ID); err != nil {
+			if isDomainError(err, codes.NotFound) {
+				continue
+			}
+			return err
+		}
+		if next.IsExpired(now) {
+			l.Warn("dropped expired season schedule", zap.String("schedule_id", next.ID))
+			continue
+		}
+		return s.openSeason(ctx, func(number int) *model.Season { return next.Open(number, now) })
+	}
+
+	latest, _, err := s.repo.ListSeasons(ctx, "", 1)
+	if err != nil {
+		return err
+	}
+	if len(latest) == 0 || !latest[0].ClosedOnSchedule() {
+		return nil
+	}
+	return s.openSeason(ctx, func(number int) *model.Season { return latest[0].Rollover(number, now) })
+}
+
+// openSeason creates the season built for the next free number. Losing the
+// number to a concurrent open is not an error.
+func (s *Service) openSeason(ctx context.Context, build func(number int) *model.Season) error {
+	count, err := s.repo.CountSeasons(ctx)
+	if err != nil {
+		return err
+	}
+
+	season := build(count + 1)
+	if _, err := s.repo.CreateSeason(ctx, season); err != nil {
+		if isDomainError(err, codes.AlreadyExists) {
+			return nil
+		}
+		return err
+	}
+	s.log.With(zap.String("method", "openSeason")).Info("season opened",
+		zap.String("season_id", season.ID), zap.Int("number", season.Number))
+	return nil
+}
+
+// notifySeasonEnding broadcasts that the season is about to end, once per
+// season. Failures are logged; the season still ends on time.
+func (s *Service) notifySeasonEnding(ctx context.Context, season *model.Season) {
+	l := s.log.With(zap.String("method", "notifySeasonEnding"), zap.String("season_id", season.ID))
+
+	claimed, err := s.repo.MarkSeasonEndNotified(ctx, season.ID)
+	if err != nil {
+		l.Error("failed to mark season end notice", zap.Error(err))
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	title := fmt.Sprintf("Сезон %d Голодных игр подходит к концу", season.Number)
+	message := fmt.Sprintf("Сезон %d завершится %s (UTC). Успейте улучшить свой рейтинг до подведения итогов и выдачи наград.",
+		season.Number, season.EndsAt.UTC().Format("02.01.2006 15:04"))
+	if err := s.notifier.CreateNotification(ctx, title, message, notificationuc.WithBroadcast()); err != nil {
+		l.Error("failed to send season end notice", zap.Error(err))
+	}
+}
@@ -5,8 +5,16 @@ import "github.com/lasthearth/vsservice/internal/server/interceptor"
 func (s *Service) Scope() map[interceptor.Method]interceptor.Scope {
 	srvName := "/hungergames.v1.HungerGamesService/"
 	return map[interceptor.Method]interceptor.Scope{
-		interceptor.Method(srvName + "RecordMatch"):  interceptor.Scope("hungergames:match:record"),
-		interceptor.Method(srvName + "ResetSeason"):  interceptor.Scope("hungergames:season:reset"),
-		interceptor.Method(srvName + "CreateSeason"): interceptor.Scope("hungergames:season:create"),
+		interceptor.Method(srvName + "RecordMatch"):          interceptor.Scope("hungergames:match:record"),
+		interceptor.Method(srvName + "ResetSeason"):          interceptor.Scope("hungergames:season:reset"),
+		interceptor.Method(srvName + "CreateSeason"):         interceptor.Scope("hungergames:season:create"),
+		interceptor.Method(srvName + "ScheduleSeason"):       interceptor.Scope("hungergames:season:create"),
+		interceptor.Method(srvName + "CancelSeasonSchedule"): interceptor.Scope("hungergames:season:create"),
+		interceptor.Method(srvName + "GetSeasonReset"):       interceptor.Scope("hungergames:season:reset"),
+		interceptor.Method(srvName + "ListSeasonResets"):     interceptor.Scope("hungergames:season:reset"),
+		interceptor.Method(srvName + "ResumeSeasonReset"):    interceptor.Scope("hungergames:season:reset"),
+		interceptor.Method(srvName + "ListFlaggedMatches"):   interceptor.Scope("hungergames:match:review"),
+		interceptor.Method(srvName + "ClearMatchFlags"):      interceptor.Scope("hungergames:match:review"),
+		interceptor.Method(srvName + "VoidMatch"):            interceptor.Scope("hungergames:match:review"),
 	}
 }
@@ -2,9 +2,11 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"time"
 
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/ierror"
 	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -25,63 +27,32 @@ func (s *Service) ResetSeason(ctx context.Context, req *hgv1.ResetSeasonRequest)
 		return nil, status.Error(codes.Internal, "failed to get active season")
 	}
 
-	allStats, err := s.repo.ListAllPlayerStatsByELO(ctx)
+	rewards := season.Rewards
+	override, err := rewardTiersFromProto(req.GetRewards(), req.GetRewardTiers())
 	if err != nil {
-		l.Error("failed to list all player stats", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to list player stats")
+		return nil, err
 	}
-
-	rewardMap := make(map[int]int64, len(req.GetRewards()))
-	for _, r := range req.GetRewards() {
-		rewardMap[int(r.GetRank())] = r.GetCoins()
-	}
-
-	results := make([]*model.SeasonResult, len(allStats))
-	for i, st := range allStats {
-		rank := i + 1
-		rewardCoins := rewardMap[rank]
-
-		results[i] = &model.SeasonResult{
-			SeasonID:    season.ID,
-			PlayerID:    st.PlayerID,
-			PlayerName:  st.PlayerName,
-			Elo:         st.Elo,
-			Wins:        st.Wins,
-			Kills:       st.Kills,
-			Rank:        rank,
-			RewardCoins: rewardCoins,
-		}
-
-		if rewardCoins > 0 {
-			reason := fmt.Sprintf("Season %d reward, rank %d", season.Number, rank)
-			if err := s.donateUC.Credit(ctx, st.PlayerID, st.PlayerName, rewardCoins, reason); err != nil {
-				l.Error("failed to credit season reward", zap.String("player_id", st.PlayerID), zap.Error(err))
-				// non-fatal: season reset continues even if a reward fails
-			}
+	if len(override) > 0 {
+		if err := model.ValidateRewards(override); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		rewards = override
 	}
 
-	if len(results) > 0 {
-		if err := s.repo.CreateSeasonResults(ctx, results); err != nil {
-			l.Error("failed to save season results", zap.Error(err))
-			return nil, status.Error(codes.Internal, "failed to save season results")
-		}
-	}
-
-	if err := s.repo.CloseSeason(ctx, season.ID); err != nil {
-		l.Error("failed to close season", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to close season")
+	reset, err := s.startSeasonReset(ctx, season, rewards)
+	switch {
+	case errors.Is(err, ierror.ErrResetExists):
+		return nil, status.Error(codes.AlreadyExists, "season reset already started")
+	case err != nil && reset == nil:
+		return nil, status.Error(codes.Internal, "failed to start season reset")
+	case err != nil:
+		return nil, resetFailedError(reset)
 	}
 
-	if err := s.repo.DeleteAllPlayerStats(ctx); err != nil {
-		l.Error("failed to delete player stats", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to reset player stats")
-	}
-
-	return &hgv1.ResetSeasonResponse{}, nil
+	return &hgv1.ResetSeasonResponse{Job: toSeasonResetProto(reset)}, nil
 }
 
-func (s *Service) CreateSeason(ctx context.Context, _ *hgv1.CreateSeasonRequest) (*hgv1.CreateSeasonResponse, error) {
+func (s *Service) CreateSeason(ctx context.Context, req *hgv1.CreateSeasonRequest) (*hgv1.CreateSeasonResponse, error) {
 	l := s.log.With(zap.String("method", "CreateSeason"))
 
 	_, err := s.repo.GetActiveSeason(ctx)
@@ -99,9 +70,28 @@ func (s *Service) CreateSeason(ctx context.Context, _ *hgv1.CreateSeasonRequest)
 		return nil, status.Error(codes.Internal, "failed to create season")
 	}
 
-	season := model.NewSeason(count + 1)
+	ratingSystem, ok := ratingSystemFromProto(req.GetRatingSystem())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "unknown rating system")
+	}
+	season := model.NewSeason(count+1, ratingSystem)
+	var endsAt *time.Time
+	if req.GetEndsAt() != nil {
+		endsAt = lo.ToPtr(req.GetEndsAt().AsTime())
+	}
+	rewards, err := rewardTiersFromProto(req.GetRewards(), req.GetRewardTiers())
+	if err != nil {
+		return nil, err
+	}
+	if err := season.SetSchedule(endsAt, rewards); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	created, err := s.repo.CreateSeason(ctx, season)
 	if err != nil {
+		if isDomainError(err, codes.AlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "active season already exists")
+		}
 		l.Error("failed to create season", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create season")
 	}
@@ -187,6 +177,7 @@ func (s *Service) GetPlayerStats(ctx context.Context, req *hgv1.GetPlayerStatsRe
 				Elo:        int32(st.Elo),
 				Wins:       int32(st.Wins),
 				Kills:      int32(st.Kills),
+				TeamWins:   int32(st.TeamWins),
 			},
 		}, nil
 	}
@@ -213,9 +204,35 @@ func toSeasonProto(s *model.Season) *hgv1.SeasonInfo {
 	if s.EndedAt != nil {
 		info.EndedAt = timestamppb.New(*s.EndedAt)
 	}
+	if s.EndsAt != nil {
+		info.EndsAt = timestamppb.New(*s.EndsAt)
+	}
+	info.Rewards = toSeasonRewardsProto(s.Rewards)
+	info.RewardTiers = toRewardTiersProto(s.Rewards)
+	info.RatingSystem = ratingSystemsToProto[s.RatingSystem.OrDefault()]
 	return info
 }
 
+var ratingSystemsToProto = map[model.RatingSystem]hgv1.RatingSystem{
+	model.RatingELO:       hgv1.RatingSystem_RATING_SYSTEM_ELO,
+	model.RatingGlicko2:   hgv1.RatingSystem_RATING_SYSTEM_GLICKO2,
+	model.RatingOpenSkill: hgv1.RatingSystem_RATING_SYSTEM_OPENSKILL,
+}
+
+// ratingSystemFromProto maps a requested rating system, unspecified meaning
+// ELO. It reports false for a value this build does not know.
+func ratingSystemFromProto(r hgv1.RatingSystem) (model.RatingSystem, bool) {
+	if r == hgv1.RatingSystem_RATING_SYSTEM_UNSPECIFIED {
+		return model.RatingELO, true
+	}
+	for sys, p := range ratingSystemsToProto {
+		if p == r {
+			return sys, true
+		}
+	}
+	return "", false
+}
+
 func toSeasonResultProto(r *model.SeasonResult) *hgv1.SeasonResultEntry {
 	return &hgv1.SeasonResultEntry{
 		PlayerId:    r.PlayerID,
@@ -225,5 +242,7 @@ func toSeasonResultProto(r *model.SeasonResult) *hgv1.SeasonResultEntry {
 		Kills:       int32(r.Kills),
 		Rank:        int32(r.Rank),
 		RewardCoins: r.RewardCoins,
+		TeamWins:    int32(r.TeamWins),
+		Rewards:     toRewardsProto(r.Rewards),
 	}
 }
@@ -0,0 +1,95 @@
+package service
+
+import (
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// matchSide is one side of a match: a team, or a single player in solo.
+type matchSide struct {
+	id    string
+	place int
+	// members are indexes into the reported players.
+	members []int
+}
+
+// groupSides validates the reported results for the mode and groups the
+// players into the sides that are rated, in the order each side was first
+// reported.
+func groupSides(mode model.MatchMode, players []*hgv1.PlayerMatchResult) ([]matchSide, error) {
+	if len(players) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "at least 2 players required")
+	}
+
+	var sides []matchSide
+	byID := make(map[string]int, len(players))
+	seen := make(map[string]bool, len(players))
+	for i, p := range players {
+		if p.GetPlayerId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "player_id required")
+		}
+		if seen[p.GetPlayerId()] {
+			return nil, status.Error(codes.InvalidArgument, "each player may be reported only once")
+		}
+		seen[p.GetPlayerId()] = true
+		if p.GetPlace() < 1 {
+			return nil, status.Error(codes.InvalidArgument, "place must be >= 1")
+		}
+
+		id := p.GetTeamId()
+		switch mode {
+		case model.ModeTeam:
+			if id == "" {
+				return nil, status.Error(codes.InvalidArgument, "team_id required in team matches")
+			}
+		default:
+			if id != "" {
+				return nil, status.Error(codes.InvalidArgument, "team_id is only allowed in team matches")
+			}
+			id = p.GetPlayerId()
+		}
+
+		if at, ok := byID[id]; ok && mode == model.ModeTeam {
+			if sides[at].place != int(p.GetPlace()) {
+				return nil, status.Error(codes.InvalidArgument, "teammates must share their team's place")
+			}
+			sides[at].members = append(sides[at].members, i)
+			continue
+		}
+		byID[id] = len(sides)
+		sides = append(sides, matchSide{id: id, place: int(p.GetPlace()), members: []int{i}})
+	}
+
+	if len(sides) < 2 {
+		return nil, status.Error(codes.InvalidArgument, "at least 2 teams required")
+	}
+	places := make(map[int]bool, len(sides))
+	for _, s := range sides {
+		if places[s.place] {
+			return nil, status.Error(codes.InvalidArgument, "duplicate places not allowed")
+		}
+		places[s.place] = true
+	}
+	return sides, nil
+}
+
+// matchModeFromProto maps a requested match mode, unspecified meaning solo.
+// It reports false for a value this build does not know.
+func matchModeFromProto(m hgv1.MatchMode) (model.MatchMode, bool) {
+	switch m {
+	case hgv1.MatchMode_MATCH_MODE_UNSPECIFIED, hgv1.MatchMode_MATCH_MODE_SOLO:
+		return model.ModeSolo, true
+	case hgv1.MatchMode_MATCH_MODE_TEAM:
+		return model.ModeTeam, true
+	}
+	return "", false
+}
+
+func toMatchModeProto(m model.MatchMode) hgv1.MatchMode {
+	if m.OrDefault() == model.ModeTeam {
+		return hgv1.MatchMode_MATCH_MODE_TEAM
+	}
+	return hgv1.MatchMode_MATCH_MODE_SOLO
+}
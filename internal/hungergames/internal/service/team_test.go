@@ -0,0 +1,77 @@
+package service
+
+import (
+	"testing"
+
+	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
+	"github.com/lasthearth/vsservice/internal/hungergames/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func result(playerID, teamID string, place int32) *hgv1.PlayerMatchResult {
+	return &hgv1.PlayerMatchResult{PlayerId: playerID, PlayerName: playerID, TeamId: teamID, Place: place}
+}
+
+func TestGroupSides_Solo(t *testing.T) {
+	sides, err := groupSides(model.ModeSolo, []*hgv1.PlayerMatchResult{
+		result("a", "", 2),
+		result("b", "", 1),
+		result("c", "", 3),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sides) != 3 || sides[1].id != "b" || sides[1].place != 1 || len(sides[1].members) != 1 {
+		t.Errorf("sides = %+v, want one side per player", sides)
+	}
+}
+
+func TestGroupSides_Team(t *testing.T) {
+	sides, err := groupSides(model.ModeTeam, []*hgv1.PlayerMatchResult{
+		result("a", "red", 1),
+		result("b", "blue", 2),
+		result("c", "red", 1),
+		result("d", "blue", 2),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sides) != 2 {
+		t.Fatalf("got %d sides, want 2", len(sides))
+	}
+	if sides[0].id != "red" || sides[0].place != 1 || len(sides[0].members) != 2 || sides[0].members[1] != 2 {
+		t.Errorf("red side = %+v, want players 0 and 2 in place 1", sides[0])
+	}
+}
+
+func TestGroupSides_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    model.MatchMode
+		players []*hgv1.PlayerMatchResult
+	}{
+		{"single player", model.ModeSolo, []*hgv1.PlayerMatchResult{result("a", "", 1)}},
+		{"missing player id", model.ModeSolo, []*hgv1.PlayerMatchResult{result("", "", 1), result("b", "", 2)}},
+		{"player reported twice", model.ModeSolo, []*hgv1.PlayerMatchResult{result("a", "", 1), result("a", "", 2)}},
+		{"player on two teams", model.ModeTeam, []*hgv1.PlayerMatchResult{result("a", "red", 1), result("a", "blue", 2)}},
+		{"place below 1", model.ModeSolo, []*hgv1.PlayerMatchResult{result("a", "", 0), result("b", "", 1)}},
+		{"duplicate solo places", model.ModeSolo, []*hgv1.PlayerMatchResult{result("a", "", 1), result("b", "", 1)}},
+		{"team id in solo", model.ModeSolo, []*hgv1.PlayerMatchResult{result("a", "red", 1), result("b", "", 2)}},
+		{"missing team id", model.ModeTeam, []*hgv1.PlayerMatchResult{result("a", "red", 1), result("b", "", 2)}},
+		{"one team", model.ModeTeam, []*hgv1.PlayerMatchResult{result("a", "red", 1), result("b", "red", 1)}},
+		{"teammates split", model.ModeTeam, []*hgv1.PlayerMatchResult{
+			result("a", "red", 1), result("b", "red", 2), result("c", "blue", 3),
+		}},
+		{"teams share a place", model.ModeTeam, []*hgv1.PlayerMatchResult{result("a", "red", 1), result("b", "blue", 1)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := groupSides(tc.mode, tc.players)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("err = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
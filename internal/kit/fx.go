@@ -5,6 +5,7 @@ import (
 	repofx "github.com/lasthearth/vsservice/internal/kit/internal/repository/app"
 	"github.com/lasthearth/vsservice/internal/kit/internal/service"
 	"github.com/lasthearth/vsservice/internal/kit/internal/service/sermapper"
+	"github.com/lasthearth/vsservice/internal/kit/kituc"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/server/interceptor"
 	"go.uber.org/fx"
@@ -35,6 +36,9 @@ var App = fx.Options(
 			service.NewEventManager,
 		),
 
+		// Kit grants for other domains, such as season rewards.
+		fx.Provide(kituc.NewAssignUseCase),
+
 		fx.Provide(
 			fx.Annotate(service.NewFx,
 				fx.As(new(kitv1.KitServiceServer)),
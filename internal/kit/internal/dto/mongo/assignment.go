@@ -17,4 +17,5 @@ type Assignment struct {
 	DeliveredAt  *time.Time `bson:"delivered_at,omitempty"`
 	ClaimedAt    *time.Time `bson:"claimed_at,omitempty"`
 	AssignedBy   string     `bson:"assigned_by"`
+	OpKey        string     `bson:"op_key,omitempty"`
 }
@@ -3,6 +3,7 @@ package ierror
 import "github.com/lasthearth/vsservice/internal/pkg/ierror"
 
 var (
-	ErrEmptyUid = ierror.InvalidArgument("user uid is empty")
-	ErrNotFound = ierror.NotFound("kit not found")
+	ErrEmptyUid   = ierror.InvalidArgument("user uid is empty")
+	ErrNotFound   = ierror.NotFound("kit not found")
+	ErrOpKeyTaken = ierror.AlreadyExists("kit already assigned for this operation")
 )
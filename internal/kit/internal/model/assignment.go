@@ -17,6 +17,10 @@ type KitAssignment struct {
 	DeliveredAt  *time.Time
 	ClaimedAt    *time.Time
 	AssignedBy   string
+	// OpKey identifies the grant that created the assignment, so a retried
+	// grant finds it instead of assigning the kit twice; empty for kits
+	// assigned by hand.
+	OpKey string
 }
 
 func NewKitAssignment(userId, userGameName, kitName, assignedBy string) *KitAssignment {
@@ -30,6 +34,12 @@ func NewKitAssignment(userId, userGameName, kitName, assignedBy string) *KitAssi
 	}
 }
 
+// WithOpKey ties the assignment to the grant opKey and returns it.
+func (ka *KitAssignment) WithOpKey(opKey string) *KitAssignment {
+	ka.OpKey = opKey
+	return ka
+}
+
 // AssignID records the persisted identity.
 func (ka *KitAssignment) AssignID(id string) { ka.Id = id }
 
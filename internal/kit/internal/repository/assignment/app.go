@@ -1,9 +1,15 @@
 package assignment
 
 import (
+	"context"
+	"time"
+
 	"github.com/lasthearth/vsservice/internal/kit/internal/service"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
 )
 
 var _ service.AssignmentRepository = (*Repository)(nil)
@@ -26,9 +32,25 @@ func New(
 	mapper Mapper,
 ) *Repository {
 	l := log.WithComponent("assignment-repository")
+	setupIndexes(l, coll)
 	return &Repository{
 		coll:   coll,
 		log:    l,
 		mapper: mapper,
 	}
 }
+
+func setupIndexes(log logger.Logger, coll *mongo.Collection) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// One assignment per idempotent grant
+	_, err := coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "op_key", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"op_key": bson.M{"$exists": true}}),
+	})
+	if err != nil {
+		log.Error("failed to create index", zap.String("collection", coll.Name()), zap.Error(err))
+	}
+}
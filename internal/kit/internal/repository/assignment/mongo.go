@@ -28,6 +28,10 @@ func (r *Repository) CreateAssignment(ctx context.Context, assignment *model.Kit
 
 	result, err := r.coll.InsertOne(ctx, dtoObj)
 	if err != nil {
+		if dtoObj.OpKey != "" && mongo.IsDuplicateKeyError(err) {
+			l.Info("assignment for op key already exists", zap.String("op_key", dtoObj.OpKey))
+			return nil, ierror.ErrOpKeyTaken
+		}
 		l.Error("failed to insert assignment", zap.Error(err))
 		return nil, err
 	}
@@ -69,6 +73,27 @@ func (r *Repository) GetAssignment(ctx context.Context, assignmentID string) (*m
 	return &assignment, nil
 }
 
+// GetAssignmentByOpKey retrieves the assignment created for an idempotent grant
+func (r *Repository) GetAssignmentByOpKey(ctx context.Context, opKey string) (*model.KitAssignment, error) {
+	l := r.log.With(
+		zap.String("method", "GetAssignmentByOpKey"),
+		zap.String("op_key", opKey),
+	)
+
+	var dtoObj dto.Assignment
+	err := r.coll.FindOne(ctx, bson.M{"op_key": opKey}).Decode(&dtoObj)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ierror.ErrNotFound
+		}
+		l.Error("failed to find assignment", zap.Error(err))
+		return nil, err
+	}
+
+	assignment := r.mapper.ToAssignment(dtoObj)
+	return &assignment, nil
+}
+
 // UpdateAssignment updates an assignment using the provided function
 func (r *Repository) UpdateAssignment(
 	ctx context.Context,
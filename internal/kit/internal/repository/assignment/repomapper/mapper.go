@@ -22,6 +22,7 @@ func (c *MapperImpl) FromAssignment(source model.KitAssignment) mongo.Assignment
 	mongoAssignment.DeliveredAt = c.pTimeTimeToPTimeTime(source.DeliveredAt)
 	mongoAssignment.ClaimedAt = c.pTimeTimeToPTimeTime(source.ClaimedAt)
 	mongoAssignment.AssignedBy = source.AssignedBy
+	mongoAssignment.OpKey = source.OpKey
 	return mongoAssignment
 }
 func (c *MapperImpl) FromAssignments(source []model.KitAssignment) []mongo.Assignment {
@@ -45,6 +46,7 @@ func (c *MapperImpl) ToAssignment(source mongo.Assignment) model.KitAssignment {
 	modelKitAssignment.DeliveredAt = c.pTimeTimeToPTimeTime(source.DeliveredAt)
 	modelKitAssignment.ClaimedAt = c.pTimeTimeToPTimeTime(source.ClaimedAt)
 	modelKitAssignment.AssignedBy = source.AssignedBy
+	modelKitAssignment.OpKey = source.OpKey
 	return modelKitAssignment
 }
 func (c *MapperImpl) ToAssignments(source []mongo.Assignment) []model.KitAssignment {
@@ -51,3 +51,14 @@ func (b *Bus) onKitReceived(ctx context.Context, data KitClaimedEvent) error {
 
 	return nil
 }
+
+// PublishKitGranted tells the game server that the assignment's kit is
+// waiting for the player.
+func (b *Bus) PublishKitGranted(ctx context.Context, assignment *model.KitAssignment) error {
+	return b.kitGrantedPub.Publish(ctx, KitGrantedEvent{
+		AssignmentID: assignment.Id,
+		KitName:      assignment.KitName,
+		UserGameName: assignment.UserGameName,
+		UserID:       assignment.UserId,
+	})
+}
@@ -18,8 +18,11 @@ type KitRepository interface {
 }
 
 type AssignmentRepository interface {
+	// CreateAssignment returns ierror.ErrOpKeyTaken when an assignment with
+	// the same non-empty OpKey exists.
 	CreateAssignment(ctx context.Context, assignment *model.KitAssignment) (*model.KitAssignment, error)
 	GetAssignment(ctx context.Context, assignmentID string) (*model.KitAssignment, error)
+	GetAssignmentByOpKey(ctx context.Context, opKey string) (*model.KitAssignment, error)
 	UpdateAssignment(
 		ctx context.Context,
 		assignmentID string,
@@ -0,0 +1,74 @@
+package kituc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/kit/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/kit/internal/model"
+	"github.com/lasthearth/vsservice/internal/kit/internal/service"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ErrMissingOpKey is returned when AssignOnce is called without an op key.
+var ErrMissingOpKey = errors.New("op key is required")
+
+// AssignUseCase lets other domains hand out kits, such as season rewards.
+type AssignUseCase struct {
+	assignments service.AssignmentRepository
+	bus         *service.Bus
+	log         logger.Logger
+}
+
+func NewAssignUseCase(assignments service.AssignmentRepository, bus *service.Bus, log logger.Logger) *AssignUseCase {
+	return &AssignUseCase{
+		assignments: assignments,
+		bus:         bus,
+		log:         log.WithComponent("kit-assign-usecase"),
+	}
+}
+
+// AssignOnce assigns kitName to the player on behalf of assignedBy, at most
+// once per opKey, and returns the assignment id — the first assignment's when
+// opKey was already used. opKey must be deterministic for the grant so a
+// retry after a crash reuses it. The game server is notified only when the
+// kit is newly assigned.
+func (uc *AssignUseCase) AssignOnce(ctx context.Context, userID, userGameName, kitName, assignedBy, opKey string) (string, error) {
+	if opKey == "" {
+		return "", ErrMissingOpKey
+	}
+	l := uc.log.With(zap.String("method", "AssignOnce"), zap.String("user_id", userID), zap.String("op_key", opKey))
+
+	existing, err := uc.assignments.GetAssignmentByOpKey(ctx, opKey)
+	if err == nil {
+		return existing.Id, nil
+	}
+	if !errors.Is(err, ierror.ErrNotFound) {
+		return "", err
+	}
+
+	assignment := model.NewKitAssignment(userID, userGameName, kitName, assignedBy).WithOpKey(opKey)
+	if err := assignment.Validate(time.Now()); err != nil {
+		return "", err
+	}
+
+	created, err := uc.assignments.CreateAssignment(ctx, assignment)
+	if err != nil {
+		if !errors.Is(err, ierror.ErrOpKeyTaken) {
+			return "", err
+		}
+		// A concurrent grant with the same key won the insert.
+		existing, err := uc.assignments.GetAssignmentByOpKey(ctx, opKey)
+		if err != nil {
+			return "", err
+		}
+		return existing.Id, nil
+	}
+
+	if err := uc.bus.PublishKitGranted(ctx, created); err != nil {
+		l.Error("failed to publish kit granted event", zap.Error(err))
+	}
+	return created.Id, nil
+}
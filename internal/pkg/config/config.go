@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -60,6 +61,65 @@ type Config struct {
 	// ReferralRefereeCoinsReward is the number of donate-coins awarded to the
 	// player who applied a referral code (the referee).
 	ReferralRefereeCoinsReward int64 `envconfig:"REFERRAL_REFEREE_COINS_REWARD" default:"50"`
+
+	// SettlementTreasuryRecoveryInterval is how often treasury deposits and
+	// payouts left pending by a failed request are finished.
+	SettlementTreasuryRecoveryInterval time.Duration `envconfig:"SETTLEMENT_TREASURY_RECOVERY_INTERVAL" default:"1m"`
+
+	// SettlementInactiveAfter is how long no member of a settlement may be
+	// online before it is flagged inactive and its leader warned.
+	SettlementInactiveAfter time.Duration `envconfig:"SETTLEMENT_INACTIVE_AFTER" default:"720h"`
+	// SettlementInactiveGrace is how long a flagged settlement has before it decays.
+	SettlementInactiveGrace time.Duration `envconfig:"SETTLEMENT_INACTIVE_GRACE" default:"168h"`
+	// SettlementDecayAction is "downgrade" (one tier per grace period, a camp
+	// is hidden) or "hide" (hidden from List straight away).
+	SettlementDecayAction string `envconfig:"SETTLEMENT_DECAY_ACTION" default:"downgrade"`
+	// SettlementActivityInterval is how often the inactivity sweep runs.
+	SettlementActivityInterval time.Duration `envconfig:"SETTLEMENT_ACTIVITY_INTERVAL" default:"1h"`
+	// SettlementFavorRecoveryInterval is how often half-applied favor
+	// transfers are finished and pending favor logs flushed.
+	SettlementFavorRecoveryInterval time.Duration `envconfig:"SETTLEMENT_FAVOR_RECOVERY_INTERVAL" default:"1m"`
+	// SettlementMemberCaps is the maximum number of members, leader included,
+	// per settlement type. A type without an entry is unlimited.
+	SettlementMemberCaps map[string]int `envconfig:"SETTLEMENT_MEMBER_CAPS" default:"camp:5,village:10,township:20,city:40,province:80"`
+	// SettlementBoardPostLimit is how many posts one author may publish on a
+	// settlement board per SettlementBoardPostWindow; 0 disables the limit.
+	SettlementBoardPostLimit  int           `envconfig:"SETTLEMENT_BOARD_POST_LIMIT" default:"5"`
+	SettlementBoardPostWindow time.Duration `envconfig:"SETTLEMENT_BOARD_POST_WINDOW" default:"1h"`
+	// ImperialPointAccrualInterval is how often controlled imperial points
+	// pay their favor income to the settlements holding them.
+	ImperialPointAccrualInterval time.Duration `envconfig:"IMPERIAL_POINT_ACCRUAL_INTERVAL" default:"5m"`
+	// TalentRespecRefundPercent is the share of a respecced node's cost
+	// refunded as imperial favor.
+	TalentRespecRefundPercent int `envconfig:"TALENT_RESPEC_REFUND_PERCENT" default:"50"`
+	// TalentRespecCooldown is the minimum time between two respecs of one
+	// settlement tree.
+	TalentRespecCooldown time.Duration `envconfig:"TALENT_RESPEC_COOLDOWN" default:"24h"`
+	// ImperialPointSiegeWindow is how long a point stays contested after an
+	// attack is declared; the capture must complete within it.
+	ImperialPointSiegeWindow time.Duration `envconfig:"IMPERIAL_POINT_SIEGE_WINDOW" default:"30m"`
+	// HungerGamesSeasonInterval is how often the season scheduler closes due
+	// seasons and opens the next ones.
+	HungerGamesSeasonInterval time.Duration `envconfig:"HUNGER_GAMES_SEASON_INTERVAL" default:"1m"`
+	// HungerGamesSeasonEndNotice is how long before a scheduled season end
+	// players are notified.
+	HungerGamesSeasonEndNotice time.Duration `envconfig:"HUNGER_GAMES_SEASON_END_NOTICE" default:"24h"`
+	// HungerGamesAbuseWindow is how far back a recorded match is compared
+	// with earlier matches of its players for rating farming.
+	HungerGamesAbuseWindow time.Duration `envconfig:"HUNGER_GAMES_ABUSE_WINDOW" default:"24h"`
+	// HungerGamesAbuseRepeatedGroup is how many matches of the same small
+	// group within the window get flagged; 0 disables the check.
+	HungerGamesAbuseRepeatedGroup int `envconfig:"HUNGER_GAMES_ABUSE_REPEATED_GROUP" default:"3"`
+	// HungerGamesAbuseMinDuration flags matches that ended sooner; 0
+	// disables the check.
+	HungerGamesAbuseMinDuration time.Duration `envconfig:"HUNGER_GAMES_ABUSE_MIN_DURATION" default:"2m"`
+	// HungerGamesAbuseWinStreak is how many small matches in a row one
+	// player may beat the same opponent before being flagged; 0 disables
+	// the check.
+	HungerGamesAbuseWinStreak int `envconfig:"HUNGER_GAMES_ABUSE_WIN_STREAK" default:"5"`
+	// HungerGamesAbuseAutoFreeze freezes the ratings of a flagged match's
+	// players until an admin reviews it.
+	HungerGamesAbuseAutoFreeze bool `envconfig:"HUNGER_GAMES_ABUSE_AUTO_FREEZE" default:"false"`
 }
 
 // New initializes from .env and returns a new Config instance.
@@ -2,10 +2,15 @@ package messaging
 
 import (
 	"context"
+	"errors"
 )
 
 const DefaultWorkerGroup = "workers"
 
+// ErrInvalidEvent marks a handler error caused by the event itself rather
+// than by the handler's dependencies; redelivering such an event cannot help.
+var ErrInvalidEvent = errors.New("invalid event")
+
 type Publisher[T any] interface {
 	Publish(ctx context.Context, event T) error
 }
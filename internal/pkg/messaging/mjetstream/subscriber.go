@@ -3,14 +3,22 @@ package mjetstream
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
 	"go.uber.org/zap"
 )
 
+// Headers set on a dead-lettered message.
+const (
+	DeadLetterSubjectHeader = "Dead-Letter-Subject"
+	DeadLetterReasonHeader  = "Dead-Letter-Reason"
+)
+
 type Subscriber[T any] struct {
 	js       jetstream.JetStream
 	stream   string
@@ -22,16 +30,47 @@ type Subscriber[T any] struct {
 	log      logger.Logger
 	timeout  time.Duration
 	cancel   context.CancelFunc
+
+	deadLetter string
+}
+
+type SubscriberOption func(*subscriberOptions)
+
+type subscriberOptions struct {
+	deadLetterStream  string
+	deadLetterSubject string
+}
+
+// WithDeadLetter moves events that cannot be decoded, or whose handler fails
+// with messaging.ErrInvalidEvent, to subject in stream instead of
+// redelivering them. The original payload is kept as is; the headers record
+// where it came from and why it was rejected.
+func WithDeadLetter(stream, subject string) SubscriberOption {
+	return func(o *subscriberOptions) {
+		o.deadLetterStream = stream
+		o.deadLetterSubject = subject
+	}
 }
 
 func NewSubscriber[T any](
 	js jetstream.JetStream,
 	stream, subject, consumer, group string,
 	log logger.Logger,
+	opts ...SubscriberOption,
 ) (messaging.Subscriber[T], error) {
+	var o subscriberOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	if err := ensureStream(js, stream, []string{subject}); err != nil {
 		return nil, err
 	}
+	if o.deadLetterSubject != "" {
+		if err := ensureStream(js, o.deadLetterStream, []string{o.deadLetterSubject}); err != nil {
+			return nil, err
+		}
+	}
 	cons, err := ensureConsumer(js, stream, consumer, subject, group)
 	if err != nil {
 		return nil, err
@@ -46,6 +85,8 @@ func NewSubscriber[T any](
 		group:    group,
 		log:      log,
 		timeout:  30 * time.Second,
+
+		deadLetter: o.deadLetterSubject,
 	}, nil
 }
 
@@ -59,6 +100,10 @@ func (s *Subscriber[T]) Subscribe(handler func(ctx context.Context, event T) err
 			if s.log != nil {
 				s.log.Error("unmarshal failed", zap.Error(err))
 			}
+			if s.deadLetter != "" {
+				s.reject(ctx, msg, err)
+				return
+			}
 			if nakErr := msg.Nak(); nakErr != nil && s.log != nil {
 				s.log.Error("msg nak failed", zap.Error(nakErr))
 			}
@@ -69,6 +114,10 @@ func (s *Subscriber[T]) Subscribe(handler func(ctx context.Context, event T) err
 			if s.log != nil {
 				s.log.Error("handler failed", zap.Error(err))
 			}
+			if s.deadLetter != "" && errors.Is(err, messaging.ErrInvalidEvent) {
+				s.reject(ctx, msg, err)
+				return
+			}
 			if nakErr := msg.Nak(); nakErr != nil && s.log != nil {
 				s.log.Error("msg nak failed", zap.Error(nakErr))
 			}
@@ -87,6 +136,32 @@ func (s *Subscriber[T]) Subscribe(handler func(ctx context.Context, event T) err
 	return nil
 }
 
+// reject publishes msg to the dead-letter subject and terminates it. If the
+// publish fails the message is redelivered so it is not lost.
+func (s *Subscriber[T]) reject(ctx context.Context, msg jetstream.Msg, reason error) {
+	dead := nats.NewMsg(s.deadLetter)
+	dead.Data = msg.Data()
+	dead.Header.Set(DeadLetterSubjectHeader, msg.Subject())
+	dead.Header.Set(DeadLetterReasonHeader, reason.Error())
+
+	if _, err := s.js.PublishMsg(ctx, dead); err != nil {
+		if s.log != nil {
+			s.log.Error("dead letter publish failed", zap.Error(err), zap.String("subject", s.deadLetter))
+		}
+		if nakErr := msg.Nak(); nakErr != nil && s.log != nil {
+			s.log.Error("msg nak failed", zap.Error(nakErr))
+		}
+		return
+	}
+
+	if s.log != nil {
+		s.log.Warn("event dead-lettered", zap.String("subject", msg.Subject()), zap.Error(reason))
+	}
+	if termErr := msg.Term(); termErr != nil && s.log != nil {
+		s.log.Error("msg term failed", zap.Error(termErr))
+	}
+}
+
 func (s *Subscriber[T]) Unsubscribe() error {
 	if s.cancel != nil {
 		s.cancel()
@@ -9,6 +9,7 @@ import (
 	"github.com/lasthearth/vsservice/internal/player/internal/repository/player/sso"
 	service "github.com/lasthearth/vsservice/internal/player/internal/service/player"
 	"github.com/lasthearth/vsservice/internal/player/internal/service/player/sermapper"
+	"github.com/lasthearth/vsservice/internal/player/playeruc"
 	"go.uber.org/fx"
 )
 
@@ -40,6 +41,7 @@ var App = fx.Options(
 			fx.Annotate(
 				repository.New,
 				fx.As(new(service.DbRepository)),
+				fx.As(new(playeruc.ActivityRepo)),
 			),
 
 			fx.Annotate(
@@ -55,6 +57,10 @@ var App = fx.Options(
 			),
 		),
 
+		fx.Provide(
+			playeruc.NewActivityUseCase,
+		),
+
 		fx.Provide(
 			fx.Annotate(service.New,
 				fx.As(new(userv1.UserServiceServer)),
@@ -5,6 +5,7 @@ import (
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/player/internal/event"
 	service "github.com/lasthearth/vsservice/internal/player/internal/service/player"
+	"github.com/lasthearth/vsservice/internal/player/playeruc"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/fx"
 )
@@ -14,6 +15,7 @@ const collName = "verification_requests"
 var (
 	_ service.DbRepository   = (*Repository)(nil)
 	_ event.PlayerRepository = (*Repository)(nil)
+	_ playeruc.ActivityRepo  = (*Repository)(nil)
 )
 
 type Opts struct {
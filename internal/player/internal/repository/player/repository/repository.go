@@ -11,6 +11,7 @@ import (
 	"github.com/lasthearth/vsservice/internal/player/internal/ierror"
 	"github.com/lasthearth/vsservice/internal/player/internal/model"
 	"github.com/lasthearth/vsservice/internal/player/internal/model/verification"
+	"github.com/lasthearth/vsservice/internal/player/playeruc"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
@@ -88,6 +89,30 @@ func (r *Repository) GetUsersByIds(ctx context.Context, ids []string) ([]model.P
 	return users, nil
 }
 
+// ListActivity implements playeruc.ActivityRepo.
+func (r *Repository) ListActivity(ctx context.Context, userIDs []string) ([]playeruc.Activity, error) {
+	cursor, err := r.coll.Find(ctx, bson.M{"user_id": bson.M{"$in": userIDs}})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = cursor.Close(ctx) }()
+
+	var dtos []dto.Player
+	if err := cursor.All(ctx, &dtos); err != nil {
+		return nil, err
+	}
+
+	activity := make([]playeruc.Activity, len(dtos))
+	for i, d := range dtos {
+		activity[i] = playeruc.Activity{
+			UserID:     d.UserId,
+			IsOnline:   d.IsOnline,
+			LastOnline: d.Stats.LastOnline,
+		}
+	}
+	return activity, nil
+}
+
 // SearchUsers searches for players based on a query string, looking for matches in user_game_name and user_name fields.
 func (r *Repository) SearchUsers(
 	ctx context.Context,
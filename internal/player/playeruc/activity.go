@@ -0,0 +1,62 @@
+package playeruc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// Activity is what other domains may know about when a player was last around.
+type Activity struct {
+	UserID     string
+	IsOnline   bool
+	LastOnline time.Time
+}
+
+// ActivityRepo is the player-side read port for online activity. Primitive
+// typed so the player model never crosses into other domains. Bound to the
+// player Mongo repository in internal/player/internal/app/playerfx.
+type ActivityRepo interface {
+	// ListActivity returns the activity of the players with the given user ids.
+	// Ids without a player are omitted.
+	ListActivity(ctx context.Context, userIDs []string) ([]Activity, error)
+}
+
+type ActivityOpts struct {
+	fx.In
+	Repo ActivityRepo
+}
+
+type ActivityUseCase struct {
+	repo ActivityRepo
+}
+
+func NewActivityUseCase(opts ActivityOpts) *ActivityUseCase {
+	return &ActivityUseCase{repo: opts.Repo}
+}
+
+// LastSeen returns the latest moment any of userIDs was around: now when one of
+// them is online, otherwise the most recent LastOnline. Zero when none of them
+// has ever been seen.
+func (uc *ActivityUseCase) LastSeen(ctx context.Context, userIDs []string, now time.Time) (time.Time, error) {
+	if len(userIDs) == 0 {
+		return time.Time{}, nil
+	}
+
+	activity, err := uc.repo.ListActivity(ctx, userIDs)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var last time.Time
+	for _, a := range activity {
+		if a.IsOnline {
+			return now, nil
+		}
+		if a.LastOnline.After(last) {
+			last = a.LastOnline
+		}
+	}
+	return last, nil
+}
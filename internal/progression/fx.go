@@ -1,13 +1,23 @@
 package progression
 
 import (
+	"context"
+
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
 	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging/mnats"
+	"github.com/lasthearth/vsservice/internal/progression/internal/event"
 	"github.com/lasthearth/vsservice/internal/progression/internal/repository"
 	"github.com/lasthearth/vsservice/internal/progression/internal/service"
+	"github.com/lasthearth/vsservice/internal/progression/progressionuc"
 	"github.com/lasthearth/vsservice/internal/server/interceptor"
 	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"github.com/nats-io/nats.go"
 	"go.uber.org/fx"
 )
 
@@ -27,12 +37,24 @@ var App = fx.Options(
 			fx.Annotate(
 				repository.New,
 				fx.As(new(service.ProgressionRepository)),
+				fx.As(new(progressionuc.NodesRepo)),
 			),
 			fx.Annotate(
 				func(f *settlementuc.FavorOps) service.FavorDeductor { return f },
 			),
+			func(f *settlementuc.FavorOps) service.FavorCreditor { return f },
+			func(m *settlementuc.Members) service.SettlementMembers { return m },
+			func(uc *notificationuc.Create) service.Notifier { return uc },
+			func(m *factionuc.Membership) service.Factions { return m },
+			func(t *settlementuc.Types) service.SettlementTypes { return t },
+			func(nc *nats.Conn) messaging.Publisher[service.BonusesChangedEvent] {
+				return mnats.NewEventPublisher[service.BonusesChangedEvent](nc, service.BonusesChangedSubject)
+			},
 		),
 
+		// Read side shared with the settlement domain.
+		fx.Provide(progressionuc.NewNodesUseCase),
+
 		// Single *Service instance shared across all role bindings; it serves
 		// both the progression and the imperial-point gRPC services.
 		fx.Provide(service.New),
@@ -49,5 +71,31 @@ var App = fx.Options(
 				fx.ResultTags(`group:"scopers"`),
 			),
 		),
+
+		fx.Provide(
+			fx.Private,
+			func(s *service.Service) event.CaptureHandler { return s },
+			event.NewEventManagerFx,
+		),
+
+		fx.Invoke(
+			func(lc fx.Lifecycle, bus *event.Bus) {
+				lc.Append(fx.StartStopHook(bus.Subscribe, bus.Unsubscribe))
+			},
+		),
+
+		fx.Invoke(func(lc fx.Lifecycle, svc *service.Service, cfg config.Config) {
+			ctx, cancel := context.WithCancel(context.Background())
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					go svc.RunFavorAccrual(ctx, cfg.ImperialPointAccrualInterval)
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					cancel()
+					return nil
+				},
+			})
+		}),
 	),
 )
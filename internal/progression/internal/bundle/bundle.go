@@ -0,0 +1,206 @@
+// Package bundle is the file format talent trees and presets are exported to
+// and imported from, for moving them between environments.
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the format version written by Encode and the only one Decode
+// accepts. Bump it on any change old files would not survive.
+const Version = 1
+
+// ErrUnsupportedVersion is returned by Decode for a file of another version.
+var ErrUnsupportedVersion = fmt.Errorf("unsupported talent bundle version, want %d", Version)
+
+type Format int
+
+const (
+	FormatJSON Format = iota
+	FormatYAML
+)
+
+// ContentType is the MIME type of files in the format.
+func (f Format) ContentType() string {
+	if f == FormatYAML {
+		return "application/yaml"
+	}
+	return "application/json"
+}
+
+// Bundle is one exported tree, or a preset with every tree it lists. Ids are
+// those of the exporting environment; importing remaps them.
+type Bundle struct {
+	Version int     `json:"version" yaml:"version"`
+	Trees   []Tree  `json:"trees" yaml:"trees"`
+	Preset  *Preset `json:"preset,omitempty" yaml:"preset,omitempty"`
+}
+
+type Tree struct {
+	Id          string `json:"id" yaml:"id"`
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Nodes       []Node `json:"nodes" yaml:"nodes"`
+	Edges       []Edge `json:"edges,omitempty" yaml:"edges,omitempty"`
+}
+
+type Node struct {
+	Id          string   `json:"id" yaml:"id"`
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	CostBi      int64    `json:"cost_bi" yaml:"cost_bi"`
+	Effects     []Effect `json:"effects,omitempty" yaml:"effects,omitempty"`
+}
+
+type Effect struct {
+	Kind  string  `json:"kind" yaml:"kind"`
+	Key   string  `json:"key" yaml:"key"`
+	Op    string  `json:"op,omitempty" yaml:"op,omitempty"`
+	Value float64 `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+type Edge struct {
+	From string `json:"from" yaml:"from"`
+	To   string `json:"to" yaml:"to"`
+}
+
+type Preset struct {
+	Id      string   `json:"id" yaml:"id"`
+	Name    string   `json:"name" yaml:"name"`
+	TreeIds []string `json:"tree_ids" yaml:"tree_ids"`
+}
+
+// New bundles trees and, if not nil, the preset listing them.
+func New(trees []model.TalentTree, preset *model.TalentPreset) Bundle {
+	b := Bundle{Version: Version, Trees: make([]Tree, len(trees))}
+	for i, t := range trees {
+		b.Trees[i] = fromTree(t)
+	}
+	if preset != nil {
+		b.Preset = &Preset{Id: preset.Id, Name: preset.Name, TreeIds: preset.TreeIds}
+	}
+	return b
+}
+
+// Encode writes the bundle in format f.
+func (b Bundle) Encode(f Format) ([]byte, error) {
+	if f == FormatYAML {
+		return yaml.Marshal(b)
+	}
+	return json.MarshalIndent(b, "", "  ")
+}
+
+// Decode reads a bundle from JSON or YAML; JSON being YAML, both go through
+// the YAML decoder. Unknown fields are rejected so typos do not pass
+// silently.
+func Decode(data []byte) (*Bundle, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	var b Bundle
+	if err := dec.Decode(&b); err != nil {
+		return nil, fmt.Errorf("parse talent bundle: %w", err)
+	}
+	if b.Version != Version {
+		return nil, ErrUnsupportedVersion
+	}
+	return &b, nil
+}
+
+// Validate checks that trees have unique ids and names, that the preset
+// lists only trees of the bundle, and that each tree is valid. Fields are
+// located in the file, e.g. "trees[1].edges[0].to".
+func (b *Bundle) Validate() []model.TreeViolation {
+	var out []model.TreeViolation
+	if len(b.Trees) == 0 {
+		out = append(out, model.TreeViolation{Field: "trees", Description: "bundle holds no trees"})
+	}
+
+	ids := make(map[string]int, len(b.Trees))
+	names := make(map[string]int, len(b.Trees))
+	for i, t := range b.Trees {
+		field := fmt.Sprintf("trees[%d]", i)
+		switch {
+		case t.Id == "":
+			out = append(out, model.TreeViolation{Field: field + ".id", Description: "tree id is empty"})
+		default:
+			if first, ok := ids[t.Id]; ok {
+				out = append(out, model.TreeViolation{Field: field + ".id", Description: fmt.Sprintf("tree %q duplicates trees[%d]", t.Id, first)})
+			} else {
+				ids[t.Id] = i
+			}
+		}
+		// Trees are matched to existing ones by name on import.
+		switch {
+		case strings.TrimSpace(t.Name) == "":
+			out = append(out, model.TreeViolation{Field: field + ".name", Description: "tree name is empty"})
+		default:
+			if first, ok := names[t.Name]; ok {
+				out = append(out, model.TreeViolation{Field: field + ".name", Description: fmt.Sprintf("tree name %q duplicates trees[%d]", t.Name, first)})
+			} else {
+				names[t.Name] = i
+			}
+		}
+		tree := t.Model()
+		for _, v := range tree.Validate() {
+			out = append(out, model.TreeViolation{Field: field + "." + v.Field, Description: v.Description})
+		}
+	}
+
+	if b.Preset != nil {
+		if strings.TrimSpace(b.Preset.Name) == "" {
+			out = append(out, model.TreeViolation{Field: "preset.name", Description: "preset name is empty"})
+		}
+		for i, id := range b.Preset.TreeIds {
+			if _, ok := ids[id]; !ok {
+				out = append(out, model.TreeViolation{
+					Field:       fmt.Sprintf("preset.tree_ids[%d]", i),
+					Description: fmt.Sprintf("preset lists tree %q the bundle does not hold", id),
+				})
+			}
+		}
+	}
+	return out
+}
+
+// Model returns the tree as a talent tree with no id.
+func (t Tree) Model() model.TalentTree {
+	var nodes []model.TalentNode
+	for _, n := range t.Nodes {
+		var effects []model.NodeEffect
+		for _, e := range n.Effects {
+			effects = append(effects, model.NodeEffect{
+				Kind:  model.EffectKind(e.Kind),
+				Key:   e.Key,
+				Op:    model.ModifierOp(e.Op),
+				Value: e.Value,
+			})
+		}
+		nodes = append(nodes, model.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effects: effects, CostBi: n.CostBi})
+	}
+	var edges []model.TalentEdge
+	for _, e := range t.Edges {
+		edges = append(edges, model.TalentEdge{From: e.From, To: e.To})
+	}
+	return *model.NewTalentTree(t.Name, t.Description, nodes, edges)
+}
+
+func fromTree(t model.TalentTree) Tree {
+	out := Tree{Id: t.Id, Name: t.Name, Description: t.Description, Nodes: make([]Node, len(t.Nodes))}
+	for i, n := range t.Nodes {
+		node := Node{Id: n.Id, Name: n.Name, Description: n.Description, CostBi: n.CostBi}
+		for _, e := range n.Effects {
+			node.Effects = append(node.Effects, Effect{Kind: string(e.Kind), Key: e.Key, Op: string(e.Op), Value: e.Value})
+		}
+		out.Nodes[i] = node
+	}
+	for _, e := range t.Edges {
+		out.Edges = append(out.Edges, Edge{From: e.From, To: e.To})
+	}
+	return out
+}
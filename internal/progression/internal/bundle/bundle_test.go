@@ -0,0 +1,90 @@
+package bundle
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+)
+
+func testTree() model.TalentTree {
+	return *model.ReconstituteTalentTree("t1", "Оборона", "Укрепления",
+		[]model.TalentNode{
+			{Id: "walls", Name: "Стены", CostBi: 10, Effects: []model.NodeEffect{
+				{Kind: model.EffectKindModifier, Key: model.MemberCapKey, Op: model.ModifierOpAdd, Value: 5},
+			}},
+			{Id: "towers", Name: "Башни", CostBi: 20, Effects: []model.NodeEffect{
+				{Kind: model.EffectKindUnlock, Key: "towers"},
+			}},
+		},
+		[]model.TalentEdge{{From: "walls", To: "towers"}},
+		0,
+	)
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	tree := testTree()
+	preset := model.ReconstituteTalentPreset("p1", "Начальный", []string{"t1"})
+
+	for _, f := range []Format{FormatJSON, FormatYAML} {
+		data, err := New([]model.TalentTree{tree}, preset).Encode(f)
+		if err != nil {
+			t.Fatalf("Encode(%v): %v", f, err)
+		}
+		b, err := Decode(data)
+		if err != nil {
+			t.Fatalf("Decode(%v): %v", f, err)
+		}
+		if v := b.Validate(); len(v) > 0 {
+			t.Fatalf("Validate(%v) = %v, want none", f, v)
+		}
+		got := b.Trees[0].Model()
+		if b.Trees[0].Id != tree.Id || got.Name != tree.Name || !got.SameContent(&tree) {
+			t.Fatalf("decoded tree (%v) = %+v, want %+v", f, got, tree)
+		}
+		if b.Preset == nil || b.Preset.Name != preset.Name || !slices.Equal(b.Preset.TreeIds, preset.TreeIds) {
+			t.Fatalf("decoded preset (%v) = %+v, want %+v", f, b.Preset, preset)
+		}
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{name: "other version", data: "version: 2\ntrees: []\n", wantErr: ErrUnsupportedVersion},
+		{name: "unknown field", data: "version: 1\ntrees:\n  - id: t1\n    name: x\n    cost: 5\n"},
+		{name: "not a bundle", data: "[1, 2]"},
+		{name: "empty", data: ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Decode([]byte(tc.data))
+			if err == nil {
+				t.Fatal("Decode succeeded, want an error")
+			}
+			if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateLocatesViolations(t *testing.T) {
+	tree := testTree()
+	b := New([]model.TalentTree{tree, tree}, model.NewTalentPreset("p", []string{"t1", "missing"}))
+	b.Trees[1].Id = "t2"
+	b.Trees[1].Edges = append(b.Trees[1].Edges, Edge{From: "towers", To: "ghost"})
+
+	var fields []string
+	for _, v := range b.Validate() {
+		fields = append(fields, v.Field)
+	}
+	want := []string{"trees[1].name", "trees[1].edges[1].to", "preset.tree_ids[1]"}
+	if !slices.Equal(fields, want) {
+		t.Fatalf("violations = %v, want %v", fields, want)
+	}
+}
@@ -0,0 +1,19 @@
+package dto
+
+import "github.com/lasthearth/vsservice/internal/pkg/mongox"
+
+type StatBonus struct {
+	Add      float64 `bson:"add"`
+	Multiply float64 `bson:"multiply"`
+}
+
+// Bonuses is the last aggregate of an owner's bonuses that was published.
+type Bonuses struct {
+	mongox.Model `bson:",inline"`
+	OwnerType    string               `bson:"owner_type"`
+	SettlementId string               `bson:"settlement_id"`
+	PointId      string               `bson:"point_id"`
+	Side         string               `bson:"side"`
+	Modifiers    map[string]StatBonus `bson:"modifiers"`
+	Unlocks      []string             `bson:"unlocks"`
+}
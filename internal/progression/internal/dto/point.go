@@ -11,6 +11,33 @@ type PointControl struct {
 	Side            string        `bson:"side"`
 	SettlementId    bson.ObjectID `bson:"settlement_id"`
 	ControlledSince time.Time     `bson:"controlled_since"`
+	AccruedUntil    time.Time     `bson:"accrued_until,omitempty"`
+}
+
+type PointHold struct {
+	SettlementId bson.ObjectID `bson:"settlement_id"`
+	From         time.Time     `bson:"from"`
+	To           time.Time     `bson:"to"`
+}
+
+// ControlPeriod is one stretch of a settlement holding a point; To is nil
+// while it lasts.
+type ControlPeriod struct {
+	mongox.Model `bson:",inline"`
+	PointId      bson.ObjectID `bson:"point_id"`
+	SettlementId bson.ObjectID `bson:"settlement_id"`
+	Side         string        `bson:"side"`
+	From         time.Time     `bson:"from"`
+	To           *time.Time    `bson:"to"`
+}
+
+type FavorAccrual struct {
+	mongox.Model `bson:",inline"`
+	PointId      bson.ObjectID `bson:"point_id"`
+	SettlementId bson.ObjectID `bson:"settlement_id"`
+	From         time.Time     `bson:"from"`
+	To           time.Time     `bson:"to"`
+	Amount       int64         `bson:"amount"`
 }
 
 type ImperialPoint struct {
@@ -20,4 +47,16 @@ type ImperialPoint struct {
 	BiRatePerHour int64         `bson:"bi_rate_per_hour"`
 	TreeId        bson.ObjectID `bson:"tree_id,omitempty"`
 	Control       *PointControl `bson:"control,omitempty"`
+	UnpaidHolds   []PointHold   `bson:"unpaid_holds,omitempty"`
+	Siege         *PointSiege   `bson:"siege,omitempty"`
+}
+
+type PointSiege struct {
+	Id                   string        `bson:"id"`
+	AttackerSettlementId bson.ObjectID `bson:"attacker_settlement_id"`
+	AttackerSide         string        `bson:"attacker_side"`
+	DefenderSettlementId bson.ObjectID `bson:"defender_settlement_id,omitempty"`
+	DeclaredAt           time.Time     `bson:"declared_at"`
+	EndsAt               time.Time     `bson:"ends_at"`
+	Progress             int           `bson:"progress"`
 }
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// PresetAssignment holds either SettlementId or, for a type's default,
+// SettlementType.
+type PresetAssignment struct {
+	mongox.Model   `bson:",inline"`
+	SettlementId   bson.ObjectID `bson:"settlement_id,omitempty"`
+	SettlementType string        `bson:"settlement_type,omitempty"`
+	PresetId       bson.ObjectID `bson:"preset_id"`
+}
@@ -11,6 +11,7 @@ type PurchasedNode struct {
 	NodeId                string    `bson:"node_id"`
 	PurchasedAt           time.Time `bson:"purchased_at"`
 	PurchasedBySettlement string    `bson:"purchased_by_settlement"`
+	PurchaseId            string    `bson:"purchase_id,omitempty"`
 }
 
 type TalentProgress struct {
@@ -21,4 +22,28 @@ type TalentProgress struct {
 	Side           string          `bson:"side,omitempty"`
 	TreeId         bson.ObjectID   `bson:"tree_id"`
 	PurchasedNodes []PurchasedNode `bson:"purchased_nodes"`
+	// TreeVersion is missing on progress stored before versions: version 1.
+	TreeVersion      int               `bson:"tree_version,omitempty"`
+	RespecAt         time.Time         `bson:"respec_at,omitempty"`
+	PendingRefunds   []FavorRefund     `bson:"pending_refunds,omitempty"`
+	PendingPurchases []PendingPurchase `bson:"pending_purchases,omitempty"`
+	// Version is missing until the first guarded save.
+	Version int `bson:"version,omitempty"`
+}
+
+type PendingPurchase struct {
+	Id           string    `bson:"id"`
+	NodeId       string    `bson:"node_id"`
+	CostBi       int64     `bson:"cost_bi"`
+	SettlementId string    `bson:"settlement_id"`
+	OpKey        string    `bson:"op_key"`
+	Reason       string    `bson:"reason"`
+	StartedAt    time.Time `bson:"started_at"`
+}
+
+type FavorRefund struct {
+	SettlementId string `bson:"settlement_id"`
+	Amount       int64  `bson:"amount"`
+	Reason       string `bson:"reason"`
+	OpKey        string `bson:"op_key"`
 }
@@ -1,13 +1,26 @@
 package dto
 
-import "github.com/lasthearth/vsservice/internal/pkg/mongox"
+import (
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type NodeEffect struct {
+	Kind  string  `bson:"kind"`
+	Key   string  `bson:"key"`
+	Op    string  `bson:"op,omitempty"`
+	Value float64 `bson:"value,omitempty"`
+}
 
 type TalentNode struct {
-	Id          string `bson:"id"`
-	Name        string `bson:"name"`
-	Description string `bson:"description"`
-	Effect      string `bson:"effect"`
-	CostBi      int64  `bson:"cost_bi"`
+	Id          string       `bson:"id"`
+	Name        string       `bson:"name"`
+	Description string       `bson:"description"`
+	Effects     []NodeEffect `bson:"effects"`
+	// Effect is the free-text effect of nodes stored before effects were
+	// typed. It is read, never written.
+	Effect string `bson:"effect,omitempty"`
+	CostBi int64  `bson:"cost_bi"`
 }
 
 type TalentEdge struct {
@@ -15,12 +28,27 @@ type TalentEdge struct {
 	To   string `bson:"to"`
 }
 
+// TalentTree is the latest version of a tree. Trees stored before versions
+// have no version field and are version 1.
 type TalentTree struct {
 	mongox.Model `bson:",inline"`
 	Name         string       `bson:"name"`
 	Description  string       `bson:"description"`
 	Nodes        []TalentNode `bson:"nodes"`
 	Edges        []TalentEdge `bson:"edges"`
+	Version      int          `bson:"version,omitempty"`
+}
+
+// TalentTreeVersion is a superseded version of a tree, kept for the progress
+// still pinned to it.
+type TalentTreeVersion struct {
+	mongox.Model `bson:",inline"`
+	TreeId       bson.ObjectID `bson:"tree_id"`
+	Version      int           `bson:"version"`
+	Name         string        `bson:"name"`
+	Description  string        `bson:"description"`
+	Nodes        []TalentNode  `bson:"nodes"`
+	Edges        []TalentEdge  `bson:"edges"`
 }
 
 type TalentPreset struct {
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging/mnats"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/fx"
+)
+
+const captureProgressSubject = "imperialpoint.capture.progress"
+
+// CaptureHandler applies capture progress to a siege.
+// Implemented by service.Service.
+type CaptureHandler interface {
+	CaptureProgress(ctx context.Context, pointId, settlementId string, progress int) error
+}
+
+type Opts struct {
+	fx.In
+	NC      *nats.Conn
+	Log     logger.Logger
+	Handler CaptureHandler
+}
+
+type Bus struct {
+	log             logger.Logger
+	handler         CaptureHandler
+	captureProgress messaging.Subscriber[CaptureProgressEvent]
+}
+
+func NewEventManagerFx(opts Opts) *Bus {
+	return NewEventManager(opts.NC, opts.Log, opts.Handler)
+}
+
+func NewEventManager(
+	nc *nats.Conn,
+	log logger.Logger,
+	handler CaptureHandler,
+) *Bus {
+	captureProgress := mnats.NewEventSubscriber[CaptureProgressEvent](
+		nc,
+		captureProgressSubject,
+		messaging.DefaultWorkerGroup,
+		mnats.WithLogger(log),
+	)
+
+	return &Bus{
+		log:             log.WithComponent("progression-event-bus"),
+		handler:         handler,
+		captureProgress: captureProgress,
+	}
+}
@@ -0,0 +1,10 @@
+package event
+
+// CaptureProgressEvent is reported by the game server while a settlement is
+// capturing a contested imperial point.
+type CaptureProgressEvent struct {
+	PointID      string `json:"point_id"`
+	SettlementID string `json:"settlement_id"`
+	// Progress is the capture progress in percent; 100 completes it.
+	Progress int `json:"progress"`
+}
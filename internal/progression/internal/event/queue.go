@@ -0,0 +1,31 @@
+package event
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+func (b *Bus) Subscribe() {
+	if err := b.captureProgress.Subscribe(b.onCaptureProgress); err != nil {
+		b.log.WithMethod("subscribe").Error(
+			"failed to subscribe to capture progress queue",
+			zap.Error(err),
+		)
+	}
+}
+
+func (b *Bus) Unsubscribe() {
+	if err := b.captureProgress.Unsubscribe(); err != nil {
+		b.log.Error("failed to unsubscribe captureProgress", zap.Error(err))
+	}
+}
+
+func (b *Bus) onCaptureProgress(ctx context.Context, event CaptureProgressEvent) error {
+	b.log.WithMethod("onCaptureProgress").Debug("received capture progress event",
+		zap.String("point_id", event.PointID),
+		zap.String("settlement_id", event.SettlementID),
+		zap.Int("progress", event.Progress),
+	)
+	return b.handler.CaptureProgress(ctx, event.PointID, event.SettlementID, event.Progress)
+}
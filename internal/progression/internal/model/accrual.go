@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// FavorAccrual is one payout of imperial favor to the settlement that held a
+// point from From to To. It is claimed once per (point, settlement, From), so
+// replicas racing over the same stretch agree on what is paid.
+type FavorAccrual struct {
+	Id           string
+	PointId      string
+	SettlementId string
+	From         time.Time
+	To           time.Time
+	Amount       int64
+	CreatedAt    time.Time
+}
+
+// SetId sets the accrual's identifier (used after persistence).
+func (a *FavorAccrual) SetId(id string) {
+	a.Id = id
+}
+
+// OpKey identifies the favor movement that pays the accrual.
+func (a FavorAccrual) OpKey() string {
+	return "accrual:" + a.Id
+}
+
+// AccrueFavor returns the whole favor earned at ratePerHour between from and
+// to, prorated to the millisecond, and the instant that amount pays up to.
+// The fraction of a unit left over is not lost: it is earned again from the
+// returned instant on.
+func AccrueFavor(ratePerHour int64, from, to time.Time) (int64, time.Time) {
+	if ratePerHour <= 0 || !to.After(from) {
+		return 0, from
+	}
+	const hourMs = int64(time.Hour / time.Millisecond)
+	ms := to.Sub(from).Milliseconds()
+	amount := ms * ratePerHour / hourMs
+	if amount == 0 {
+		return 0, from
+	}
+	// Ceiling, so the next stretch never re-earns a fraction already paid.
+	paidMs := (amount*hourMs + ratePerHour - 1) / ratePerHour
+	return amount, from.Add(time.Duration(paidMs) * time.Millisecond)
+}
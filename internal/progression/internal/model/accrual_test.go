@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccrueFavorProrates(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	amount, until := AccrueFavor(60, t0, t0.Add(30*time.Minute))
+	if amount != 30 || !until.Equal(t0.Add(30*time.Minute)) {
+		t.Fatalf("half an hour at 60/h = %d until %v, want 30 until +30m", amount, until)
+	}
+
+	// 10/h over 15 minutes earns 2.5: two are paid, the half carries over.
+	amount, until = AccrueFavor(10, t0, t0.Add(15*time.Minute))
+	if amount != 2 || !until.Equal(t0.Add(12*time.Minute)) {
+		t.Fatalf("15m at 10/h = %d until %v, want 2 until +12m", amount, until)
+	}
+	amount, _ = AccrueFavor(10, until, t0.Add(18*time.Minute))
+	if amount != 1 {
+		t.Fatalf("carried half plus 3m at 10/h = %d, want 1", amount)
+	}
+
+	if amount, until := AccrueFavor(0, t0, t0.Add(time.Hour)); amount != 0 || !until.Equal(t0) {
+		t.Fatalf("zero rate = %d until %v, want nothing", amount, until)
+	}
+}
+
+func TestCloseHoldStartsAfterPaidIncome(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &ImperialPoint{Control: &PointControl{
+		SettlementId:    "s1",
+		ControlledSince: t0,
+		AccruedUntil:    t0.Add(time.Hour),
+	}}
+
+	hold := p.CloseHold(t0.Add(90 * time.Minute))
+	if hold == nil || !hold.From.Equal(t0.Add(time.Hour)) || !hold.To.Equal(t0.Add(90*time.Minute)) {
+		t.Fatalf("hold = %+v, want the unpaid last half hour", hold)
+	}
+	if len(p.UnpaidHolds) != 1 {
+		t.Fatalf("unpaid holds = %d, want 1", len(p.UnpaidHolds))
+	}
+
+	if (&ImperialPoint{}).CloseHold(t0) != nil {
+		t.Fatal("unclaimed point closed a hold")
+	}
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// EffectKind tells what a talent node effect does.
+type EffectKind string
+
+const (
+	// EffectKindModifier changes a numeric stat, see ModifierOp.
+	EffectKindModifier EffectKind = "modifier"
+	// EffectKindUnlock turns a feature, named by its key, on.
+	EffectKindUnlock EffectKind = "unlock"
+)
+
+// ModifierOp is how a modifier effect applies its value to a stat.
+type ModifierOp string
+
+const (
+	// ModifierOpAdd adds the value to the stat.
+	ModifierOpAdd ModifierOp = "add"
+	// ModifierOpMultiply scales the stat by the value.
+	ModifierOpMultiply ModifierOp = "multiply"
+)
+
+// MemberCapKey is the stat whose added value raises the owning settlement's
+// member cap.
+const MemberCapKey = "settlement.member_cap"
+
+// NodeEffect is one typed effect of a talent node. Op and Value are only
+// meaningful for modifiers.
+type NodeEffect struct {
+	Kind  EffectKind
+	Key   string
+	Op    ModifierOp
+	Value float64
+}
+
+// violation describes what is wrong with the effect, "" when it is valid.
+func (e NodeEffect) violation() string {
+	if strings.TrimSpace(e.Key) == "" {
+		return "effect key is empty"
+	}
+	switch e.Kind {
+	case EffectKindUnlock:
+		return ""
+	case EffectKindModifier:
+	default:
+		return "unknown effect kind " + strconv.Quote(string(e.Kind))
+	}
+	switch {
+	case e.Op != ModifierOpAdd && e.Op != ModifierOpMultiply:
+		return "unknown modifier operation " + strconv.Quote(string(e.Op))
+	case math.IsNaN(e.Value) || math.IsInf(e.Value, 0):
+		return "modifier value is not a finite number"
+	case e.Op == ModifierOpMultiply && e.Value < 0:
+		return "multiplier is negative"
+	}
+	return ""
+}
+
+// memberCapEffect prefixes the free-text effect that raised the member cap
+// before effects were typed, e.g. "member_cap+5".
+const memberCapEffect = "member_cap+"
+
+// ParseLegacyEffect converts a free-text effect stored before effects were
+// typed. Only the member cap bonus had a meaning; anything else is dropped.
+func ParseLegacyEffect(effect string) (NodeEffect, bool) {
+	v, ok := strings.CutPrefix(strings.TrimSpace(effect), memberCapEffect)
+	if !ok {
+		return NodeEffect{}, false
+	}
+	bonus, err := strconv.Atoi(v)
+	if err != nil || bonus < 0 {
+		return NodeEffect{}, false
+	}
+	return NodeEffect{Kind: EffectKindModifier, Key: MemberCapKey, Op: ModifierOpAdd, Value: float64(bonus)}, true
+}
+
+// StatBonus is the aggregate of every modifier on one stat: the stat becomes
+// (base + Add) * Multiply.
+type StatBonus struct {
+	Add      float64
+	Multiply float64
+}
+
+// Bonuses is what an owner's purchased nodes add up to.
+type Bonuses struct {
+	Modifiers map[string]StatBonus
+	// Unlocks is sorted and holds each key once.
+	Unlocks []string
+}
+
+// FoldBonuses aggregates effects: additions are summed and multipliers
+// multiplied per stat, unlocks are collected once each.
+func FoldBonuses(effects []NodeEffect) Bonuses {
+	b := Bonuses{Modifiers: map[string]StatBonus{}}
+	for _, e := range effects {
+		switch e.Kind {
+		case EffectKindModifier:
+			sb, ok := b.Modifiers[e.Key]
+			if !ok {
+				sb.Multiply = 1
+			}
+			switch e.Op {
+			case ModifierOpAdd:
+				sb.Add += e.Value
+			case ModifierOpMultiply:
+				sb.Multiply *= e.Value
+			}
+			b.Modifiers[e.Key] = sb
+		case EffectKindUnlock:
+			if !slices.Contains(b.Unlocks, e.Key) {
+				b.Unlocks = append(b.Unlocks, e.Key)
+			}
+		}
+	}
+	slices.Sort(b.Unlocks)
+	return b
+}
+
+// Equal reports whether b and o grant the same bonuses.
+func (b Bonuses) Equal(o Bonuses) bool {
+	if len(b.Modifiers) != len(o.Modifiers) || !slices.Equal(b.Unlocks, o.Unlocks) {
+		return false
+	}
+	for k, v := range b.Modifiers {
+		if w, ok := o.Modifiers[k]; !ok || w != v {
+			return false
+		}
+	}
+	return true
+}
+
+// MemberCap returns the member slots the bonuses add to a settlement.
+func (b Bonuses) MemberCap() int {
+	return max(int(b.Modifiers[MemberCapKey].Add), 0)
+}
+
+// BonusOwner is whoever owns progress and so receives bonuses: a settlement,
+// or one side of an imperial point.
+type BonusOwner struct {
+	Type         OwnerType
+	SettlementId string
+	PointId      string
+	Side         string
+}
+
+// SettlementOwner is the owner of a settlement's own trees.
+func SettlementOwner(settlementId string) BonusOwner {
+	return BonusOwner{Type: OwnerTypeSettlement, SettlementId: settlementId}
+}
+
+// PointSideOwner is the owner of one side's trees on a point.
+func PointSideOwner(pointId, side string) BonusOwner {
+	return BonusOwner{Type: OwnerTypePointSide, PointId: pointId, Side: side}
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestParseLegacyEffect(t *testing.T) {
+	cases := map[string]float64{
+		"member_cap+5":    5,
+		" member_cap+12 ": 12,
+	}
+	for effect, want := range cases {
+		got, ok := ParseLegacyEffect(effect)
+		if !ok || got.Key != MemberCapKey || got.Op != ModifierOpAdd || got.Value != want {
+			t.Errorf("ParseLegacyEffect(%q) = %+v, %v; want member cap +%v", effect, got, ok, want)
+		}
+	}
+	for _, effect := range []string{"member_cap+-3", "member_cap+x", "tax-5%", ""} {
+		if _, ok := ParseLegacyEffect(effect); ok {
+			t.Errorf("ParseLegacyEffect(%q) parsed, want dropped", effect)
+		}
+	}
+}
+
+func TestFoldBonuses(t *testing.T) {
+	mod := func(key string, op ModifierOp, v float64) NodeEffect {
+		return NodeEffect{Kind: EffectKindModifier, Key: key, Op: op, Value: v}
+	}
+	unlock := func(key string) NodeEffect { return NodeEffect{Kind: EffectKindUnlock, Key: key} }
+
+	got := FoldBonuses([]NodeEffect{
+		mod(MemberCapKey, ModifierOpAdd, 5),
+		mod(MemberCapKey, ModifierOpAdd, 3),
+		mod("harvest", ModifierOpMultiply, 1.5),
+		mod("harvest", ModifierOpMultiply, 2),
+		unlock("stables"),
+		unlock("forge"),
+		unlock("stables"),
+	})
+
+	if got.MemberCap() != 8 {
+		t.Errorf("MemberCap() = %d, want 8", got.MemberCap())
+	}
+	if sb := got.Modifiers[MemberCapKey]; sb.Multiply != 1 {
+		t.Errorf("member cap multiplier = %v, want 1", sb.Multiply)
+	}
+	if sb := got.Modifiers["harvest"]; sb.Add != 0 || sb.Multiply != 3 {
+		t.Errorf("harvest = %+v, want add 0 multiply 3", sb)
+	}
+	if !slices.Equal(got.Unlocks, []string{"forge", "stables"}) {
+		t.Errorf("unlocks = %v, want [forge stables]", got.Unlocks)
+	}
+
+	same := FoldBonuses([]NodeEffect{
+		unlock("forge"),
+		mod("harvest", ModifierOpMultiply, 3),
+		mod(MemberCapKey, ModifierOpAdd, 8),
+		unlock("stables"),
+	})
+	if !got.Equal(same) {
+		t.Errorf("Equal = false for %+v and %+v", got, same)
+	}
+	if got.Equal(FoldBonuses(nil)) {
+		t.Error("Equal = true against no bonuses")
+	}
+}
+
+func TestNodeEffectViolation(t *testing.T) {
+	cases := map[string]struct {
+		effect NodeEffect
+		valid  bool
+	}{
+		"unlock":            {NodeEffect{Kind: EffectKindUnlock, Key: "forge"}, true},
+		"add":               {NodeEffect{Kind: EffectKindModifier, Key: "tax", Op: ModifierOpAdd, Value: -5}, true},
+		"empty key":         {NodeEffect{Kind: EffectKindUnlock}, false},
+		"unknown kind":      {NodeEffect{Kind: "buff", Key: "x"}, false},
+		"unknown op":        {NodeEffect{Kind: EffectKindModifier, Key: "x", Op: "pow", Value: 2}, false},
+		"infinite":          {NodeEffect{Kind: EffectKindModifier, Key: "x", Op: ModifierOpAdd, Value: math.Inf(1)}, false},
+		"negative multiply": {NodeEffect{Kind: EffectKindModifier, Key: "x", Op: ModifierOpMultiply, Value: -1}, false},
+	}
+	for name, tc := range cases {
+		if got := tc.effect.violation() == ""; got != tc.valid {
+			t.Errorf("%s: valid = %v, want %v", name, got, tc.valid)
+		}
+	}
+}
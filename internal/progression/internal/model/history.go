@@ -0,0 +1,92 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// ControlPeriod is one stretch of a settlement holding a point, from the
+// control change that gave it the point to the one that took it away.
+type ControlPeriod struct {
+	PointId      string
+	SettlementId string
+	Side         string
+	From         time.Time
+	// To is when the hold ended; zero while it lasts.
+	To time.Time
+}
+
+// Period returns the control as a period of pointId, ended at to, or ongoing
+// if to is zero.
+func (c *PointControl) Period(pointId string, to time.Time) ControlPeriod {
+	return ControlPeriod{
+		PointId:      pointId,
+		SettlementId: c.SettlementId,
+		Side:         c.Side,
+		From:         c.ControlledSince,
+		To:           to,
+	}
+}
+
+// Overlap returns how much of the period falls within [since, until). An
+// ongoing period lasts until until.
+func (p ControlPeriod) Overlap(since, until time.Time) time.Duration {
+	start, end := p.From, p.To
+	if end.IsZero() || end.After(until) {
+		end = until
+	}
+	if start.Before(since) {
+		start = since
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
+
+// HoldTime is how long one settlement or side held points in a period.
+type HoldTime struct {
+	// Key is the settlement id or the side.
+	Key  string
+	Held time.Duration
+	// Points is how many distinct points were held.
+	Points int
+}
+
+// HoldTimes sums how long periods overlap [since, until), per settlement and
+// per side, longest first; ties are ordered by key. Periods outside the range
+// are left out.
+func HoldTimes(periods []ControlPeriod, since, until time.Time) (bySettlement, bySide []HoldTime) {
+	return sumHoldTimes(periods, since, until, func(p ControlPeriod) string { return p.SettlementId }),
+		sumHoldTimes(periods, since, until, func(p ControlPeriod) string { return p.Side })
+}
+
+func sumHoldTimes(periods []ControlPeriod, since, until time.Time, key func(ControlPeriod) string) []HoldTime {
+	held := map[string]time.Duration{}
+	points := map[string]map[string]bool{}
+	for _, p := range periods {
+		d := p.Overlap(since, until)
+		if d == 0 {
+			continue
+		}
+		k := key(p)
+		held[k] += d
+		if points[k] == nil {
+			points[k] = map[string]bool{}
+		}
+		points[k][p.PointId] = true
+	}
+
+	out := make([]HoldTime, 0, len(held))
+	for k, d := range held {
+		out = append(out, HoldTime{Key: k, Held: d, Points: len(points[k])})
+	}
+	slices.SortFunc(out, func(a, b HoldTime) int {
+		if c := cmp.Compare(b.Held, a.Held); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return out
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHoldTimes(t *testing.T) {
+	t0 := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	h := func(n int) time.Time { return t0.Add(time.Duration(n) * time.Hour) }
+	periods := []ControlPeriod{
+		{PointId: "p1", SettlementId: "s1", Side: "east", From: h(-5), To: h(2)}, // clipped to 2h
+		{PointId: "p1", SettlementId: "s2", Side: "west", From: h(2), To: h(5)},  // 3h
+		{PointId: "p2", SettlementId: "s1", Side: "east", From: h(4)},            // ongoing: 6h
+		{PointId: "p3", SettlementId: "s3", Side: "west", From: h(20)},           // after the range
+	}
+
+	bySettlement, bySide := HoldTimes(periods, t0, h(10))
+
+	want := []HoldTime{{Key: "s1", Held: 8 * time.Hour, Points: 2}, {Key: "s2", Held: 3 * time.Hour, Points: 1}}
+	if len(bySettlement) != len(want) || bySettlement[0] != want[0] || bySettlement[1] != want[1] {
+		t.Fatalf("by settlement = %+v, want %+v", bySettlement, want)
+	}
+	wantSide := []HoldTime{{Key: "east", Held: 8 * time.Hour, Points: 2}, {Key: "west", Held: 3 * time.Hour, Points: 1}}
+	if len(bySide) != len(wantSide) || bySide[0] != wantSide[0] || bySide[1] != wantSide[1] {
+		t.Fatalf("by side = %+v, want %+v", bySide, wantSide)
+	}
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// TreeMigration moves progress from one version of a tree to a newer one.
+// Mapping renames old node ids to new ones; an unmapped node keeps its id when
+// the new version still has it.
+type TreeMigration struct {
+	From    *TalentTree
+	To      *TalentTree
+	Mapping map[string]string
+}
+
+// Validate checks that the migration goes forward and that the mapping joins
+// nodes of From to distinct nodes of To.
+func (m TreeMigration) Validate() []TreeViolation {
+	var out []TreeViolation
+	if m.To.Version <= m.From.Version {
+		out = append(out, TreeViolation{"to_version", fmt.Sprintf("version %d is not newer than version %d", m.To.Version, m.From.Version)})
+	}
+
+	targets := make(map[string]string, len(m.Mapping))
+	for _, from := range slices.Sorted(maps.Keys(m.Mapping)) {
+		to := m.Mapping[from]
+		field := fmt.Sprintf("node_mapping[%s]", from)
+		if m.From.Node(from) == nil {
+			out = append(out, TreeViolation{field, fmt.Sprintf("node %q is not in version %d", from, m.From.Version)})
+		}
+		if m.To.Node(to) == nil {
+			out = append(out, TreeViolation{field, fmt.Sprintf("node %q is not in version %d", to, m.To.Version)})
+			continue
+		}
+		if other, taken := targets[to]; taken {
+			out = append(out, TreeViolation{field, fmt.Sprintf("node %q is already the target of node_mapping[%s]", to, other)})
+			continue
+		}
+		targets[to] = from
+	}
+	return out
+}
+
+// Apply migrates p onto To and returns the purchased nodes it dropped: those
+// with no counterpart in To, those whose counterpart an earlier purchase
+// already took, and those whose parents in To are not all kept. The kept
+// nodes keep their purchase order. What the dropped nodes cost in From is
+// added to p's pending refunds.
+func (m TreeMigration) Apply(p *TalentProgress) []PurchasedNode {
+	var (
+		mapped    []PurchasedNode // kept candidates, renamed
+		originals []PurchasedNode // the same nodes under their old ids
+		removed   []PurchasedNode
+	)
+	keep := make(map[string]bool, len(p.PurchasedNodes))
+	for _, n := range p.PurchasedNodes {
+		id, ok := m.target(n.NodeId)
+		if !ok || keep[id] {
+			removed = append(removed, n)
+			continue
+		}
+		keep[id] = true
+		renamed := n
+		renamed.NodeId = id
+		mapped = append(mapped, renamed)
+		originals = append(originals, n)
+	}
+
+	// Drop nodes whose parent is not kept, down to the leaves.
+	for changed := true; changed; {
+		changed = false
+		for _, e := range m.To.Edges {
+			if keep[e.To] && !keep[e.From] {
+				keep[e.To] = false
+				changed = true
+			}
+		}
+	}
+
+	kept := make([]PurchasedNode, 0, len(mapped))
+	for i, n := range mapped {
+		if keep[n.NodeId] {
+			kept = append(kept, n)
+		} else {
+			removed = append(removed, originals[i])
+		}
+	}
+
+	p.PurchasedNodes = kept
+	p.TreeVersion = m.To.Version
+	p.PendingRefunds = append(p.PendingRefunds, m.refunds(p, removed)...)
+	return removed
+}
+
+func (m TreeMigration) target(id string) (string, bool) {
+	if to, ok := m.Mapping[id]; ok {
+		return to, true
+	}
+	if m.To.Node(id) != nil {
+		return id, true
+	}
+	return "", false
+}
+
+// refunds returns the From cost of the removed nodes, one refund per
+// settlement that paid for them. A node with no recorded payer is refunded to
+// the settlement owning p, if any.
+func (m TreeMigration) refunds(p *TalentProgress, removed []PurchasedNode) []FavorRefund {
+	var out []FavorRefund
+	index := map[string]int{}
+	for _, n := range removed {
+		payer := n.PurchasedBySettlement
+		if payer == "" {
+			payer = p.SettlementId
+		}
+		node := m.From.Node(n.NodeId)
+		if payer == "" || node == nil || node.CostBi == 0 {
+			continue
+		}
+		i, ok := index[payer]
+		if !ok {
+			i = len(out)
+			index[payer] = i
+			out = append(out, FavorRefund{
+				SettlementId: payer,
+				Reason:       fmt.Sprintf("talent tree migration refund: tree %s v%d", p.TreeId, m.To.Version),
+				OpKey:        fmt.Sprintf("migration:%s:v%d:%s", p.Id, m.To.Version, payer),
+			})
+		}
+		out[i].Amount += node.CostBi
+	}
+	return out
+}
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+// migrationTarget is v2 of respecTree: a is renamed alpha, b is gone and a1
+// now hangs off the new node c.
+func migrationTarget() *TalentTree {
+	return &TalentTree{
+		Version: 2,
+		Nodes:   []TalentNode{{Id: "root"}, {Id: "alpha"}, {Id: "c"}, {Id: "a1"}},
+		Edges:   []TalentEdge{{"root", "alpha"}, {"root", "c"}, {"c", "a1"}},
+	}
+}
+
+func TestTreeMigrationApply(t *testing.T) {
+	from := respecTree()
+	from.Version = 1
+	p := respecProgress()
+	p.Id, p.TreeId, p.SettlementId = "p1", "t1", "s1"
+	p.PurchasedNodes[2].PurchasedBySettlement = "s2" // b
+
+	m := TreeMigration{From: from, To: migrationTarget(), Mapping: map[string]string{"a": "alpha"}}
+	removed := m.Apply(p)
+
+	if got := ids(p.PurchasedNodes); !slices.Equal(got, []string{"root", "alpha"}) {
+		t.Fatalf("kept = %v, want [root alpha]", got)
+	}
+	if got := ids(removed); !slices.Equal(got, []string{"b", "a1"}) {
+		t.Fatalf("removed = %v, want [b a1]", got)
+	}
+	if p.TreeVersion != 2 {
+		t.Fatalf("tree version = %d, want 2", p.TreeVersion)
+	}
+	want := []FavorRefund{
+		{SettlementId: "s2", Amount: 30, Reason: "talent tree migration refund: tree t1 v2", OpKey: "migration:p1:v2:s2"},
+		{SettlementId: "s1", Amount: 40, Reason: "talent tree migration refund: tree t1 v2", OpKey: "migration:p1:v2:s1"},
+	}
+	if !slices.Equal(p.PendingRefunds, want) {
+		t.Fatalf("refunds = %+v, want %+v", p.PendingRefunds, want)
+	}
+}
+
+func TestTreeMigrationApplyDropsDuplicateTarget(t *testing.T) {
+	from := respecTree()
+	p := respecProgress()
+	p.SettlementId = "s1"
+
+	to := &TalentTree{Version: 2, Nodes: []TalentNode{{Id: "root"}, {Id: "x"}}, Edges: []TalentEdge{{"root", "x"}}}
+	m := TreeMigration{From: from, To: to, Mapping: map[string]string{"a": "x", "b": "x"}}
+	m.Apply(p)
+
+	if got := ids(p.PurchasedNodes); !slices.Equal(got, []string{"root", "x"}) {
+		t.Fatalf("kept = %v, want [root x]", got)
+	}
+}
+
+func TestTreeMigrationValidate(t *testing.T) {
+	from := respecTree()
+	from.Version = 2
+	to := migrationTarget()
+
+	m := TreeMigration{From: from, To: to, Mapping: map[string]string{
+		"a":    "alpha",
+		"b":    "alpha",
+		"gone": "root",
+		"root": "nowhere",
+	}}
+	var fields []string
+	for _, v := range m.Validate() {
+		fields = append(fields, v.Field)
+	}
+	want := []string{"to_version", "node_mapping[b]", "node_mapping[gone]", "node_mapping[root]"}
+	if !slices.Equal(fields, want) {
+		t.Fatalf("violations = %v, want %v", fields, want)
+	}
+
+	from.Version = 1
+	if v := (TreeMigration{From: from, To: to, Mapping: map[string]string{"a": "alpha"}}).Validate(); v != nil {
+		t.Fatalf("valid migration reported %v", v)
+	}
+}
@@ -6,6 +6,32 @@ type PointControl struct {
 	Side            string
 	SettlementId    string
 	ControlledSince time.Time
+	// AccruedUntil is the instant up to which favor income has been paid to
+	// the settlement; zero until the first payout.
+	AccruedUntil time.Time
+}
+
+// AdvanceAccrual records that income is paid up to until; an earlier until
+// changes nothing.
+func (c *PointControl) AdvanceAccrual(until time.Time) {
+	if until.After(c.AccruedUntil) {
+		c.AccruedUntil = until
+	}
+}
+
+// AccrualStart is the instant unpaid income starts from.
+func (c *PointControl) AccrualStart() time.Time {
+	if c.AccruedUntil.After(c.ControlledSince) {
+		return c.AccruedUntil
+	}
+	return c.ControlledSince
+}
+
+// PointHold is a finished stretch of control whose income is still unpaid.
+type PointHold struct {
+	SettlementId string
+	From         time.Time
+	To           time.Time
 }
 
 type ImperialPoint struct {
@@ -15,6 +41,10 @@ type ImperialPoint struct {
 	BiRatePerHour int64
 	TreeId        string
 	Control       *PointControl // nil = unclaimed
+	// UnpaidHolds are holds closed by a handover and not yet paid out.
+	UnpaidHolds []PointHold
+	// Siege is the last attack declared on the point; nil if none is pending.
+	Siege *PointSiege
 }
 
 // SetId sets the point's identifier (used after persistence).
@@ -27,6 +57,27 @@ func (p *ImperialPoint) RestoreControl(ctrl *PointControl) {
 	p.Control = ctrl
 }
 
+// RestoreUnpaidHolds sets the unpaid holds from persisted data.
+func (p *ImperialPoint) RestoreUnpaidHolds(holds []PointHold) {
+	p.UnpaidHolds = holds
+}
+
+// CloseHold ends the current controller's hold at now and returns it, so the
+// income earned before a handover is paid to the settlement that earned it.
+// Returns nil when the point is unclaimed or held by no settlement.
+func (p *ImperialPoint) CloseHold(now time.Time) *PointHold {
+	if p.Control == nil || p.Control.SettlementId == "" {
+		return nil
+	}
+	hold := PointHold{
+		SettlementId: p.Control.SettlementId,
+		From:         p.Control.AccrualStart(),
+		To:           now,
+	}
+	p.UnpaidHolds = append(p.UnpaidHolds, hold)
+	return &hold
+}
+
 // SetControl updates the controlling settlement. Returns the previous side (empty if unclaimed).
 func (p *ImperialPoint) SetControl(side, settlementId string) string {
 	prev := ""
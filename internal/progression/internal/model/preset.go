@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// PresetAssignment binds a preset to one settlement, or, with SettlementType
+// set instead, to every settlement of that type that has none of its own.
+type PresetAssignment struct {
+	SettlementId   string
+	SettlementType string
+	PresetId       string
+	UpdatedAt      time.Time
+}
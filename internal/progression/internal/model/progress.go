@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"slices"
 	"time"
 )
 
@@ -16,6 +17,9 @@ type PurchasedNode struct {
 	NodeId                string
 	PurchasedAt           time.Time
 	PurchasedBySettlement string
+	// PurchaseId is the id of the purchase that bought the node; empty for
+	// nodes bought before purchases had ids.
+	PurchaseId string
 }
 
 type TalentProgress struct {
@@ -26,20 +30,80 @@ type TalentProgress struct {
 	Side           string // "east" | "west" — set when OwnerType == OwnerTypePointSide
 	TreeId         string
 	PurchasedNodes []PurchasedNode
+
+	// TreeVersion is the version of the tree the progress is pinned to.
+	TreeVersion int
+
+	// RespecAt is when the progress was last respecced; zero if never.
+	RespecAt time.Time
+	// PendingRefunds is the favor a respec, migration or abandoned purchase
+	// still owes.
+	PendingRefunds []FavorRefund
+	// PendingPurchases are purchases begun but not yet completed.
+	PendingPurchases []PendingPurchase
+
+	// Version counts the saves of the progress. A save is conditional on it,
+	// so of two concurrent writers the second fails.
+	Version int
+}
+
+// FavorRefund is favor owed for removed nodes, recorded with the removal and
+// cleared once credited to SettlementId under OpKey.
+type FavorRefund struct {
+	SettlementId string
+	Amount       int64
+	Reason       string
+	OpKey        string
+}
+
+// ProgressState is what a stored progress records besides its owner and
+// nodes.
+type ProgressState struct {
+	TreeVersion      int
+	RespecAt         time.Time
+	PendingRefunds   []FavorRefund
+	PendingPurchases []PendingPurchase
+	Version          int
 }
 
-func ReconstituteTalentProgress(id string, ownerType OwnerType, settlementId, pointId, side, treeId string, nodes []PurchasedNode) *TalentProgress {
+func ReconstituteTalentProgress(id string, ownerType OwnerType, settlementId, pointId, side, treeId string, nodes []PurchasedNode, state ProgressState) *TalentProgress {
 	return &TalentProgress{
-		Id:             id,
-		OwnerType:      ownerType,
-		SettlementId:   settlementId,
-		PointId:        pointId,
-		Side:           side,
-		TreeId:         treeId,
-		PurchasedNodes: nodes,
+		Id:               id,
+		OwnerType:        ownerType,
+		SettlementId:     settlementId,
+		PointId:          pointId,
+		Side:             side,
+		TreeId:           treeId,
+		PurchasedNodes:   nodes,
+		TreeVersion:      state.TreeVersion,
+		RespecAt:         state.RespecAt,
+		PendingRefunds:   state.PendingRefunds,
+		PendingPurchases: state.PendingPurchases,
+		Version:          state.Version,
 	}
 }
 
+// Clone returns a copy of p that shares none of its slices.
+func (p *TalentProgress) Clone() *TalentProgress {
+	out := *p
+	out.PurchasedNodes = slices.Clone(p.PurchasedNodes)
+	out.PendingRefunds = slices.Clone(p.PendingRefunds)
+	out.PendingPurchases = slices.Clone(p.PendingPurchases)
+	return &out
+}
+
+// ClearRefund drops the pending refund opKey once it is credited. Clearing is
+// a save of its own, so it advances Version.
+func (p *TalentProgress) ClearRefund(opKey string) {
+	p.PendingRefunds = slices.DeleteFunc(p.PendingRefunds, func(r FavorRefund) bool { return r.OpKey == opKey })
+	p.Version++
+}
+
+// MarkSaved advances Version once the progress is stored.
+func (p *TalentProgress) MarkSaved() {
+	p.Version++
+}
+
 // RollbackLast removes the last purchased node and returns it.
 // AddNode rejects out-of-order purchases, so the last element is always the most
 // recent one. Returns false if no nodes are purchased.
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// ErrPurchaseInProgress is returned when a node is purchased while another
+// purchase of it is pending.
+var ErrPurchaseInProgress = errors.New("a purchase of the node is already in progress")
+
+// PendingPurchase is a node purchase recorded before favor is deducted for
+// it. It is completed once the deduction succeeds, or abandoned, refunding
+// the deduction if there was one.
+type PendingPurchase struct {
+	Id           string
+	NodeId       string
+	CostBi       int64
+	SettlementId string
+	// OpKey is the favor movement the purchase deducts under. It is fixed
+	// when the purchase begins, so retrying the purchase charges once.
+	OpKey     string
+	Reason    string
+	StartedAt time.Time
+}
+
+// CompletedPurchase returns the node the purchase purchaseId bought, or nil
+// if it is not completed.
+func (p *TalentProgress) CompletedPurchase(purchaseId string) *PurchasedNode {
+	for i := range p.PurchasedNodes {
+		if p.PurchasedNodes[i].PurchaseId == purchaseId {
+			return &p.PurchasedNodes[i]
+		}
+	}
+	return nil
+}
+
+// PendingPurchase returns the pending purchase purchaseId, or nil.
+func (p *TalentProgress) PendingPurchase(purchaseId string) *PendingPurchase {
+	for i := range p.PendingPurchases {
+		if p.PendingPurchases[i].Id == purchaseId {
+			return &p.PendingPurchases[i]
+		}
+	}
+	return nil
+}
+
+// BeginPurchase records purchase as pending.
+func (p *TalentProgress) BeginPurchase(purchase PendingPurchase) error {
+	if p.HasNode(purchase.NodeId) {
+		return errors.New("node already purchased")
+	}
+	if slices.ContainsFunc(p.PendingPurchases, func(pp PendingPurchase) bool { return pp.NodeId == purchase.NodeId }) {
+		return ErrPurchaseInProgress
+	}
+	p.PendingPurchases = append(p.PendingPurchases, purchase)
+	return nil
+}
+
+// CompletePurchase turns the pending purchase purchaseId into a purchased
+// node bought at now.
+func (p *TalentProgress) CompletePurchase(purchaseId string, now time.Time) error {
+	pending := p.PendingPurchase(purchaseId)
+	if pending == nil {
+		return errors.New("purchase is not pending")
+	}
+	if err := p.AddNode(PurchasedNode{
+		NodeId:                pending.NodeId,
+		PurchasedAt:           now,
+		PurchasedBySettlement: pending.SettlementId,
+		PurchaseId:            purchaseId,
+	}); err != nil {
+		return err
+	}
+	p.removePending(purchaseId)
+	return nil
+}
+
+// AbandonPurchase drops the pending purchase purchaseId. When its favor was
+// charged, a refund of it is left pending. It returns false if the purchase
+// is not pending.
+func (p *TalentProgress) AbandonPurchase(purchaseId string, charged bool) bool {
+	pending := p.PendingPurchase(purchaseId)
+	if pending == nil {
+		return false
+	}
+	if charged {
+		p.queuePurchaseRefund(*pending)
+	}
+	p.removePending(purchaseId)
+	return true
+}
+
+// RefundAbandonedPurchase leaves a refund of purchase pending after its favor
+// was deducted although the purchase had already been abandoned. It returns
+// false if the purchase is not abandoned, or its refund is already pending.
+// The refund reuses the op key AbandonPurchase gives it, so it is credited
+// once however many times it is queued.
+func (p *TalentProgress) RefundAbandonedPurchase(purchase PendingPurchase) bool {
+	if p.PendingPurchase(purchase.Id) != nil || p.CompletedPurchase(purchase.Id) != nil {
+		return false
+	}
+	return p.queuePurchaseRefund(purchase)
+}
+
+func (p *TalentProgress) queuePurchaseRefund(purchase PendingPurchase) bool {
+	opKey := "refund:" + purchase.OpKey
+	if slices.ContainsFunc(p.PendingRefunds, func(r FavorRefund) bool { return r.OpKey == opKey }) {
+		return false
+	}
+	p.PendingRefunds = append(p.PendingRefunds, FavorRefund{
+		SettlementId: purchase.SettlementId,
+		Amount:       purchase.CostBi,
+		Reason:       "refund: " + purchase.Reason,
+		OpKey:        opKey,
+	})
+	return true
+}
+
+func (p *TalentProgress) removePending(purchaseId string) {
+	p.PendingPurchases = slices.DeleteFunc(p.PendingPurchases, func(pp PendingPurchase) bool {
+		return pp.Id == purchaseId
+	})
+}
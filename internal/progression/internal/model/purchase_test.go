@@ -0,0 +1,66 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPurchaseLifecycle(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &TalentProgress{}
+	purchase := PendingPurchase{Id: "p1", NodeId: "a", CostBi: 30, SettlementId: "s", OpKey: "purchase:k", Reason: "node a"}
+
+	if err := p.BeginPurchase(purchase); err != nil {
+		t.Fatalf("BeginPurchase: %v", err)
+	}
+	if err := p.BeginPurchase(PendingPurchase{Id: "p2", NodeId: "a"}); !errors.Is(err, ErrPurchaseInProgress) {
+		t.Fatalf("second BeginPurchase of the node = %v, want ErrPurchaseInProgress", err)
+	}
+	if err := p.CompletePurchase("p1", now); err != nil {
+		t.Fatalf("CompletePurchase: %v", err)
+	}
+	if done := p.CompletedPurchase("p1"); done == nil || done.NodeId != "a" || len(p.PendingPurchases) != 0 {
+		t.Fatalf("completed %v with pending %v, want node a and nothing pending", done, p.PendingPurchases)
+	}
+	if err := p.BeginPurchase(PendingPurchase{Id: "p3", NodeId: "a"}); err == nil {
+		t.Fatal("BeginPurchase of a purchased node succeeded")
+	}
+}
+
+func TestAbandonPurchaseRefundsOnlyCharged(t *testing.T) {
+	p := &TalentProgress{PendingPurchases: []PendingPurchase{
+		{Id: "charged", NodeId: "a", CostBi: 30, SettlementId: "s", OpKey: "purchase:1"},
+		{Id: "free", NodeId: "b", CostBi: 20, SettlementId: "s", OpKey: "purchase:2"},
+	}}
+
+	if !p.AbandonPurchase("charged", true) || !p.AbandonPurchase("free", false) {
+		t.Fatal("AbandonPurchase of a pending purchase returned false")
+	}
+	if p.AbandonPurchase("free", false) {
+		t.Fatal("AbandonPurchase of an abandoned purchase returned true")
+	}
+	if len(p.PendingRefunds) != 1 || p.PendingRefunds[0].Amount != 30 || p.PendingRefunds[0].OpKey != "refund:purchase:1" {
+		t.Fatalf("refunds = %+v, want one of 30 under refund:purchase:1", p.PendingRefunds)
+	}
+}
+
+func TestRefundAbandonedPurchase(t *testing.T) {
+	late := PendingPurchase{Id: "late", NodeId: "a", CostBi: 30, SettlementId: "s", OpKey: "purchase:1"}
+	p := &TalentProgress{PendingPurchases: []PendingPurchase{late}}
+
+	if p.RefundAbandonedPurchase(late) {
+		t.Fatal("RefundAbandonedPurchase of a pending purchase returned true")
+	}
+	// Abandoned before its deduction landed, so without a refund.
+	p.AbandonPurchase("late", false)
+	if !p.RefundAbandonedPurchase(late) {
+		t.Fatal("RefundAbandonedPurchase of an abandoned purchase returned false")
+	}
+	if p.RefundAbandonedPurchase(late) {
+		t.Fatal("RefundAbandonedPurchase queued the refund twice")
+	}
+	if len(p.PendingRefunds) != 1 || p.PendingRefunds[0].Amount != 30 || p.PendingRefunds[0].OpKey != "refund:purchase:1" {
+		t.Fatalf("refunds = %+v, want one of 30 under refund:purchase:1", p.PendingRefunds)
+	}
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var (
+	// ErrNodeNotPurchased is returned when a respec names a node the progress
+	// does not hold.
+	ErrNodeNotPurchased = errors.New("node is not purchased")
+	// ErrProgressChanged is returned when progress was modified between being
+	// read and a respec being saved.
+	ErrProgressChanged = errors.New("progress changed concurrently")
+)
+
+// RespecPolicy is what a settlement gets back from undoing purchases and how
+// often it may.
+type RespecPolicy struct {
+	// RefundPercent of the removed nodes' CostBi is refunded, rounded down.
+	RefundPercent int
+	// Cooldown is the minimum time between two respecs of one progress.
+	Cooldown time.Duration
+}
+
+// Refund returns the favor refunded for the removed nodes of tree.
+func (p RespecPolicy) Refund(tree *TalentTree, removed []PurchasedNode) int64 {
+	percent := int64(min(max(p.RefundPercent, 0), 100))
+	var cost int64
+	for _, r := range removed {
+		if n := tree.Node(r.NodeId); n != nil {
+			cost += n.CostBi
+		}
+	}
+	return cost * percent / 100
+}
+
+// NextRespecAt returns when the progress may be respecced again.
+func (p *TalentProgress) NextRespecAt(policy RespecPolicy) time.Time {
+	if p.RespecAt.IsZero() {
+		return time.Time{}
+	}
+	return p.RespecAt.Add(policy.Cooldown)
+}
+
+// QueueRespecRefund leaves amount of favor owed to settlementId for the
+// respec stamped at now pending, under an op key unique to that respec, next
+// to any refund still owed. Nothing is queued for a zero amount.
+func (p *TalentProgress) QueueRespecRefund(settlementId string, amount int64, now time.Time) {
+	if amount <= 0 {
+		return
+	}
+	p.PendingRefunds = append(p.PendingRefunds, FavorRefund{
+		SettlementId: settlementId,
+		Amount:       amount,
+		Reason:       "talent respec refund: tree " + p.TreeId,
+		OpKey:        "respec:" + p.Id + ":" + strconv.FormatInt(now.UnixNano(), 10),
+	})
+}
+
+// Respec removes the given nodes and every purchased node that depends on
+// them in tree, or every purchased node when nodeIds is empty, and stamps
+// the respec at now. It returns the removed nodes.
+//
+// Removing the dependants too keeps each remaining node's parents purchased,
+// as purchaseNode requires, and the remaining nodes keep their purchase order,
+// as AddNode requires.
+func (p *TalentProgress) Respec(tree *TalentTree, nodeIds []string, now time.Time) ([]PurchasedNode, error) {
+	remove := make(map[string]bool, len(p.PurchasedNodes))
+	if len(nodeIds) == 0 {
+		for _, n := range p.PurchasedNodes {
+			remove[n.NodeId] = true
+		}
+	}
+	for _, id := range nodeIds {
+		if !p.HasNode(id) {
+			return nil, ErrNodeNotPurchased
+		}
+		remove[id] = true
+	}
+
+	// Walk down the edges until no purchased child is left behind.
+	for changed := true; changed; {
+		changed = false
+		for _, e := range tree.Edges {
+			if remove[e.From] && !remove[e.To] && p.HasNode(e.To) {
+				remove[e.To] = true
+				changed = true
+			}
+		}
+	}
+
+	var removed []PurchasedNode
+	p.PurchasedNodes = slices.DeleteFunc(p.PurchasedNodes, func(n PurchasedNode) bool {
+		if remove[n.NodeId] {
+			removed = append(removed, n)
+			return true
+		}
+		return false
+	})
+	p.RespecAt = now
+	return removed, nil
+}
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+// respecTree is root -> a -> a1, root -> b, with costs 10, 20, 40, 30.
+func respecTree() *TalentTree {
+	return &TalentTree{
+		Nodes: []TalentNode{{Id: "root", CostBi: 10}, {Id: "a", CostBi: 20}, {Id: "a1", CostBi: 40}, {Id: "b", CostBi: 30}},
+		Edges: []TalentEdge{{"root", "a"}, {"a", "a1"}, {"root", "b"}},
+	}
+}
+
+func respecProgress() *TalentProgress {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := &TalentProgress{}
+	for i, id := range []string{"root", "a", "b", "a1"} {
+		_ = p.AddNode(PurchasedNode{NodeId: id, PurchasedAt: t0.Add(time.Duration(i) * time.Hour)})
+	}
+	return p
+}
+
+func ids(nodes []PurchasedNode) []string {
+	out := make([]string, len(nodes))
+	for i, n := range nodes {
+		out[i] = n.NodeId
+	}
+	return out
+}
+
+func TestRespecRemovesSubtreeInOrder(t *testing.T) {
+	now := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	p := respecProgress()
+
+	removed, err := p.Respec(respecTree(), []string{"a"}, now)
+	if err != nil {
+		t.Fatalf("Respec: %v", err)
+	}
+	if !slices.Equal(ids(removed), []string{"a", "a1"}) {
+		t.Errorf("removed = %v, want [a a1]", ids(removed))
+	}
+	if !slices.Equal(ids(p.PurchasedNodes), []string{"root", "b"}) {
+		t.Errorf("kept = %v, want [root b]", ids(p.PurchasedNodes))
+	}
+	if !p.RespecAt.Equal(now) {
+		t.Errorf("RespecAt = %v, want %v", p.RespecAt, now)
+	}
+	// What is left still accepts the next purchase in order.
+	if err := p.AddNode(PurchasedNode{NodeId: "a", PurchasedAt: now}); err != nil {
+		t.Errorf("AddNode after respec: %v", err)
+	}
+}
+
+func TestRespecClearsAll(t *testing.T) {
+	p := respecProgress()
+	removed, err := p.Respec(respecTree(), nil, time.Now())
+	if err != nil {
+		t.Fatalf("Respec: %v", err)
+	}
+	if len(removed) != 4 || len(p.PurchasedNodes) != 0 {
+		t.Fatalf("removed %d, kept %d; want 4 and 0", len(removed), len(p.PurchasedNodes))
+	}
+}
+
+func TestRespecRejectsUnpurchasedNode(t *testing.T) {
+	p := &TalentProgress{}
+	if _, err := p.Respec(respecTree(), []string{"a"}, time.Now()); !errors.Is(err, ErrNodeNotPurchased) {
+		t.Fatalf("err = %v, want ErrNodeNotPurchased", err)
+	}
+}
+
+func TestRespecPolicy(t *testing.T) {
+	removed := []PurchasedNode{{NodeId: "a"}, {NodeId: "a1"}, {NodeId: "gone"}}
+	cases := map[int]int64{50: 30, 33: 19, 0: 0, 150: 60, -10: 0}
+	for percent, want := range cases {
+		if got := (RespecPolicy{RefundPercent: percent}).Refund(respecTree(), removed); got != want {
+			t.Errorf("Refund at %d%% = %d, want %d", percent, got, want)
+		}
+	}
+
+	policy := RespecPolicy{Cooldown: 24 * time.Hour}
+	p := &TalentProgress{}
+	if !p.NextRespecAt(policy).IsZero() {
+		t.Error("never respecced progress has a cooldown")
+	}
+	p.RespecAt = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if want := p.RespecAt.Add(24 * time.Hour); !p.NextRespecAt(policy).Equal(want) {
+		t.Errorf("NextRespecAt = %v, want %v", p.NextRespecAt(policy), want)
+	}
+}
+
+func TestQueueRespecRefundKeepsEarlierRefunds(t *testing.T) {
+	p := respecProgress()
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	p.QueueRespecRefund("s1", 0, t0)
+	if len(p.PendingRefunds) != 0 {
+		t.Fatalf("zero refund queued: %+v", p.PendingRefunds)
+	}
+	p.QueueRespecRefund("s1", 10, t0)
+	p.QueueRespecRefund("s1", 20, t0.Add(time.Hour))
+	if len(p.PendingRefunds) != 2 || p.PendingRefunds[0].OpKey == p.PendingRefunds[1].OpKey {
+		t.Fatalf("refunds = %+v, want two under distinct keys", p.PendingRefunds)
+	}
+}
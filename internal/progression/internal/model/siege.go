@@ -0,0 +1,81 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrPointContested is returned when an attack is declared on a point that
+	// is already under siege.
+	ErrPointContested = errors.New("point is already contested")
+	// ErrAlreadyControlled is returned when a settlement attacks a point it
+	// controls.
+	ErrAlreadyControlled = errors.New("settlement already controls the point")
+	// ErrSiegeNotActive is returned when a siege was resolved or replaced
+	// before a change to it was saved.
+	ErrSiegeNotActive = errors.New("siege is not active")
+)
+
+// CaptureComplete is the capture progress, in percent, at which the attacker
+// takes the point.
+const CaptureComplete = 100
+
+// PointSiege is an attack declared on a point. The point is contested until
+// EndsAt; the attacker takes it if the game server reports the capture
+// complete before then.
+type PointSiege struct {
+	Id                   string
+	AttackerSettlementId string
+	AttackerSide         string
+	// DefenderSettlementId is the settlement controlling the point when the
+	// attack was declared; empty if the point was unclaimed.
+	DefenderSettlementId string
+	DeclaredAt           time.Time
+	EndsAt               time.Time
+	// Progress is the capture progress in percent, 0 to CaptureComplete.
+	Progress int
+}
+
+// ReportProgress records capture progress; progress never goes back.
+func (s *PointSiege) ReportProgress(progress int) {
+	s.Progress = max(s.Progress, progress)
+}
+
+// RestoreSiege sets the pending siege from persisted data; nil clears it.
+func (p *ImperialPoint) RestoreSiege(siege *PointSiege) {
+	p.Siege = siege
+}
+
+// Active reports whether the siege still contests the point at now.
+func (s *PointSiege) Active(now time.Time) bool {
+	return s != nil && now.Before(s.EndsAt)
+}
+
+// Contested reports whether the point is under siege at now.
+func (p *ImperialPoint) Contested(now time.Time) bool {
+	return p.Siege.Active(now)
+}
+
+// DeclareAttack starts a siege of the point by settlementId for side, lasting
+// window from now. An expired siege is replaced.
+func (p *ImperialPoint) DeclareAttack(id, settlementId, side string, now time.Time, window time.Duration) (*PointSiege, error) {
+	if p.Contested(now) {
+		return nil, ErrPointContested
+	}
+	siege := &PointSiege{
+		Id:                   id,
+		AttackerSettlementId: settlementId,
+		AttackerSide:         side,
+		DeclaredAt:           now,
+		EndsAt:               now.Add(window),
+	}
+	if p.Control != nil {
+		if p.Control.SettlementId == settlementId {
+			return nil, ErrAlreadyControlled
+		}
+		siege.DefenderSettlementId = p.Control.SettlementId
+	}
+	p.Siege = siege
+	return siege, nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeclareAttack(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	p := &ImperialPoint{Control: &PointControl{Side: "east", SettlementId: "s1"}}
+
+	if _, err := p.DeclareAttack("x", "s1", "east", now, time.Hour); !errors.Is(err, ErrAlreadyControlled) {
+		t.Fatalf("attack on own point: err = %v, want ErrAlreadyControlled", err)
+	}
+
+	siege, err := p.DeclareAttack("a", "s2", "west", now, time.Hour)
+	if err != nil {
+		t.Fatalf("DeclareAttack: %v", err)
+	}
+	if siege.DefenderSettlementId != "s1" || !siege.EndsAt.Equal(now.Add(time.Hour)) {
+		t.Fatalf("siege = %+v, want defender s1 ending in an hour", siege)
+	}
+	if !p.Contested(now.Add(59 * time.Minute)) {
+		t.Fatal("point not contested within the window")
+	}
+
+	if _, err := p.DeclareAttack("b", "s3", "west", now.Add(30*time.Minute), time.Hour); !errors.Is(err, ErrPointContested) {
+		t.Fatalf("attack during siege: err = %v, want ErrPointContested", err)
+	}
+	if _, err := p.DeclareAttack("c", "s3", "west", now.Add(time.Hour), time.Hour); err != nil {
+		t.Fatalf("attack after the window: %v", err)
+	}
+}
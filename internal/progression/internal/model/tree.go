@@ -1,10 +1,19 @@
 package model
 
+import (
+	"errors"
+	"slices"
+)
+
+// ErrTreeVersionConflict is returned when another version of a tree was
+// published between reading the tree and publishing a new version.
+var ErrTreeVersionConflict = errors.New("tree version changed concurrently")
+
 type TalentNode struct {
 	Id          string
 	Name        string
 	Description string
-	Effect      string
+	Effects     []NodeEffect
 	CostBi      int64
 }
 
@@ -19,15 +28,54 @@ type TalentTree struct {
 	Description string
 	Nodes       []TalentNode
 	Edges       []TalentEdge
+	// Version counts the tree's published versions from 1. Publishing a new
+	// one keeps the old readable; progress stays pinned to its version until
+	// migrated.
+	Version int
+}
+
+// Node returns the node with the given id, or nil.
+func (t *TalentTree) Node(id string) *TalentNode {
+	for i := range t.Nodes {
+		if t.Nodes[i].Id == id {
+			return &t.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// SameContent reports whether other has the same description, nodes and
+// edges, in the same order; names, ids and versions are not compared.
+func (t *TalentTree) SameContent(other *TalentTree) bool {
+	return t.Description == other.Description &&
+		slices.EqualFunc(t.Nodes, other.Nodes, func(a, b TalentNode) bool {
+			return a.Id == b.Id && a.Name == b.Name && a.Description == b.Description &&
+				a.CostBi == b.CostBi && slices.Equal(a.Effects, b.Effects)
+		}) &&
+		slices.Equal(t.Edges, other.Edges)
 }
 
-func ReconstituteTalentTree(id, name, description string, nodes []TalentNode, edges []TalentEdge) *TalentTree {
+// NextVersionOf makes t the version of head that follows it: head's id and
+// the next version number.
+func (t *TalentTree) NextVersionOf(head *TalentTree) {
+	t.Id = head.Id
+	t.Version = head.Version + 1
+}
+
+// NewTalentTree builds a tree not yet stored; it has no id or version until
+// created or published. Validate it before storing.
+func NewTalentTree(name, description string, nodes []TalentNode, edges []TalentEdge) *TalentTree {
+	return &TalentTree{Name: name, Description: description, Nodes: nodes, Edges: edges}
+}
+
+func ReconstituteTalentTree(id, name, description string, nodes []TalentNode, edges []TalentEdge, version int) *TalentTree {
 	return &TalentTree{
 		Id:          id,
 		Name:        name,
 		Description: description,
 		Nodes:       nodes,
 		Edges:       edges,
+		Version:     version,
 	}
 }
 
@@ -37,6 +85,16 @@ type TalentPreset struct {
 	TreeIds []string
 }
 
+// HasTree reports whether the preset lists the tree.
+func (p *TalentPreset) HasTree(treeId string) bool {
+	return slices.Contains(p.TreeIds, treeId)
+}
+
+// NewTalentPreset builds a preset not yet stored.
+func NewTalentPreset(name string, treeIds []string) *TalentPreset {
+	return &TalentPreset{Name: name, TreeIds: treeIds}
+}
+
 func ReconstituteTalentPreset(id, name string, treeIds []string) *TalentPreset {
 	return &TalentPreset{Id: id, Name: name, TreeIds: treeIds}
 }
@@ -0,0 +1,138 @@
+package model
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TreeViolation names one part of a talent tree that breaks its invariants.
+// Field locates it in the request, e.g. "nodes[2].cost_bi" or "edges[0].to".
+type TreeViolation struct {
+	Field       string
+	Description string
+}
+
+// Validate checks the tree: node ids are present and unique, costs are
+// non-negative, effects are well-formed, every edge joins two distinct nodes of
+// the tree at most once, and the edges form no cycle. It returns every
+// violation found, nil when the tree is valid.
+func (t *TalentTree) Validate() []TreeViolation {
+	var out []TreeViolation
+
+	nodes := make(map[string]int, len(t.Nodes))
+	for i, n := range t.Nodes {
+		switch id := strings.TrimSpace(n.Id); {
+		case id == "":
+			out = append(out, TreeViolation{fmt.Sprintf("nodes[%d].id", i), "node id is empty"})
+		case id != n.Id:
+			out = append(out, TreeViolation{fmt.Sprintf("nodes[%d].id", i), fmt.Sprintf("node id %q has surrounding spaces", n.Id)})
+		default:
+			if first, ok := nodes[n.Id]; ok {
+				out = append(out, TreeViolation{fmt.Sprintf("nodes[%d].id", i), fmt.Sprintf("node %q duplicates nodes[%d]", n.Id, first)})
+			} else {
+				nodes[n.Id] = i
+			}
+		}
+		if n.CostBi < 0 {
+			out = append(out, TreeViolation{fmt.Sprintf("nodes[%d].cost_bi", i), fmt.Sprintf("node %q has negative cost %d", n.Id, n.CostBi)})
+		}
+		for j, e := range n.Effects {
+			if v := e.violation(); v != "" {
+				out = append(out, TreeViolation{fmt.Sprintf("nodes[%d].effects[%d]", i, j), fmt.Sprintf("node %q: %s", n.Id, v)})
+			}
+		}
+	}
+
+	// valid holds the edges that join two known nodes, by index, for the cycle
+	// check; the others are already reported.
+	var valid []int
+	seen := make(map[TalentEdge]int, len(t.Edges))
+	for i, e := range t.Edges {
+		ok := true
+		if _, found := nodes[e.From]; !found {
+			out = append(out, TreeViolation{fmt.Sprintf("edges[%d].from", i), fmt.Sprintf("edge %s points from unknown node %q", e, e.From)})
+			ok = false
+		}
+		if _, found := nodes[e.To]; !found {
+			out = append(out, TreeViolation{fmt.Sprintf("edges[%d].to", i), fmt.Sprintf("edge %s points to unknown node %q", e, e.To)})
+			ok = false
+		}
+		if !ok {
+			continue
+		}
+		if e.From == e.To {
+			out = append(out, TreeViolation{fmt.Sprintf("edges[%d]", i), fmt.Sprintf("edge %s links node %q to itself", e, e.From)})
+			continue
+		}
+		if first, dup := seen[e]; dup {
+			out = append(out, TreeViolation{fmt.Sprintf("edges[%d]", i), fmt.Sprintf("edge %s duplicates edges[%d]", e, first)})
+			continue
+		}
+		seen[e] = i
+		valid = append(valid, i)
+	}
+
+	for _, cycle := range t.cycles(valid) {
+		path := make([]string, 0, len(cycle)+1)
+		for _, i := range cycle {
+			path = append(path, t.Edges[i].From)
+		}
+		path = append(path, t.Edges[cycle[0]].From)
+		for _, i := range cycle {
+			out = append(out, TreeViolation{fmt.Sprintf("edges[%d]", i), fmt.Sprintf("edge %s is part of cycle %s", t.Edges[i], strings.Join(path, " -> "))})
+		}
+	}
+	return out
+}
+
+// cycles returns one cycle, as a list of edge indexes in walk order, for every
+// back edge a depth-first walk over the given edges meets.
+func (t *TalentTree) cycles(edges []int) [][]int {
+	out := make(map[string][]int)
+	for _, i := range edges {
+		out[t.Edges[i].From] = append(out[t.Edges[i].From], i)
+	}
+
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+	state := make(map[string]int, len(t.Nodes))
+	var path []int // edge indexes from the walk's root to the current node
+	var found [][]int
+
+	var visit func(node string)
+	visit = func(node string) {
+		state[node] = onPath
+		for _, i := range out[node] {
+			next := t.Edges[i].To
+			switch state[next] {
+			case onPath:
+				start := slices.IndexFunc(path, func(j int) bool { return t.Edges[j].From == next })
+				if start < 0 {
+					start = len(path)
+				}
+				found = append(found, append(slices.Clone(path[start:]), i))
+			case unvisited:
+				path = append(path, i)
+				visit(next)
+				path = path[:len(path)-1]
+			}
+		}
+		state[node] = done
+	}
+
+	for _, n := range t.Nodes {
+		if state[n.Id] == unvisited {
+			visit(n.Id)
+		}
+	}
+	return found
+}
+
+// String renders the edge as "from->to".
+func (e TalentEdge) String() string {
+	return e.From + "->" + e.To
+}
@@ -0,0 +1,82 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func violationFields(vs []TreeViolation) []string {
+	out := make([]string, len(vs))
+	for i, v := range vs {
+		out[i] = v.Field
+	}
+	return out
+}
+
+func TestTalentTreeValidate(t *testing.T) {
+	node := func(id string, cost int64) TalentNode { return TalentNode{Id: id, CostBi: cost} }
+
+	cases := []struct {
+		name  string
+		tree  TalentTree
+		wants []string
+	}{
+		{
+			name: "valid diamond",
+			tree: TalentTree{
+				Nodes: []TalentNode{node("a", 0), node("b", 5), node("c", 5), node("d", 10)},
+				Edges: []TalentEdge{{"a", "b"}, {"a", "c"}, {"b", "d"}, {"c", "d"}},
+			},
+		},
+		{
+			name: "bad nodes",
+			tree: TalentTree{
+				Nodes: []TalentNode{node("a", 0), node("", 1), node("a", -3)},
+			},
+			wants: []string{"nodes[1].id", "nodes[2].id", "nodes[2].cost_bi"},
+		},
+		{
+			name: "bad edges",
+			tree: TalentTree{
+				Nodes: []TalentNode{node("a", 0), node("b", 0)},
+				Edges: []TalentEdge{{"a", "x"}, {"y", "b"}, {"a", "a"}, {"a", "b"}, {"a", "b"}},
+			},
+			wants: []string{"edges[0].to", "edges[1].from", "edges[2]", "edges[4]"},
+		},
+		{
+			name: "cycle",
+			tree: TalentTree{
+				Nodes: []TalentNode{node("root", 0), node("a", 0), node("b", 0), node("c", 0)},
+				Edges: []TalentEdge{{"root", "a"}, {"a", "b"}, {"b", "c"}, {"c", "a"}},
+			},
+			wants: []string{"edges[1]", "edges[2]", "edges[3]"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := violationFields(tc.tree.Validate())
+			slices.Sort(got)
+			want := slices.Clone(tc.wants)
+			slices.Sort(want)
+			if !slices.Equal(got, want) {
+				t.Fatalf("violations = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestTalentTreeValidateNamesCycle(t *testing.T) {
+	tree := TalentTree{
+		Nodes: []TalentNode{{Id: "a"}, {Id: "b"}},
+		Edges: []TalentEdge{{"a", "b"}, {"b", "a"}},
+	}
+	vs := tree.Validate()
+	if len(vs) != 2 {
+		t.Fatalf("violations = %v, want 2", vs)
+	}
+	const want = `edge a->b is part of cycle a -> b -> a`
+	if vs[0].Description != want {
+		t.Fatalf("description = %q, want %q", vs[0].Description, want)
+	}
+}
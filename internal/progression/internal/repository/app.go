@@ -1,11 +1,20 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/progression/progressionuc"
+	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.uber.org/fx"
+	"go.uber.org/zap"
 )
 
+var _ progressionuc.NodesRepo = (*Repository)(nil)
+
 type Opts struct {
 	fx.In
 
@@ -16,17 +25,105 @@ type Opts struct {
 type Repository struct {
 	log          logger.Logger
 	treesColl    *mongo.Collection
+	versionsColl *mongo.Collection
 	presetsColl  *mongo.Collection
 	progressColl *mongo.Collection
 	pointsColl   *mongo.Collection
+	accrualsColl *mongo.Collection
+	bonusesColl  *mongo.Collection
+	historyColl  *mongo.Collection
+
+	assignmentsColl *mongo.Collection
 }
 
 func New(opts Opts) *Repository {
-	return &Repository{
+	r := &Repository{
 		log:          opts.Log,
 		treesColl:    opts.Database.Collection("talent_trees"),
+		versionsColl: opts.Database.Collection("talent_tree_versions"),
 		presetsColl:  opts.Database.Collection("talent_presets"),
 		progressColl: opts.Database.Collection("talent_progress"),
 		pointsColl:   opts.Database.Collection("imperial_points"),
+		accrualsColl: opts.Database.Collection("imperial_point_accruals"),
+		bonusesColl:  opts.Database.Collection("talent_bonuses"),
+		historyColl:  opts.Database.Collection("imperial_point_control_history"),
+
+		assignmentsColl: opts.Database.Collection("talent_preset_assignments"),
+	}
+	r.setupIndexes()
+	return r
+}
+
+func (r *Repository) setupIndexes() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// One accrual per stretch of a hold: the claim that makes payouts safe
+	// across replicas.
+	_, err := r.accrualsColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "point_id", Value: 1},
+			{Key: "settlement_id", Value: 1},
+			{Key: "from", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.accrualsColl.Name()), zap.Error(err))
+	}
+
+	// One archive per superseded tree version.
+	_, err = r.versionsColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tree_id", Value: 1},
+			{Key: "version", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.versionsColl.Name()), zap.Error(err))
+	}
+
+	// One published aggregate per owner.
+	_, err = r.bonusesColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "owner_type", Value: 1},
+			{Key: "settlement_id", Value: 1},
+			{Key: "point_id", Value: 1},
+			{Key: "side", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.bonusesColl.Name()), zap.Error(err))
+	}
+
+	// One entry per control period, so recording a change twice is harmless.
+	_, err = r.historyColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "point_id", Value: 1},
+			{Key: "from", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.historyColl.Name()), zap.Error(err))
+	}
+
+	// At most one assignment per settlement and one default per type.
+	_, err = r.assignmentsColl.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "settlement_id", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"settlement_id": bson.M{"$exists": true}}),
+		},
+		{
+			Keys: bson.D{{Key: "settlement_type", Value: 1}},
+			Options: options.Index().SetUnique(true).
+				SetPartialFilterExpression(bson.M{"settlement_type": bson.M{"$exists": true}}),
+		},
+	})
+	if err != nil {
+		r.log.Error("failed to create index", zap.String("collection", r.assignmentsColl.Name()), zap.Error(err))
 	}
 }
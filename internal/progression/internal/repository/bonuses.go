@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// GetBonuses returns the owner's last published bonuses, nil when none were.
+func (r *Repository) GetBonuses(ctx context.Context, owner model.BonusOwner) (*model.Bonuses, error) {
+	var d dto.Bonuses
+	err := r.bonusesColl.FindOne(ctx, bonusesFilter(owner)).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := &model.Bonuses{Modifiers: make(map[string]model.StatBonus, len(d.Modifiers)), Unlocks: d.Unlocks}
+	for k, v := range d.Modifiers {
+		b.Modifiers[k] = model.StatBonus{Add: v.Add, Multiply: v.Multiply}
+	}
+	return b, nil
+}
+
+// SaveBonuses records b as the owner's last published bonuses.
+func (r *Repository) SaveBonuses(ctx context.Context, owner model.BonusOwner, b model.Bonuses) error {
+	modifiers := make(map[string]dto.StatBonus, len(b.Modifiers))
+	for k, v := range b.Modifiers {
+		modifiers[k] = dto.StatBonus{Add: v.Add, Multiply: v.Multiply}
+	}
+	unlocks := b.Unlocks
+	if unlocks == nil {
+		unlocks = []string{}
+	}
+
+	now := time.Now()
+	_, err := r.bonusesColl.UpdateOne(ctx, bonusesFilter(owner), bson.M{
+		"$set": bson.M{
+			"modifiers":  modifiers,
+			"unlocks":    unlocks,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{"_id": bson.NewObjectIDFromTimestamp(now), "created_at": now},
+	}, options.UpdateOne().SetUpsert(true))
+	return err
+}
+
+func bonusesFilter(owner model.BonusOwner) bson.M {
+	return bson.M{
+		"owner_type":    string(owner.Type),
+		"settlement_id": owner.SettlementId,
+		"point_id":      owner.PointId,
+		"side":          owner.Side,
+	}
+}
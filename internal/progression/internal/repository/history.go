@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// SaveControlPeriod records the period, keyed by its point and start, so a
+// period opened earlier is closed by saving it again with To set.
+func (r *Repository) SaveControlPeriod(ctx context.Context, period model.ControlPeriod) error {
+	pointOid, err := mongox.ParseObjectID(period.PointId)
+	if err != nil {
+		return err
+	}
+	settlementOid, err := mongox.ParseObjectID(period.SettlementId)
+	if err != nil {
+		return err
+	}
+	var to *time.Time
+	if !period.To.IsZero() {
+		to = &period.To
+	}
+
+	now := time.Now()
+	_, err = r.historyColl.UpdateOne(ctx,
+		bson.M{"point_id": pointOid, "from": period.From},
+		bson.M{
+			"$set": bson.M{
+				"settlement_id": settlementOid,
+				"side":          period.Side,
+				"to":            to,
+				"updated_at":    now,
+			},
+			"$setOnInsert": bson.M{"_id": bson.NewObjectIDFromTimestamp(now), "created_at": now},
+		},
+		options.UpdateOne().SetUpsert(true),
+	)
+	return err
+}
+
+// ListControlPeriods returns the periods overlapping [since, until), newest
+// first, of one point or, when pointId is empty, of every point.
+func (r *Repository) ListControlPeriods(ctx context.Context, pointId string, since, until time.Time) ([]model.ControlPeriod, error) {
+	filter := bson.M{
+		"from": bson.M{"$lt": until},
+		"$or": bson.A{
+			bson.M{"to": nil},
+			bson.M{"to": bson.M{"$gt": since}},
+		},
+	}
+	if pointId != "" {
+		oid, err := mongox.ParseObjectID(pointId)
+		if err != nil {
+			return nil, err
+		}
+		filter["point_id"] = oid
+	}
+
+	cur, err := r.historyColl.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "from", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	var docs []dto.ControlPeriod
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	out := make([]model.ControlPeriod, len(docs))
+	for i, d := range docs {
+		var to time.Time
+		if d.To != nil {
+			to = *d.To
+		}
+		out[i] = model.ControlPeriod{
+			PointId:      d.PointId.Hex(),
+			SettlementId: d.SettlementId.Hex(),
+			Side:         d.Side,
+			From:         d.From,
+			To:           to,
+		}
+	}
+	return out, nil
+}
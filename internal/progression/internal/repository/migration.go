@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// ListTreeProgress returns every progress pinned to the given tree version.
+func (r *Repository) ListTreeProgress(ctx context.Context, treeId string, version int) ([]model.TalentProgress, error) {
+	oid, err := mongox.ParseObjectID(treeId)
+	if err != nil {
+		return nil, err
+	}
+	return r.findProgress(ctx, bson.M{"tree_id": oid, "tree_version": versionFilter(version)})
+}
+
+// SaveMigration writes a migrated progress — its nodes, version and pending
+// refunds — in one update, provided it is still pinned to fromVersion with
+// the purchased nodes before and has not been saved since it was read.
+// Otherwise it returns model.ErrProgressChanged.
+func (r *Repository) SaveMigration(ctx context.Context, progress model.TalentProgress, before []model.PurchasedNode, fromVersion int) error {
+	oid, err := mongox.ParseObjectID(progress.Id)
+	if err != nil {
+		return err
+	}
+	return r.saveGuarded(ctx,
+		bson.M{
+			"_id":             oid,
+			"version":         revisionFilter(progress.Version),
+			"tree_version":    versionFilter(fromVersion),
+			"purchased_nodes": toPurchasedNodeDTOs(before),
+		},
+		bson.M{
+			"purchased_nodes": toPurchasedNodeDTOs(progress.PurchasedNodes),
+			"tree_version":    progress.TreeVersion,
+			"pending_refunds": toRefundDTOs(progress.PendingRefunds),
+		},
+	)
+}
@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/lasthearth/vsservice/internal/pkg/mongox"
 	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 func (r *Repository) CreatePoint(ctx context.Context, point model.ImperialPoint) (*model.ImperialPoint, error) {
@@ -82,29 +84,122 @@ func (r *Repository) ListPoints(ctx context.Context) ([]model.ImperialPoint, err
 	return out, nil
 }
 
-func (r *Repository) SaveControl(ctx context.Context, pointId string, control *model.PointControl) error {
+// SaveControl replaces the point's control. closed, when set, is the hold
+// the change ended; it is queued for payout in the same write, so a handover
+// can never lose the outgoing settlement's income.
+func (r *Repository) SaveControl(ctx context.Context, pointId string, control *model.PointControl, closed *model.PointHold) error {
 	oid, err := mongox.ParseObjectID(pointId)
 	if err != nil {
 		return err
 	}
-	var update bson.M
+	update := bson.M{}
 	if control == nil {
-		update = bson.M{"$unset": bson.M{"control": ""}}
+		update["$unset"] = bson.M{"control": ""}
 	} else {
 		soid, err := mongox.ParseObjectID(control.SettlementId)
 		if err != nil {
 			return err
 		}
-		update = bson.M{"$set": bson.M{"control": dto.PointControl{
+		update["$set"] = bson.M{"control": dto.PointControl{
 			Side:            control.Side,
 			SettlementId:    soid,
 			ControlledSince: control.ControlledSince,
-		}}}
+			AccruedUntil:    control.AccruedUntil,
+		}}
+	}
+	if closed != nil {
+		hold, err := toHoldDTO(*closed)
+		if err != nil {
+			return err
+		}
+		update["$push"] = bson.M{"unpaid_holds": hold}
 	}
 	_, err = r.pointsColl.UpdateByID(ctx, oid, update)
 	return err
 }
 
+// ClaimAccrual stores the accrual for its (point, settlement, from) unless one
+// is already stored, and returns whichever is stored.
+func (r *Repository) ClaimAccrual(ctx context.Context, a model.FavorAccrual) (*model.FavorAccrual, error) {
+	pointOid, err := mongox.ParseObjectID(a.PointId)
+	if err != nil {
+		return nil, err
+	}
+	settlementOid, err := mongox.ParseObjectID(a.SettlementId)
+	if err != nil {
+		return nil, err
+	}
+
+	m := mongox.NewModel()
+	var d dto.FavorAccrual
+	err = r.accrualsColl.FindOneAndUpdate(ctx,
+		bson.M{"point_id": pointOid, "settlement_id": settlementOid, "from": a.From},
+		bson.M{"$setOnInsert": bson.M{
+			"_id":        m.Id,
+			"created_at": m.CreatedAt,
+			"updated_at": m.UpdatedAt,
+			"to":         a.To,
+			"amount":     a.Amount,
+		}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FavorAccrual{
+		Id:           d.Id.Hex(),
+		PointId:      d.PointId.Hex(),
+		SettlementId: d.SettlementId.Hex(),
+		From:         d.From,
+		To:           d.To,
+		Amount:       d.Amount,
+		CreatedAt:    d.CreatedAt,
+	}, nil
+}
+
+// AdvanceAccrual moves the current control's AccruedUntil forward to until.
+// A no-op when the point has changed hands since controlledSince.
+func (r *Repository) AdvanceAccrual(ctx context.Context, pointId, settlementId string, controlledSince, until time.Time) error {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return err
+	}
+	soid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return err
+	}
+	_, err = r.pointsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "control.settlement_id": soid, "control.controlled_since": controlledSince},
+		bson.M{"$max": bson.M{"control.accrued_until": until}},
+	)
+	return err
+}
+
+// SettleHold drops a closed hold once it has been paid out.
+func (r *Repository) SettleHold(ctx context.Context, pointId string, hold model.PointHold) error {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return err
+	}
+	soid, err := mongox.ParseObjectID(hold.SettlementId)
+	if err != nil {
+		return err
+	}
+	_, err = r.pointsColl.UpdateByID(ctx, oid, bson.M{
+		"$pull": bson.M{"unpaid_holds": bson.M{"settlement_id": soid, "from": hold.From}},
+	})
+	return err
+}
+
+func toHoldDTO(h model.PointHold) (dto.PointHold, error) {
+	soid, err := mongox.ParseObjectID(h.SettlementId)
+	if err != nil {
+		return dto.PointHold{}, err
+	}
+	return dto.PointHold{SettlementId: soid, From: h.From, To: h.To}, nil
+}
+
 func fromPointDTO(d dto.ImperialPoint) *model.ImperialPoint {
 	p := &model.ImperialPoint{
 		Id:            d.Id.Hex(),
@@ -118,7 +213,32 @@ func fromPointDTO(d dto.ImperialPoint) *model.ImperialPoint {
 			Side:            d.Control.Side,
 			SettlementId:    d.Control.SettlementId.Hex(),
 			ControlledSince: d.Control.ControlledSince,
+			AccruedUntil:    d.Control.AccruedUntil,
+		})
+	}
+	if d.Siege != nil {
+		defender := ""
+		if !d.Siege.DefenderSettlementId.IsZero() {
+			defender = d.Siege.DefenderSettlementId.Hex()
+		}
+		p.RestoreSiege(&model.PointSiege{
+			Id:                   d.Siege.Id,
+			AttackerSettlementId: d.Siege.AttackerSettlementId.Hex(),
+			AttackerSide:         d.Siege.AttackerSide,
+			DefenderSettlementId: defender,
+			DeclaredAt:           d.Siege.DeclaredAt,
+			EndsAt:               d.Siege.EndsAt,
+			Progress:             d.Siege.Progress,
+		})
+	}
+	var holds []model.PointHold
+	for _, h := range d.UnpaidHolds {
+		holds = append(holds, model.PointHold{
+			SettlementId: h.SettlementId.Hex(),
+			From:         h.From,
+			To:           h.To,
 		})
 	}
+	p.RestoreUnpaidHolds(holds)
 	return p
 }
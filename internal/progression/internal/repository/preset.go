@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// AssignPreset stores the assignment, replacing the settlement's or the
+// type's previous one.
+func (r *Repository) AssignPreset(ctx context.Context, a model.PresetAssignment) error {
+	filter, err := assignmentFilter(a.SettlementId, a.SettlementType)
+	if err != nil {
+		return err
+	}
+	presetOid, err := mongox.ParseObjectID(a.PresetId)
+	if err != nil {
+		return err
+	}
+	_, err = r.assignmentsColl.UpdateOne(ctx, filter,
+		bson.M{
+			"$set":         bson.M{"preset_id": presetOid, "updated_at": a.UpdatedAt},
+			"$setOnInsert": bson.M{"created_at": a.UpdatedAt},
+		},
+		options.UpdateOne().SetUpsert(true),
+	)
+	return err
+}
+
+// UnassignPreset removes the settlement's assignment, or the type's default
+// when settlementId is empty. Removing a missing one is not an error.
+func (r *Repository) UnassignPreset(ctx context.Context, settlementId, settlementType string) error {
+	filter, err := assignmentFilter(settlementId, settlementType)
+	if err != nil {
+		return err
+	}
+	_, err = r.assignmentsColl.DeleteOne(ctx, filter)
+	return err
+}
+
+// GetPresetAssignment returns the settlement's assignment, or the type's
+// default when settlementId is empty; mongo.ErrNoDocuments if there is none.
+func (r *Repository) GetPresetAssignment(ctx context.Context, settlementId, settlementType string) (*model.PresetAssignment, error) {
+	filter, err := assignmentFilter(settlementId, settlementType)
+	if err != nil {
+		return nil, err
+	}
+	var d dto.PresetAssignment
+	if err := r.assignmentsColl.FindOne(ctx, filter).Decode(&d); err != nil {
+		return nil, err
+	}
+	return fromAssignmentDTO(d), nil
+}
+
+// ListPresetAssignments returns the type defaults, then the settlements'
+// assignments.
+func (r *Repository) ListPresetAssignments(ctx context.Context) ([]model.PresetAssignment, error) {
+	cur, err := r.assignmentsColl.Find(ctx, bson.M{},
+		options.Find().SetSort(bson.D{{Key: "settlement_id", Value: 1}, {Key: "settlement_type", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	var docs []dto.PresetAssignment
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	out := make([]model.PresetAssignment, len(docs))
+	for i, d := range docs {
+		out[i] = *fromAssignmentDTO(d)
+	}
+	return out, nil
+}
+
+func assignmentFilter(settlementId, settlementType string) (bson.M, error) {
+	if settlementId == "" {
+		return bson.M{"settlement_type": settlementType}, nil
+	}
+	oid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return nil, err
+	}
+	return bson.M{"settlement_id": oid}, nil
+}
+
+func fromAssignmentDTO(d dto.PresetAssignment) *model.PresetAssignment {
+	settlementId := ""
+	if !d.SettlementId.IsZero() {
+		settlementId = d.SettlementId.Hex()
+	}
+	return &model.PresetAssignment{
+		SettlementId:   settlementId,
+		SettlementType: d.SettlementType,
+		PresetId:       d.PresetId.Hex(),
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
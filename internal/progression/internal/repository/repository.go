@@ -3,12 +3,14 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/lasthearth/vsservice/internal/pkg/mongox"
 	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
 // --- Trees ---
@@ -20,32 +22,63 @@ func (r *Repository) CreateTree(ctx context.Context, tree model.TalentTree) (*mo
 		Description: tree.Description,
 		Nodes:       toNodeDTOs(tree.Nodes),
 		Edges:       toEdgeDTOs(tree.Edges),
+		Version:     1,
 	}
 	if _, err := r.treesColl.InsertOne(ctx, d); err != nil {
 		return nil, err
 	}
-	return model.ReconstituteTalentTree(d.Id.Hex(), tree.Name, tree.Description, tree.Nodes, tree.Edges), nil
+	return model.ReconstituteTalentTree(d.Id.Hex(), tree.Name, tree.Description, tree.Nodes, tree.Edges, d.Version), nil
 }
 
-func (r *Repository) UpdateTree(ctx context.Context, tree model.TalentTree) (*model.TalentTree, error) {
-	oid, err := mongox.ParseObjectID(tree.Id)
+// PublishTreeVersion makes next, whose Version follows prev's, the latest
+// version of the tree and keeps prev readable through GetTreeVersion.
+//
+// prev is archived first: it is immutable while it is the latest version, so
+// archiving it again on a retry writes the same thing. The switch to next is
+// then conditional on prev still being the latest version; otherwise it
+// returns model.ErrTreeVersionConflict.
+func (r *Repository) PublishTreeVersion(ctx context.Context, prev *model.TalentTree, next model.TalentTree) (*model.TalentTree, error) {
+	oid, err := mongox.ParseObjectID(prev.Id)
 	if err != nil {
 		return nil, err
 	}
-	update := bson.M{"$set": bson.M{
-		"name":        tree.Name,
-		"description": tree.Description,
-		"nodes":       toNodeDTOs(tree.Nodes),
-		"edges":       toEdgeDTOs(tree.Edges),
-	}}
-	res, err := r.treesColl.UpdateByID(ctx, oid, update)
+
+	archived := dto.TalentTreeVersion{
+		Model:       mongox.NewModel(),
+		TreeId:      oid,
+		Version:     prev.Version,
+		Name:        prev.Name,
+		Description: prev.Description,
+		Nodes:       toNodeDTOs(prev.Nodes),
+		Edges:       toEdgeDTOs(prev.Edges),
+	}
+	_, err = r.versionsColl.UpdateOne(ctx,
+		bson.M{"tree_id": oid, "version": prev.Version},
+		bson.M{"$setOnInsert": archived},
+		options.UpdateOne().SetUpsert(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.treesColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "version": versionFilter(prev.Version)},
+		bson.M{"$set": bson.M{
+			"name":        next.Name,
+			"description": next.Description,
+			"nodes":       toNodeDTOs(next.Nodes),
+			"edges":       toEdgeDTOs(next.Edges),
+			"version":     next.Version,
+			"updated_at":  time.Now(),
+		}},
+	)
 	if err != nil {
 		return nil, err
 	}
 	if res.MatchedCount == 0 {
-		return nil, mongo.ErrNoDocuments
+		return nil, model.ErrTreeVersionConflict
 	}
-	return &tree, nil
+	return &next, nil
 }
 
 func (r *Repository) GetTree(ctx context.Context, id string) (*model.TalentTree, error) {
@@ -60,6 +93,29 @@ func (r *Repository) GetTree(ctx context.Context, id string) (*model.TalentTree,
 	return fromTreeDTO(d), nil
 }
 
+// GetTreeVersion returns the given version of a tree, the latest or an
+// archived one.
+func (r *Repository) GetTreeVersion(ctx context.Context, id string, version int) (*model.TalentTree, error) {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return nil, err
+	}
+	head, err := r.GetTree(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if version == head.Version {
+		return head, nil
+	}
+
+	var d dto.TalentTreeVersion
+	err = r.versionsColl.FindOne(ctx, bson.M{"tree_id": oid, "version": version}).Decode(&d)
+	if err != nil {
+		return nil, err
+	}
+	return fromTreeVersionDTO(d), nil
+}
+
 func (r *Repository) ListTrees(ctx context.Context) ([]model.TalentTree, error) {
 	cur, err := r.treesColl.Find(ctx, bson.M{})
 	if err != nil {
@@ -172,12 +228,18 @@ func (r *Repository) GetOrCreateProgress(ctx context.Context, ownerType, settlem
 		return nil, err
 	}
 
-	// Create empty progress document.
+	// Create empty progress document, pinned to the latest tree version.
+	var head dto.TalentTree
+	err = r.treesColl.FindOne(ctx, bson.M{"_id": treeOid}, options.FindOne().SetProjection(bson.M{"version": 1})).Decode(&head)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
 	d = dto.TalentProgress{
 		Model:          mongox.NewModel(),
 		OwnerType:      ownerType,
 		TreeId:         treeOid,
 		PurchasedNodes: []dto.PurchasedNode{},
+		TreeVersion:    max(head.Version, 1),
 	}
 	if settlementId != "" {
 		d.SettlementId = settlementOid
@@ -192,21 +254,123 @@ func (r *Repository) GetOrCreateProgress(ctx context.Context, ownerType, settlem
 	return fromProgressDTO(d), nil
 }
 
-func (r *Repository) SaveProgress(ctx context.Context, progress model.TalentProgress) error {
+// SaveProgress writes the progress's nodes, pending purchases and refunds,
+// provided it is still at progress.Version, and advances the version.
+// Otherwise it returns model.ErrProgressChanged and writes nothing.
+func (r *Repository) SaveProgress(ctx context.Context, progress *model.TalentProgress) error {
 	oid, err := mongox.ParseObjectID(progress.Id)
 	if err != nil {
 		return err
 	}
-	nodes := make([]dto.PurchasedNode, len(progress.PurchasedNodes))
-	for i, n := range progress.PurchasedNodes {
-		nodes[i] = dto.PurchasedNode{
-			NodeId:                n.NodeId,
-			PurchasedAt:           n.PurchasedAt,
-			PurchasedBySettlement: n.PurchasedBySettlement,
+	err = r.saveGuarded(ctx,
+		bson.M{"_id": oid, "version": revisionFilter(progress.Version)},
+		bson.M{
+			"purchased_nodes":   toPurchasedNodeDTOs(progress.PurchasedNodes),
+			"pending_purchases": toPendingPurchaseDTOs(progress.PendingPurchases),
+			"pending_refunds":   toRefundDTOs(progress.PendingRefunds),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	progress.MarkSaved()
+	return nil
+}
+
+// ListSettlementNodes implements progressionuc.NodesRepo.
+func (r *Repository) ListSettlementNodes(ctx context.Context, settlementId string) (map[string][]string, error) {
+	oid, err := mongox.ParseObjectID(settlementId)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := r.progressColl.Find(ctx, bson.M{
+		"owner_type":    string(model.OwnerTypeSettlement),
+		"settlement_id": oid,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var docs []dto.TalentProgress
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	out := make(map[string][]string, len(docs))
+	for _, d := range docs {
+		for _, n := range d.PurchasedNodes {
+			out[d.TreeId.Hex()] = append(out[d.TreeId.Hex()], n.NodeId)
+		}
+	}
+	return out, nil
+}
+
+// ListNodeEffects returns the effects of the nodes the owner has purchased,
+// across all its trees, each read from the tree version the progress is
+// pinned to. Nodes missing from that version are skipped.
+func (r *Repository) ListNodeEffects(ctx context.Context, owner model.BonusOwner) ([]model.NodeEffect, error) {
+	filter, err := ownerFilter(owner)
+	if err != nil {
+		return nil, err
+	}
+	cur, err := r.progressColl.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var docs []dto.TalentProgress
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	type pinned struct {
+		treeId  string
+		version int
+	}
+	trees := map[pinned]*model.TalentTree{}
+	var out []model.NodeEffect
+	for _, d := range docs {
+		if len(d.PurchasedNodes) == 0 {
+			continue
+		}
+		p := fromProgressDTO(d)
+		key := pinned{p.TreeId, p.TreeVersion}
+		tree, ok := trees[key]
+		if !ok {
+			tree, err = r.GetTreeVersion(ctx, p.TreeId, p.TreeVersion)
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			trees[key] = tree
+		}
+		for _, n := range p.PurchasedNodes {
+			if node := tree.Node(n.NodeId); node != nil {
+				out = append(out, node.Effects...)
+			}
+		}
+	}
+	return out, nil
+}
+
+// ownerFilter selects the progress documents of owner.
+func ownerFilter(owner model.BonusOwner) (bson.M, error) {
+	filter := bson.M{"owner_type": string(owner.Type)}
+	switch owner.Type {
+	case model.OwnerTypeSettlement:
+		oid, err := mongox.ParseObjectID(owner.SettlementId)
+		if err != nil {
+			return nil, err
 		}
+		filter["settlement_id"] = oid
+	case model.OwnerTypePointSide:
+		oid, err := mongox.ParseObjectID(owner.PointId)
+		if err != nil {
+			return nil, err
+		}
+		filter["point_id"] = oid
+		filter["side"] = owner.Side
 	}
-	_, err = r.progressColl.UpdateByID(ctx, oid, bson.M{"$set": bson.M{"purchased_nodes": nodes}})
-	return err
+	return filter, nil
 }
 
 // --- helpers ---
@@ -214,7 +378,11 @@ func (r *Repository) SaveProgress(ctx context.Context, progress model.TalentProg
 func toNodeDTOs(nodes []model.TalentNode) []dto.TalentNode {
 	out := make([]dto.TalentNode, len(nodes))
 	for i, n := range nodes {
-		out[i] = dto.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effect: n.Effect, CostBi: n.CostBi}
+		effects := make([]dto.NodeEffect, len(n.Effects))
+		for j, e := range n.Effects {
+			effects[j] = dto.NodeEffect{Kind: string(e.Kind), Key: e.Key, Op: string(e.Op), Value: e.Value}
+		}
+		out[i] = dto.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effects: effects, CostBi: n.CostBi}
 	}
 	return out
 }
@@ -228,21 +396,88 @@ func toEdgeDTOs(edges []model.TalentEdge) []dto.TalentEdge {
 }
 
 func fromTreeDTO(d dto.TalentTree) *model.TalentTree {
-	nodes := make([]model.TalentNode, len(d.Nodes))
-	for i, n := range d.Nodes {
-		nodes[i] = model.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effect: n.Effect, CostBi: n.CostBi}
+	return model.ReconstituteTalentTree(d.Id.Hex(), d.Name, d.Description, fromNodeDTOs(d.Nodes), fromEdgeDTOs(d.Edges), max(d.Version, 1))
+}
+
+func fromTreeVersionDTO(d dto.TalentTreeVersion) *model.TalentTree {
+	return model.ReconstituteTalentTree(d.TreeId.Hex(), d.Name, d.Description, fromNodeDTOs(d.Nodes), fromEdgeDTOs(d.Edges), d.Version)
+}
+
+func fromNodeDTOs(nodes []dto.TalentNode) []model.TalentNode {
+	out := make([]model.TalentNode, len(nodes))
+	for i, n := range nodes {
+		out[i] = model.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effects: fromEffectDTOs(n), CostBi: n.CostBi}
+	}
+	return out
+}
+
+func fromEdgeDTOs(edges []dto.TalentEdge) []model.TalentEdge {
+	out := make([]model.TalentEdge, len(edges))
+	for i, e := range edges {
+		out[i] = model.TalentEdge{From: e.From, To: e.To}
 	}
-	edges := make([]model.TalentEdge, len(d.Edges))
-	for i, e := range d.Edges {
-		edges[i] = model.TalentEdge{From: e.From, To: e.To}
+	return out
+}
+
+// versionFilter matches a tree or progress version; version 1 also matches
+// documents stored before versions, which have none.
+func versionFilter(version int) bson.M {
+	if version == 1 {
+		return bson.M{"$in": bson.A{1, nil}}
 	}
-	return model.ReconstituteTalentTree(d.Id.Hex(), d.Name, d.Description, nodes, edges)
+	return bson.M{"$eq": version}
+}
+
+// revisionFilter matches a progress at version; version 0 is a progress
+// never saved with one.
+func revisionFilter(version int) bson.M {
+	if version == 0 {
+		return bson.M{"$exists": false}
+	}
+	return bson.M{"$eq": version}
+}
+
+// fromEffectDTOs returns the node's typed effects, or its legacy free-text
+// effect converted when it has none.
+func fromEffectDTOs(n dto.TalentNode) []model.NodeEffect {
+	if len(n.Effects) == 0 {
+		if e, ok := model.ParseLegacyEffect(n.Effect); ok {
+			return []model.NodeEffect{e}
+		}
+		return nil
+	}
+	out := make([]model.NodeEffect, len(n.Effects))
+	for i, e := range n.Effects {
+		out[i] = model.NodeEffect{Kind: model.EffectKind(e.Kind), Key: e.Key, Op: model.ModifierOp(e.Op), Value: e.Value}
+	}
+	return out
+}
+
+func toPurchasedNodeDTOs(nodes []model.PurchasedNode) []dto.PurchasedNode {
+	out := make([]dto.PurchasedNode, len(nodes))
+	for i, n := range nodes {
+		out[i] = dto.PurchasedNode{
+			NodeId:                n.NodeId,
+			PurchasedAt:           n.PurchasedAt,
+			PurchasedBySettlement: n.PurchasedBySettlement,
+			PurchaseId:            n.PurchaseId,
+		}
+	}
+	return out
+}
+
+func toPendingPurchaseDTOs(purchases []model.PendingPurchase) []dto.PendingPurchase {
+	out := make([]dto.PendingPurchase, len(purchases))
+	for i, p := range purchases {
+		out[i] = dto.PendingPurchase(p)
+	}
+	return out
 }
 
 func fromProgressDTO(d dto.TalentProgress) *model.TalentProgress {
 	nodes := make([]model.PurchasedNode, len(d.PurchasedNodes))
 	for i, n := range d.PurchasedNodes {
-		nodes[i] = model.PurchasedNode{NodeId: n.NodeId, PurchasedAt: n.PurchasedAt, PurchasedBySettlement: n.PurchasedBySettlement}
+		nodes[i] = model.PurchasedNode{NodeId: n.NodeId, PurchasedAt: n.PurchasedAt, PurchasedBySettlement: n.PurchasedBySettlement, PurchaseId: n.PurchaseId}
 	}
 	settlementId := ""
 	if !d.SettlementId.IsZero() {
@@ -252,6 +487,19 @@ func fromProgressDTO(d dto.TalentProgress) *model.TalentProgress {
 	if !d.PointId.IsZero() {
 		pointId = d.PointId.Hex()
 	}
+	var refunds []model.FavorRefund
+	for _, ref := range d.PendingRefunds {
+		refunds = append(refunds, model.FavorRefund{
+			SettlementId: ref.SettlementId,
+			Amount:       ref.Amount,
+			Reason:       ref.Reason,
+			OpKey:        ref.OpKey,
+		})
+	}
+	var purchases []model.PendingPurchase
+	for _, pp := range d.PendingPurchases {
+		purchases = append(purchases, model.PendingPurchase(pp))
+	}
 	return model.ReconstituteTalentProgress(
 		d.Id.Hex(),
 		model.OwnerType(d.OwnerType),
@@ -260,5 +508,20 @@ func fromProgressDTO(d dto.TalentProgress) *model.TalentProgress {
 		d.Side,
 		d.TreeId.Hex(),
 		nodes,
+		model.ProgressState{
+			TreeVersion:      max(d.TreeVersion, 1),
+			RespecAt:         d.RespecAt,
+			PendingRefunds:   refunds,
+			PendingPurchases: purchases,
+			Version:          d.Version,
+		},
 	)
 }
+
+func toRefundDTOs(refunds []model.FavorRefund) []dto.FavorRefund {
+	out := make([]dto.FavorRefund, len(refunds))
+	for i, ref := range refunds {
+		out[i] = dto.FavorRefund{SettlementId: ref.SettlementId, Amount: ref.Amount, Reason: ref.Reason, OpKey: ref.OpKey}
+	}
+	return out
+}
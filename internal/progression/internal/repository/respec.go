@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// SaveRespec writes a respecced progress — its remaining nodes, respec time
+// and pending refunds — in one update, provided its purchased nodes are still
+// before and its version progress.Version. Otherwise it returns
+// model.ErrProgressChanged and writes nothing, so a purchase or respec that
+// raced it is neither lost nor refunded twice.
+func (r *Repository) SaveRespec(ctx context.Context, progress model.TalentProgress, before []model.PurchasedNode) error {
+	oid, err := mongox.ParseObjectID(progress.Id)
+	if err != nil {
+		return err
+	}
+	return r.saveGuarded(ctx,
+		bson.M{"_id": oid, "version": revisionFilter(progress.Version), "purchased_nodes": toPurchasedNodeDTOs(before)},
+		bson.M{
+			"purchased_nodes": toPurchasedNodeDTOs(progress.PurchasedNodes),
+			"respec_at":       progress.RespecAt,
+			"pending_refunds": toRefundDTOs(progress.PendingRefunds),
+		},
+	)
+}
+
+// saveGuarded sets fields on the progress filter matches and advances its
+// version, or returns model.ErrProgressChanged when it matches none.
+func (r *Repository) saveGuarded(ctx context.Context, filter, fields bson.M) error {
+	res, err := r.progressColl.UpdateOne(ctx, filter, bson.M{"$set": fields, "$inc": bson.M{"version": 1}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return model.ErrProgressChanged
+	}
+	return nil
+}
+
+// ClearRefund drops a pending refund of the progress once it is credited.
+func (r *Repository) ClearRefund(ctx context.Context, progressId, opKey string) error {
+	oid, err := mongox.ParseObjectID(progressId)
+	if err != nil {
+		return err
+	}
+	_, err = r.progressColl.UpdateByID(ctx, oid, bson.M{
+		"$pull": bson.M{"pending_refunds": bson.M{"op_key": opKey}},
+		"$inc":  bson.M{"version": 1},
+	})
+	return err
+}
+
+// ListPendingRefunds returns the progress that still owes a refund.
+func (r *Repository) ListPendingRefunds(ctx context.Context) ([]model.TalentProgress, error) {
+	return r.findProgress(ctx, bson.M{"pending_refunds.0": bson.M{"$exists": true}})
+}
+
+// ListPendingPurchases returns the progress with a purchase pending since
+// before startedBefore.
+func (r *Repository) ListPendingPurchases(ctx context.Context, startedBefore time.Time) ([]model.TalentProgress, error) {
+	return r.findProgress(ctx, bson.M{"pending_purchases.started_at": bson.M{"$lt": startedBefore}})
+}
+
+func (r *Repository) findProgress(ctx context.Context, filter bson.M) ([]model.TalentProgress, error) {
+	cur, err := r.progressColl.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	var docs []dto.TalentProgress
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	out := make([]model.TalentProgress, len(docs))
+	for i, d := range docs {
+		out[i] = *fromProgressDTO(d)
+	}
+	return out, nil
+}
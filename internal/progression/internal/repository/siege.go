@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/progression/internal/dto"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// DeclareSiege stores siege on the point unless another siege is still
+// active, in which case it returns model.ErrPointContested.
+func (r *Repository) DeclareSiege(ctx context.Context, pointId string, siege model.PointSiege) error {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return err
+	}
+	d, err := toSiegeDTO(siege)
+	if err != nil {
+		return err
+	}
+	res, err := r.pointsColl.UpdateOne(ctx,
+		bson.M{
+			"_id": oid,
+			"$or": bson.A{
+				bson.M{"siege": nil},
+				bson.M{"siege.ends_at": bson.M{"$lte": siege.DeclaredAt}},
+			},
+		},
+		bson.M{"$set": bson.M{"siege": d}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return model.ErrPointContested
+	}
+	return nil
+}
+
+// ReportSiegeProgress raises the capture progress of the active siege to
+// progress, never lowering it, and returns the siege as stored. It returns
+// model.ErrSiegeNotActive when the siege ended, expired or was replaced.
+func (r *Repository) ReportSiegeProgress(ctx context.Context, pointId, siegeId string, progress int, now time.Time) (*model.PointSiege, error) {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return nil, err
+	}
+	var d dto.ImperialPoint
+	err = r.pointsColl.FindOneAndUpdate(ctx,
+		bson.M{"_id": oid, "siege.id": siegeId, "siege.ends_at": bson.M{"$gt": now}},
+		bson.M{"$max": bson.M{"siege.progress": progress}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&d)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, model.ErrSiegeNotActive
+	}
+	if err != nil {
+		return nil, err
+	}
+	return fromPointDTO(d).Siege, nil
+}
+
+// EndSiege removes the siege from the point. Only one caller can end a given
+// siege; the others get model.ErrSiegeNotActive, so it doubles as the claim
+// on resolving it.
+func (r *Repository) EndSiege(ctx context.Context, pointId, siegeId string) error {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return err
+	}
+	res, err := r.pointsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "siege.id": siegeId},
+		bson.M{"$unset": bson.M{"siege": ""}},
+	)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return model.ErrSiegeNotActive
+	}
+	return nil
+}
+
+// RestoreSiege puts back a siege ended by a capture that could not be
+// applied, unless a new siege was declared since.
+func (r *Repository) RestoreSiege(ctx context.Context, pointId string, siege model.PointSiege) error {
+	oid, err := mongox.ParseObjectID(pointId)
+	if err != nil {
+		return err
+	}
+	d, err := toSiegeDTO(siege)
+	if err != nil {
+		return err
+	}
+	_, err = r.pointsColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "siege": nil},
+		bson.M{"$set": bson.M{"siege": d}},
+	)
+	return err
+}
+
+func toSiegeDTO(s model.PointSiege) (dto.PointSiege, error) {
+	attacker, err := mongox.ParseObjectID(s.AttackerSettlementId)
+	if err != nil {
+		return dto.PointSiege{}, err
+	}
+	d := dto.PointSiege{
+		Id:                   s.Id,
+		AttackerSettlementId: attacker,
+		AttackerSide:         s.AttackerSide,
+		DeclaredAt:           s.DeclaredAt,
+		EndsAt:               s.EndsAt,
+		Progress:             s.Progress,
+	}
+	if s.DefenderSettlementId != "" {
+		if d.DefenderSettlementId, err = mongox.ParseObjectID(s.DefenderSettlementId); err != nil {
+			return dto.PointSiege{}, err
+		}
+	}
+	return d, nil
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.uber.org/zap"
+)
+
+// RunFavorAccrual runs AccrueFavor, and SettlePurchases and SettleRefunds
+// with it, every interval until ctx is done.
+func (s *Service) RunFavorAccrual(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	l := s.log.WithMethod("RunFavorAccrual")
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.AccrueFavor(ctx, now); err != nil {
+				l.Error("favor accrual failed", zap.Error(err))
+			}
+			if err := s.SettlePurchases(ctx); err != nil {
+				l.Error("purchase settlement failed", zap.Error(err))
+			}
+			if err := s.SettleRefunds(ctx); err != nil {
+				l.Error("refund settlement failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// AccrueFavor pays every point's BiRatePerHour, prorated by hold duration, to
+// the settlements that held it up to now: first the holds closed by a
+// handover, then the current controller.
+//
+// Each payout is claimed in the accrual collection before it is credited, and
+// credited once per claim, so replicas running at the same time — or a rerun
+// after a crash — pay every stretch exactly once. A point that fails is
+// logged and retried on the next run.
+func (s *Service) AccrueFavor(ctx context.Context, now time.Time) error {
+	l := s.log.WithMethod("AccrueFavor")
+
+	points, err := s.repo.ListPoints(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range points {
+		if err := s.accruePoint(ctx, &points[i], now); err != nil {
+			l.Error("failed to accrue point favor", zap.String("point_id", points[i].Id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *Service) accruePoint(ctx context.Context, point *model.ImperialPoint, now time.Time) error {
+	for _, hold := range point.UnpaidHolds {
+		if _, err := s.payHold(ctx, point, hold.SettlementId, hold.From, hold.To); err != nil {
+			return err
+		}
+		// What is left is under one unit of favor and is dropped with the hold.
+		if err := s.repo.SettleHold(ctx, point.Id, hold); err != nil {
+			return err
+		}
+	}
+
+	ctrl := point.Control
+	if ctrl == nil || ctrl.SettlementId == "" {
+		return nil
+	}
+	paidUntil, err := s.payHold(ctx, point, ctrl.SettlementId, ctrl.AccrualStart(), now)
+	if err != nil {
+		return err
+	}
+	if !paidUntil.After(ctrl.AccrualStart()) {
+		return nil
+	}
+	return s.repo.AdvanceAccrual(ctx, point.Id, ctrl.SettlementId, ctrl.ControlledSince, paidUntil)
+}
+
+// payHold credits settlementID for holding point from from to to and returns
+// the instant it has been paid up to. Stretches claimed before, by this or
+// another replica, are re-credited idempotently rather than paid again.
+func (s *Service) payHold(ctx context.Context, point *model.ImperialPoint, settlementID string, from, to time.Time) (time.Time, error) {
+	cursor := from
+	for cursor.Before(to) {
+		amount, until := model.AccrueFavor(point.BiRatePerHour, cursor, to)
+		if amount == 0 {
+			break
+		}
+
+		claimed, err := s.repo.ClaimAccrual(ctx, model.FavorAccrual{
+			PointId:      point.Id,
+			SettlementId: settlementID,
+			From:         cursor,
+			To:           until,
+			Amount:       amount,
+		})
+		if err != nil {
+			return cursor, err
+		}
+
+		err = s.creditor.Credit(ctx, settlementID, claimed.Amount, "imperial point income: "+point.Name, claimed.OpKey())
+		if errors.Is(err, settlementuc.ErrSettlementNotFound) {
+			// The settlement is gone; its income is forfeit.
+			return to, nil
+		}
+		if err != nil {
+			return cursor, err
+		}
+		cursor = claimed.To
+	}
+	return cursor, nil
+}
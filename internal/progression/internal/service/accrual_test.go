@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+)
+
+// accrualRepo adds the accrual claims to fakeRepo.
+type accrualRepo struct {
+	*fakeRepo
+	claims map[string]model.FavorAccrual
+}
+
+func (r *accrualRepo) ClaimAccrual(_ context.Context, a model.FavorAccrual) (*model.FavorAccrual, error) {
+	key := fmt.Sprintf("%s|%s|%d", a.PointId, a.SettlementId, a.From.UnixNano())
+	if stored, ok := r.claims[key]; ok {
+		return &stored, nil
+	}
+	a.SetId(fmt.Sprintf("a%d", len(r.claims)+1))
+	r.claims[key] = a
+	return &a, nil
+}
+
+func (r *accrualRepo) AdvanceAccrual(_ context.Context, _, settlementId string, controlledSince, until time.Time) error {
+	c := r.point.Control
+	if c != nil && c.SettlementId == settlementId && c.ControlledSince.Equal(controlledSince) {
+		c.AdvanceAccrual(until)
+	}
+	return nil
+}
+
+func (r *accrualRepo) SettleHold(_ context.Context, _ string, hold model.PointHold) error {
+	var kept []model.PointHold
+	for _, h := range r.point.UnpaidHolds {
+		if h.SettlementId != hold.SettlementId || !h.From.Equal(hold.From) {
+			kept = append(kept, h)
+		}
+	}
+	r.point.RestoreUnpaidHolds(kept)
+	return nil
+}
+
+// fakeCreditor credits once per op key, like settlementuc.FavorOps.
+type fakeCreditor struct {
+	paid  map[string]bool
+	favor map[string]int64
+}
+
+func (c *fakeCreditor) Credit(_ context.Context, settlementID string, amount int64, _, opKey string) error {
+	if c.paid[opKey] {
+		return nil
+	}
+	c.paid[opKey] = true
+	c.favor[settlementID] += amount
+	return nil
+}
+
+func newAccrualService(t *testing.T, control *model.PointControl) (*Service, *accrualRepo, *fakeCreditor) {
+	t.Helper()
+	repo := &accrualRepo{
+		fakeRepo: &fakeRepo{
+			point:    &model.ImperialPoint{Id: testPointID, BiRatePerHour: 60, Control: control},
+			progress: map[string]*model.TalentProgress{},
+		},
+		claims: map[string]model.FavorAccrual{},
+	}
+	creditor := &fakeCreditor{paid: map[string]bool{}, favor: map[string]int64{}}
+	svc := newTestService(t, repo)
+	svc.creditor = creditor
+	return svc, repo, creditor
+}
+
+func TestAccrueFavorSplitsIncomeAtHandover(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc, repo, creditor := newAccrualService(t, &model.PointControl{Side: sideEast, SettlementId: settlA, ControlledSince: t0})
+
+	if err := svc.AccrueFavor(context.Background(), t0.Add(20*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Settlement B takes the point 40 minutes in.
+	repo.point.CloseHold(t0.Add(40 * time.Minute))
+	repo.point.RestoreControl(&model.PointControl{Side: sideWest, SettlementId: settlB, ControlledSince: t0.Add(40 * time.Minute)})
+
+	if err := svc.AccrueFavor(context.Background(), t0.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	if creditor.favor[settlA] != 40 || creditor.favor[settlB] != 20 {
+		t.Fatalf("favor A = %d, B = %d; want 40 and 20", creditor.favor[settlA], creditor.favor[settlB])
+	}
+	if len(repo.point.UnpaidHolds) != 0 {
+		t.Fatalf("unpaid holds left: %+v", repo.point.UnpaidHolds)
+	}
+}
+
+func TestAccrueFavorPaysOnceAcrossReplicas(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc, repo, creditor := newAccrualService(t, &model.PointControl{Side: sideEast, SettlementId: settlA, ControlledSince: t0})
+
+	// A second replica read the point before the first advanced its cursor.
+	stale := clonePoint(repo.point)
+	if err := svc.AccrueFavor(context.Background(), t0.Add(30*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.accruePoint(context.Background(), stale, t0.Add(45*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+
+	if creditor.favor[settlA] != 45 {
+		t.Fatalf("favor = %d, want 45 (no stretch paid twice)", creditor.favor[settlA])
+	}
+	if got := repo.point.Control.AccruedUntil; !got.Equal(t0.Add(45 * time.Minute)) {
+		t.Fatalf("accrued until %v, want +45m", got)
+	}
+}
@@ -1,28 +1,60 @@
 package service
 
 import (
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/messaging"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 	"go.uber.org/fx"
 )
 
 type Opts struct {
 	fx.In
 
-	Log   logger.Logger
-	Repo  ProgressionRepository
-	Favor FavorDeductor
+	Log      logger.Logger
+	Repo     ProgressionRepository
+	Favor    FavorDeductor
+	Creditor FavorCreditor
+	BonusPub messaging.Publisher[BonusesChangedEvent]
+	Members  SettlementMembers
+	Notifier Notifier
+	Factions Factions
+	Types    SettlementTypes
+	Config   config.Config
 }
 
 type Service struct {
-	log   logger.Logger
-	repo  ProgressionRepository
-	favor FavorDeductor
+	log      logger.Logger
+	repo     ProgressionRepository
+	favor    FavorDeductor
+	creditor FavorCreditor
+	bonusPub messaging.Publisher[BonusesChangedEvent]
+	members  SettlementMembers
+	notifier Notifier
+	factions Factions
+	types    SettlementTypes
+	respec   model.RespecPolicy
+	// siegeWindow is how long a declared attack contests a point.
+	siegeWindow time.Duration
 }
 
 func New(opts Opts) *Service {
 	return &Service{
-		log:   opts.Log,
-		repo:  opts.Repo,
-		favor: opts.Favor,
+		log:      opts.Log,
+		repo:     opts.Repo,
+		favor:    opts.Favor,
+		creditor: opts.Creditor,
+		bonusPub: opts.BonusPub,
+		members:  opts.Members,
+		notifier: opts.Notifier,
+		factions: opts.Factions,
+		types:    opts.Types,
+		respec: model.RespecPolicy{
+			RefundPercent: opts.Config.TalentRespecRefundPercent,
+			Cooldown:      opts.Config.TalentRespecCooldown,
+		},
+		siegeWindow: opts.Config.ImperialPointSiegeWindow,
 	}
 }
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) GetEffectiveBonuses(ctx context.Context, req *progressionv1.GetEffectiveBonusesRequest) (*progressionv1.EffectiveBonuses, error) {
+	var owner model.BonusOwner
+	switch {
+	case req.GetSettlementId() != "" && req.GetPointId() == "" && req.GetSide() == "":
+		owner = model.SettlementOwner(req.GetSettlementId())
+	case req.GetSettlementId() == "" && req.GetPointId() != "" && req.GetSide() != "":
+		owner = model.PointSideOwner(req.GetPointId(), req.GetSide())
+	default:
+		return nil, status.Error(codes.InvalidArgument, "set either settlement_id or point_id and side")
+	}
+
+	bonuses, err := s.effectiveBonuses(ctx, owner)
+	if err != nil {
+		s.log.WithMethod("GetEffectiveBonuses").Error("failed", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return bonusesToProto(bonuses), nil
+}
+
+func (s *Service) effectiveBonuses(ctx context.Context, owner model.BonusOwner) (model.Bonuses, error) {
+	effects, err := s.repo.ListNodeEffects(ctx, owner)
+	if err != nil {
+		return model.Bonuses{}, err
+	}
+	return model.FoldBonuses(effects), nil
+}
+
+// refreshBonuses recomputes the owners' bonuses and publishes those that
+// differ from the last published ones. It runs after the change that may
+// have moved them is committed, so failures are logged rather than returned;
+// the snapshot is only advanced after a successful publish, so the next
+// refresh of that owner publishes anything missed.
+func (s *Service) refreshBonuses(ctx context.Context, owners ...model.BonusOwner) {
+	l := s.log.WithMethod("refreshBonuses")
+	for _, owner := range owners {
+		if err := s.refreshOwnerBonuses(ctx, owner); err != nil {
+			l.Error("failed to refresh bonuses",
+				zap.String("owner_type", string(owner.Type)),
+				zap.String("settlement_id", owner.SettlementId),
+				zap.String("point_id", owner.PointId),
+				zap.String("side", owner.Side),
+				zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) refreshOwnerBonuses(ctx context.Context, owner model.BonusOwner) error {
+	current, err := s.effectiveBonuses(ctx, owner)
+	if err != nil {
+		return err
+	}
+	published, err := s.repo.GetBonuses(ctx, owner)
+	if err != nil {
+		return err
+	}
+	// An owner never published before had no bonuses.
+	if published == nil {
+		published = &model.Bonuses{}
+	}
+	if current.Equal(*published) {
+		return nil
+	}
+
+	if err := s.bonusPub.Publish(ctx, bonusesChangedEvent(owner, current, time.Now())); err != nil {
+		return err
+	}
+	return s.repo.SaveBonuses(ctx, owner, current)
+}
+
+func bonusesChangedEvent(owner model.BonusOwner, b model.Bonuses, now time.Time) BonusesChangedEvent {
+	modifiers := make(map[string]StatBonusEvent, len(b.Modifiers))
+	for k, v := range b.Modifiers {
+		modifiers[k] = StatBonusEvent{Add: v.Add, Multiply: v.Multiply}
+	}
+	unlocks := b.Unlocks
+	if unlocks == nil {
+		unlocks = []string{}
+	}
+	return BonusesChangedEvent{
+		OwnerType:    string(owner.Type),
+		SettlementID: owner.SettlementId,
+		PointID:      owner.PointId,
+		Side:         owner.Side,
+		Modifiers:    modifiers,
+		Unlocks:      unlocks,
+		ChangedAt:    now,
+	}
+}
+
+func bonusesToProto(b model.Bonuses) *progressionv1.EffectiveBonuses {
+	modifiers := make(map[string]*progressionv1.StatBonus, len(b.Modifiers))
+	for k, v := range b.Modifiers {
+		modifiers[k] = &progressionv1.StatBonus{Add: v.Add, Multiply: v.Multiply}
+	}
+	return &progressionv1.EffectiveBonuses{Modifiers: modifiers, Unlocks: b.Unlocks}
+}
+
+func effectsToProto(effects []model.NodeEffect) []*progressionv1.NodeEffect {
+	out := make([]*progressionv1.NodeEffect, len(effects))
+	for i, e := range effects {
+		switch e.Kind {
+		case model.EffectKindUnlock:
+			out[i] = &progressionv1.NodeEffect{Effect: &progressionv1.NodeEffect_Unlock{
+				Unlock: &progressionv1.Unlock{Key: e.Key},
+			}}
+		default:
+			out[i] = &progressionv1.NodeEffect{Effect: &progressionv1.NodeEffect_Modifier{
+				Modifier: &progressionv1.StatModifier{Key: e.Key, Operation: modifierOpToProto(e.Op), Value: e.Value},
+			}}
+		}
+	}
+	return out
+}
+
+// protoEffectsToModel converts effects as given; an effect with neither
+// variant set, or an unspecified operation, becomes one Validate rejects.
+func protoEffectsToModel(effects []*progressionv1.NodeEffect) []model.NodeEffect {
+	out := make([]model.NodeEffect, len(effects))
+	for i, e := range effects {
+		switch {
+		case e.GetModifier() != nil:
+			m := e.GetModifier()
+			out[i] = model.NodeEffect{Kind: model.EffectKindModifier, Key: m.GetKey(), Op: modifierOpFromProto(m.GetOperation()), Value: m.GetValue()}
+		case e.GetUnlock() != nil:
+			out[i] = model.NodeEffect{Kind: model.EffectKindUnlock, Key: e.GetUnlock().GetKey()}
+		}
+	}
+	return out
+}
+
+func modifierOpToProto(op model.ModifierOp) progressionv1.StatModifier_Operation {
+	switch op {
+	case model.ModifierOpAdd:
+		return progressionv1.StatModifier_OPERATION_ADD
+	case model.ModifierOpMultiply:
+		return progressionv1.StatModifier_OPERATION_MULTIPLY
+	default:
+		return progressionv1.StatModifier_OPERATION_UNSPECIFIED
+	}
+}
+
+func modifierOpFromProto(op progressionv1.StatModifier_Operation) model.ModifierOp {
+	switch op {
+	case progressionv1.StatModifier_OPERATION_ADD:
+		return model.ModifierOpAdd
+	case progressionv1.StatModifier_OPERATION_MULTIPLY:
+		return model.ModifierOpMultiply
+	default:
+		return ""
+	}
+}
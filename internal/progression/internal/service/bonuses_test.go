@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type bonusRepo struct {
+	ProgressionRepository
+
+	effects   []model.NodeEffect
+	published *model.Bonuses
+}
+
+func (r *bonusRepo) ListNodeEffects(_ context.Context, _ model.BonusOwner) ([]model.NodeEffect, error) {
+	return r.effects, nil
+}
+
+func (r *bonusRepo) GetBonuses(_ context.Context, _ model.BonusOwner) (*model.Bonuses, error) {
+	return r.published, nil
+}
+
+func (r *bonusRepo) SaveBonuses(_ context.Context, _ model.BonusOwner, b model.Bonuses) error {
+	r.published = &b
+	return nil
+}
+
+type fakePublisher struct {
+	events []BonusesChangedEvent
+	err    error
+}
+
+func (p *fakePublisher) Publish(_ context.Context, e BonusesChangedEvent) error {
+	if p.err != nil {
+		return p.err
+	}
+	p.events = append(p.events, e)
+	return nil
+}
+
+func newBonusService(t *testing.T, repo *bonusRepo, pub *fakePublisher) *Service {
+	t.Helper()
+	svc := newTestService(t, repo)
+	svc.bonusPub = pub
+	return svc
+}
+
+func TestRefreshBonusesPublishesOnlyChanges(t *testing.T) {
+	repo := &bonusRepo{}
+	pub := &fakePublisher{}
+	svc := newBonusService(t, repo, pub)
+	owner := model.SettlementOwner(settlA)
+	ctx := context.Background()
+
+	// Nothing purchased: nothing to announce.
+	svc.refreshBonuses(ctx, owner)
+	if len(pub.events) != 0 {
+		t.Fatalf("published %d events for no bonuses", len(pub.events))
+	}
+
+	repo.effects = []model.NodeEffect{{Kind: model.EffectKindModifier, Key: model.MemberCapKey, Op: model.ModifierOpAdd, Value: 5}}
+	svc.refreshBonuses(ctx, owner)
+	svc.refreshBonuses(ctx, owner)
+	if len(pub.events) != 1 {
+		t.Fatalf("published %d events, want 1", len(pub.events))
+	}
+	if e := pub.events[0]; e.SettlementID != settlA || e.Modifiers[model.MemberCapKey].Add != 5 {
+		t.Fatalf("event = %+v", e)
+	}
+
+	// A failed publish leaves the snapshot behind, so the next refresh retries.
+	repo.effects = append(repo.effects, model.NodeEffect{Kind: model.EffectKindUnlock, Key: "forge"})
+	pub.err = errors.New("nats down")
+	svc.refreshBonuses(ctx, owner)
+	pub.err = nil
+	svc.refreshBonuses(ctx, owner)
+	if len(pub.events) != 2 {
+		t.Fatalf("published %d events, want 2", len(pub.events))
+	}
+}
+
+func TestGetEffectiveBonusesOwner(t *testing.T) {
+	repo := &bonusRepo{effects: []model.NodeEffect{{Kind: model.EffectKindUnlock, Key: "forge"}}}
+	svc := newBonusService(t, repo, &fakePublisher{})
+	ctx := context.Background()
+
+	got, err := svc.GetEffectiveBonuses(ctx, &progressionv1.GetEffectiveBonusesRequest{PointId: testPointID, Side: sideEast})
+	if err != nil {
+		t.Fatalf("GetEffectiveBonuses: %v", err)
+	}
+	if len(got.GetUnlocks()) != 1 || got.GetUnlocks()[0] != "forge" {
+		t.Fatalf("unlocks = %v, want [forge]", got.GetUnlocks())
+	}
+
+	for _, req := range []*progressionv1.GetEffectiveBonusesRequest{
+		{},
+		{SettlementId: settlA, PointId: testPointID, Side: sideEast},
+		{PointId: testPointID},
+	} {
+		if _, err := svc.GetEffectiveBonuses(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetEffectiveBonuses(%v) code = %v, want InvalidArgument", req, status.Code(err))
+		}
+	}
+}
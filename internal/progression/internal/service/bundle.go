@@ -0,0 +1,266 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/bundle"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ExportTree(ctx context.Context, req *progressionv1.ExportTreeRequest) (*httpbody.HttpBody, error) {
+	tree, err := s.repo.GetTree(ctx, req.GetTreeId())
+	if err != nil {
+		if isNotFound(err) {
+			return nil, status.Error(codes.NotFound, "tree not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return encodeBundle(bundle.New([]model.TalentTree{*tree}, nil), req.GetFormat())
+}
+
+func (s *Service) ExportPreset(ctx context.Context, req *progressionv1.ExportPresetRequest) (*httpbody.HttpBody, error) {
+	preset, err := s.repo.GetPreset(ctx, req.GetPresetId())
+	if err != nil {
+		if isNotFound(err) {
+			return nil, status.Error(codes.NotFound, "preset not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	trees := make([]model.TalentTree, 0, len(preset.TreeIds))
+	for _, id := range preset.TreeIds {
+		tree, err := s.repo.GetTree(ctx, id)
+		if err != nil {
+			if isNotFound(err) {
+				return nil, status.Error(codes.NotFound, fmt.Sprintf("tree %q of the preset not found", id))
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		trees = append(trees, *tree)
+	}
+	return encodeBundle(bundle.New(trees, preset), req.GetFormat())
+}
+
+func encodeBundle(b bundle.Bundle, format progressionv1.BundleFormat) (*httpbody.HttpBody, error) {
+	f := bundle.FormatJSON
+	if format == progressionv1.BundleFormat_BUNDLE_FORMAT_YAML {
+		f = bundle.FormatYAML
+	}
+	data, err := b.Encode(f)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &httpbody.HttpBody{ContentType: f.ContentType(), Data: data}, nil
+}
+
+// ImportTalents plans the import of a bundle against the trees and presets
+// here and, unless it is a dry run, applies it. Trees are applied before the
+// preset that lists them; a failure part way leaves the applied part in
+// place, and importing the file again finishes the rest.
+func (s *Service) ImportTalents(ctx context.Context, req *progressionv1.ImportTalentsRequest) (*progressionv1.ImportTalentsResponse, error) {
+	b, err := bundle.Decode(req.GetFile().GetData())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if violations := b.Validate(); len(violations) > 0 {
+		return nil, fieldViolationsError("invalid talent bundle", violations)
+	}
+
+	plan, err := s.planImport(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	if !req.GetDryRun() {
+		if err := s.applyImport(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+	return plan.toProto(req.GetDryRun()), nil
+}
+
+// importPlan is what importing a bundle does to each of its trees and to its
+// preset.
+type importPlan struct {
+	trees  []treeImport
+	preset *presetImport
+}
+
+type treeImport struct {
+	sourceId  string
+	candidate model.TalentTree
+	action    progressionv1.ImportAction
+	// existing is the tree matched by name; nil for a create.
+	existing *model.TalentTree
+	// result is the tree after the import, set once applied or when there is
+	// nothing to apply.
+	result *model.TalentTree
+}
+
+type presetImport struct {
+	source   bundle.Preset
+	action   progressionv1.ImportAction
+	existing *model.TalentPreset
+	result   *model.TalentPreset
+}
+
+func (s *Service) planImport(ctx context.Context, b *bundle.Bundle) (*importPlan, error) {
+	trees, err := s.repo.ListTrees(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	treesByName := make(map[string][]*model.TalentTree, len(trees))
+	for i := range trees {
+		treesByName[trees[i].Name] = append(treesByName[trees[i].Name], &trees[i])
+	}
+
+	plan := &importPlan{}
+	for _, t := range b.Trees {
+		ti := treeImport{sourceId: t.Id, candidate: t.Model(), action: progressionv1.ImportAction_IMPORT_ACTION_CREATE}
+		switch matches := treesByName[t.Name]; len(matches) {
+		case 0:
+		case 1:
+			ti.existing = matches[0]
+			ti.action = progressionv1.ImportAction_IMPORT_ACTION_UPDATE
+			if matches[0].SameContent(&ti.candidate) {
+				ti.action = progressionv1.ImportAction_IMPORT_ACTION_UNCHANGED
+				ti.result = matches[0]
+			}
+		default:
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%d trees are named %q", len(matches), t.Name))
+		}
+		plan.trees = append(plan.trees, ti)
+	}
+
+	if b.Preset == nil {
+		return plan, nil
+	}
+	presets, err := s.repo.ListPresets(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pi := &presetImport{source: *b.Preset, action: progressionv1.ImportAction_IMPORT_ACTION_CREATE}
+	for i := range presets {
+		if presets[i].Name != b.Preset.Name {
+			continue
+		}
+		if pi.existing != nil {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("several presets are named %q", b.Preset.Name))
+		}
+		pi.existing = &presets[i]
+	}
+	if pi.existing != nil {
+		pi.action = progressionv1.ImportAction_IMPORT_ACTION_UPDATE
+		// Known now only if every listed tree already exists here.
+		if ids, ok := plan.targetTreeIds(b.Preset.TreeIds); ok && slices.Equal(ids, pi.existing.TreeIds) {
+			pi.action = progressionv1.ImportAction_IMPORT_ACTION_UNCHANGED
+			pi.result = pi.existing
+		}
+	}
+	plan.preset = pi
+	return plan, nil
+}
+
+func (s *Service) applyImport(ctx context.Context, plan *importPlan) error {
+	l := s.log.WithMethod("ImportTalents")
+
+	for i := range plan.trees {
+		ti := &plan.trees[i]
+		switch ti.action {
+		case progressionv1.ImportAction_IMPORT_ACTION_CREATE:
+			created, err := s.repo.CreateTree(ctx, ti.candidate)
+			if err != nil {
+				l.Error("failed to create tree", zap.String("source_id", ti.sourceId), zap.Error(err))
+				return status.Error(codes.Internal, err.Error())
+			}
+			ti.result = created
+		case progressionv1.ImportAction_IMPORT_ACTION_UPDATE:
+			next := ti.candidate
+			next.NextVersionOf(ti.existing)
+			published, err := s.repo.PublishTreeVersion(ctx, ti.existing, next)
+			if err != nil {
+				if errors.Is(err, model.ErrTreeVersionConflict) {
+					return status.Error(codes.Aborted, err.Error())
+				}
+				l.Error("failed to publish tree version", zap.String("tree_id", ti.existing.Id), zap.Error(err))
+				return status.Error(codes.Internal, err.Error())
+			}
+			ti.result = published
+		}
+	}
+
+	pi := plan.preset
+	if pi == nil || pi.action == progressionv1.ImportAction_IMPORT_ACTION_UNCHANGED {
+		return nil
+	}
+	treeIds, _ := plan.targetTreeIds(pi.source.TreeIds)
+	var err error
+	if pi.existing == nil {
+		pi.result, err = s.repo.CreatePreset(ctx, *model.NewTalentPreset(pi.source.Name, treeIds))
+	} else {
+		pi.result, err = s.repo.UpdatePreset(ctx, *model.ReconstituteTalentPreset(pi.existing.Id, pi.existing.Name, treeIds))
+	}
+	if err != nil {
+		l.Error("failed to save preset", zap.String("source_id", pi.source.Id), zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// targetTreeIds maps tree ids of the bundle to the ids of the trees here. ok
+// is false if one of them is yet to be created.
+func (p *importPlan) targetTreeIds(sourceIds []string) (ids []string, ok bool) {
+	target := make(map[string]string, len(p.trees))
+	for _, ti := range p.trees {
+		switch {
+		case ti.result != nil:
+			target[ti.sourceId] = ti.result.Id
+		case ti.existing != nil:
+			target[ti.sourceId] = ti.existing.Id
+		}
+	}
+	ids = make([]string, 0, len(sourceIds))
+	for _, id := range sourceIds {
+		t, found := target[id]
+		if !found {
+			return nil, false
+		}
+		ids = append(ids, t)
+	}
+	return ids, true
+}
+
+func (p *importPlan) toProto(dryRun bool) *progressionv1.ImportTalentsResponse {
+	resp := &progressionv1.ImportTalentsResponse{DryRun: dryRun}
+	for _, ti := range p.trees {
+		out := &progressionv1.ImportedTree{SourceId: ti.sourceId, Name: ti.candidate.Name, Action: ti.action}
+		switch {
+		case ti.result != nil:
+			out.Id = ti.result.Id
+			out.Version = int32(ti.result.Version)
+		case ti.existing != nil:
+			out.Id = ti.existing.Id
+			out.Version = int32(ti.existing.Version + 1)
+		default:
+			out.Version = 1
+		}
+		resp.Trees = append(resp.Trees, out)
+	}
+	if pi := p.preset; pi != nil {
+		out := &progressionv1.ImportedPreset{SourceId: pi.source.Id, Name: pi.source.Name, Action: pi.action}
+		switch {
+		case pi.result != nil:
+			out.Id = pi.result.Id
+		case pi.existing != nil:
+			out.Id = pi.existing.Id
+		}
+		resp.Preset = out
+	}
+	return resp
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bundleRepo stores trees and presets in creation order.
+type bundleRepo struct {
+	ProgressionRepository
+
+	trees   []*model.TalentTree
+	presets []*model.TalentPreset
+	writes  int
+	// idPrefix names the created trees, "tree" when empty.
+	idPrefix string
+}
+
+func (r *bundleRepo) CreateTree(_ context.Context, tree model.TalentTree) (*model.TalentTree, error) {
+	r.writes++
+	prefix := r.idPrefix
+	if prefix == "" {
+		prefix = "tree"
+	}
+	created := model.ReconstituteTalentTree(fmt.Sprintf("%s-%d", prefix, len(r.trees)+1), tree.Name, tree.Description, tree.Nodes, tree.Edges, 1)
+	r.trees = append(r.trees, created)
+	return created, nil
+}
+
+func (r *bundleRepo) PublishTreeVersion(_ context.Context, prev *model.TalentTree, next model.TalentTree) (*model.TalentTree, error) {
+	r.writes++
+	for i, t := range r.trees {
+		if t.Id == prev.Id {
+			r.trees[i] = &next
+			return &next, nil
+		}
+	}
+	return nil, model.ErrTreeVersionConflict
+}
+
+func (r *bundleRepo) GetTree(_ context.Context, id string) (*model.TalentTree, error) {
+	for _, t := range r.trees {
+		if t.Id == id {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("tree %s: %w", id, mongo.ErrNoDocuments)
+}
+
+func (r *bundleRepo) ListTrees(_ context.Context) ([]model.TalentTree, error) {
+	out := make([]model.TalentTree, len(r.trees))
+	for i, t := range r.trees {
+		out[i] = *t
+	}
+	return out, nil
+}
+
+func (r *bundleRepo) CreatePreset(_ context.Context, preset model.TalentPreset) (*model.TalentPreset, error) {
+	r.writes++
+	created := model.ReconstituteTalentPreset(fmt.Sprintf("preset-%d", len(r.presets)+1), preset.Name, preset.TreeIds)
+	r.presets = append(r.presets, created)
+	return created, nil
+}
+
+func (r *bundleRepo) UpdatePreset(_ context.Context, preset model.TalentPreset) (*model.TalentPreset, error) {
+	r.writes++
+	for i, p := range r.presets {
+		if p.Id == preset.Id {
+			r.presets[i] = &preset
+			return &preset, nil
+		}
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (r *bundleRepo) GetPreset(_ context.Context, id string) (*model.TalentPreset, error) {
+	for _, p := range r.presets {
+		if p.Id == id {
+			return p, nil
+		}
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (r *bundleRepo) ListPresets(_ context.Context) ([]model.TalentPreset, error) {
+	out := make([]model.TalentPreset, len(r.presets))
+	for i, p := range r.presets {
+		out[i] = *p
+	}
+	return out, nil
+}
+
+// exportedPreset is a preset export from another environment.
+func exportedPreset(t *testing.T, format progressionv1.BundleFormat) *httpbody.HttpBody {
+	t.Helper()
+	// Ids differ from the importing side's on purpose.
+	staging := &bundleRepo{idPrefix: "staging"}
+	ctx := context.Background()
+	for _, name := range []string{"Оборона", "Торговля"} {
+		if _, err := staging.CreateTree(ctx, *model.NewTalentTree(name, "",
+			[]model.TalentNode{{Id: "a", Name: "A", CostBi: 5}, {Id: "b", Name: "B", CostBi: 10}},
+			[]model.TalentEdge{{From: "a", To: "b"}},
+		)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := staging.CreatePreset(ctx, *model.NewTalentPreset("Начальный", []string{"staging-2", "staging-1"})); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := newTestService(t, staging).ExportPreset(ctx, &progressionv1.ExportPresetRequest{PresetId: "preset-1", Format: format})
+	if err != nil {
+		t.Fatalf("ExportPreset: %v", err)
+	}
+	return file
+}
+
+func importActions(resp *progressionv1.ImportTalentsResponse) []progressionv1.ImportAction {
+	var out []progressionv1.ImportAction
+	for _, tr := range resp.GetTrees() {
+		out = append(out, tr.GetAction())
+	}
+	return append(out, resp.GetPreset().GetAction())
+}
+
+func TestImportTalentsRemapsAndIsIdempotent(t *testing.T) {
+	const (
+		create    = progressionv1.ImportAction_IMPORT_ACTION_CREATE
+		update    = progressionv1.ImportAction_IMPORT_ACTION_UPDATE
+		unchanged = progressionv1.ImportAction_IMPORT_ACTION_UNCHANGED
+	)
+	ctx := context.Background()
+	file := exportedPreset(t, progressionv1.BundleFormat_BUNDLE_FORMAT_YAML)
+	repo := &bundleRepo{}
+	svc := newTestService(t, repo)
+
+	dry, err := svc.ImportTalents(ctx, &progressionv1.ImportTalentsRequest{File: file, DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if got := importActions(dry); !slices.Equal(got, []progressionv1.ImportAction{create, create, create}) {
+		t.Fatalf("dry run actions = %v, want all create", got)
+	}
+	if repo.writes != 0 {
+		t.Fatalf("dry run wrote %d times", repo.writes)
+	}
+
+	if _, err := svc.ImportTalents(ctx, &progressionv1.ImportTalentsRequest{File: file}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if len(repo.trees) != 2 || len(repo.presets) != 1 {
+		t.Fatalf("imported %d trees and %d presets, want 2 and 1", len(repo.trees), len(repo.presets))
+	}
+	// The preset lists the imported trees in the staging preset's order.
+	var names []string
+	for _, id := range repo.presets[0].TreeIds {
+		tree, err := repo.GetTree(ctx, id)
+		if err != nil {
+			t.Fatalf("preset lists %s: %v", id, err)
+		}
+		names = append(names, tree.Name)
+	}
+	if want := []string{"Торговля", "Оборона"}; !slices.Equal(names, want) {
+		t.Fatalf("preset trees = %v, want %v", names, want)
+	}
+
+	again, err := svc.ImportTalents(ctx, &progressionv1.ImportTalentsRequest{File: file})
+	if err != nil {
+		t.Fatalf("second import: %v", err)
+	}
+	if got := importActions(again); !slices.Equal(got, []progressionv1.ImportAction{unchanged, unchanged, unchanged}) {
+		t.Fatalf("second import actions = %v, want all unchanged", got)
+	}
+
+	// A tree edited here gets the file's content back as a new version.
+	edited := repo.trees[0]
+	repo.trees[0] = model.ReconstituteTalentTree(edited.Id, edited.Name, edited.Description,
+		[]model.TalentNode{{Id: "a", Name: "A", CostBi: 50}, edited.Nodes[1]}, edited.Edges, edited.Version)
+	back, err := svc.ImportTalents(ctx, &progressionv1.ImportTalentsRequest{File: file})
+	if err != nil {
+		t.Fatalf("third import: %v", err)
+	}
+	if got := importActions(back); !slices.Equal(got, []progressionv1.ImportAction{update, unchanged, unchanged}) {
+		t.Fatalf("third import actions = %v, want the edited tree updated", got)
+	}
+	if repo.trees[0].Version != 2 || repo.trees[0].Nodes[0].CostBi != 5 {
+		t.Fatalf("edited tree = version %d cost %d, want version 2 cost 5", repo.trees[0].Version, repo.trees[0].Nodes[0].CostBi)
+	}
+}
+
+func TestImportTalentsRejectsInvalidBundle(t *testing.T) {
+	svc := newTestService(t, &bundleRepo{})
+	cases := map[string]string{
+		"unparsable":   "version: [",
+		"invalid tree": `{"version": 1, "trees": [{"id": "t", "name": "x", "nodes": [{"id": "a", "name": "A", "cost_bi": -1}]}]}`,
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := svc.ImportTalents(context.Background(), &progressionv1.ImportTalentsRequest{
+				File: &httpbody.HttpBody{Data: []byte(data)},
+			})
+			if status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+			}
+		})
+	}
+}
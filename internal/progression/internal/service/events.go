@@ -0,0 +1,23 @@
+package service
+
+import "time"
+
+// BonusesChangedSubject is the NATS subject BonusesChangedEvent is published on.
+const BonusesChangedSubject = "progression.bonuses.changed"
+
+// BonusesChangedEvent carries an owner's aggregated bonuses after they
+// changed. It holds the whole aggregate, so a consumer replaces what it had.
+type BonusesChangedEvent struct {
+	OwnerType    string                    `json:"owner_type"`
+	SettlementID string                    `json:"settlement_id,omitempty"`
+	PointID      string                    `json:"point_id,omitempty"`
+	Side         string                    `json:"side,omitempty"`
+	Modifiers    map[string]StatBonusEvent `json:"modifiers"`
+	Unlocks      []string                  `json:"unlocks"`
+	ChangedAt    time.Time                 `json:"changed_at"`
+}
+
+type StatBonusEvent struct {
+	Add      float64 `json:"add"`
+	Multiply float64 `json:"multiply"`
+}
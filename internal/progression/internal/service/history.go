@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultStatsPeriod is how far back GetControlStats looks when since is not
+// given.
+const defaultStatsPeriod = 7 * 24 * time.Hour
+
+// recordControl adds a committed control change to the point's history: it
+// closes prev's period and opens next's. Failures are logged; the change is
+// already persisted, and the current control stands in for a missing open
+// period when history is read.
+func (s *Service) recordControl(ctx context.Context, l logger.Logger, pointId string, prev, next *model.PointControl) {
+	end := time.Now()
+	var periods []model.ControlPeriod
+	if next != nil {
+		end = next.ControlledSince
+	}
+	if prev != nil && prev.SettlementId != "" {
+		periods = append(periods, prev.Period(pointId, end))
+	}
+	if next != nil && next.SettlementId != "" {
+		periods = append(periods, next.Period(pointId, time.Time{}))
+	}
+	for _, p := range periods {
+		if err := s.repo.SaveControlPeriod(ctx, p); err != nil {
+			l.Error("failed to record control history",
+				zap.String("settlement_id", p.SettlementId), zap.Time("from", p.From), zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) GetPointTimeline(ctx context.Context, req *imperialpointv1.GetPointTimelineRequest) (*imperialpointv1.PointTimeline, error) {
+	since, until, err := statsRange(req.GetSince(), req.GetUntil(), false)
+	if err != nil {
+		return nil, err
+	}
+	point, err := s.repo.GetPoint(ctx, req.GetPointId())
+	if err != nil {
+		if isNotFound(err) {
+			return nil, status.Error(codes.NotFound, "point not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	periods, err := s.repo.ListControlPeriods(ctx, point.Id, since, until)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	periods = withCurrentControl(periods, []model.ImperialPoint{*point}, since, until)
+
+	out := make([]*imperialpointv1.ControlPeriod, len(periods))
+	for i, p := range periods {
+		out[i] = controlPeriodToProto(p)
+	}
+	return &imperialpointv1.PointTimeline{PointId: point.Id, Periods: out}, nil
+}
+
+func (s *Service) GetControlStats(ctx context.Context, req *imperialpointv1.GetControlStatsRequest) (*imperialpointv1.ControlStats, error) {
+	since, until, err := statsRange(req.GetSince(), req.GetUntil(), true)
+	if err != nil {
+		return nil, err
+	}
+	periods, err := s.repo.ListControlPeriods(ctx, "", since, until)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	points, err := s.repo.ListPoints(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	periods = withCurrentControl(periods, points, since, until)
+
+	bySettlement, bySide := model.HoldTimes(periods, since, until)
+	resp := &imperialpointv1.ControlStats{
+		Since:       timestamppb.New(since),
+		Until:       timestamppb.New(until),
+		Settlements: make([]*imperialpointv1.SettlementHoldTime, len(bySettlement)),
+		Sides:       make([]*imperialpointv1.SideHoldTime, len(bySide)),
+	}
+	for i, h := range bySettlement {
+		resp.Settlements[i] = &imperialpointv1.SettlementHoldTime{
+			SettlementId: h.Key,
+			HeldSeconds:  int64(h.Held / time.Second),
+			PointsHeld:   int32(h.Points),
+		}
+	}
+	for i, h := range bySide {
+		resp.Sides[i] = &imperialpointv1.SideHoldTime{
+			Side:        h.Key,
+			HeldSeconds: int64(h.Held / time.Second),
+			PointsHeld:  int32(h.Points),
+		}
+	}
+	return resp, nil
+}
+
+// statsRange resolves the requested range: until defaults to, and is capped
+// at, now; since defaults to defaultStatsPeriod before until when recent is
+// set, and to the beginning of time otherwise.
+func statsRange(sinceTs, untilTs *timestamppb.Timestamp, recent bool) (time.Time, time.Time, error) {
+	now := time.Now()
+	until := now
+	if untilTs != nil && untilTs.AsTime().Before(now) {
+		until = untilTs.AsTime()
+	}
+	since := time.Time{}
+	switch {
+	case sinceTs != nil:
+		since = sinceTs.AsTime()
+	case recent:
+		since = until.Add(-defaultStatsPeriod)
+	}
+	if !since.Before(until) {
+		return time.Time{}, time.Time{}, status.Error(codes.InvalidArgument, "since must be before until")
+	}
+	return since, until, nil
+}
+
+// withCurrentControl adds the ongoing period of each controlled point whose
+// history lacks it, as for control taken before history was recorded.
+func withCurrentControl(periods []model.ControlPeriod, points []model.ImperialPoint, since, until time.Time) []model.ControlPeriod {
+	type key struct {
+		pointId string
+		from    int64
+	}
+	// Compared at millisecond precision, which is what the database keeps.
+	recorded := make(map[key]bool, len(periods))
+	for _, p := range periods {
+		recorded[key{p.PointId, p.From.UnixMilli()}] = true
+	}
+	for _, pt := range points {
+		c := pt.Control
+		if c == nil || c.SettlementId == "" || recorded[key{pt.Id, c.ControlledSince.UnixMilli()}] {
+			continue
+		}
+		if p := c.Period(pt.Id, time.Time{}); p.Overlap(since, until) > 0 {
+			periods = append([]model.ControlPeriod{p}, periods...)
+		}
+	}
+	return periods
+}
+
+func controlPeriodToProto(p model.ControlPeriod) *imperialpointv1.ControlPeriod {
+	out := &imperialpointv1.ControlPeriod{
+		SettlementId: p.SettlementId,
+		Side:         p.Side,
+		From:         timestamppb.New(p.From),
+	}
+	if !p.To.IsZero() {
+		out.To = timestamppb.New(p.To)
+	}
+	return out
+}
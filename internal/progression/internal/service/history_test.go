@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyRepo reads the history fakeRepo records.
+type historyRepo struct{ *fakeRepo }
+
+func (r *historyRepo) ListControlPeriods(_ context.Context, _ string, since, until time.Time) ([]model.ControlPeriod, error) {
+	var out []model.ControlPeriod
+	for _, p := range r.periods {
+		if p.From.Before(until) && (p.To.IsZero() || p.To.After(since)) {
+			out = append(out, p)
+		}
+	}
+	slices.SortFunc(out, func(a, b model.ControlPeriod) int { return b.From.Compare(a.From) })
+	return out, nil
+}
+
+func TestControlChangesAreRecordedAsPeriods(t *testing.T) {
+	repo := &historyRepo{&fakeRepo{point: &model.ImperialPoint{Id: testPointID}, progress: map[string]*model.TalentProgress{}}}
+	svc := newTestService(t, repo)
+	ctx := context.Background()
+
+	for _, req := range []*imperialpointv1.SetControlRequest{
+		{PointId: testPointID, SettlementId: settlA, Side: sideEast},
+		{PointId: testPointID, SettlementId: settlB, Side: sideWest},
+	} {
+		if _, err := svc.SetControl(ctx, req); err != nil {
+			t.Fatalf("SetControl: %v", err)
+		}
+	}
+	if _, err := svc.ReleaseControl(ctx, &imperialpointv1.ReleaseControlRequest{PointId: testPointID}); err != nil {
+		t.Fatalf("ReleaseControl: %v", err)
+	}
+
+	timeline, err := svc.GetPointTimeline(ctx, &imperialpointv1.GetPointTimelineRequest{PointId: testPointID})
+	if err != nil {
+		t.Fatalf("GetPointTimeline: %v", err)
+	}
+	periods := timeline.GetPeriods()
+	if len(periods) != 2 {
+		t.Fatalf("got %d periods, want 2", len(periods))
+	}
+	newer, older := periods[0], periods[1]
+	if newer.GetSettlementId() != settlB || older.GetSettlementId() != settlA {
+		t.Fatalf("settlements = %s, %s; want %s, %s", newer.GetSettlementId(), older.GetSettlementId(), settlB, settlA)
+	}
+	if newer.GetTo() == nil || !older.GetTo().AsTime().Equal(newer.GetFrom().AsTime()) {
+		t.Fatal("periods are not closed back to back")
+	}
+}
+
+func TestGetControlStatsCountsUnrecordedCurrentControl(t *testing.T) {
+	now := time.Now()
+	point := &model.ImperialPoint{Id: testPointID}
+	point.RestoreControl(&model.PointControl{Side: sideEast, SettlementId: settlA, ControlledSince: now.Add(-3 * time.Hour)})
+	repo := &historyRepo{&fakeRepo{point: point}}
+	svc := newTestService(t, repo)
+
+	stats, err := svc.GetControlStats(context.Background(), &imperialpointv1.GetControlStatsRequest{
+		Since: timestamppb.New(now.Add(-2 * time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("GetControlStats: %v", err)
+	}
+	if len(stats.GetSettlements()) != 1 || stats.GetSettlements()[0].GetSettlementId() != settlA {
+		t.Fatalf("settlements = %v, want only %s", stats.GetSettlements(), settlA)
+	}
+	if held := stats.GetSettlements()[0].GetHeldSeconds(); held < 7199 || held > 7200 {
+		t.Fatalf("held %ds, want about 2h", held)
+	}
+}
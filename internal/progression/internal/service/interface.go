@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 )
 
@@ -11,8 +14,9 @@ import (
 type ProgressionRepository interface {
 	// Trees
 	CreateTree(ctx context.Context, tree model.TalentTree) (*model.TalentTree, error)
-	UpdateTree(ctx context.Context, tree model.TalentTree) (*model.TalentTree, error)
+	PublishTreeVersion(ctx context.Context, prev *model.TalentTree, next model.TalentTree) (*model.TalentTree, error)
 	GetTree(ctx context.Context, id string) (*model.TalentTree, error)
+	GetTreeVersion(ctx context.Context, id string, version int) (*model.TalentTree, error)
 	ListTrees(ctx context.Context) ([]model.TalentTree, error)
 
 	// Presets
@@ -20,22 +24,85 @@ type ProgressionRepository interface {
 	UpdatePreset(ctx context.Context, preset model.TalentPreset) (*model.TalentPreset, error)
 	GetPreset(ctx context.Context, id string) (*model.TalentPreset, error)
 	ListPresets(ctx context.Context) ([]model.TalentPreset, error)
+	AssignPreset(ctx context.Context, a model.PresetAssignment) error
+	UnassignPreset(ctx context.Context, settlementId, settlementType string) error
+	GetPresetAssignment(ctx context.Context, settlementId, settlementType string) (*model.PresetAssignment, error)
+	ListPresetAssignments(ctx context.Context) ([]model.PresetAssignment, error)
 
 	// Progress
 	GetOrCreateProgress(ctx context.Context, ownerType, settlementId, pointId, side, treeId string) (*model.TalentProgress, error)
-	SaveProgress(ctx context.Context, progress model.TalentProgress) error
+	SaveProgress(ctx context.Context, progress *model.TalentProgress) error
+	ListPendingPurchases(ctx context.Context, startedBefore time.Time) ([]model.TalentProgress, error)
+
+	// Respec, migration and the refunds they owe
+	SaveRespec(ctx context.Context, progress model.TalentProgress, before []model.PurchasedNode) error
+	ListTreeProgress(ctx context.Context, treeId string, version int) ([]model.TalentProgress, error)
+	SaveMigration(ctx context.Context, progress model.TalentProgress, before []model.PurchasedNode, fromVersion int) error
+	ClearRefund(ctx context.Context, progressId, opKey string) error
+	ListPendingRefunds(ctx context.Context) ([]model.TalentProgress, error)
+
+	// Bonuses
+	ListNodeEffects(ctx context.Context, owner model.BonusOwner) ([]model.NodeEffect, error)
+	GetBonuses(ctx context.Context, owner model.BonusOwner) (*model.Bonuses, error)
+	SaveBonuses(ctx context.Context, owner model.BonusOwner, b model.Bonuses) error
 
 	// Imperial points
 	CreatePoint(ctx context.Context, point model.ImperialPoint) (*model.ImperialPoint, error)
 	UpdatePoint(ctx context.Context, point model.ImperialPoint) (*model.ImperialPoint, error)
 	GetPoint(ctx context.Context, id string) (*model.ImperialPoint, error)
 	ListPoints(ctx context.Context) ([]model.ImperialPoint, error)
-	SaveControl(ctx context.Context, pointId string, control *model.PointControl) error
+	SaveControl(ctx context.Context, pointId string, control *model.PointControl, closed *model.PointHold) error
+
+	// Sieges
+	DeclareSiege(ctx context.Context, pointId string, siege model.PointSiege) error
+	ReportSiegeProgress(ctx context.Context, pointId, siegeId string, progress int, now time.Time) (*model.PointSiege, error)
+	EndSiege(ctx context.Context, pointId, siegeId string) error
+	RestoreSiege(ctx context.Context, pointId string, siege model.PointSiege) error
+
+	// Control history
+	SaveControlPeriod(ctx context.Context, period model.ControlPeriod) error
+	ListControlPeriods(ctx context.Context, pointId string, since, until time.Time) ([]model.ControlPeriod, error)
+
+	// Favor accrual
+	ClaimAccrual(ctx context.Context, a model.FavorAccrual) (*model.FavorAccrual, error)
+	AdvanceAccrual(ctx context.Context, pointId, settlementId string, controlledSince, until time.Time) error
+	SettleHold(ctx context.Context, pointId string, hold model.PointHold) error
 }
 
-// FavorDeductor deducts imperial favor from a settlement.
+// FavorDeductor deducts imperial favor from a settlement, once per op key.
 // Implemented by settlementuc.FavorOps, injected via fx.
 type FavorDeductor interface {
-	Deduct(ctx context.Context, settlementID string, amount int64, reason, byPlayerID string) error
+	Deduct(ctx context.Context, settlementID string, amount int64, reason, byPlayerID, opKey string) error
+	Applied(ctx context.Context, settlementID, opKey string) (bool, error)
 	IsLeader(ctx context.Context, settlementID, playerID string) error
 }
+
+// SettlementMembers lists who belongs to a settlement.
+// Implemented by settlementuc.Members, injected via fx.
+type SettlementMembers interface {
+	UserIds(ctx context.Context, settlementID string) ([]string, error)
+}
+
+// SettlementTypes reads the type of a settlement.
+// Implemented by settlementuc.Types, injected via fx.
+type SettlementTypes interface {
+	Type(ctx context.Context, settlementID string) (string, error)
+}
+
+// Factions resolves point sides to the factions settlements belong to.
+// Implemented by factionuc.Membership, injected via fx.
+type Factions interface {
+	MemberSide(ctx context.Context, factionID, settlementID string) (factionuc.Side, error)
+}
+
+// Notifier delivers in-app notifications.
+// Implemented by notificationuc.Create, injected via fx.
+type Notifier interface {
+	CreateNotification(ctx context.Context, title, message string, opts ...notificationuc.NotificationOpts) error
+}
+
+// FavorCreditor credits imperial favor to a settlement, once per op key.
+// Implemented by settlementuc.FavorOps, injected via fx.
+type FavorCreditor interface {
+	Credit(ctx context.Context, settlementID string, amount int64, reason, opKey string) error
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) PreviewTreeMigration(ctx context.Context, req *progressionv1.TreeMigrationRequest) (*progressionv1.TreeMigrationPlan, error) {
+	m, progress, err := s.loadTreeMigration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := migrationPlan(m)
+	for i := range progress {
+		p := &progress[i]
+		pending := len(p.PendingRefunds)
+		removed := m.Apply(p)
+		addProgressMigration(plan, p, removed, p.PendingRefunds[pending:])
+	}
+	return plan, nil
+}
+
+// ApplyTreeMigration moves each progress on its own: it is saved only if it
+// did not change since it was read, then its refunds are credited and its
+// owner's bonuses refreshed. A refund whose credit fails stays pending for
+// SettleRefunds.
+func (s *Service) ApplyTreeMigration(ctx context.Context, req *progressionv1.TreeMigrationRequest) (*progressionv1.TreeMigrationPlan, error) {
+	l := s.log.WithMethod("ApplyTreeMigration")
+
+	m, progress, err := s.loadTreeMigration(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := migrationPlan(m)
+	for i := range progress {
+		p := &progress[i]
+		before := slices.Clone(p.PurchasedNodes)
+		pending := len(p.PendingRefunds)
+		removed := m.Apply(p)
+		refunds := slices.Clone(p.PendingRefunds[pending:])
+
+		if err := s.repo.SaveMigration(ctx, *p, before, m.From.Version); err != nil {
+			if !errors.Is(err, model.ErrProgressChanged) {
+				l.Error("failed to save migrated progress", zap.String("progress_id", p.Id), zap.Error(err))
+			}
+			plan.FailedProgressIds = append(plan.FailedProgressIds, p.Id)
+			continue
+		}
+		addProgressMigration(plan, p, removed, refunds)
+
+		if err := s.payRefunds(ctx, p); err != nil {
+			l.Error("failed to credit migration refund, left for retry", zap.String("progress_id", p.Id), zap.Error(err))
+		}
+		s.refreshBonuses(ctx, progressOwner(p))
+	}
+	return plan, nil
+}
+
+// loadTreeMigration reads both versions a migration request names, checks
+// the mapping between them and lists the progress to migrate.
+func (s *Service) loadTreeMigration(ctx context.Context, req *progressionv1.TreeMigrationRequest) (model.TreeMigration, []model.TalentProgress, error) {
+	from, err := s.repo.GetTreeVersion(ctx, req.GetTreeId(), int(req.GetFromVersion()))
+	if err != nil {
+		if isNotFound(err) {
+			return model.TreeMigration{}, nil, status.Error(codes.NotFound, "tree version not found")
+		}
+		return model.TreeMigration{}, nil, status.Error(codes.Internal, err.Error())
+	}
+	var to *model.TalentTree
+	if v := int(req.GetToVersion()); v != 0 {
+		to, err = s.repo.GetTreeVersion(ctx, req.GetTreeId(), v)
+	} else {
+		to, err = s.repo.GetTree(ctx, req.GetTreeId())
+	}
+	if err != nil {
+		if isNotFound(err) {
+			return model.TreeMigration{}, nil, status.Error(codes.NotFound, "tree version not found")
+		}
+		return model.TreeMigration{}, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	m := model.TreeMigration{From: from, To: to, Mapping: req.GetNodeMapping()}
+	if violations := m.Validate(); len(violations) > 0 {
+		return model.TreeMigration{}, nil, fieldViolationsError("invalid tree migration", violations)
+	}
+
+	progress, err := s.repo.ListTreeProgress(ctx, from.Id, from.Version)
+	if err != nil {
+		return model.TreeMigration{}, nil, status.Error(codes.Internal, err.Error())
+	}
+	return m, progress, nil
+}
+
+func migrationPlan(m model.TreeMigration) *progressionv1.TreeMigrationPlan {
+	return &progressionv1.TreeMigrationPlan{
+		FromVersion:       int32(m.From.Version),
+		ToVersion:         int32(m.To.Version),
+		Progress:          []*progressionv1.ProgressMigration{},
+		FailedProgressIds: []string{},
+	}
+}
+
+func addProgressMigration(plan *progressionv1.TreeMigrationPlan, p *model.TalentProgress, removed []model.PurchasedNode, refunds []model.FavorRefund) {
+	var refund int64
+	for _, r := range refunds {
+		refund += r.Amount
+	}
+	plan.Progress = append(plan.Progress, &progressionv1.ProgressMigration{
+		ProgressId:     p.Id,
+		OwnerType:      string(p.OwnerType),
+		SettlementId:   p.SettlementId,
+		PointId:        p.PointId,
+		Side:           p.Side,
+		KeptNodeIds:    purchasedNodeIds(p.PurchasedNodes),
+		RemovedNodeIds: purchasedNodeIds(removed),
+		RefundBi:       refund,
+	})
+	plan.TotalRefundBi += refund
+}
+
+func purchasedNodeIds(nodes []model.PurchasedNode) []string {
+	ids := make([]string, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.NodeId
+	}
+	return ids
+}
+
+// progressOwner returns who receives the bonuses of p.
+func progressOwner(p *model.TalentProgress) model.BonusOwner {
+	if p.OwnerType == model.OwnerTypePointSide {
+		return model.PointSideOwner(p.PointId, p.Side)
+	}
+	return model.SettlementOwner(p.SettlementId)
+}
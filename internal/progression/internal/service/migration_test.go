@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// migrationRepo holds two versions of one tree and the progress pinned to the
+// first, keyed by id.
+type migrationRepo struct {
+	*fakeRepo
+	v1, v2  *model.TalentTree
+	stored  map[string]*model.TalentProgress
+	changed string // id of progress modified behind the migration's back
+}
+
+func (r *migrationRepo) GetTree(_ context.Context, _ string) (*model.TalentTree, error) {
+	return r.v2, nil
+}
+
+func (r *migrationRepo) GetTreeVersion(_ context.Context, _ string, version int) (*model.TalentTree, error) {
+	if version == 1 {
+		return r.v1, nil
+	}
+	return r.v2, nil
+}
+
+func (r *migrationRepo) ListTreeProgress(_ context.Context, _ string, version int) ([]model.TalentProgress, error) {
+	var out []model.TalentProgress
+	for _, id := range []string{"p1", "p2"} {
+		if p := r.stored[id]; p.TreeVersion == version {
+			out = append(out, *p.Clone())
+		}
+	}
+	return out, nil
+}
+
+func (r *migrationRepo) SaveMigration(_ context.Context, progress model.TalentProgress, _ []model.PurchasedNode, _ int) error {
+	if progress.Id == r.changed {
+		return model.ErrProgressChanged
+	}
+	r.stored[progress.Id] = progress.Clone()
+	return nil
+}
+
+func (r *migrationRepo) ClearRefund(_ context.Context, progressId, opKey string) error {
+	p := r.stored[progressId]
+	p.ClearRefund(opKey)
+	return nil
+}
+
+func newMigrationService(t *testing.T) (*Service, *migrationRepo, *fakeCreditor) {
+	t.Helper()
+	repo := &migrationRepo{
+		fakeRepo: &fakeRepo{},
+		v1: model.ReconstituteTalentTree(testTreeID, "", "",
+			[]model.TalentNode{{Id: "root", CostBi: 100}, {Id: "a", CostBi: 60}, {Id: "b", CostBi: 40}},
+			[]model.TalentEdge{{From: "root", To: "a"}, {From: "root", To: "b"}},
+			1,
+		),
+		v2: model.ReconstituteTalentTree(testTreeID, "", "",
+			[]model.TalentNode{{Id: "root"}, {Id: "alpha"}},
+			[]model.TalentEdge{{From: "root", To: "alpha"}},
+			2,
+		),
+		stored: map[string]*model.TalentProgress{},
+	}
+	for id, settlementId := range map[string]string{"p1": settlA, "p2": settlB} {
+		repo.stored[id] = model.ReconstituteTalentProgress(id, model.OwnerTypeSettlement, settlementId, "", "", testTreeID,
+			nodes("root", "a", "b"), model.ProgressState{TreeVersion: 1})
+	}
+
+	creditor := &fakeCreditor{paid: map[string]bool{}, favor: map[string]int64{}}
+	svc := newTestService(t, repo)
+	svc.creditor = creditor
+	return svc, repo, creditor
+}
+
+func migrationReq() *progressionv1.TreeMigrationRequest {
+	return &progressionv1.TreeMigrationRequest{TreeId: testTreeID, FromVersion: 1, NodeMapping: map[string]string{"a": "alpha"}}
+}
+
+func TestPreviewTreeMigrationChangesNothing(t *testing.T) {
+	svc, repo, creditor := newMigrationService(t)
+
+	plan, err := svc.PreviewTreeMigration(context.Background(), migrationReq())
+	if err != nil {
+		t.Fatalf("PreviewTreeMigration: %v", err)
+	}
+	if plan.GetToVersion() != 2 || len(plan.GetProgress()) != 2 || plan.GetTotalRefundBi() != 80 {
+		t.Fatalf("plan = %v, want 2 progress to v2 refunding 80", plan)
+	}
+	got := plan.GetProgress()[0]
+	if !slices.Equal(got.GetKeptNodeIds(), []string{"root", "alpha"}) || !slices.Equal(got.GetRemovedNodeIds(), []string{"b"}) {
+		t.Fatalf("kept %v removed %v, want [root alpha] and [b]", got.GetKeptNodeIds(), got.GetRemovedNodeIds())
+	}
+	if repo.stored["p1"].TreeVersion != 1 || len(creditor.favor) > 0 {
+		t.Fatal("preview changed progress or credited favor")
+	}
+}
+
+func TestApplyTreeMigrationRefundsAndSkipsChangedProgress(t *testing.T) {
+	svc, repo, creditor := newMigrationService(t)
+	repo.changed = "p2"
+
+	plan, err := svc.ApplyTreeMigration(context.Background(), migrationReq())
+	if err != nil {
+		t.Fatalf("ApplyTreeMigration: %v", err)
+	}
+	if !slices.Equal(plan.GetFailedProgressIds(), []string{"p2"}) || len(plan.GetProgress()) != 1 {
+		t.Fatalf("failed %v with %d migrated, want [p2] and 1", plan.GetFailedProgressIds(), len(plan.GetProgress()))
+	}
+	p1 := repo.stored["p1"]
+	if p1.TreeVersion != 2 || !slices.Equal(nodeIDs(p1), []string{"root", "alpha"}) || len(p1.PendingRefunds) > 0 {
+		t.Fatalf("p1 = v%d %v pending %v, want v2 [root alpha] none", p1.TreeVersion, nodeIDs(p1), p1.PendingRefunds)
+	}
+	if creditor.favor[settlA] != 40 || creditor.favor[settlB] != 0 {
+		t.Fatalf("credited %v, want 40 to %s only", creditor.favor, settlA)
+	}
+
+	// A rerun migrates what was skipped and leaves the rest alone.
+	repo.changed = ""
+	plan, err = svc.ApplyTreeMigration(context.Background(), migrationReq())
+	if err != nil {
+		t.Fatalf("ApplyTreeMigration rerun: %v", err)
+	}
+	if len(plan.GetProgress()) != 1 || plan.GetProgress()[0].GetProgressId() != "p2" {
+		t.Fatalf("rerun migrated %v, want p2", plan.GetProgress())
+	}
+	if creditor.favor[settlA] != 40 || creditor.favor[settlB] != 40 {
+		t.Fatalf("credited %v, want 40 each", creditor.favor)
+	}
+}
+
+func TestTreeMigrationRejectsBadMapping(t *testing.T) {
+	svc, _, _ := newMigrationService(t)
+	req := migrationReq()
+	req.NodeMapping = map[string]string{"a": "missing"}
+
+	if _, err := svc.PreviewTreeMigration(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", status.Code(err))
+	}
+}
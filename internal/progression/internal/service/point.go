@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 	"go.uber.org/zap"
@@ -80,34 +84,82 @@ func (s *Service) SetControl(ctx context.Context, req *imperialpointv1.SetContro
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// Enforce max 2 points per side
-	allPoints, err := s.repo.ListPoints(ctx)
+	side, err := s.memberSide(ctx, req.GetSide(), req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+	full, err := s.sideAtPointLimit(ctx, side, req.GetPointId())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if full {
+		return nil, sideAtLimitError(side)
+	}
+
+	if err := s.handOver(ctx, l, point, req.GetSide(), req.GetSettlementId()); err != nil {
+		return nil, err
+	}
+	return pointToProto(point), nil
+}
+
+// memberSide resolves the faction a settlement takes a point for, checking
+// the settlement belongs to it.
+func (s *Service) memberSide(ctx context.Context, factionId, settlementId string) (factionuc.Side, error) {
+	side, err := s.factions.MemberSide(ctx, factionId, settlementId)
+	switch {
+	case errors.Is(err, factionuc.ErrFactionNotFound):
+		return side, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown faction %q", factionId))
+	case errors.Is(err, factionuc.ErrNotMember):
+		return side, status.Error(codes.FailedPrecondition, fmt.Sprintf("settlement is not a member of faction %q", factionId))
+	case err != nil:
+		return side, status.Error(codes.Internal, err.Error())
+	}
+	return side, nil
+}
+
+// sideAtPointLimit reports whether side already controls the most points its
+// faction may, not counting pointId.
+func (s *Service) sideAtPointLimit(ctx context.Context, side factionuc.Side, pointId string) (bool, error) {
+	if side.PointLimit == 0 {
+		return false, nil
+	}
+	allPoints, err := s.repo.ListPoints(ctx)
+	if err != nil {
+		return false, err
+	}
 	count := 0
 	for _, p := range allPoints {
-		if p.Control != nil && p.Control.Side == req.GetSide() && p.Id != req.GetPointId() {
+		if p.Control != nil && p.Control.Side == side.FactionId && p.Id != pointId {
 			count++
 		}
 	}
-	if count >= 2 {
-		return nil, status.Error(codes.FailedPrecondition, "side already controls 2 points")
-	}
+	return count >= side.PointLimit, nil
+}
 
-	prevSide := point.SetControl(req.GetSide(), req.GetSettlementId())
+func sideAtLimitError(side factionuc.Side) error {
+	return status.Error(codes.FailedPrecondition,
+		fmt.Sprintf("faction %q already controls %d points", side.FactionId, side.PointLimit))
+}
+
+// handOver gives point to settlementId on side and persists the change
+// through applyControl.
+func (s *Service) handOver(ctx context.Context, l logger.Logger, point *model.ImperialPoint, side, settlementId string) error {
+	prev := point.Control
+	closed := point.CloseHold(time.Now())
+	prevSide := point.SetControl(side, settlementId)
 
 	// The losing side forfeits its last node only when the point actually
 	// changes hands.
 	rollbackSide := ""
-	if prevSide != "" && prevSide != req.GetSide() && point.TreeId != "" {
+	if prevSide != "" && prevSide != side && point.TreeId != "" {
 		rollbackSide = prevSide
 	}
 
-	if err := s.applyControl(ctx, l, req.GetPointId(), point.Control, rollbackSide, point.TreeId); err != nil {
-		return nil, err
+	if err := s.applyControl(ctx, l, point.Id, point.Control, closed, rollbackSide, point.TreeId); err != nil {
+		return err
 	}
-	return pointToProto(point), nil
+	s.recordControl(ctx, l, point.Id, prev, point.Control)
+	return nil
 }
 
 func (s *Service) ReleaseControl(ctx context.Context, req *imperialpointv1.ReleaseControlRequest) (*imperialpointv1.ImperialPoint, error) {
@@ -121,20 +173,24 @@ func (s *Service) ReleaseControl(ctx context.Context, req *imperialpointv1.Relea
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	prev := point.Control
+	closed := point.CloseHold(time.Now())
 	releasedSide := point.ReleaseControl()
 	rollbackSide := ""
 	if releasedSide != "" && point.TreeId != "" {
 		rollbackSide = releasedSide
 	}
 
-	if err := s.applyControl(ctx, l, req.GetPointId(), nil, rollbackSide, point.TreeId); err != nil {
+	if err := s.applyControl(ctx, l, req.GetPointId(), nil, closed, rollbackSide, point.TreeId); err != nil {
 		return nil, err
 	}
+	s.recordControl(ctx, l, point.Id, prev, nil)
 	return pointToProto(point), nil
 }
 
 // applyControl persists a control change together with the progression rollback
-// of the side that lost the point.
+// of the side that lost the point. closed is the outgoing settlement's hold,
+// queued for favor payout in the same write as the control change.
 //
 // Order matters and it is deliberate: the rollback is written FIRST, the control
 // change second. The deployed MongoDB is a standalone (no replica set), so
@@ -155,6 +211,7 @@ func (s *Service) applyControl(
 	l logger.Logger,
 	pointId string,
 	control *model.PointControl,
+	closed *model.PointHold,
 	rollbackSide, treeId string,
 ) error {
 	var err error
@@ -165,9 +222,12 @@ func (s *Service) applyControl(
 			l.Error("progression rollback failed, control change aborted", zap.Error(err))
 			return status.Error(codes.Internal, "failed to roll back progression for the losing side")
 		}
+		// Whether the control change then commits or is compensated, the
+		// losing side's bonuses are recomputed from what was persisted.
+		defer s.refreshBonuses(ctx, model.PointSideOwner(pointId, rollbackSide))
 	}
 
-	if err := s.repo.SaveControl(ctx, pointId, control); err != nil {
+	if err := s.repo.SaveControl(ctx, pointId, control, closed); err != nil {
 		l.Error("failed to save control", zap.Error(err))
 		if rerr := restore(ctx); rerr != nil {
 			l.Error("failed to restore rolled-back node after control save failure",
@@ -192,7 +252,7 @@ func (s *Service) rollbackLastPointNode(ctx context.Context, pointId, side, tree
 	if !ok {
 		return noRestore, nil
 	}
-	if err := s.repo.SaveProgress(ctx, *progress); err != nil {
+	if err := s.repo.SaveProgress(ctx, progress); err != nil {
 		return nil, err
 	}
 	return func(ctx context.Context) error {
@@ -201,23 +261,10 @@ func (s *Service) rollbackLastPointNode(ctx context.Context, pointId, side, tree
 		if err := progress.AddNode(node); err != nil {
 			return err
 		}
-		return s.repo.SaveProgress(ctx, *progress)
+		return s.repo.SaveProgress(ctx, progress)
 	}, nil
 }
 
-// controllingSettlement returns the settlement currently controlling the point,
-// or "" when the point is unclaimed.
-func (s *Service) controllingSettlement(ctx context.Context, pointId string) (string, error) {
-	point, err := s.repo.GetPoint(ctx, pointId)
-	if err != nil {
-		return "", err
-	}
-	if point.Control == nil {
-		return "", nil
-	}
-	return point.Control.SettlementId, nil
-}
-
 func pointToProto(p *model.ImperialPoint) *imperialpointv1.ImperialPoint {
 	proto := &imperialpointv1.ImperialPoint{
 		Id:            p.Id,
@@ -233,5 +280,15 @@ func pointToProto(p *model.ImperialPoint) *imperialpointv1.ImperialPoint {
 			ControlledSince: timestamppb.New(p.Control.ControlledSince),
 		}
 	}
+	if siege := p.Siege; siege.Active(time.Now()) {
+		proto.Siege = &imperialpointv1.PointSiege{
+			AttackerSettlementId: siege.AttackerSettlementId,
+			AttackerSide:         siege.AttackerSide,
+			DefenderSettlementId: siege.DefenderSettlementId,
+			DeclaredAt:           timestamppb.New(siege.DeclaredAt),
+			EndsAt:               timestamppb.New(siege.EndsAt),
+			Progress:             int32(siege.Progress),
+		}
+	}
 	return proto
 }
@@ -3,14 +3,18 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -35,6 +39,9 @@ type fakeRepo struct {
 	saveProgressErr error
 
 	saveProgressCalls int
+
+	// periods holds the control history, keyed by point and start.
+	periods map[string]model.ControlPeriod
 }
 
 func progressKey(pointId, side, treeId string) string {
@@ -49,11 +56,22 @@ func (r *fakeRepo) ListPoints(_ context.Context) ([]model.ImperialPoint, error)
 	return []model.ImperialPoint{*clonePoint(r.point)}, nil
 }
 
-func (r *fakeRepo) SaveControl(_ context.Context, _ string, control *model.PointControl) error {
+func (r *fakeRepo) SaveControl(_ context.Context, _ string, control *model.PointControl, closed *model.PointHold) error {
 	if r.saveControlErr != nil {
 		return r.saveControlErr
 	}
 	r.point.RestoreControl(control)
+	if closed != nil {
+		r.point.RestoreUnpaidHolds(append(slices.Clone(r.point.UnpaidHolds), *closed))
+	}
+	return nil
+}
+
+func (r *fakeRepo) SaveControlPeriod(_ context.Context, period model.ControlPeriod) error {
+	if r.periods == nil {
+		r.periods = map[string]model.ControlPeriod{}
+	}
+	r.periods[period.PointId+"|"+period.From.String()] = period
 	return nil
 }
 
@@ -61,21 +79,51 @@ func (r *fakeRepo) GetOrCreateProgress(_ context.Context, ownerType, _, pointId,
 	key := progressKey(pointId, side, treeId)
 	stored, ok := r.progress[key]
 	if !ok {
-		stored = model.ReconstituteTalentProgress("p-"+key, model.OwnerType(ownerType), "", pointId, side, treeId, nil)
+		stored = model.ReconstituteTalentProgress("p-"+key, model.OwnerType(ownerType), "", pointId, side, treeId, nil, model.ProgressState{})
 		r.progress[key] = stored
 	}
-	return cloneProgress(stored), nil
+	return stored.Clone(), nil
 }
 
-func (r *fakeRepo) SaveProgress(_ context.Context, progress model.TalentProgress) error {
+func (r *fakeRepo) SaveProgress(_ context.Context, progress *model.TalentProgress) error {
 	r.saveProgressCalls++
 	if r.saveProgressErr != nil {
 		return r.saveProgressErr
 	}
-	r.progress[progressKey(progress.PointId, progress.Side, progress.TreeId)] = cloneProgress(&progress)
+	key := progressKey(progress.PointId, progress.Side, progress.TreeId)
+	if stored, ok := r.progress[key]; ok && stored.Version != progress.Version {
+		return model.ErrProgressChanged
+	}
+	progress.MarkSaved()
+	r.progress[key] = progress.Clone()
 	return nil
 }
 
+func (r *fakeRepo) ListNodeEffects(_ context.Context, _ model.BonusOwner) ([]model.NodeEffect, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) GetBonuses(_ context.Context, _ model.BonusOwner) (*model.Bonuses, error) {
+	return nil, nil
+}
+
+// fakeFactions knows the east and west factions, both with limit, and makes
+// every settlement but the outsiders a member of whichever it asks for.
+type fakeFactions struct {
+	limit     int
+	outsiders []string
+}
+
+func (f fakeFactions) MemberSide(_ context.Context, factionID, settlementID string) (factionuc.Side, error) {
+	if factionID != sideEast && factionID != sideWest {
+		return factionuc.Side{}, factionuc.ErrFactionNotFound
+	}
+	if slices.Contains(f.outsiders, settlementID) {
+		return factionuc.Side{}, factionuc.ErrNotMember
+	}
+	return factionuc.Side{FactionId: factionID, PointLimit: f.limit}, nil
+}
+
 func clonePoint(p *model.ImperialPoint) *model.ImperialPoint {
 	out := &model.ImperialPoint{
 		Id:            p.Id,
@@ -88,15 +136,14 @@ func clonePoint(p *model.ImperialPoint) *model.ImperialPoint {
 		ctrl := *p.Control
 		out.RestoreControl(&ctrl)
 	}
+	out.RestoreUnpaidHolds(slices.Clone(p.UnpaidHolds))
+	if p.Siege != nil {
+		siege := *p.Siege
+		out.RestoreSiege(&siege)
+	}
 	return out
 }
 
-func cloneProgress(p *model.TalentProgress) *model.TalentProgress {
-	nodes := make([]model.PurchasedNode, len(p.PurchasedNodes))
-	copy(nodes, p.PurchasedNodes)
-	return model.ReconstituteTalentProgress(p.Id, p.OwnerType, p.SettlementId, p.PointId, p.Side, p.TreeId, nodes)
-}
-
 func newTestService(t *testing.T, repo ProgressionRepository) *Service {
 	t.Helper()
 	zc := zap.NewProductionConfig()
@@ -105,7 +152,7 @@ func newTestService(t *testing.T, repo ProgressionRepository) *Service {
 	if err != nil {
 		t.Fatalf("logger.New: %v", err)
 	}
-	return New(Opts{Log: l, Repo: repo})
+	return New(Opts{Log: l, Repo: repo, Factions: fakeFactions{limit: 2}})
 }
 
 // nodes builds a chronologically ordered purchase history.
@@ -238,7 +285,7 @@ func TestControlTransitionRollsBackLosingSide(t *testing.T) {
 			if len(tc.eastNodes) > 0 {
 				key := progressKey(testPointID, sideEast, testTreeID)
 				repo.progress[key] = model.ReconstituteTalentProgress(
-					"p-"+key, model.OwnerTypePointSide, "", testPointID, sideEast, testTreeID, nodes(tc.eastNodes...))
+					"p-"+key, model.OwnerTypePointSide, "", testPointID, sideEast, testTreeID, nodes(tc.eastNodes...), model.ProgressState{})
 			}
 			repo.saveControlErr = tc.controlErr
 			repo.saveProgressErr = tc.progressErr
@@ -280,3 +327,37 @@ func TestControlTransitionRollsBackLosingSide(t *testing.T) {
 		})
 	}
 }
+
+func TestSetControlValidatesFaction(t *testing.T) {
+	cases := []struct {
+		name     string
+		factions fakeFactions
+		side     string
+		// pointId differs from the held point's to count it against the limit.
+		pointId  string
+		wantCode codes.Code
+	}{
+		{name: "member takes the point", factions: fakeFactions{limit: 2}, side: sideWest, pointId: testPointID, wantCode: codes.OK},
+		{name: "unknown faction", factions: fakeFactions{limit: 2}, side: "north", pointId: testPointID, wantCode: codes.InvalidArgument},
+		{name: "settlement outside the faction", factions: fakeFactions{limit: 2, outsiders: []string{settlB}}, side: sideWest, pointId: testPointID, wantCode: codes.FailedPrecondition},
+		{name: "faction at its limit", factions: fakeFactions{limit: 1}, side: sideEast, pointId: "other", wantCode: codes.FailedPrecondition},
+		{name: "no limit", factions: fakeFactions{}, side: sideEast, pointId: "other", wantCode: codes.OK},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			point := &model.ImperialPoint{Id: testPointID, Name: "point"}
+			point.SetControl(sideEast, settlA)
+			svc := newTestService(t, &fakeRepo{point: point, progress: map[string]*model.TalentProgress{}})
+			svc.factions = tc.factions
+
+			_, err := svc.SetControl(context.Background(), &imperialpointv1.SetControlRequest{
+				PointId:      tc.pointId,
+				Side:         tc.side,
+				SettlementId: settlB,
+			})
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("code = %v, want %v (err %v)", status.Code(err), tc.wantCode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// settlementTypes maps the proto settlement types to the names the
+// settlement domain stores.
+var settlementTypes = map[settlementv1.SettlementType]string{
+	settlementv1.SettlementType_CAMP:     "camp",
+	settlementv1.SettlementType_VILLAGE:  "village",
+	settlementv1.SettlementType_TOWNSHIP: "township",
+	settlementv1.SettlementType_CITY:     "city",
+	settlementv1.SettlementType_PROVINCE: "province",
+}
+
+func (s *Service) AssignSettlementPreset(ctx context.Context, req *progressionv1.AssignSettlementPresetRequest) (*progressionv1.PresetAssignment, error) {
+	l := s.log.WithMethod("AssignSettlementPreset").With(zap.String("settlement_id", req.GetSettlementId()))
+
+	if _, err := s.settlementType(ctx, req.GetSettlementId()); err != nil {
+		return nil, err
+	}
+	a := model.PresetAssignment{SettlementId: req.GetSettlementId(), PresetId: req.GetPresetId(), UpdatedAt: time.Now()}
+	if err := s.savePresetAssignment(ctx, a); err != nil {
+		if status.Code(err) == codes.Internal {
+			l.Error("failed to save preset assignment", zap.Error(err))
+		}
+		return nil, err
+	}
+	return assignmentToProto(&a), nil
+}
+
+func (s *Service) SetDefaultPreset(ctx context.Context, req *progressionv1.SetDefaultPresetRequest) (*progressionv1.PresetAssignment, error) {
+	l := s.log.WithMethod("SetDefaultPreset").With(zap.String("settlement_type", req.GetSettlementType().String()))
+
+	settlementType, ok := settlementTypes[req.GetSettlementType()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "settlement_type is required")
+	}
+	a := model.PresetAssignment{SettlementType: settlementType, PresetId: req.GetPresetId(), UpdatedAt: time.Now()}
+	if err := s.savePresetAssignment(ctx, a); err != nil {
+		if status.Code(err) == codes.Internal {
+			l.Error("failed to save default preset", zap.Error(err))
+		}
+		return nil, err
+	}
+	return assignmentToProto(&a), nil
+}
+
+// savePresetAssignment stores a, or removes the assignment it replaces when
+// it names no preset.
+func (s *Service) savePresetAssignment(ctx context.Context, a model.PresetAssignment) error {
+	if a.PresetId == "" {
+		if err := s.repo.UnassignPreset(ctx, a.SettlementId, a.SettlementType); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	}
+	if _, err := s.repo.GetPreset(ctx, a.PresetId); err != nil {
+		if isNotFound(err) {
+			return status.Error(codes.NotFound, "preset not found")
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	if err := s.repo.AssignPreset(ctx, a); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func (s *Service) ListPresetAssignments(ctx context.Context, _ *progressionv1.ListPresetAssignmentsRequest) (*progressionv1.ListPresetAssignmentsResponse, error) {
+	assignments, err := s.repo.ListPresetAssignments(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	protos := make([]*progressionv1.PresetAssignment, len(assignments))
+	for i := range assignments {
+		protos[i] = assignmentToProto(&assignments[i])
+	}
+	return &progressionv1.ListPresetAssignmentsResponse{Assignments: protos}, nil
+}
+
+func (s *Service) GetSettlementTalentOverview(ctx context.Context, req *progressionv1.GetSettlementTalentOverviewRequest) (*progressionv1.SettlementTalentOverview, error) {
+	preset, assigned, err := s.settlementPreset(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+	out := &progressionv1.SettlementTalentOverview{Preset: presetToProto(preset), Assigned: assigned}
+	for _, treeId := range preset.TreeIds {
+		progress, tree, err := s.pinnedProgress(ctx, string(model.OwnerTypeSettlement), req.GetSettlementId(), "", "", treeId)
+		if err != nil {
+			return nil, err
+		}
+		out.Trees = append(out.Trees, &progressionv1.TreeOverview{Tree: treeToProto(tree), Progress: progressToProto(progress)})
+	}
+	return out, nil
+}
+
+// settlementPreset returns the preset assigned to the settlement or, failing
+// that, its type's default. assigned reports which of the two it is.
+func (s *Service) settlementPreset(ctx context.Context, settlementId string) (preset *model.TalentPreset, assigned bool, err error) {
+	settlementType, err := s.settlementType(ctx, settlementId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	a, err := s.repo.GetPresetAssignment(ctx, settlementId, "")
+	assigned = err == nil
+	if isNotFound(err) {
+		a, err = s.repo.GetPresetAssignment(ctx, "", settlementType)
+	}
+	if err != nil {
+		if isNotFound(err) {
+			return nil, false, status.Error(codes.FailedPrecondition, "no talent preset is assigned to the settlement")
+		}
+		return nil, false, status.Error(codes.Internal, err.Error())
+	}
+
+	preset, err = s.repo.GetPreset(ctx, a.PresetId)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, false, status.Error(codes.FailedPrecondition, "the settlement's talent preset no longer exists")
+		}
+		return nil, false, status.Error(codes.Internal, err.Error())
+	}
+	return preset, assigned, nil
+}
+
+// requirePresetTree fails with FailedPrecondition unless treeId is in the
+// settlement's preset.
+func (s *Service) requirePresetTree(ctx context.Context, settlementId, treeId string) error {
+	preset, _, err := s.settlementPreset(ctx, settlementId)
+	if err != nil {
+		return err
+	}
+	if !preset.HasTree(treeId) {
+		return status.Error(codes.FailedPrecondition, "tree is not in the settlement's talent preset")
+	}
+	return nil
+}
+
+func (s *Service) settlementType(ctx context.Context, settlementId string) (string, error) {
+	t, err := s.types.Type(ctx, settlementId)
+	if err != nil {
+		if errors.Is(err, settlementuc.ErrSettlementNotFound) {
+			return "", status.Error(codes.NotFound, "settlement not found")
+		}
+		return "", status.Error(codes.Internal, err.Error())
+	}
+	return t, nil
+}
+
+func assignmentToProto(a *model.PresetAssignment) *progressionv1.PresetAssignment {
+	out := &progressionv1.PresetAssignment{
+		SettlementId: a.SettlementId,
+		PresetId:     a.PresetId,
+		UpdatedAt:    timestamppb.New(a.UpdatedAt),
+	}
+	for t, name := range settlementTypes {
+		if name == a.SettlementType {
+			out.SettlementType = t
+		}
+	}
+	return out
+}
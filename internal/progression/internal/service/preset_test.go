@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// presetRepo keeps presets, assignments and empty progress in memory.
+type presetRepo struct {
+	ProgressionRepository
+
+	presets     map[string]*model.TalentPreset
+	assignments map[string]model.PresetAssignment
+}
+
+func newPresetRepo() *presetRepo {
+	return &presetRepo{
+		presets: map[string]*model.TalentPreset{
+			"economy": {Id: "economy", Name: "Экономика", TreeIds: []string{"trade"}},
+			"war":     {Id: "war", Name: "Война", TreeIds: []string{"defense", "trade"}},
+		},
+		assignments: map[string]model.PresetAssignment{},
+	}
+}
+
+func assignmentKey(settlementId, settlementType string) string {
+	if settlementId == "" {
+		return "type:" + settlementType
+	}
+	return settlementId
+}
+
+func (r *presetRepo) GetPreset(_ context.Context, id string) (*model.TalentPreset, error) {
+	if p, ok := r.presets[id]; ok {
+		return p, nil
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (r *presetRepo) AssignPreset(_ context.Context, a model.PresetAssignment) error {
+	r.assignments[assignmentKey(a.SettlementId, a.SettlementType)] = a
+	return nil
+}
+
+func (r *presetRepo) UnassignPreset(_ context.Context, settlementId, settlementType string) error {
+	delete(r.assignments, assignmentKey(settlementId, settlementType))
+	return nil
+}
+
+func (r *presetRepo) GetPresetAssignment(_ context.Context, settlementId, settlementType string) (*model.PresetAssignment, error) {
+	if a, ok := r.assignments[assignmentKey(settlementId, settlementType)]; ok {
+		return &a, nil
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (r *presetRepo) GetTree(_ context.Context, id string) (*model.TalentTree, error) {
+	return model.ReconstituteTalentTree(id, id, "", nil, nil, 1), nil
+}
+
+func (r *presetRepo) GetOrCreateProgress(_ context.Context, _, settlementId, _, _, treeId string) (*model.TalentProgress, error) {
+	return &model.TalentProgress{Id: settlementId + "/" + treeId, TreeId: treeId, TreeVersion: 1}, nil
+}
+
+type fakeTypes map[string]string
+
+func (f fakeTypes) Type(_ context.Context, settlementID string) (string, error) {
+	if t, ok := f[settlementID]; ok {
+		return t, nil
+	}
+	return "", fmt.Errorf("settlement %s: %w", settlementID, settlementuc.ErrSettlementNotFound)
+}
+
+func newPresetService(t *testing.T) (*Service, *presetRepo) {
+	t.Helper()
+	repo := newPresetRepo()
+	svc := newTestService(t, repo)
+	svc.types = fakeTypes{settlA: "city", settlB: "city"}
+	svc.favor = leaderFavor{}
+	return svc, repo
+}
+
+func TestSettlementPresetFallsBackToTypeDefault(t *testing.T) {
+	svc, _ := newPresetService(t)
+	ctx := context.Background()
+
+	_, err := svc.GetSettlementTalentOverview(ctx, &progressionv1.GetSettlementTalentOverviewRequest{SettlementId: settlA})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("overview without preset code = %v, want FailedPrecondition", status.Code(err))
+	}
+
+	if _, err := svc.SetDefaultPreset(ctx, &progressionv1.SetDefaultPresetRequest{
+		SettlementType: settlementv1.SettlementType_CITY, PresetId: "economy",
+	}); err != nil {
+		t.Fatalf("SetDefaultPreset: %v", err)
+	}
+	if _, err := svc.AssignSettlementPreset(ctx, &progressionv1.AssignSettlementPresetRequest{
+		SettlementId: settlB, PresetId: "war",
+	}); err != nil {
+		t.Fatalf("AssignSettlementPreset: %v", err)
+	}
+
+	cases := []struct {
+		settlementId string
+		preset       string
+		assigned     bool
+		trees        int
+	}{
+		{settlA, "economy", false, 1},
+		{settlB, "war", true, 2},
+	}
+	for _, c := range cases {
+		got, err := svc.GetSettlementTalentOverview(ctx, &progressionv1.GetSettlementTalentOverviewRequest{SettlementId: c.settlementId})
+		if err != nil {
+			t.Fatalf("%s: GetSettlementTalentOverview: %v", c.settlementId, err)
+		}
+		if got.GetPreset().GetId() != c.preset || got.GetAssigned() != c.assigned || len(got.GetTrees()) != c.trees {
+			t.Fatalf("%s: preset %q assigned %v with %d trees, want %q %v %d", c.settlementId,
+				got.GetPreset().GetId(), got.GetAssigned(), len(got.GetTrees()), c.preset, c.assigned, c.trees)
+		}
+	}
+
+	// Removing the own assignment falls back to the default again.
+	if _, err := svc.AssignSettlementPreset(ctx, &progressionv1.AssignSettlementPresetRequest{SettlementId: settlB}); err != nil {
+		t.Fatalf("AssignSettlementPreset: %v", err)
+	}
+	got, err := svc.GetSettlementTalentOverview(ctx, &progressionv1.GetSettlementTalentOverviewRequest{SettlementId: settlB})
+	if err != nil {
+		t.Fatalf("GetSettlementTalentOverview: %v", err)
+	}
+	if got.GetPreset().GetId() != "economy" || got.GetAssigned() {
+		t.Fatalf("preset %q assigned %v after unassign, want the economy default", got.GetPreset().GetId(), got.GetAssigned())
+	}
+}
+
+func TestPurchaseSettlementNodeOutsidePreset(t *testing.T) {
+	svc, repo := newPresetService(t)
+	repo.assignments[settlA] = model.PresetAssignment{SettlementId: settlA, PresetId: "economy"}
+	ctx := interceptor.ContextWithUserID(context.Background(), "leader")
+
+	_, err := svc.PurchaseSettlementNode(ctx, &progressionv1.PurchaseSettlementNodeRequest{
+		SettlementId: settlA, TreeId: "defense", NodeId: "walls",
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("purchase outside preset code = %v, want FailedPrecondition", status.Code(err))
+	}
+	_, err = svc.GetSettlementProgress(ctx, &progressionv1.GetSettlementProgressRequest{SettlementId: settlA, TreeId: "defense"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("progress outside preset code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestAssignSettlementPresetValidates(t *testing.T) {
+	svc, _ := newPresetService(t)
+	ctx := context.Background()
+
+	cases := []struct {
+		name string
+		call func() error
+		want codes.Code
+	}{
+		{"unknown settlement", func() error {
+			_, err := svc.AssignSettlementPreset(ctx, &progressionv1.AssignSettlementPresetRequest{SettlementId: "missing", PresetId: "war"})
+			return err
+		}, codes.NotFound},
+		{"unknown preset", func() error {
+			_, err := svc.AssignSettlementPreset(ctx, &progressionv1.AssignSettlementPresetRequest{SettlementId: settlA, PresetId: "missing"})
+			return err
+		}, codes.NotFound},
+		{"unspecified type", func() error {
+			_, err := svc.SetDefaultPreset(ctx, &progressionv1.SetDefaultPresetRequest{PresetId: "war"})
+			return err
+		}, codes.InvalidArgument},
+	}
+	for _, c := range cases {
+		if got := status.Code(c.call()); got != c.want {
+			t.Errorf("%s: code = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// purchaseSaveAttempts bounds how often a purchase reloads the progress
+	// after losing a concurrent save.
+	purchaseSaveAttempts = 3
+	// stalePurchaseAge is how long a purchase may stay pending before
+	// SettlePurchases abandons it.
+	stalePurchaseAge = 5 * time.Minute
+)
+
+// errPurchaseAbandoned is returned when the purchase being completed was
+// abandoned, by SettlePurchases, in the meantime.
+var errPurchaseAbandoned = status.Error(codes.Aborted, "purchase was abandoned")
+
+// purchaseNode buys nodeId in two saves of the progress: the purchase is
+// recorded as pending, favor is deducted under the op key the pending
+// purchase carries, and the purchase is completed. A retry with the same
+// purchaseId resumes where the last attempt stopped, so favor is deducted
+// once; a purchase that cannot be completed is abandoned and its favor
+// refunded.
+func (s *Service) purchaseNode(
+	ctx context.Context,
+	treeId, nodeId, purchaseId, spendingSettlementId string,
+	ownerType, settlementId, pointId, side string,
+	callerID string,
+) (*progressionv1.TalentProgress, error) {
+	if purchaseId == "" {
+		purchaseId = uuid.NewString()
+	}
+	l := s.log.WithMethod("purchaseNode").With(
+		zap.String("tree_id", treeId),
+		zap.String("node_id", nodeId),
+		zap.String("purchase_id", purchaseId),
+	)
+
+	progress, tree, err := s.pinnedProgress(ctx, ownerType, settlementId, pointId, side, treeId)
+	if err != nil {
+		return nil, err
+	}
+	if done := progress.CompletedPurchase(purchaseId); done != nil {
+		if done.NodeId != nodeId {
+			return nil, status.Error(codes.InvalidArgument, "purchase_id was used for another node")
+		}
+		return progressToProto(progress), nil
+	}
+
+	pending := progress.PendingPurchase(purchaseId)
+	switch {
+	case pending == nil:
+		if err := checkPurchasable(progress, tree, nodeId); err != nil {
+			return nil, err
+		}
+		err := progress.BeginPurchase(model.PendingPurchase{
+			Id:           purchaseId,
+			NodeId:       nodeId,
+			CostBi:       tree.Node(nodeId).CostBi,
+			SettlementId: spendingSettlementId,
+			OpKey:        "purchase:" + uuid.NewString(),
+			Reason:       fmt.Sprintf("node purchase: %s in tree %s", nodeId, treeId),
+			StartedAt:    time.Now(),
+		})
+		if err != nil {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		if err := s.repo.SaveProgress(ctx, progress); err != nil {
+			return nil, saveProgressError(err)
+		}
+		pending = progress.PendingPurchase(purchaseId)
+	case pending.NodeId != nodeId:
+		return nil, status.Error(codes.InvalidArgument, "purchase_id was used for another node")
+	}
+
+	purchase := *pending
+	if err := s.favor.Deduct(ctx, purchase.SettlementId, purchase.CostBi, purchase.Reason, callerID, purchase.OpKey); err != nil {
+		if aerr := s.abandonPurchase(ctx, progress, purchaseId); aerr != nil {
+			l.Error("failed to abandon purchase after failed deduction", zap.Error(aerr))
+		}
+		return nil, err
+	}
+
+	progress, err = s.completePurchase(ctx, progress, tree, purchaseId)
+	if errors.Is(err, errPurchaseAbandoned) {
+		// SettlePurchases gave up on the purchase, possibly before the
+		// deduction above landed, so it may not have refunded it.
+		if rerr := s.refundAbandonedPurchase(ctx, progress, purchase); rerr != nil {
+			l.Error("failed to refund abandoned purchase", zap.Error(rerr))
+		}
+		return nil, err
+	}
+	if err != nil {
+		if aerr := s.abandonPurchase(ctx, progress, purchaseId); aerr != nil {
+			l.Error("failed to abandon purchase; left for SettlePurchases", zap.Error(aerr))
+		}
+		return nil, err
+	}
+	s.refreshBonuses(ctx, model.BonusOwner{
+		Type:         model.OwnerType(ownerType),
+		SettlementId: settlementId,
+		PointId:      pointId,
+		Side:         side,
+	})
+	return progressToProto(progress), nil
+}
+
+// completePurchase turns the pending purchase into a purchased node, reloading
+// the progress when a concurrent save got in first. It returns the progress
+// the purchase was completed in.
+func (s *Service) completePurchase(ctx context.Context, progress *model.TalentProgress, tree *model.TalentTree, purchaseId string) (*model.TalentProgress, error) {
+	for attempt := 1; ; attempt++ {
+		if progress.CompletedPurchase(purchaseId) != nil {
+			return progress, nil
+		}
+		pending := progress.PendingPurchase(purchaseId)
+		if pending == nil {
+			return progress, errPurchaseAbandoned
+		}
+		if err := checkPurchasable(progress, tree, pending.NodeId); err != nil {
+			return progress, err
+		}
+		if err := progress.CompletePurchase(purchaseId, time.Now()); err != nil {
+			return progress, status.Error(codes.Internal, err.Error())
+		}
+
+		err := s.repo.SaveProgress(ctx, progress)
+		if err == nil {
+			return progress, nil
+		}
+		if !errors.Is(err, model.ErrProgressChanged) || attempt == purchaseSaveAttempts {
+			return progress, saveProgressError(err)
+		}
+		if progress, err = s.reloadProgress(ctx, progress); err != nil {
+			return progress, status.Error(codes.Internal, err.Error())
+		}
+	}
+}
+
+// abandonPurchase drops the pending purchase purchaseId of the progress and,
+// if its favor was deducted, refunds it. The refund is saved with the
+// abandonment and paid by payRefunds, so it is neither lost nor paid twice.
+func (s *Service) abandonPurchase(ctx context.Context, progress *model.TalentProgress, purchaseId string) error {
+	for range purchaseSaveAttempts {
+		current, err := s.reloadProgress(ctx, progress)
+		if err != nil {
+			return err
+		}
+		pending := current.PendingPurchase(purchaseId)
+		if pending == nil {
+			return nil
+		}
+		charged, err := s.favor.Applied(ctx, pending.SettlementId, pending.OpKey)
+		if err != nil && !errors.Is(err, settlementuc.ErrSettlementNotFound) {
+			return err
+		}
+		current.AbandonPurchase(purchaseId, charged)
+		err = s.repo.SaveProgress(ctx, current)
+		if errors.Is(err, model.ErrProgressChanged) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return s.payRefunds(ctx, current)
+	}
+	return model.ErrProgressChanged
+}
+
+// refundAbandonedPurchase refunds the favor deducted for purchase after it
+// was abandoned. Abandoning refunds only a deduction that had landed, so one
+// landing later is refunded here; should both refund it, the shared op key
+// credits it once.
+func (s *Service) refundAbandonedPurchase(ctx context.Context, progress *model.TalentProgress, purchase model.PendingPurchase) error {
+	for range purchaseSaveAttempts {
+		current, err := s.reloadProgress(ctx, progress)
+		if err != nil {
+			return err
+		}
+		if !current.RefundAbandonedPurchase(purchase) {
+			return s.payRefunds(ctx, current)
+		}
+		err = s.repo.SaveProgress(ctx, current)
+		if errors.Is(err, model.ErrProgressChanged) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return s.payRefunds(ctx, current)
+	}
+	return model.ErrProgressChanged
+}
+
+// SettlePurchases abandons the purchases left pending for longer than
+// stalePurchaseAge, refunding those that were charged. A purchase stays
+// pending only if the service stopped, or could not write, between
+// beginning and completing it.
+func (s *Service) SettlePurchases(ctx context.Context) error {
+	cutoff := time.Now().Add(-stalePurchaseAge)
+	stale, err := s.repo.ListPendingPurchases(ctx, cutoff)
+	if err != nil {
+		return err
+	}
+	for i := range stale {
+		for _, pending := range stale[i].PendingPurchases {
+			if !pending.StartedAt.Before(cutoff) {
+				continue
+			}
+			if err := s.abandonPurchase(ctx, &stale[i], pending.Id); err != nil {
+				s.log.WithMethod("SettlePurchases").Error("failed to abandon stale purchase",
+					zap.String("progress_id", stale[i].Id), zap.String("purchase_id", pending.Id), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// checkPurchasable reports, as a gRPC status, why nodeId cannot be bought in
+// the progress pinned to tree.
+func checkPurchasable(progress *model.TalentProgress, tree *model.TalentTree, nodeId string) error {
+	if progress.TreeVersion != tree.Version {
+		return status.Error(codes.Aborted, "the tree was migrated during the purchase")
+	}
+	if tree.Node(nodeId) == nil {
+		return status.Error(codes.NotFound, fmt.Sprintf("node %q not found in tree", nodeId))
+	}
+	if progress.HasNode(nodeId) {
+		return status.Error(codes.InvalidArgument, "node already purchased")
+	}
+	for _, edge := range tree.Edges {
+		if edge.To == nodeId && !progress.HasNode(edge.From) {
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("parent node %q must be purchased first", edge.From))
+		}
+	}
+	return nil
+}
+
+// reloadProgress reads the progress again.
+func (s *Service) reloadProgress(ctx context.Context, p *model.TalentProgress) (*model.TalentProgress, error) {
+	return s.repo.GetOrCreateProgress(ctx, string(p.OwnerType), p.SettlementId, p.PointId, p.Side, p.TreeId)
+}
+
+func saveProgressError(err error) error {
+	if errors.Is(err, model.ErrProgressChanged) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
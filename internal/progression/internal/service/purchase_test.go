@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purchaseRepo serves one tree and fails the next SaveProgress calls with
+// saveErrs, nil letting a call through. A model.ErrProgressChanged there
+// stands for a concurrent save and advances the stored version.
+type purchaseRepo struct {
+	*fakeRepo
+	saveErrs []error
+}
+
+func (r *purchaseRepo) GetTree(_ context.Context, id string) (*model.TalentTree, error) {
+	return model.ReconstituteTalentTree(id, "", "",
+		[]model.TalentNode{{Id: "a", CostBi: 30}, {Id: "b", CostBi: 20}},
+		[]model.TalentEdge{{From: "a", To: "b"}},
+		1,
+	), nil
+}
+
+func (r *purchaseRepo) SaveProgress(ctx context.Context, progress *model.TalentProgress) error {
+	if len(r.saveErrs) > 0 {
+		err := r.saveErrs[0]
+		r.saveErrs = r.saveErrs[1:]
+		if errors.Is(err, model.ErrProgressChanged) {
+			r.stored().MarkSaved()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return r.fakeRepo.SaveProgress(ctx, progress)
+}
+
+func (r *purchaseRepo) ListPendingPurchases(context.Context, time.Time) ([]model.TalentProgress, error) {
+	return []model.TalentProgress{*r.stored().Clone()}, nil
+}
+
+func (r *purchaseRepo) ClearRefund(_ context.Context, _, opKey string) error {
+	p := r.stored()
+	p.ClearRefund(opKey)
+	return nil
+}
+
+func (r *purchaseRepo) stored() *model.TalentProgress {
+	return r.progress[progressKey(testPointID, sideEast, testTreeID)]
+}
+
+// ledgerFavor keeps settlement balances and applies each op key once.
+type ledgerFavor struct {
+	balance map[string]int64
+	applied map[string]bool
+}
+
+func (f *ledgerFavor) Deduct(_ context.Context, settlementID string, amount int64, _, _, opKey string) error {
+	if f.applied[opKey] {
+		return nil
+	}
+	if f.balance[settlementID] < amount {
+		return errors.New("insufficient imperial favor")
+	}
+	f.applied[opKey] = true
+	f.balance[settlementID] -= amount
+	return nil
+}
+
+func (f *ledgerFavor) Credit(_ context.Context, settlementID string, amount int64, _, opKey string) error {
+	if f.applied[opKey] {
+		return nil
+	}
+	f.applied[opKey] = true
+	f.balance[settlementID] += amount
+	return nil
+}
+
+func (f *ledgerFavor) Applied(_ context.Context, _, opKey string) (bool, error) {
+	return f.applied[opKey], nil
+}
+
+func (f *ledgerFavor) IsLeader(context.Context, string, string) error { return nil }
+
+func newPurchaseService(t *testing.T, balance int64) (*Service, *purchaseRepo, *ledgerFavor) {
+	t.Helper()
+	repo := &purchaseRepo{fakeRepo: &fakeRepo{progress: map[string]*model.TalentProgress{
+		progressKey(testPointID, sideEast, testTreeID): {
+			Id: "p", OwnerType: model.OwnerTypePointSide, PointId: testPointID, Side: sideEast, TreeId: testTreeID, TreeVersion: 1,
+		},
+	}}}
+	favor := &ledgerFavor{balance: map[string]int64{settlA: balance}, applied: map[string]bool{}}
+	svc := newTestService(t, repo)
+	svc.favor = favor
+	svc.creditor = favor
+	svc.bonusPub = &fakePublisher{}
+	return svc, repo, favor
+}
+
+func (s *Service) buy(nodeId, purchaseId string) error {
+	_, err := s.purchaseNode(context.Background(), testTreeID, nodeId, purchaseId, settlA,
+		string(model.OwnerTypePointSide), "", testPointID, sideEast, "leader")
+	return err
+}
+
+func TestPurchaseRetryChargesOnce(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 100)
+
+	if err := svc.buy("a", "p1"); err != nil {
+		t.Fatalf("purchase: %v", err)
+	}
+	if err := svc.buy("a", "p1"); err != nil {
+		t.Fatalf("retried purchase: %v", err)
+	}
+	if got := favor.balance[settlA]; got != 70 {
+		t.Fatalf("balance = %d, want 70", got)
+	}
+	if got := nodeIDs(repo.stored()); len(got) != 1 || got[0] != "a" {
+		t.Fatalf("nodes = %v, want [a]", got)
+	}
+	if err := svc.buy("b", "p1"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("purchase id reused for another node: code = %v, want InvalidArgument", status.Code(err))
+	}
+}
+
+func TestPurchaseResumesAfterCrashBetweenChargeAndSave(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 100)
+	// The service charged the pending purchase and stopped before
+	// completing it.
+	repo.saveErrs = []error{nil, errors.New("connection reset"), errors.New("connection reset")}
+	if err := svc.buy("a", "p1"); status.Code(err) != codes.Internal {
+		t.Fatalf("purchase code = %v, want Internal", status.Code(err))
+	}
+	if len(repo.stored().PendingPurchases) != 1 || favor.balance[settlA] != 70 {
+		t.Fatalf("pending %v, balance %d; want one pending purchase charged 30",
+			repo.stored().PendingPurchases, favor.balance[settlA])
+	}
+
+	if err := svc.buy("a", "p1"); err != nil {
+		t.Fatalf("retried purchase: %v", err)
+	}
+	if favor.balance[settlA] != 70 || len(repo.stored().PendingPurchases) != 0 || !repo.stored().HasNode("a") {
+		t.Fatalf("balance %d, pending %v, nodes %v; want 70, none and [a]",
+			favor.balance[settlA], repo.stored().PendingPurchases, nodeIDs(repo.stored()))
+	}
+}
+
+func TestPurchaseRefundedWhenSaveFails(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 100)
+	repo.saveErrs = []error{nil, errors.New("connection reset")}
+
+	if err := svc.buy("a", "p1"); status.Code(err) != codes.Internal {
+		t.Fatalf("purchase code = %v, want Internal", status.Code(err))
+	}
+	p := repo.stored()
+	if favor.balance[settlA] != 100 || p.HasNode("a") || len(p.PendingPurchases) != 0 || len(p.PendingRefunds) != 0 {
+		t.Fatalf("balance %d, nodes %v, pending %v, refunds %v; want the charge refunded and nothing left",
+			favor.balance[settlA], nodeIDs(p), p.PendingPurchases, p.PendingRefunds)
+	}
+
+	// The refunded purchase can be retried, and is charged again.
+	if err := svc.buy("a", "p1"); err != nil {
+		t.Fatalf("retried purchase: %v", err)
+	}
+	if favor.balance[settlA] != 70 || !repo.stored().HasNode("a") {
+		t.Fatalf("balance %d, nodes %v; want 70 and [a]", favor.balance[settlA], nodeIDs(repo.stored()))
+	}
+}
+
+func TestPurchaseCompletesAfterConcurrentSave(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 100)
+	repo.saveErrs = []error{nil, model.ErrProgressChanged}
+
+	if err := svc.buy("a", "p1"); err != nil {
+		t.Fatalf("purchase: %v", err)
+	}
+	if favor.balance[settlA] != 70 || !repo.stored().HasNode("a") {
+		t.Fatalf("balance %d, nodes %v; want 70 and [a]", favor.balance[settlA], nodeIDs(repo.stored()))
+	}
+}
+
+func TestPurchaseWithoutFavorLeavesNothingPending(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 10)
+
+	if err := svc.buy("a", "p1"); err == nil {
+		t.Fatal("purchase without favor succeeded")
+	}
+	if p := repo.stored(); favor.balance[settlA] != 10 || len(p.PendingPurchases) != 0 || len(p.PendingRefunds) != 0 {
+		t.Fatalf("balance %d, pending %v, refunds %v; want nothing moved", favor.balance[settlA], p.PendingPurchases, p.PendingRefunds)
+	}
+}
+
+func TestSettlePurchasesRefundsStalePurchase(t *testing.T) {
+	svc, repo, favor := newPurchaseService(t, 100)
+	charged := model.PendingPurchase{Id: "p1", NodeId: "a", CostBi: 30, SettlementId: settlA, OpKey: "purchase:x", StartedAt: time.Now().Add(-time.Hour)}
+	fresh := model.PendingPurchase{Id: "p2", NodeId: "b", CostBi: 20, SettlementId: settlA, OpKey: "purchase:y", StartedAt: time.Now()}
+	repo.progress[progressKey(testPointID, sideEast, testTreeID)] = &model.TalentProgress{
+		Id: "p", OwnerType: model.OwnerTypePointSide, PointId: testPointID, Side: sideEast, TreeId: testTreeID,
+		TreeVersion: 1, PendingPurchases: []model.PendingPurchase{charged, fresh},
+	}
+	favor.applied[charged.OpKey] = true
+	favor.balance[settlA] = 70
+
+	if err := svc.SettlePurchases(context.Background()); err != nil {
+		t.Fatalf("SettlePurchases: %v", err)
+	}
+	p := repo.stored()
+	if favor.balance[settlA] != 100 {
+		t.Fatalf("balance = %d, want the stale charge of 30 refunded", favor.balance[settlA])
+	}
+	if len(p.PendingPurchases) != 1 || p.PendingPurchases[0].Id != "p2" {
+		t.Fatalf("pending = %v, want only the fresh purchase", p.PendingPurchases)
+	}
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.uber.org/zap"
+)
+
+// SettleRefunds credits every refund a respec, migration or abandoned
+// purchase left pending because its credit failed. Failures are logged and
+// retried on the next run.
+func (s *Service) SettleRefunds(ctx context.Context) error {
+	pending, err := s.repo.ListPendingRefunds(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range pending {
+		if err := s.payRefunds(ctx, &pending[i]); err != nil {
+			s.log.WithMethod("SettleRefunds").Error("failed to credit refund",
+				zap.String("progress_id", pending[i].Id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// payRefunds credits the progress's pending refunds, each under its op key so
+// a retry never pays twice, and clears them. The refund of a settlement that
+// no longer exists is dropped. It stops at the first failure, leaving the
+// rest pending.
+func (s *Service) payRefunds(ctx context.Context, progress *model.TalentProgress) error {
+	for len(progress.PendingRefunds) > 0 {
+		ref := progress.PendingRefunds[0]
+		err := s.creditor.Credit(ctx, ref.SettlementId, ref.Amount, ref.Reason, ref.OpKey)
+		if err != nil && !errors.Is(err, settlementuc.ErrSettlementNotFound) {
+			return err
+		}
+		if err := s.repo.ClearRefund(ctx, progress.Id, ref.OpKey); err != nil {
+			return err
+		}
+		progress.ClearRefund(ref.OpKey)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RespecSettlementTree removes purchased nodes from a settlement's tree and
+// refunds part of their cost.
+//
+// The removal and the refund it owes are saved in one write, guarded against
+// a concurrent change of the progress; the refund is then credited under an
+// op key unique to the respec, so it is paid once even when the credit fails
+// and SettleRefunds retries it.
+func (s *Service) RespecSettlementTree(ctx context.Context, req *progressionv1.RespecSettlementTreeRequest) (*progressionv1.RespecSettlementTreeResponse, error) {
+	l := s.log.WithMethod("RespecSettlementTree").With(
+		zap.String("settlement_id", req.GetSettlementId()),
+		zap.String("tree_id", req.GetTreeId()),
+	)
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favor.IsLeader(ctx, req.GetSettlementId(), callerID); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "caller is not the leader of the settlement")
+	}
+
+	progress, tree, err := s.pinnedProgress(ctx, string(model.OwnerTypeSettlement), req.GetSettlementId(), "", "", req.GetTreeId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Settle what is still owed first, so a respec never stacks refunds.
+	if len(progress.PendingRefunds) > 0 {
+		if err := s.payRefunds(ctx, progress); err != nil {
+			l.Error("failed to credit pending refunds", zap.Error(err))
+			return nil, status.Error(codes.Internal, "previous refund is not credited yet")
+		}
+	}
+
+	now := time.Now()
+	if next := progress.NextRespecAt(s.respec); now.Before(next) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("respec is on cooldown until %s", next.Format(time.RFC3339)))
+	}
+
+	before := slices.Clone(progress.PurchasedNodes)
+	removed, err := progress.Respec(tree, req.GetNodeIds(), now)
+	if errors.Is(err, model.ErrNodeNotPurchased) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(removed) == 0 {
+		return nil, status.Error(codes.FailedPrecondition, "no purchased nodes to respec")
+	}
+
+	refund := s.respec.Refund(tree, removed)
+	progress.QueueRespecRefund(req.GetSettlementId(), refund, now)
+
+	if err := s.repo.SaveRespec(ctx, *progress, before); err != nil {
+		if errors.Is(err, model.ErrProgressChanged) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		l.Error("failed to save respec", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.refreshBonuses(ctx, model.SettlementOwner(req.GetSettlementId()))
+
+	if err := s.payRefunds(ctx, progress); err != nil {
+		l.Error("failed to credit respec refund, left for retry", zap.Error(err))
+	}
+
+	return &progressionv1.RespecSettlementTreeResponse{
+		Progress:       progressToProto(progress),
+		RemovedNodeIds: purchasedNodeIds(removed),
+		RefundBi:       refund,
+		NextRespecAt:   timestamppb.New(progress.NextRespecAt(s.respec)),
+	}, nil
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// respecRepo adds trees and the respec writes to fakeRepo.
+type respecRepo struct {
+	*fakeRepo
+	tree *model.TalentTree
+}
+
+func (r *respecRepo) GetTree(_ context.Context, _ string) (*model.TalentTree, error) {
+	return r.tree, nil
+}
+
+func (r *respecRepo) stored() *model.TalentProgress {
+	return r.progress[progressKey("", "", testTreeID)]
+}
+
+func (r *respecRepo) SaveRespec(_ context.Context, progress model.TalentProgress, before []model.PurchasedNode) error {
+	if !slices.Equal(nodeIDs(r.stored()), nodeIDs(&model.TalentProgress{PurchasedNodes: before})) {
+		return model.ErrProgressChanged
+	}
+	r.progress[progressKey("", "", testTreeID)] = progress.Clone()
+	return nil
+}
+
+func (r *respecRepo) ClearRefund(_ context.Context, _, opKey string) error {
+	p := r.stored()
+	p.ClearRefund(opKey)
+	return nil
+}
+
+func (r *respecRepo) ListPendingRefunds(_ context.Context) ([]model.TalentProgress, error) {
+	if p := r.stored(); len(p.PendingRefunds) > 0 {
+		return []model.TalentProgress{*p.Clone()}, nil
+	}
+	return nil, nil
+}
+
+type leaderFavor struct{ FavorDeductor }
+
+func (leaderFavor) IsLeader(context.Context, string, string) error { return nil }
+
+// failingCreditor fails while failing is set, then credits like fakeCreditor.
+type failingCreditor struct {
+	*fakeCreditor
+	failing bool
+}
+
+func (c *failingCreditor) Credit(ctx context.Context, settlementID string, amount int64, reason, opKey string) error {
+	if c.failing {
+		return errors.New("settlement store down")
+	}
+	return c.fakeCreditor.Credit(ctx, settlementID, amount, reason, opKey)
+}
+
+func newRespecService(t *testing.T) (*Service, *respecRepo, *failingCreditor) {
+	t.Helper()
+	repo := &respecRepo{
+		fakeRepo: &fakeRepo{progress: map[string]*model.TalentProgress{}},
+		tree: model.ReconstituteTalentTree(testTreeID, "", "",
+			[]model.TalentNode{{Id: "root", CostBi: 100}, {Id: "a", CostBi: 60}, {Id: "b", CostBi: 40}},
+			[]model.TalentEdge{{From: "root", To: "a"}, {From: "a", To: "b"}},
+			0,
+		),
+	}
+	repo.progress[progressKey("", "", testTreeID)] = model.ReconstituteTalentProgress(
+		"p1", model.OwnerTypeSettlement, settlA, "", "", testTreeID, nodes("root", "a", "b"), model.ProgressState{})
+
+	creditor := &failingCreditor{fakeCreditor: &fakeCreditor{paid: map[string]bool{}, favor: map[string]int64{}}}
+	svc := newTestService(t, repo)
+	svc.favor = leaderFavor{}
+	svc.creditor = creditor
+	svc.respec = model.RespecPolicy{RefundPercent: 50, Cooldown: time.Hour}
+	return svc, repo, creditor
+}
+
+func respecReq(nodeIds ...string) *progressionv1.RespecSettlementTreeRequest {
+	return &progressionv1.RespecSettlementTreeRequest{SettlementId: settlA, TreeId: testTreeID, NodeIds: nodeIds}
+}
+
+func TestRespecSettlementTreeRefundsAndCoolsDown(t *testing.T) {
+	svc, repo, creditor := newRespecService(t)
+	ctx := interceptor.ContextWithUserID(context.Background(), "leader")
+
+	resp, err := svc.RespecSettlementTree(ctx, respecReq("a"))
+	if err != nil {
+		t.Fatalf("RespecSettlementTree: %v", err)
+	}
+	if !slices.Equal(resp.GetRemovedNodeIds(), []string{"a", "b"}) || resp.GetRefundBi() != 50 {
+		t.Fatalf("removed %v refund %d, want [a b] and 50", resp.GetRemovedNodeIds(), resp.GetRefundBi())
+	}
+	if got := creditor.favor[settlA]; got != 50 {
+		t.Fatalf("credited %d, want 50", got)
+	}
+	if got := nodeIDs(repo.stored()); !slices.Equal(got, []string{"root"}) {
+		t.Fatalf("stored nodes = %v, want [root]", got)
+	}
+	if len(repo.stored().PendingRefunds) > 0 {
+		t.Fatal("refund left pending after credit")
+	}
+
+	if _, err := svc.RespecSettlementTree(ctx, respecReq()); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("second respec code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestRespecSettlementTreeRetriesFailedRefundOnce(t *testing.T) {
+	svc, repo, creditor := newRespecService(t)
+	ctx := interceptor.ContextWithUserID(context.Background(), "leader")
+
+	creditor.failing = true
+	if _, err := svc.RespecSettlementTree(ctx, respecReq()); err != nil {
+		t.Fatalf("RespecSettlementTree: %v", err)
+	}
+	if pending := repo.stored().PendingRefunds; len(pending) != 1 || pending[0].Amount != 100 {
+		t.Fatalf("pending refunds = %+v, want one of 100", pending)
+	}
+
+	creditor.failing = false
+	for range 2 {
+		if err := svc.SettleRefunds(ctx); err != nil {
+			t.Fatalf("SettleRefunds: %v", err)
+		}
+	}
+	if got := creditor.favor[settlA]; got != 100 {
+		t.Fatalf("credited %d, want 100", got)
+	}
+	if len(repo.stored().PendingRefunds) > 0 {
+		t.Fatal("refund still pending after settlement")
+	}
+}
@@ -9,14 +9,24 @@ func (s *Service) Scope() map[interceptor.Method]interceptor.Scope {
 	return map[interceptor.Method]interceptor.Scope{
 		interceptor.Method(prog + "CreateTree"):             interceptor.Scope("progression:write"),
 		interceptor.Method(prog + "UpdateTree"):             interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "PreviewTreeMigration"):   interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "ApplyTreeMigration"):     interceptor.Scope("progression:write"),
 		interceptor.Method(prog + "CreatePreset"):           interceptor.Scope("progression:write"),
 		interceptor.Method(prog + "UpdatePreset"):           interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "AssignSettlementPreset"): interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "SetDefaultPreset"):       interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "ListPresetAssignments"):  interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "ExportTree"):             interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "ExportPreset"):           interceptor.Scope("progression:write"),
+		interceptor.Method(prog + "ImportTalents"):          interceptor.Scope("progression:write"),
 		interceptor.Method(prog + "PurchaseSettlementNode"): interceptor.Scope(""),
 		interceptor.Method(prog + "PurchasePointNode"):      interceptor.Scope(""),
+		interceptor.Method(prog + "RespecSettlementTree"):   interceptor.Scope(""),
 
 		interceptor.Method(point + "CreatePoint"):    interceptor.Scope("imperialpoint:write"),
 		interceptor.Method(point + "UpdatePoint"):    interceptor.Scope("imperialpoint:write"),
 		interceptor.Method(point + "SetControl"):     interceptor.Scope("imperialpoint:write"),
 		interceptor.Method(point + "ReleaseControl"): interceptor.Scope("imperialpoint:write"),
+		interceptor.Method(point + "DeclareAttack"):  interceptor.Scope(""),
 	}
 }
@@ -3,8 +3,6 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
-	"time"
 
 	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
 	"github.com/lasthearth/vsservice/internal/progression/internal/model"
@@ -23,12 +21,17 @@ var _ progressionv1.ProgressionServiceServer = (*Service)(nil)
 // --- Trees ---
 
 func (s *Service) CreateTree(ctx context.Context, req *progressionv1.CreateTreeRequest) (*progressionv1.TalentTree, error) {
-	tree, err := s.repo.CreateTree(ctx, model.TalentTree{
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Nodes:       protoNodesToModel(req.GetNodes()),
-		Edges:       protoEdgesToModel(req.GetEdges()),
-	})
+	candidate := model.NewTalentTree(
+		req.GetName(),
+		req.GetDescription(),
+		protoNodesToModel(req.GetNodes()),
+		protoEdgesToModel(req.GetEdges()),
+	)
+	if violations := candidate.Validate(); len(violations) > 0 {
+		return nil, invalidTreeError(violations)
+	}
+
+	tree, err := s.repo.CreateTree(ctx, *candidate)
 	if err != nil {
 		s.log.WithMethod("CreateTree").Error("failed", zap.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
@@ -36,25 +39,49 @@ func (s *Service) CreateTree(ctx context.Context, req *progressionv1.CreateTreeR
 	return treeToProto(tree), nil
 }
 
+// UpdateTree publishes a new version of a tree. Progress stays pinned to the
+// version it was bought in until a tree migration moves it.
 func (s *Service) UpdateTree(ctx context.Context, req *progressionv1.UpdateTreeRequest) (*progressionv1.TalentTree, error) {
-	tree, err := s.repo.UpdateTree(ctx, model.TalentTree{
-		Id:          req.GetId(),
-		Name:        req.GetName(),
-		Description: req.GetDescription(),
-		Nodes:       protoNodesToModel(req.GetNodes()),
-		Edges:       protoEdgesToModel(req.GetEdges()),
-	})
+	candidate := model.NewTalentTree(
+		req.GetName(),
+		req.GetDescription(),
+		protoNodesToModel(req.GetNodes()),
+		protoEdgesToModel(req.GetEdges()),
+	)
+	if violations := candidate.Validate(); len(violations) > 0 {
+		return nil, invalidTreeError(violations)
+	}
+
+	head, err := s.repo.GetTree(ctx, req.GetId())
 	if err != nil {
 		if isNotFound(err) {
 			return nil, status.Error(codes.NotFound, "tree not found")
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	candidate.NextVersionOf(head)
+
+	tree, err := s.repo.PublishTreeVersion(ctx, head, *candidate)
+	if err != nil {
+		if errors.Is(err, model.ErrTreeVersionConflict) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		s.log.WithMethod("UpdateTree").Error("failed to publish tree version", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	return treeToProto(tree), nil
 }
 
 func (s *Service) GetTree(ctx context.Context, req *progressionv1.GetTreeRequest) (*progressionv1.TalentTree, error) {
-	tree, err := s.repo.GetTree(ctx, req.GetId())
+	var (
+		tree *model.TalentTree
+		err  error
+	)
+	if v := int(req.GetVersion()); v != 0 {
+		tree, err = s.repo.GetTreeVersion(ctx, req.GetId(), v)
+	} else {
+		tree, err = s.repo.GetTree(ctx, req.GetId())
+	}
 	if err != nil {
 		if isNotFound(err) {
 			return nil, status.Error(codes.NotFound, "tree not found")
@@ -79,7 +106,7 @@ func (s *Service) ListTrees(ctx context.Context, _ *progressionv1.ListTreesReque
 // --- Presets ---
 
 func (s *Service) CreatePreset(ctx context.Context, req *progressionv1.CreatePresetRequest) (*progressionv1.TalentPreset, error) {
-	preset, err := s.repo.CreatePreset(ctx, model.TalentPreset{Name: req.GetName(), TreeIds: req.GetTreeIds()})
+	preset, err := s.repo.CreatePreset(ctx, *model.NewTalentPreset(req.GetName(), req.GetTreeIds()))
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -87,7 +114,7 @@ func (s *Service) CreatePreset(ctx context.Context, req *progressionv1.CreatePre
 }
 
 func (s *Service) UpdatePreset(ctx context.Context, req *progressionv1.UpdatePresetRequest) (*progressionv1.TalentPreset, error) {
-	preset, err := s.repo.UpdatePreset(ctx, model.TalentPreset{Id: req.GetId(), Name: req.GetName(), TreeIds: req.GetTreeIds()})
+	preset, err := s.repo.UpdatePreset(ctx, *model.ReconstituteTalentPreset(req.GetId(), req.GetName(), req.GetTreeIds()))
 	if err != nil {
 		if isNotFound(err) {
 			return nil, status.Error(codes.NotFound, "preset not found")
@@ -123,6 +150,9 @@ func (s *Service) ListPresets(ctx context.Context, _ *progressionv1.ListPresetsR
 // --- Progress ---
 
 func (s *Service) GetSettlementProgress(ctx context.Context, req *progressionv1.GetSettlementProgressRequest) (*progressionv1.TalentProgress, error) {
+	if err := s.requirePresetTree(ctx, req.GetSettlementId(), req.GetTreeId()); err != nil {
+		return nil, err
+	}
 	p, err := s.repo.GetOrCreateProgress(ctx, string(model.OwnerTypeSettlement), req.GetSettlementId(), "", "", req.GetTreeId())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -138,8 +168,11 @@ func (s *Service) PurchaseSettlementNode(ctx context.Context, req *progressionv1
 	if err := s.favor.IsLeader(ctx, req.GetSettlementId(), callerID); err != nil {
 		return nil, status.Error(codes.PermissionDenied, "caller is not the leader of the settlement")
 	}
+	if err := s.requirePresetTree(ctx, req.GetSettlementId(), req.GetTreeId()); err != nil {
+		return nil, err
+	}
 
-	return s.purchaseNode(ctx, req.GetTreeId(), req.GetNodeId(), req.GetSettlementId(),
+	return s.purchaseNode(ctx, req.GetTreeId(), req.GetNodeId(), req.GetPurchaseId(), req.GetSettlementId(),
 		string(model.OwnerTypeSettlement), req.GetSettlementId(), "", "", callerID)
 }
 
@@ -157,81 +190,54 @@ func (s *Service) PurchasePointNode(ctx context.Context, req *progressionv1.Purc
 		return nil, err
 	}
 
-	controllingSettlement, err := s.controllingSettlement(ctx, req.GetPointId())
+	point, err := s.repo.GetPoint(ctx, req.GetPointId())
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to fetch point control")
 	}
-	if controllingSettlement == "" {
+	if point.Control == nil {
 		return nil, status.Error(codes.PermissionDenied, "point is not controlled by any settlement")
 	}
-	if req.GetSettlementId() != controllingSettlement {
+	if req.GetSettlementId() != point.Control.SettlementId {
 		return nil, status.Error(codes.PermissionDenied, "settlement does not control this point")
 	}
+	if req.GetSide() != point.Control.Side {
+		return nil, status.Error(codes.PermissionDenied, "side does not control this point")
+	}
+	if _, err := s.memberSide(ctx, req.GetSide(), req.GetSettlementId()); err != nil {
+		return nil, err
+	}
 	if err := s.favor.IsLeader(ctx, req.GetSettlementId(), callerID); err != nil {
 		return nil, status.Error(codes.PermissionDenied, "caller is not the leader of the controlling settlement")
 	}
 
-	return s.purchaseNode(ctx, req.GetTreeId(), req.GetNodeId(), req.GetSettlementId(),
+	return s.purchaseNode(ctx, req.GetTreeId(), req.GetNodeId(), req.GetPurchaseId(), req.GetSettlementId(),
 		string(model.OwnerTypePointSide), "", req.GetPointId(), req.GetSide(), callerID)
 }
 
-func (s *Service) purchaseNode(
+// pinnedProgress returns the owner's progress in a tree together with the
+// tree version it is pinned to.
+func (s *Service) pinnedProgress(
 	ctx context.Context,
-	treeId, nodeId, spendingSettlementId string,
-	ownerType, settlementId, pointId, side string,
-	callerID string,
-) (*progressionv1.TalentProgress, error) {
+	ownerType, settlementId, pointId, side, treeId string,
+) (*model.TalentProgress, *model.TalentTree, error) {
 	tree, err := s.repo.GetTree(ctx, treeId)
 	if err != nil {
 		if isNotFound(err) {
-			return nil, status.Error(codes.NotFound, "tree not found")
+			return nil, nil, status.Error(codes.NotFound, "tree not found")
 		}
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	var targetNode *model.TalentNode
-	for i := range tree.Nodes {
-		if tree.Nodes[i].Id == nodeId {
-			targetNode = &tree.Nodes[i]
-			break
-		}
-	}
-	if targetNode == nil {
-		return nil, status.Error(codes.NotFound, fmt.Sprintf("node %q not found in tree", nodeId))
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
 
 	progress, err := s.repo.GetOrCreateProgress(ctx, ownerType, settlementId, pointId, side, treeId)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	if progress.HasNode(nodeId) {
-		return nil, status.Error(codes.InvalidArgument, "node already purchased")
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
-
-	for _, edge := range tree.Edges {
-		if edge.To == nodeId && !progress.HasNode(edge.From) {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("parent node %q must be purchased first", edge.From))
+	if progress.TreeVersion != tree.Version {
+		if tree, err = s.repo.GetTreeVersion(ctx, treeId, progress.TreeVersion); err != nil {
+			return nil, nil, status.Error(codes.Internal, err.Error())
 		}
 	}
-
-	reason := fmt.Sprintf("node purchase: %s in tree %s", nodeId, treeId)
-	if err := s.favor.Deduct(ctx, spendingSettlementId, targetNode.CostBi, reason, callerID); err != nil {
-		return nil, err
-	}
-
-	if err := progress.AddNode(model.PurchasedNode{
-		NodeId:                nodeId,
-		PurchasedAt:           time.Now(),
-		PurchasedBySettlement: spendingSettlementId,
-	}); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if err := s.repo.SaveProgress(ctx, *progress); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	return progressToProto(progress), nil
+	return progress, tree, nil
 }
 
 // --- proto converters ---
@@ -239,13 +245,13 @@ func (s *Service) purchaseNode(
 func treeToProto(t *model.TalentTree) *progressionv1.TalentTree {
 	nodes := make([]*progressionv1.TalentNode, len(t.Nodes))
 	for i, n := range t.Nodes {
-		nodes[i] = &progressionv1.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effect: n.Effect, CostBi: n.CostBi}
+		nodes[i] = &progressionv1.TalentNode{Id: n.Id, Name: n.Name, Description: n.Description, Effects: effectsToProto(n.Effects), CostBi: n.CostBi}
 	}
 	edges := make([]*progressionv1.TalentEdge, len(t.Edges))
 	for i, e := range t.Edges {
 		edges[i] = &progressionv1.TalentEdge{From: e.From, To: e.To}
 	}
-	return &progressionv1.TalentTree{Id: t.Id, Name: t.Name, Description: t.Description, Nodes: nodes, Edges: edges}
+	return &progressionv1.TalentTree{Id: t.Id, Name: t.Name, Description: t.Description, Nodes: nodes, Edges: edges, Version: int32(t.Version)}
 }
 
 func presetToProto(p *model.TalentPreset) *progressionv1.TalentPreset {
@@ -261,13 +267,13 @@ func progressToProto(p *model.TalentProgress) *progressionv1.TalentProgress {
 			PurchasedBySettlementId: n.PurchasedBySettlement,
 		}
 	}
-	return &progressionv1.TalentProgress{Id: p.Id, TreeId: p.TreeId, PurchasedNodes: nodes}
+	return &progressionv1.TalentProgress{Id: p.Id, TreeId: p.TreeId, PurchasedNodes: nodes, TreeVersion: int32(p.TreeVersion)}
 }
 
 func protoNodesToModel(nodes []*progressionv1.TalentNode) []model.TalentNode {
 	out := make([]model.TalentNode, len(nodes))
 	for i, n := range nodes {
-		out[i] = model.TalentNode{Id: n.GetId(), Name: n.GetName(), Description: n.GetDescription(), Effect: n.GetEffect(), CostBi: n.GetCostBi()}
+		out[i] = model.TalentNode{Id: n.GetId(), Name: n.GetName(), Description: n.GetDescription(), Effects: protoEffectsToModel(n.GetEffects()), CostBi: n.GetCostBi()}
 	}
 	return out
 }
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) DeclareAttack(ctx context.Context, req *imperialpointv1.DeclareAttackRequest) (*imperialpointv1.ImperialPoint, error) {
+	l := s.log.WithMethod("DeclareAttack").With(
+		zap.String("point_id", req.GetPointId()),
+		zap.String("settlement_id", req.GetSettlementId()),
+	)
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.favor.IsLeader(ctx, req.GetSettlementId(), callerID); err != nil {
+		return nil, status.Error(codes.PermissionDenied, "caller is not the leader of the settlement")
+	}
+
+	point, err := s.repo.GetPoint(ctx, req.GetPointId())
+	if err != nil {
+		if isNotFound(err) {
+			return nil, status.Error(codes.NotFound, "point not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	side, err := s.memberSide(ctx, req.GetSide(), req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+	full, err := s.sideAtPointLimit(ctx, side, req.GetPointId())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if full {
+		return nil, sideAtLimitError(side)
+	}
+
+	siege, err := point.DeclareAttack(uuid.NewString(), req.GetSettlementId(), req.GetSide(), time.Now(), s.siegeWindow)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err := s.repo.DeclareSiege(ctx, point.Id, *siege); err != nil {
+		if errors.Is(err, model.ErrPointContested) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		l.Error("failed to declare siege", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if siege.DefenderSettlementId != "" {
+		s.notifyDefenders(ctx, point, siege)
+	}
+	return pointToProto(point), nil
+}
+
+// CaptureProgress records the capture progress the game server reports for
+// the siege settlementId laid to pointId, and hands the point over once the
+// capture completes. Reports for a point that is not contested by
+// settlementId are ignored.
+//
+// Reports may arrive concurrently and out of order: progress only ever rises,
+// and ending the siege is what claims the handover, so it runs once.
+func (s *Service) CaptureProgress(ctx context.Context, pointId, settlementId string, progress int) error {
+	l := s.log.WithMethod("CaptureProgress").With(
+		zap.String("point_id", pointId),
+		zap.String("settlement_id", settlementId),
+	)
+
+	point, err := s.repo.GetPoint(ctx, pointId)
+	if err != nil {
+		if isNotFound(err) {
+			l.Warn("capture progress for unknown point")
+			return nil
+		}
+		return err
+	}
+	now := time.Now()
+	if !point.Contested(now) || point.Siege.AttackerSettlementId != settlementId {
+		l.Debug("capture progress without an active siege, ignored")
+		return nil
+	}
+
+	siege, err := s.repo.ReportSiegeProgress(ctx, pointId, point.Siege.Id, min(max(progress, 0), model.CaptureComplete), now)
+	if err != nil {
+		if errors.Is(err, model.ErrSiegeNotActive) {
+			return nil
+		}
+		return err
+	}
+	if siege.Progress < model.CaptureComplete {
+		return nil
+	}
+	return s.completeCapture(ctx, l, pointId, *siege)
+}
+
+// completeCapture ends the siege and hands the point to the attacker. When
+// the handover fails the siege is put back, so the next report retries it.
+func (s *Service) completeCapture(ctx context.Context, l logger.Logger, pointId string, siege model.PointSiege) error {
+	if err := s.repo.EndSiege(ctx, pointId, siege.Id); err != nil {
+		if errors.Is(err, model.ErrSiegeNotActive) {
+			// Another report already completed it.
+			return nil
+		}
+		return err
+	}
+
+	restore := func(cause error) error {
+		if rerr := s.repo.RestoreSiege(ctx, pointId, siege); rerr != nil {
+			l.Error("failed to restore siege after failed capture", zap.Error(rerr))
+		}
+		return cause
+	}
+
+	point, err := s.repo.GetPoint(ctx, pointId)
+	if err != nil {
+		return restore(err)
+	}
+	if point.Control != nil && point.Control.SettlementId == siege.AttackerSettlementId {
+		return nil
+	}
+	// Membership and limits are checked again: either may have changed
+	// during the siege.
+	side, err := s.factions.MemberSide(ctx, siege.AttackerSide, siege.AttackerSettlementId)
+	if errors.Is(err, factionuc.ErrFactionNotFound) || errors.Is(err, factionuc.ErrNotMember) {
+		l.Warn("capture completed but the attacker left its faction; point kept by defender", zap.Error(err))
+		return nil
+	}
+	if err != nil {
+		return restore(err)
+	}
+	full, err := s.sideAtPointLimit(ctx, side, pointId)
+	if err != nil {
+		return restore(err)
+	}
+	if full {
+		l.Warn("capture completed but the attacking faction is at its point limit; point kept by defender")
+		return nil
+	}
+
+	if err := s.handOver(ctx, l, point, siege.AttackerSide, siege.AttackerSettlementId); err != nil {
+		return restore(err)
+	}
+	l.Info("point captured", zap.String("side", siege.AttackerSide))
+	return nil
+}
+
+// notifyDefenders tells every member of the defending settlement that the
+// point is under attack. Failures are logged; the siege is already declared.
+func (s *Service) notifyDefenders(ctx context.Context, point *model.ImperialPoint, siege *model.PointSiege) {
+	l := s.log.WithMethod("notifyDefenders").With(zap.String("point_id", point.Id))
+
+	userIds, err := s.members.UserIds(ctx, siege.DefenderSettlementId)
+	if err != nil {
+		l.Error("failed to list defenders", zap.String("settlement_id", siege.DefenderSettlementId), zap.Error(err))
+		return
+	}
+	title := fmt.Sprintf("Нападение на точку «%s»", point.Name)
+	message := fmt.Sprintf("Объявлен штурм имперской точки «%s». Удержите её %d мин., иначе точка перейдёт к нападающим.",
+		point.Name, int(s.siegeWindow.Minutes()))
+	for _, id := range userIds {
+		if err := s.notifier.CreateNotification(ctx, title, message, notificationuc.WithUserId(id)); err != nil {
+			l.Error("failed to notify defender", zap.String("user_id", id), zap.Error(err))
+		}
+	}
+}
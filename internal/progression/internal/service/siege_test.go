@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// siegeRepo keeps the siege on fakeRepo's point, as the repository keeps it
+// on the point document.
+type siegeRepo struct {
+	*fakeRepo
+	ended int
+}
+
+func (r *siegeRepo) DeclareSiege(_ context.Context, _ string, siege model.PointSiege) error {
+	if r.point.Siege.Active(siege.DeclaredAt) {
+		return model.ErrPointContested
+	}
+	r.point.RestoreSiege(&siege)
+	return nil
+}
+
+func (r *siegeRepo) ReportSiegeProgress(_ context.Context, _, siegeId string, progress int, now time.Time) (*model.PointSiege, error) {
+	siege := r.point.Siege
+	if siege == nil || siege.Id != siegeId || !siege.Active(now) {
+		return nil, model.ErrSiegeNotActive
+	}
+	siege.ReportProgress(progress)
+	out := *siege
+	return &out, nil
+}
+
+func (r *siegeRepo) EndSiege(_ context.Context, _, siegeId string) error {
+	if r.point.Siege == nil || r.point.Siege.Id != siegeId {
+		return model.ErrSiegeNotActive
+	}
+	r.point.RestoreSiege(nil)
+	r.ended++
+	return nil
+}
+
+func (r *siegeRepo) RestoreSiege(_ context.Context, _ string, siege model.PointSiege) error {
+	if r.point.Siege == nil {
+		r.point.RestoreSiege(&siege)
+	}
+	return nil
+}
+
+type fakeMembers map[string][]string
+
+func (m fakeMembers) UserIds(_ context.Context, settlementID string) ([]string, error) {
+	return m[settlementID], nil
+}
+
+type fakeNotifier struct{ sent int }
+
+func (n *fakeNotifier) CreateNotification(context.Context, string, string, ...notificationuc.NotificationOpts) error {
+	n.sent++
+	return nil
+}
+
+func newSiegeService(t *testing.T) (*Service, *siegeRepo, *fakeNotifier) {
+	t.Helper()
+	point := &model.ImperialPoint{Id: testPointID, Name: "Форт", TreeId: testTreeID}
+	point.SetControl(sideEast, settlA)
+
+	repo := &siegeRepo{fakeRepo: &fakeRepo{point: point, progress: map[string]*model.TalentProgress{}}}
+	key := progressKey(testPointID, sideEast, testTreeID)
+	repo.progress[key] = model.ReconstituteTalentProgress(
+		"p-"+key, model.OwnerTypePointSide, "", testPointID, sideEast, testTreeID, nodes("n1", "n2"), model.ProgressState{})
+
+	notifier := &fakeNotifier{}
+	svc := newTestService(t, repo)
+	svc.favor = leaderFavor{}
+	svc.members = fakeMembers{settlA: {"leader-a", "member-a"}}
+	svc.notifier = notifier
+	svc.siegeWindow = time.Hour
+	return svc, repo, notifier
+}
+
+func attackReq() *imperialpointv1.DeclareAttackRequest {
+	return &imperialpointv1.DeclareAttackRequest{PointId: testPointID, SettlementId: settlB, Side: sideWest}
+}
+
+func TestDeclareAttackContestsPointAndNotifiesDefenders(t *testing.T) {
+	svc, repo, notifier := newSiegeService(t)
+	ctx := interceptor.ContextWithUserID(context.Background(), "leader-b")
+
+	point, err := svc.DeclareAttack(ctx, attackReq())
+	if err != nil {
+		t.Fatalf("DeclareAttack: %v", err)
+	}
+	if point.GetSiege().GetAttackerSettlementId() != settlB || point.GetSiege().GetDefenderSettlementId() != settlA {
+		t.Fatalf("siege = %v, want %s attacking %s", point.GetSiege(), settlB, settlA)
+	}
+	if repo.point.Siege == nil {
+		t.Fatal("siege not stored")
+	}
+	if notifier.sent != 2 {
+		t.Fatalf("sent %d notifications, want 2", notifier.sent)
+	}
+
+	if _, err := svc.DeclareAttack(ctx, attackReq()); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("second attack code = %v, want FailedPrecondition", status.Code(err))
+	}
+}
+
+func TestCaptureProgressHandsOverOnce(t *testing.T) {
+	svc, repo, _ := newSiegeService(t)
+	ctx := interceptor.ContextWithUserID(context.Background(), "leader-b")
+	if _, err := svc.DeclareAttack(ctx, attackReq()); err != nil {
+		t.Fatalf("DeclareAttack: %v", err)
+	}
+
+	// A report from a settlement that is not attacking is ignored.
+	if err := svc.CaptureProgress(ctx, testPointID, settlA, 100); err != nil {
+		t.Fatalf("CaptureProgress: %v", err)
+	}
+	for _, progress := range []int{40, 20} {
+		if err := svc.CaptureProgress(ctx, testPointID, settlB, progress); err != nil {
+			t.Fatalf("CaptureProgress(%d): %v", progress, err)
+		}
+	}
+	if got := repo.point.Siege.Progress; got != 40 {
+		t.Fatalf("progress = %d, want 40", got)
+	}
+	if repo.point.Control.Side != sideEast {
+		t.Fatal("point handed over before the capture completed")
+	}
+
+	for range 2 {
+		if err := svc.CaptureProgress(ctx, testPointID, settlB, 100); err != nil {
+			t.Fatalf("CaptureProgress(100): %v", err)
+		}
+	}
+	if repo.point.Control.Side != sideWest || repo.point.Control.SettlementId != settlB {
+		t.Fatalf("control = %+v, want %s/%s", repo.point.Control, sideWest, settlB)
+	}
+	if repo.ended != 1 {
+		t.Fatalf("siege ended %d times, want 1", repo.ended)
+	}
+	east := repo.progress[progressKey(testPointID, sideEast, testTreeID)]
+	if got := nodeIDs(east); !slices.Equal(got, []string{"n1"}) {
+		t.Fatalf("defender nodes = %v, want [n1]", got)
+	}
+}
+
+func TestCaptureProgressIgnoresExpiredSiege(t *testing.T) {
+	svc, repo, _ := newSiegeService(t)
+	repo.point.RestoreSiege(&model.PointSiege{
+		Id:                   "s1",
+		AttackerSettlementId: settlB,
+		AttackerSide:         sideWest,
+		EndsAt:               time.Now().Add(-time.Minute),
+	})
+
+	if err := svc.CaptureProgress(context.Background(), testPointID, settlB, 100); err != nil {
+		t.Fatalf("CaptureProgress: %v", err)
+	}
+	if repo.point.Control.Side != sideEast {
+		t.Fatal("expired siege captured the point")
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// invalidTreeError reports violations as an INVALID_ARGUMENT status carrying
+// one BadRequest field violation per offending node or edge.
+func invalidTreeError(violations []model.TreeViolation) error {
+	return fieldViolationsError("invalid talent tree", violations)
+}
+
+// fieldViolationsError reports violations as an INVALID_ARGUMENT status
+// carrying one BadRequest field violation each.
+func fieldViolationsError(msg string, violations []model.TreeViolation) error {
+	fields := make([]*errdetails.BadRequest_FieldViolation, len(violations))
+	for i, v := range violations {
+		fields[i] = &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description}
+	}
+
+	st := status.New(codes.InvalidArgument, msg)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: fields})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
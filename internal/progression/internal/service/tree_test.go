@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type treeRepo struct {
+	ProgressionRepository
+
+	head      *model.TalentTree
+	published *model.TalentTree
+	conflict  bool
+}
+
+func (r *treeRepo) GetTree(_ context.Context, _ string) (*model.TalentTree, error) {
+	return r.head, nil
+}
+
+func (r *treeRepo) PublishTreeVersion(_ context.Context, prev *model.TalentTree, next model.TalentTree) (*model.TalentTree, error) {
+	if r.conflict || prev.Version != r.head.Version {
+		return nil, model.ErrTreeVersionConflict
+	}
+	r.published = &next
+	return &next, nil
+}
+
+func TestCreateTreeRejectsInvalidGraph(t *testing.T) {
+	svc := newTestService(t, &treeRepo{})
+
+	_, err := svc.CreateTree(context.Background(), &progressionv1.CreateTreeRequest{
+		Name:  "broken",
+		Nodes: []*progressionv1.TalentNode{{Id: "a"}, {Id: "a", CostBi: -1}},
+		Edges: []*progressionv1.TalentEdge{{From: "a", To: "missing"}},
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				fields = append(fields, v.GetField())
+			}
+		}
+	}
+	want := []string{"nodes[1].id", "nodes[1].cost_bi", "edges[0].to"}
+	if !slices.Equal(fields, want) {
+		t.Fatalf("field violations = %v, want %v", fields, want)
+	}
+}
+
+func TestUpdateTreePublishesNextVersion(t *testing.T) {
+	req := &progressionv1.UpdateTreeRequest{Id: testTreeID, Name: "v2", Nodes: []*progressionv1.TalentNode{{Id: "a"}}}
+
+	t.Run("published", func(t *testing.T) {
+		repo := &treeRepo{head: model.ReconstituteTalentTree(testTreeID, "v1", "", nil, nil, 3)}
+		tree, err := newTestService(t, repo).UpdateTree(context.Background(), req)
+		if err != nil {
+			t.Fatalf("UpdateTree: %v", err)
+		}
+		if tree.GetVersion() != 4 || repo.published == nil || repo.published.Version != 4 {
+			t.Fatalf("published version %d, want 4", tree.GetVersion())
+		}
+	})
+
+	t.Run("concurrent publish aborts", func(t *testing.T) {
+		repo := &treeRepo{head: model.ReconstituteTalentTree(testTreeID, "v1", "", nil, nil, 3), conflict: true}
+		_, err := newTestService(t, repo).UpdateTree(context.Background(), req)
+		if status.Code(err) != codes.Aborted {
+			t.Fatalf("code = %v, want Aborted", status.Code(err))
+		}
+	})
+}
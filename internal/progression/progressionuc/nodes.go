@@ -0,0 +1,55 @@
+package progressionuc
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/progression/internal/model"
+	"go.uber.org/fx"
+)
+
+// NodesRepo is the progression-side read port for purchased talent nodes.
+// Primitive typed so the progression model never crosses into other domains.
+// Bound to the progression Mongo repository in internal/progression/fx.go.
+type NodesRepo interface {
+	// ListSettlementNodes returns the node ids the settlement has purchased in
+	// its own trees, keyed by tree id. Point-side progress is not included.
+	ListSettlementNodes(ctx context.Context, settlementID string) (map[string][]string, error)
+	// ListNodeEffects returns the effects of the nodes the owner has purchased.
+	ListNodeEffects(ctx context.Context, owner model.BonusOwner) ([]model.NodeEffect, error)
+}
+
+type NodesOpts struct {
+	fx.In
+	Repo NodesRepo
+}
+
+type NodesUseCase struct {
+	repo NodesRepo
+}
+
+func NewNodesUseCase(opts NodesOpts) *NodesUseCase {
+	return &NodesUseCase{repo: opts.Repo}
+}
+
+// SettlementNodes returns the node ids settlementID has purchased, keyed by
+// tree id. Empty, not nil, when it has bought nothing.
+func (uc *NodesUseCase) SettlementNodes(ctx context.Context, settlementID string) (map[string][]string, error) {
+	nodes, err := uc.repo.ListSettlementNodes(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	if nodes == nil {
+		nodes = map[string][]string{}
+	}
+	return nodes, nil
+}
+
+// MemberCapBonus returns the extra member slots settlementID's purchased
+// nodes grant.
+func (uc *NodesUseCase) MemberCapBonus(ctx context.Context, settlementID string) (int, error) {
+	effects, err := uc.repo.ListNodeEffects(ctx, model.SettlementOwner(settlementID))
+	if err != nil {
+		return 0, err
+	}
+	return model.FoldBonuses(effects).MemberCap(), nil
+}
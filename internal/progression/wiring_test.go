@@ -5,9 +5,14 @@ import (
 
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
 	progressionv1 "github.com/lasthearth/vsservice/gen/progression/v1"
+	"github.com/lasthearth/vsservice/internal/faction/factionuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/progression"
+	"github.com/lasthearth/vsservice/internal/progression/progressionuc"
 	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"github.com/nats-io/nats.go"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
@@ -21,9 +26,14 @@ func TestWiring(t *testing.T) {
 	}
 	err = fx.ValidateApp(
 		fx.Supply(fx.Annotate(l, fx.As(new(logger.Logger)))),
-		fx.Supply(&mongo.Database{}, &settlementuc.FavorOps{}),
+		fx.Supply(&mongo.Database{}, &settlementuc.FavorOps{}, &settlementuc.Members{}, &notificationuc.Create{}, &factionuc.Membership{}, &settlementuc.Types{}, config.Config{}, &nats.Conn{}),
 		progression.App,
-		fx.Invoke(func(progressionv1.ProgressionServiceServer, imperialpointv1.ImperialPointServiceServer) {}),
+		fx.Invoke(func(
+			progressionv1.ProgressionServiceServer,
+			imperialpointv1.ImperialPointServiceServer,
+			*progressionuc.NodesUseCase,
+		) {
+		}),
 	)
 	if err != nil {
 		t.Fatal(err)
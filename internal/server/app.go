@@ -5,6 +5,7 @@ import (
 
 	discordv1 "github.com/lasthearth/vsservice/gen/discord/v1"
 	donatev1 "github.com/lasthearth/vsservice/gen/donate/v1"
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
 	leaderboardv1 "github.com/lasthearth/vsservice/gen/leaderboard/v1"
@@ -49,6 +50,7 @@ type Opts struct {
 	MediaV1         mediav1.MediaServiceServer
 	ProgressionV1   progressionv1.ProgressionServiceServer
 	ImperialPointV1 imperialpointv1.ImperialPointServiceServer
+	FactionV1       factionv1.FactionServiceServer
 	DiscordV1       discordv1.DiscordServiceServer
 	// Add the webhook service
 	LogtoWebhookService *webhook.LogtoWebhookService
@@ -73,6 +75,7 @@ type Server struct {
 	mediaV1             mediav1.MediaServiceServer
 	progressionV1       progressionv1.ProgressionServiceServer
 	imperialPointV1     imperialpointv1.ImperialPointServiceServer
+	factionV1           factionv1.FactionServiceServer
 	discordV1           discordv1.DiscordServiceServer
 	logtoWebhookService *webhook.LogtoWebhookService
 
@@ -102,6 +105,7 @@ func New(opts Opts) *Server {
 		mediaV1:             opts.MediaV1,
 		progressionV1:       opts.ProgressionV1,
 		imperialPointV1:     opts.ImperialPointV1,
+		factionV1:           opts.FactionV1,
 		discordV1:           opts.DiscordV1,
 		logtoWebhookService: opts.LogtoWebhookService,
 		log:                 opts.Log,
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+)
+
+// maxHTTPBodySize caps a raw request body bound to a google.api.HttpBody
+// field.
+const maxHTTPBodySize = 8 << 20
+
+// httpBodyMarshaler carries google.api.HttpBody as the raw HTTP body both
+// ways: responses go out as their data with their content type, and a request
+// body bound to an HttpBody field is taken as is instead of parsed as JSON.
+// Everything else goes through the wrapped marshaler.
+type httpBodyMarshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+func newHTTPBodyMarshaler(m runtime.Marshaler) *httpBodyMarshaler {
+	return &httpBodyMarshaler{runtime.HTTPBodyMarshaler{Marshaler: m}}
+}
+
+func (m *httpBodyMarshaler) NewDecoder(r io.Reader) runtime.Decoder {
+	return runtime.DecoderFunc(func(v any) error {
+		body, ok := v.(**httpbody.HttpBody)
+		if !ok {
+			return m.Marshaler.NewDecoder(r).Decode(v)
+		}
+		data, err := io.ReadAll(io.LimitReader(r, maxHTTPBodySize+1))
+		if err != nil {
+			return err
+		}
+		if len(data) > maxHTTPBodySize {
+			return fmt.Errorf("request body exceeds %d bytes", maxHTTPBodySize)
+		}
+		*body = &httpbody.HttpBody{Data: data}
+		return nil
+	})
+}
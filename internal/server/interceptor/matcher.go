@@ -14,8 +14,14 @@ var publicMethods = map[string]struct{}{
 	"/leaderboard.v1.LeaderboardService/ListEntries":                 {},
 	"/hungergames.v1.HungerGamesService/ListLeaderboard":             {},
 	"/hungergames.v1.HungerGamesService/ListSeasons":                 {},
+	"/hungergames.v1.HungerGamesService/ListSeasonSchedules":         {},
 	"/hungergames.v1.HungerGamesService/GetSeasonLeaderboard":        {},
 	"/hungergames.v1.HungerGamesService/GetPlayerStats":              {},
+	"/hungergames.v1.HungerGamesService/ListMatches":                 {},
+	"/hungergames.v1.HungerGamesService/GetMatch":                    {},
+	"/hungergames.v1.HungerGamesService/GetRatingHistory":            {},
+	"/imperialpoint.v1.ImperialPointService/GetPointTimeline":        {},
+	"/imperialpoint.v1.ImperialPointService/GetControlStats":         {},
 	"/verification.v1.VerificationService/VerifyCode":                {},
 	"/verification.v1.VerificationService/VerifyStatusByName":        {},
 	"/news.v1.NewsService/ListNews":                                  {},
@@ -23,6 +29,7 @@ var publicMethods = map[string]struct{}{
 	"/donate.v1.DonateService/ListShopItems":                         {},
 	"/settlement.v1.SettlementService/Get":                           {},
 	"/settlement.v1.SettlementService/List":                          {},
+	"/settlement.v1.SettlementService/ListPublicBoardPosts":          {},
 	"/user.v1.UserService/GetUser":                                   {},
 	"/user.v1.UserService/BatchGetUsers":                             {},
 	"/settlement.v1.SettlementTagService/GetTag":                     {},
@@ -13,14 +13,18 @@ func TestAuthMatcherPublicMethods(t *testing.T) {
 		"/user.v1.UserService/GetUser",
 		"/user.v1.UserService/BatchGetUsers",
 		"/settlement.v1.SettlementService/List",
+		"/settlement.v1.SettlementService/ListPublicBoardPosts",
 		"/settlement.v1.SettlementTagService/GetTag",
 		"/settlement.v1.SettlementTagService/GetTags",
 		"/settlement.v1.SettlementTagService/GetTagsByIds",
+		"/imperialpoint.v1.ImperialPointService/GetPointTimeline",
+		"/imperialpoint.v1.ImperialPointService/GetControlStats",
 	}
 	protected := []string{
 		"/user.v1.UserService/SearchUsers",
 		"/user.v1.UserService/ChangeNickname",
 		"/settlement.v1.SettlementTagService/CreateTag",
+		"/settlement.v1.SettlementService/ListBoardPosts",
 	}
 
 	split := func(m string) interceptors.CallMeta {
@@ -60,13 +60,13 @@ var App = fx.Options(
 									grpcaddr,
 									addr,
 									runtime.WithMarshalerOption(
-										runtime.MIMEWildcard, &runtime.JSONPb{
+										runtime.MIMEWildcard, newHTTPBodyMarshaler(&runtime.JSONPb{
 											MarshalOptions: protojson.MarshalOptions{
 												UseProtoNames:     true,
 												EmitDefaultValues: true,
 											},
 											UnmarshalOptions: protojson.UnmarshalOptions{},
-										},
+										}),
 									),
 								)
 								if err != nil {
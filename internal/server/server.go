@@ -18,6 +18,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	discordv1 "github.com/lasthearth/vsservice/gen/discord/v1"
 	donatev1 "github.com/lasthearth/vsservice/gen/donate/v1"
+	factionv1 "github.com/lasthearth/vsservice/gen/faction/v1"
 	hgv1 "github.com/lasthearth/vsservice/gen/hungergames/v1"
 	imperialpointv1 "github.com/lasthearth/vsservice/gen/imperialpoint/v1"
 	leaderboardv1 "github.com/lasthearth/vsservice/gen/leaderboard/v1"
@@ -111,6 +112,7 @@ func (s *Server) Run(ctx context.Context, network, address string) error {
 	mediav1.RegisterMediaServiceServer(srv, s.mediaV1)
 	progressionv1.RegisterProgressionServiceServer(srv, s.progressionV1)
 	imperialpointv1.RegisterImperialPointServiceServer(srv, s.imperialPointV1)
+	factionv1.RegisterFactionServiceServer(srv, s.factionV1)
 	discordv1.RegisterDiscordServiceServer(srv, s.discordV1)
 	reflection.Register(srv)
 
@@ -187,6 +189,10 @@ func (s *Server) RunInProcessGateway(ctx context.Context, grpcaddr, addr string,
 		return errors.Wrap(err, "register imperialpoint service handler")
 	}
 
+	if err := factionv1.RegisterFactionServiceHandlerFromEndpoint(ctx, mux, grpcaddr, dopts); err != nil {
+		return errors.Wrap(err, "register faction service handler")
+	}
+
 	if err := discordv1.RegisterDiscordServiceHandlerFromEndpoint(ctx, mux, grpcaddr, dopts); err != nil {
 		return errors.Wrap(err, "register discord service handler")
 	}
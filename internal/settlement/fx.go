@@ -1,8 +1,15 @@
 package settlement
 
 import (
+	"context"
+
 	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/player/playeruc"
+	"github.com/lasthearth/vsservice/internal/progression/progressionuc"
 	"github.com/lasthearth/vsservice/internal/server/interceptor"
 	repository "github.com/lasthearth/vsservice/internal/settlement/internal/repository/mongo"
 	"github.com/lasthearth/vsservice/internal/settlement/internal/repository/mongo/repomapper"
@@ -41,6 +48,12 @@ var App = fx.Options(
 				repository.New,
 				fx.As(new(service.SettlementRepository)),
 			),
+			func(uc *donateuc.DebitUseCase) service.CoinDebitor { return uc },
+			func(uc *donateuc.AddCoinsUseCase) service.CoinCreditor { return uc },
+			func(uc *donateuc.ShopUseCase) service.ShopGranter { return uc },
+			func(uc *playeruc.ActivityUseCase) service.ActivityTracker { return uc },
+			func(uc *notificationuc.Create) service.Notifier { return uc },
+			func(uc *progressionuc.NodesUseCase) service.TalentNodes { return uc },
 		),
 
 		fx.Provide(
@@ -56,6 +69,29 @@ var App = fx.Options(
 			func(repo service.SettlementRepository) *settlementuc.FavorOps {
 				return settlementuc.NewFavorOps(repo)
 			},
+			func(repo service.SettlementRepository) *settlementuc.Members {
+				return settlementuc.NewMembers(repo)
+			},
+			func(repo service.SettlementRepository) *settlementuc.Types {
+				return settlementuc.NewTypes(repo)
+			},
 		),
+
+		fx.Invoke(func(lc fx.Lifecycle, opts service.Opts, cfg config.Config) {
+			svc := service.New(opts)
+			ctx, cancel := context.WithCancel(context.Background())
+			lc.Append(fx.Hook{
+				OnStart: func(context.Context) error {
+					go svc.RunActivitySweeps(ctx, cfg.SettlementActivityInterval)
+					go svc.RunFavorRecovery(ctx, cfg.SettlementFavorRecoveryInterval)
+					go svc.RunTreasuryRecovery(ctx, cfg.SettlementTreasuryRecoveryInterval)
+					return nil
+				},
+				OnStop: func(context.Context) error {
+					cancel()
+					return nil
+				},
+			})
+		}),
 	),
 )
@@ -0,0 +1,29 @@
+package boardpostdto
+
+import (
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	attachmentdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/attachment"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type BoardPost struct {
+	mongox.Model  `bson:",inline"`
+	SettlementId  bson.ObjectID              `bson:"settlement_id"`
+	AuthorId      string                     `bson:"author_id"`
+	Title         string                     `bson:"title"`
+	Body          string                     `bson:"body"`
+	Attachments   []attachmentdto.Attachment `bson:"attachments"`
+	Visibility    string                     `bson:"visibility"`
+	Announcement  bool                       `bson:"announcement"`
+	Pinned        bool                       `bson:"pinned"`
+	RemovedBy     string                     `bson:"removed_by,omitempty"`
+	RemovalReason string                     `bson:"removal_reason,omitempty"`
+	RemovedAt     time.Time                  `bson:"removed_at,omitempty"`
+	RateSlot      string                     `bson:"rate_slot,omitempty"`
+}
+
+func (p BoardPost) Id() bson.ObjectID {
+	return p.Model.Id
+}
@@ -0,0 +1,15 @@
+package favortransferdto
+
+import (
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type FavorTransfer struct {
+	mongox.Model     `bson:",inline"`
+	FromSettlementId bson.ObjectID `bson:"from_settlement_id"`
+	ToSettlementId   bson.ObjectID `bson:"to_settlement_id"`
+	Amount           int64         `bson:"amount"`
+	ActorId          string        `bson:"actor_id"`
+	State            string        `bson:"state"`
+}
@@ -11,6 +11,7 @@ type ImperialFavorLog struct {
 	AdminId      string        `bson:"admin_id"`
 	Amount       int64         `bson:"amount"`
 	Reason       string        `bson:"reason"`
+	OpKey        string        `bson:"op_key,omitempty"`
 }
 
 func (l ImperialFavorLog) Id() bson.ObjectID {
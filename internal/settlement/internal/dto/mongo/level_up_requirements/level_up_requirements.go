@@ -0,0 +1,21 @@
+package levelupdto
+
+import (
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+)
+
+type TalentNodeRef struct {
+	TreeId string `bson:"tree_id"`
+	NodeId string `bson:"node_id"`
+}
+
+type LevelUpRequirements struct {
+	mongox.Model     `bson:",inline"`
+	Type             string          `bson:"type"`
+	MinMembers       int             `bson:"min_members"`
+	MinImperialFavor int64           `bson:"min_imperial_favor"`
+	MinAgeDays       int             `bson:"min_age_days"`
+	RequiredNodes    []TalentNodeRef `bson:"required_nodes"`
+	FeeImperialFavor int64           `bson:"fee_imperial_favor"`
+	FeeCoins         int64           `bson:"fee_coins"`
+}
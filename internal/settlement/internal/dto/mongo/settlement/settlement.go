@@ -1,6 +1,8 @@
 package settlementdto
 
 import (
+	"time"
+
 	"github.com/lasthearth/vsservice/internal/pkg/mongox"
 	attachmentdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/attachment"
 	memberdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/member"
@@ -19,4 +21,58 @@ type Settlement struct {
 	Description   string                     `bson:"description"`
 	TagIds        []string                   `bson:"tag_ids"`
 	ImperialFavor int64                      `bson:"imperial_favor"`
+	// PendingFavorLogs are written with the balance change that produced
+	// them and removed once copied to the favor log collection.
+	PendingFavorLogs []PendingFavorLog `bson:"pending_favor_logs"`
+	Treasury         int64             `bson:"treasury"`
+	// PendingTreasuryEntries are written with the balance change that
+	// produced them and removed once copied to the treasury ledger.
+	PendingTreasuryEntries []PendingTreasuryEntry `bson:"pending_treasury_entries"`
+	PendingDeposits        []PendingDeposit       `bson:"pending_deposits"`
+	PendingPayouts         []PendingPayout        `bson:"pending_payouts"`
+	Treasurers             []string               `bson:"treasurers"`
+	Heralds                []string               `bson:"heralds"`
+
+	LastActivityAt time.Time `bson:"last_activity_at"`
+	InactiveSince  time.Time `bson:"inactive_since"`
+	Hidden         bool      `bson:"hidden"`
+	ActivityExempt bool      `bson:"activity_exempt"`
+}
+
+type PendingFavorLog struct {
+	OpKey     string    `bson:"op_key"`
+	AdminId   string    `bson:"admin_id"`
+	Amount    int64     `bson:"amount"`
+	Reason    string    `bson:"reason"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type PendingTreasuryEntry struct {
+	OpKey        string    `bson:"op_key"`
+	ActorId      string    `bson:"actor_id"`
+	Kind         string    `bson:"kind"`
+	Amount       int64     `bson:"amount"`
+	BalanceAfter int64     `bson:"balance_after"`
+	Reason       string    `bson:"reason"`
+	ItemId       string    `bson:"item_id,omitempty"`
+	PurchaseId   string    `bson:"purchase_id,omitempty"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+type PendingDeposit struct {
+	OpKey     string    `bson:"op_key"`
+	ActorId   string    `bson:"actor_id"`
+	Amount    int64     `bson:"amount"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type PendingPayout struct {
+	OpKey       string    `bson:"op_key"`
+	ActorId     string    `bson:"actor_id"`
+	RecipientId string    `bson:"recipient_id"`
+	Kind        string    `bson:"kind"`
+	Amount      int64     `bson:"amount"`
+	Reason      string    `bson:"reason"`
+	ItemId      string    `bson:"item_id,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
 }
@@ -0,0 +1,23 @@
+package treasuryentrydto
+
+import (
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type TreasuryEntry struct {
+	mongox.Model `bson:",inline"`
+	SettlementId bson.ObjectID `bson:"settlement_id"`
+	ActorId      string        `bson:"actor_id"`
+	Kind         string        `bson:"kind"`
+	Amount       int64         `bson:"amount"`
+	BalanceAfter int64         `bson:"balance_after"`
+	Reason       string        `bson:"reason"`
+	ItemId       string        `bson:"item_id,omitempty"`
+	PurchaseId   string        `bson:"purchase_id,omitempty"`
+	OpKey        string        `bson:"op_key,omitempty"`
+}
+
+func (e TreasuryEntry) Id() bson.ObjectID {
+	return e.Model.Id
+}
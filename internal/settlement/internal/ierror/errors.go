@@ -19,4 +19,12 @@ var (
 	ErrAlreadyMember           = ierror.AlreadyExists("user is already a member of the settlement")
 	ErrNotLeader               = ierror.PermissionDenied("user is not a leader of this settlement")
 	ErrPermissionDenied        = ierror.PermissionDenied("permission denied")
+	ErrNotMember               = ierror.PermissionDenied("user is not a member of this settlement")
+	ErrNotTreasurer            = ierror.PermissionDenied("user is not the leader or a treasurer of this settlement")
+	ErrDepositAbandoned        = ierror.FailedPrecondition("treasury deposit was abandoned, coins returned to the wallet")
+	ErrTransferStateChanged    = ierror.FailedPrecondition("favor transfer state changed concurrently")
+	ErrMemberCapReached        = ierror.ResourceExhausted("settlement member cap reached")
+	ErrBoardPostNotFound       = ierror.NotFound("board post not found")
+	ErrNotHerald               = ierror.PermissionDenied("user is not the leader or a herald of this settlement")
+	ErrBoardRateLimited        = ierror.ResourceExhausted("too many board posts, try again later")
 )
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	boardpostdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/board_post"
 	invitationdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/invitation"
 	settlementdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/settlement"
 	verificationdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/verification"
@@ -23,6 +24,10 @@ const (
 	settlementReqCollName        = "settlement_requests"
 	settlementInvitationCollName = "settlement_invitations"
 	imperialFavorLogCollName     = "imperial_favor_logs"
+	treasuryLedgerCollName       = "settlement_treasury_ledger"
+	levelUpReqCollName           = "settlement_level_up_requirements"
+	favorTransferCollName        = "imperial_favor_transfers"
+	boardPostCollName            = "settlement_board_posts"
 )
 
 var _ service.SettlementRepository = (*Repository)(nil)
@@ -39,7 +44,9 @@ type Mapper interface {
 	ToInvModels(dto []invitationdto.Invitation) []model.Invitation
 	ToInvModel(dto invitationdto.Invitation) model.Invitation
 
-	// goverter:ignore Members TagIds ImperialFavor
+	// goverter:ignore Members TagIds ImperialFavor PendingFavorLogs Treasury Treasurers Heralds
+	// goverter:ignore PendingTreasuryEntries PendingDeposits PendingPayouts
+	// goverter:ignore LastActivityAt InactiveSince Hidden ActivityExempt
 	FromVerification(dto verificationdto.SettlementVerification) settlementdto.Settlement
 
 	FromSettlementsDTO([]settlementdto.Settlement) []model.Settlement
@@ -49,6 +56,19 @@ type Mapper interface {
 
 	// goverter:ignore Model
 	ToSettlementDTO(model.Settlement) settlementdto.Settlement
+
+	// goverter:ignore Id SettlementId
+	FromPendingFavorLogDTO(settlementdto.PendingFavorLog) model.ImperialFavorLog
+
+	// goverter:ignore Id SettlementId
+	FromPendingTreasuryEntryDTO(settlementdto.PendingTreasuryEntry) model.TreasuryEntry
+
+	// goverter:autoMap Model
+	// goverter:map Model.Id Id
+	FromBoardPostDTO(boardpostdto.BoardPost) model.BoardPost
+
+	// goverter:ignore Model SettlementId
+	ToBoardPostDTO(model.BoardPost) boardpostdto.BoardPost
 }
 
 type Opts struct {
@@ -69,6 +89,14 @@ type Repository struct {
 	setInvColl *mongo.Collection
 	// Imperial favor log collection
 	favorLogColl *mongo.Collection
+	// Treasury ledger collection
+	treasuryColl *mongo.Collection
+	// Level-up requirements collection, one document per target type
+	levelUpColl *mongo.Collection
+	// Favor transfer journal collection
+	transferColl *mongo.Collection
+	// Bulletin board posts collection
+	boardColl *mongo.Collection
 	// MongoDB client used for transactions
 	client *mongo.Client
 	mapper Mapper
@@ -79,14 +107,22 @@ func New(opts Opts) *Repository {
 	srColl := opts.Database.Collection(settlementReqCollName)
 	siColl := opts.Database.Collection(settlementInvitationCollName)
 	flColl := opts.Database.Collection(imperialFavorLogCollName)
+	tlColl := opts.Database.Collection(treasuryLedgerCollName)
+	luColl := opts.Database.Collection(levelUpReqCollName)
+	ftColl := opts.Database.Collection(favorTransferCollName)
+	bpColl := opts.Database.Collection(boardPostCollName)
 	logger := opts.Log.WithComponent("settlement-mongo-repository")
-	setupIndexes(logger, sColl, srColl, siColl, flColl)
+	setupIndexes(logger, sColl, srColl, siColl, flColl, tlColl, luColl, ftColl, bpColl)
 	return &Repository{
 		log:          logger,
 		setColl:      sColl,
 		setReqColl:   srColl,
 		setInvColl:   siColl,
 		favorLogColl: flColl,
+		treasuryColl: tlColl,
+		levelUpColl:  luColl,
+		transferColl: ftColl,
+		boardColl:    bpColl,
 		client:       opts.Client,
 		mapper:       opts.Mapper,
 	}
@@ -98,6 +134,10 @@ func setupIndexes(
 	setReqColl *mongo.Collection,
 	setInvColl *mongo.Collection,
 	favorLogColl *mongo.Collection,
+	treasuryColl *mongo.Collection,
+	levelUpColl *mongo.Collection,
+	transferColl *mongo.Collection,
+	boardColl *mongo.Collection,
 ) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
@@ -132,4 +172,39 @@ func setupIndexes(
 	createIndex(favorLogColl, mongo.IndexModel{
 		Keys: bson.D{{Key: "settlement_id", Value: -1}},
 	})
+
+	createIndex(favorLogColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "op_key", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"op_key": bson.M{"$exists": true}}),
+	})
+
+	createIndex(treasuryColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "settlement_id", Value: 1}, {Key: "_id", Value: -1}},
+	})
+
+	createIndex(treasuryColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "op_key", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"op_key": bson.M{"$exists": true}}),
+	})
+
+	createIndex(transferColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "state", Value: 1}, {Key: "updated_at", Value: 1}},
+	})
+
+	createIndex(boardColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "settlement_id", Value: 1}, {Key: "pinned", Value: 1}, {Key: "_id", Value: -1}},
+	})
+
+	createIndex(boardColl, mongo.IndexModel{
+		Keys: bson.D{{Key: "rate_slot", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.M{"rate_slot": bson.M{"$exists": true}}),
+	})
+
+	createIndex(levelUpColl, mongo.IndexModel{
+		Keys:    bson.D{{Key: "type", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
 }
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox/pagination"
+	boardpostdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/board_post"
+	repoerr "github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/zap"
+)
+
+// CreateBoardPost implements service.SettlementDbRepository. The post takes
+// the first of slots whose unique index entry is free; removed posts keep
+// theirs, so taking a post down does not free a slot.
+func (r *Repository) CreateBoardPost(ctx context.Context, post model.BoardPost, slots []string) (*model.BoardPost, error) {
+	l := r.log.WithMethod("CreateBoardPost").
+		With(zap.String("settlement_id", post.SettlementId), zap.String("author_id", post.AuthorId))
+
+	oid, err := mongox.ParseObjectID(post.SettlementId)
+	if err != nil {
+		return nil, repoerr.ErrNotFound
+	}
+
+	dto := r.mapper.ToBoardPostDTO(post)
+	dto.SettlementId = oid
+	if len(slots) == 0 {
+		slots = []string{""}
+	}
+	for _, slot := range slots {
+		dto.Model = mongox.NewModel()
+		dto.RateSlot = slot
+		_, err := r.boardColl.InsertOne(ctx, dto)
+		if err == nil {
+			post.MarkCreated(dto.Model.Id.Hex(), slot, dto.CreatedAt)
+			return &post, nil
+		}
+		if slot == "" || !mongo.IsDuplicateKeyError(err) {
+			l.Error("failed to insert board post", zap.Error(err))
+			return nil, err
+		}
+	}
+	return nil, repoerr.ErrBoardRateLimited
+}
+
+// UpdateBoardPost implements service.SettlementDbRepository.
+func (r *Repository) UpdateBoardPost(
+	ctx context.Context,
+	id string,
+	updateFn func(ctx context.Context, p *model.BoardPost) (*model.BoardPost, error),
+) (*model.BoardPost, error) {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return nil, repoerr.ErrBoardPostNotFound
+	}
+
+	updated, err := mongox.UpdateDoc(
+		ctx,
+		r.boardColl,
+		bson.M{"_id": oid},
+		repoerr.ErrBoardPostNotFound,
+		func(d boardpostdto.BoardPost) *model.BoardPost {
+			p := r.mapper.FromBoardPostDTO(d)
+			return &p
+		},
+		func(p *model.BoardPost) boardpostdto.BoardPost {
+			d := r.mapper.ToBoardPostDTO(*p)
+			d.SettlementId, _ = mongox.ParseObjectID(p.SettlementId)
+			return d
+		},
+		updateFn,
+	)
+	if err != nil && !errors.Is(err, repoerr.ErrBoardPostNotFound) {
+		r.log.WithMethod("UpdateBoardPost").Error("failed to update board post", zap.String("id", id), zap.Error(err))
+	}
+	return updated, err
+}
+
+// ListBoardPosts implements service.SettlementDbRepository. Lists the posts of
+// a settlement that are not removed, newest first.
+func (r *Repository) ListBoardPosts(ctx context.Context, filter model.BoardPostFilter, nextToken string, limit int64) ([]model.BoardPost, string, error) {
+	l := r.log.WithMethod("ListBoardPosts").With(zap.String("settlement_id", filter.SettlementId))
+
+	oid, err := mongox.ParseObjectID(filter.SettlementId)
+	if err != nil {
+		return nil, "", repoerr.ErrNotFound
+	}
+
+	query := bson.M{
+		"settlement_id": oid,
+		"pinned":        filter.Pinned,
+		"removed_by":    bson.M{"$exists": false},
+	}
+	if filter.PublicOnly {
+		query["visibility"] = string(model.PostVisibilityPublic)
+	}
+
+	posts, next, err := pagination.List(
+		ctx, r.boardColl, nextToken, limit, r.mapper.FromBoardPostDTO,
+		pagination.WithFilter(query),
+	)
+	if err != nil {
+		l.Error("failed to list board posts", zap.Error(err))
+		return nil, "", err
+	}
+
+	return posts, next, nil
+}
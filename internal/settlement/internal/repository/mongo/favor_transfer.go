@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
+	favortransferdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/favor_transfer"
+	repoerr "github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.uber.org/zap"
+)
+
+// CreateFavorTransfer implements service.SettlementDbRepository.
+func (r *Repository) CreateFavorTransfer(ctx context.Context, t model.FavorTransfer) (*model.FavorTransfer, error) {
+	l := r.log.WithMethod("CreateFavorTransfer").
+		With(zap.String("from", t.FromSettlementId), zap.String("to", t.ToSettlementId), zap.Int64("amount", t.Amount))
+
+	fromOid, err := mongox.ParseObjectID(t.FromSettlementId)
+	if err != nil {
+		return nil, repoerr.ErrNotFound
+	}
+	toOid, err := mongox.ParseObjectID(t.ToSettlementId)
+	if err != nil {
+		return nil, repoerr.ErrNotFound
+	}
+
+	dto := favortransferdto.FavorTransfer{
+		Model:            mongox.NewModel(),
+		FromSettlementId: fromOid,
+		ToSettlementId:   toOid,
+		Amount:           t.Amount,
+		ActorId:          t.ActorId,
+		State:            string(t.State),
+	}
+	if _, err := r.transferColl.InsertOne(ctx, dto); err != nil {
+		l.Error("failed to insert favor transfer", zap.Error(err))
+		return nil, err
+	}
+
+	created := favorTransferFromDTO(dto)
+	return &created, nil
+}
+
+// SetFavorTransferState implements service.SettlementDbRepository. Moves the
+// transfer from one state to another; ErrTransferStateChanged when it is no
+// longer in from.
+func (r *Repository) SetFavorTransferState(ctx context.Context, id string, from, to model.FavorTransferState) error {
+	oid, err := mongox.ParseObjectID(id)
+	if err != nil {
+		return repoerr.ErrNotFound
+	}
+
+	res, err := r.transferColl.UpdateOne(ctx,
+		bson.M{"_id": oid, "state": string(from)},
+		bson.M{"$set": bson.M{"state": string(to), "updated_at": time.Now()}},
+	)
+	if err != nil {
+		r.log.WithMethod("SetFavorTransferState").Error("failed to update favor transfer",
+			zap.Error(err), zap.String("id", id), zap.String("to", string(to)))
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repoerr.ErrTransferStateChanged
+	}
+	return nil
+}
+
+// ListStaleFavorTransfers implements service.SettlementDbRepository. Returns
+// unfinished transfers last touched before before, oldest first.
+func (r *Repository) ListStaleFavorTransfers(ctx context.Context, before time.Time) ([]model.FavorTransfer, error) {
+	l := r.log.WithMethod("ListStaleFavorTransfers")
+
+	cur, err := r.transferColl.Find(ctx, bson.M{
+		"state": bson.M{"$in": bson.A{
+			string(model.FavorTransferPending),
+			string(model.FavorTransferDebited),
+		}},
+		"updated_at": bson.M{"$lt": before},
+	})
+	if err != nil {
+		l.Error("failed to find favor transfers", zap.Error(err))
+		return nil, err
+	}
+
+	var docs []favortransferdto.FavorTransfer
+	if err := cur.All(ctx, &docs); err != nil {
+		l.Error("failed to decode favor transfers", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]model.FavorTransfer, len(docs))
+	for i, d := range docs {
+		out[i] = favorTransferFromDTO(d)
+	}
+	return out, nil
+}
+
+func favorTransferFromDTO(d favortransferdto.FavorTransfer) model.FavorTransfer {
+	return model.FavorTransfer{
+		Id:               d.Model.Id.Hex(),
+		FromSettlementId: d.FromSettlementId.Hex(),
+		ToSettlementId:   d.ToSettlementId.Hex(),
+		Amount:           d.Amount,
+		ActorId:          d.ActorId,
+		State:            model.FavorTransferState(d.State),
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
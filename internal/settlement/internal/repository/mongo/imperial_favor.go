@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
 	"github.com/lasthearth/vsservice/internal/pkg/mongox/orderby"
@@ -9,9 +10,12 @@ import (
 	favorlogdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/imperial_favor_log"
 	"github.com/lasthearth/vsservice/internal/settlement/model"
 	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
 	"go.uber.org/zap"
 )
 
+// CreateFavorLog implements service.SettlementDbRepository. An entry with an
+// OpKey is written at most once; writing it again is a no-op.
 func (r *Repository) CreateFavorLog(ctx context.Context, log model.ImperialFavorLog) error {
 	l := r.log.WithMethod("CreateFavorLog").
 		With(zap.String("settlement_id", log.SettlementId), zap.Int64("amount", log.Amount))
@@ -28,9 +32,23 @@ func (r *Repository) CreateFavorLog(ctx context.Context, log model.ImperialFavor
 		AdminId:      log.AdminId,
 		Amount:       log.Amount,
 		Reason:       log.Reason,
+		OpKey:        log.OpKey,
+	}
+	if !log.CreatedAt.IsZero() {
+		dto.CreatedAt = log.CreatedAt
+		dto.UpdatedAt = log.CreatedAt
 	}
 
-	if _, err := r.favorLogColl.InsertOne(ctx, dto); err != nil {
+	if log.OpKey == "" {
+		_, err = r.favorLogColl.InsertOne(ctx, dto)
+	} else {
+		_, err = r.favorLogColl.UpdateOne(ctx,
+			bson.M{"op_key": log.OpKey},
+			bson.M{"$setOnInsert": dto},
+			options.UpdateOne().SetUpsert(true),
+		)
+	}
+	if err != nil {
 		l.Error("failed to insert favor log", zap.Error(err))
 		return err
 	}
@@ -38,6 +56,81 @@ func (r *Repository) CreateFavorLog(ctx context.Context, log model.ImperialFavor
 	return nil
 }
 
+// HasFavorLog implements service.SettlementDbRepository.
+func (r *Repository) HasFavorLog(ctx context.Context, opKey string) (bool, error) {
+	n, err := r.favorLogColl.CountDocuments(ctx, bson.M{"op_key": opKey}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.WithMethod("HasFavorLog").Error("failed to count favor logs", zap.Error(err), zap.String("op_key", opKey))
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// FlushFavorLogs implements service.SettlementDbRepository. Copies the
+// settlement's pending favor logs into the favor log collection, then removes
+// them from the settlement. Log writes are idempotent by op key, so a crash
+// between the two steps only makes the next flush repeat the copy.
+func (r *Repository) FlushFavorLogs(ctx context.Context, settlementID string) error {
+	l := r.log.WithMethod("FlushFavorLogs").With(zap.String("settlement_id", settlementID))
+
+	settlement, err := r.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return err
+	}
+	if len(settlement.PendingFavorLogs) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(settlement.PendingFavorLogs))
+	for i, entry := range settlement.PendingFavorLogs {
+		if err := r.CreateFavorLog(ctx, entry); err != nil {
+			return err
+		}
+		keys[i] = entry.OpKey
+	}
+
+	oid, err := mongox.ParseObjectID(settlementID)
+	if err != nil {
+		return err
+	}
+	// updated_at is bumped so a concurrent UpdateSettlement retries on fresh
+	// state instead of putting the flushed entries back.
+	if _, err := r.setColl.UpdateByID(ctx, oid, bson.M{
+		"$pull": bson.M{"pending_favor_logs": bson.M{"op_key": bson.M{"$in": keys}}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}); err != nil {
+		l.Error("failed to clear pending favor logs", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ListSettlementsWithPendingFavorLogs implements service.SettlementDbRepository.
+func (r *Repository) ListSettlementsWithPendingFavorLogs(ctx context.Context) ([]string, error) {
+	cur, err := r.setColl.Find(ctx,
+		bson.M{"pending_favor_logs.0": bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		r.log.WithMethod("ListSettlementsWithPendingFavorLogs").Error("failed to find settlements", zap.Error(err))
+		return nil, err
+	}
+
+	var docs []struct {
+		Id bson.ObjectID `bson:"_id"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(docs))
+	for i, d := range docs {
+		ids[i] = d.Id.Hex()
+	}
+	return ids, nil
+}
+
 var favorLogAllowedSortFields = map[string]string{
 	"created_at":    "created_at",
 	"settlement_id": "settlement_id",
@@ -92,6 +185,7 @@ func favorLogFromDTO(d favorlogdto.ImperialFavorLog) model.ImperialFavorLog {
 		AdminId:      d.AdminId,
 		Amount:       d.Amount,
 		Reason:       d.Reason,
+		OpKey:        d.OpKey,
 		CreatedAt:    d.CreatedAt,
 	}
 }
@@ -47,10 +47,15 @@ func (r *Repository) GetUserInvitations(ctx context.Context, userID string) ([]m
 	return r.mapper.ToInvModels(invitations), nil
 }
 
-func (r *Repository) AcceptInvitation(ctx context.Context, invitationID, userID string) error {
+// AcceptInvitation adds the invited user to the settlement and deletes the
+// invitation. The push only matches while the settlement has fewer than
+// maxMembers members, leader included, so concurrent accepts cannot overfill
+// it; maxMembers <= 0 means unlimited.
+func (r *Repository) AcceptInvitation(ctx context.Context, invitationID, userID string, maxMembers int) error {
 	l := r.log.
 		With(
 			zap.String("invitation_id", invitationID),
+			zap.Int("max_members", maxMembers),
 		).
 		WithMethod("accept_invitation")
 
@@ -84,9 +89,24 @@ func (r *Repository) AcceptInvitation(ctx context.Context, invitationID, userID
 			return err
 		}
 
+		filter := bson.M{
+			"_id":             sid,
+			"leader.user_id":  bson.M{"$ne": inv.UserId},
+			"members.user_id": bson.M{"$ne": inv.UserId},
+		}
+		if maxMembers > 0 {
+			// +1 for the leader, who is not stored in members.
+			filter["$expr"] = bson.M{
+				"$lt": bson.A{
+					bson.M{"$add": bson.A{bson.M{"$size": bson.M{"$ifNull": bson.A{"$members", bson.A{}}}}, 1}},
+					maxMembers,
+				},
+			}
+		}
+
 		upd, err := r.setColl.UpdateOne(
 			ctx,
-			bson.M{"_id": sid},
+			filter,
 			bson.D{
 				{
 					Key: "$push",
@@ -106,6 +126,9 @@ func (r *Repository) AcceptInvitation(ctx context.Context, invitationID, userID
 			l.Error("failed to update settlement", zap.Error(err))
 			return err
 		}
+		if upd.MatchedCount == 0 {
+			return r.acceptMismatch(ctx, inv.SettlementId, inv.UserId)
+		}
 
 		err = r.DeleteInvitation(ctx, invitationID)
 		if err != nil {
@@ -124,6 +147,24 @@ func (r *Repository) AcceptInvitation(ctx context.Context, invitationID, userID
 	return err
 }
 
+// acceptMismatch explains why the conditional push in AcceptInvitation
+// matched nothing.
+func (r *Repository) acceptMismatch(ctx context.Context, settlementID, userID string) error {
+	s, err := r.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return err
+	}
+	if s.IsMember(userID) {
+		return repoerr.ErrAlreadyMember
+	}
+	return repoerr.ErrMemberCapReached
+}
+
+// GetInvitation returns the invitation with the given id.
+func (r *Repository) GetInvitation(ctx context.Context, invitationID string) (*model.Invitation, error) {
+	return r.getInvitation(ctx, invitationID)
+}
+
 func (r *Repository) GetInvitations(ctx context.Context, settlementID string) ([]model.Invitation, error) {
 	l := r.log.
 		With(
@@ -211,6 +252,8 @@ func (r *Repository) RemoveMember(ctx context.Context, settlementID, userID stri
 				Key: "$pull",
 				Value: bson.D{
 					{Key: "members", Value: bson.M{"user_id": userID}},
+					{Key: "treasurers", Value: userID},
+					{Key: "heralds", Value: userID},
 				},
 			},
 			{
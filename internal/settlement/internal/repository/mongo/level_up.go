@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
+	levelupdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/level_up_requirements"
+	repoerr "github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+// SetLevelUpRequirements implements service.SettlementDbRepository. Replaces
+// the requirements stored for req.Type, creating them if there are none.
+func (r *Repository) SetLevelUpRequirements(ctx context.Context, req model.LevelUpRequirements) (*model.LevelUpRequirements, error) {
+	l := r.log.WithMethod("SetLevelUpRequirements").With(zap.String("type", string(req.Type)))
+
+	nodes := make([]levelupdto.TalentNodeRef, len(req.RequiredNodes))
+	for i, n := range req.RequiredNodes {
+		nodes[i] = levelupdto.TalentNodeRef{TreeId: n.TreeId, NodeId: n.NodeId}
+	}
+
+	now := time.Now()
+	fresh := mongox.NewModel()
+	var dto levelupdto.LevelUpRequirements
+	err := r.levelUpColl.FindOneAndUpdate(ctx,
+		bson.M{"type": string(req.Type)},
+		bson.M{
+			"$set": bson.M{
+				"min_members":        req.MinMembers,
+				"min_imperial_favor": req.MinImperialFavor,
+				"min_age_days":       req.MinAgeDays,
+				"required_nodes":     nodes,
+				"fee_imperial_favor": req.FeeImperialFavor,
+				"fee_coins":          req.FeeCoins,
+				"updated_at":         now,
+			},
+			"$setOnInsert": bson.M{"_id": fresh.Id, "created_at": now},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&dto)
+	if err != nil {
+		l.Error("failed to upsert level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	res := levelUpFromDTO(dto)
+	return &res, nil
+}
+
+// GetLevelUpRequirements implements service.SettlementDbRepository.
+// ErrNotFound when nothing is configured for t.
+func (r *Repository) GetLevelUpRequirements(ctx context.Context, t model.SettlementType) (*model.LevelUpRequirements, error) {
+	var dto levelupdto.LevelUpRequirements
+	if err := r.levelUpColl.FindOne(ctx, bson.M{"type": string(t)}).Decode(&dto); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repoerr.ErrNotFound
+		}
+		r.log.WithMethod("GetLevelUpRequirements").Error("failed to find level-up requirements", zap.Error(err), zap.String("type", string(t)))
+		return nil, err
+	}
+
+	res := levelUpFromDTO(dto)
+	return &res, nil
+}
+
+// ListLevelUpRequirements implements service.SettlementDbRepository.
+func (r *Repository) ListLevelUpRequirements(ctx context.Context) ([]model.LevelUpRequirements, error) {
+	l := r.log.WithMethod("ListLevelUpRequirements")
+
+	cur, err := r.levelUpColl.Find(ctx, bson.M{})
+	if err != nil {
+		l.Error("failed to find level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	var docs []levelupdto.LevelUpRequirements
+	if err := cur.All(ctx, &docs); err != nil {
+		l.Error("failed to decode level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]model.LevelUpRequirements, len(docs))
+	for i, d := range docs {
+		out[i] = levelUpFromDTO(d)
+	}
+	return out, nil
+}
+
+func levelUpFromDTO(d levelupdto.LevelUpRequirements) model.LevelUpRequirements {
+	nodes := make([]model.TalentNodeRef, len(d.RequiredNodes))
+	for i, n := range d.RequiredNodes {
+		nodes[i] = model.TalentNodeRef{TreeId: n.TreeId, NodeId: n.NodeId}
+	}
+	return model.LevelUpRequirements{
+		Type:             model.SettlementType(d.Type),
+		MinMembers:       d.MinMembers,
+		MinImperialFavor: d.MinImperialFavor,
+		MinAgeDays:       d.MinAgeDays,
+		RequiredNodes:    nodes,
+		FeeImperialFavor: d.FeeImperialFavor,
+		FeeCoins:         d.FeeCoins,
+		UpdatedAt:        d.UpdatedAt,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	goverter "github.com/lasthearth/vsservice/internal/pkg/goverter"
 	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
 	attachment "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/attachment"
+	boardpost "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/board_post"
 	invitation "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/invitation"
 	member "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/member"
 	settlement "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/settlement"
@@ -17,6 +18,30 @@ import (
 
 type MapperImpl struct{}
 
+func (c *MapperImpl) FromBoardPostDTO(source boardpost.BoardPost) model.BoardPost {
+	var modelBoardPost model.BoardPost
+	modelBoardPost.Id = goverter.ObjectIdToString(source.Model.Id)
+	modelBoardPost.SettlementId = goverter.ObjectIdToString(source.SettlementId)
+	modelBoardPost.AuthorId = source.AuthorId
+	modelBoardPost.Title = source.Title
+	modelBoardPost.Body = source.Body
+	if source.Attachments != nil {
+		modelBoardPost.Attachments = make([]model.Attachment, len(source.Attachments))
+		for i := 0; i < len(source.Attachments); i++ {
+			modelBoardPost.Attachments[i] = c.attachmentdtoAttachmentToModelAttachment(source.Attachments[i])
+		}
+	}
+	modelBoardPost.Visibility = model.PostVisibility(source.Visibility)
+	modelBoardPost.Announcement = source.Announcement
+	modelBoardPost.Pinned = source.Pinned
+	modelBoardPost.RemovedBy = source.RemovedBy
+	modelBoardPost.RemovalReason = source.RemovalReason
+	modelBoardPost.RemovedAt = goverter.TimeToTime(source.RemovedAt)
+	modelBoardPost.RateSlot = source.RateSlot
+	modelBoardPost.CreatedAt = goverter.TimeToTime(source.Model.CreatedAt)
+	modelBoardPost.UpdatedAt = goverter.TimeToTime(source.Model.UpdatedAt)
+	return modelBoardPost
+}
 func (c *MapperImpl) FromInvModel(source model.Invitation) invitation.Invitation {
 	var invitationdtoInvitation invitation.Invitation
 	invitationdtoInvitation.UserId = source.UserId
@@ -33,6 +58,28 @@ func (c *MapperImpl) FromInvModels(source []model.Invitation) []invitation.Invit
 	}
 	return invitationdtoInvitationList
 }
+func (c *MapperImpl) FromPendingFavorLogDTO(source settlement.PendingFavorLog) model.ImperialFavorLog {
+	var modelImperialFavorLog model.ImperialFavorLog
+	modelImperialFavorLog.AdminId = source.AdminId
+	modelImperialFavorLog.Amount = source.Amount
+	modelImperialFavorLog.Reason = source.Reason
+	modelImperialFavorLog.OpKey = source.OpKey
+	modelImperialFavorLog.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return modelImperialFavorLog
+}
+func (c *MapperImpl) FromPendingTreasuryEntryDTO(source settlement.PendingTreasuryEntry) model.TreasuryEntry {
+	var modelTreasuryEntry model.TreasuryEntry
+	modelTreasuryEntry.ActorId = source.ActorId
+	modelTreasuryEntry.Kind = model.TreasuryEntryKind(source.Kind)
+	modelTreasuryEntry.Amount = source.Amount
+	modelTreasuryEntry.BalanceAfter = source.BalanceAfter
+	modelTreasuryEntry.Reason = source.Reason
+	modelTreasuryEntry.ItemId = source.ItemId
+	modelTreasuryEntry.PurchaseId = source.PurchaseId
+	modelTreasuryEntry.OpKey = source.OpKey
+	modelTreasuryEntry.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return modelTreasuryEntry
+}
 func (c *MapperImpl) FromSettlementDTO(source settlement.Settlement) model.Settlement {
 	var modelSettlement model.Settlement
 	modelSettlement.Id = goverter.ObjectIdToString(source.Model.Id)
@@ -61,6 +108,47 @@ func (c *MapperImpl) FromSettlementDTO(source settlement.Settlement) model.Settl
 		}
 	}
 	modelSettlement.ImperialFavor = source.ImperialFavor
+	if source.PendingFavorLogs != nil {
+		modelSettlement.PendingFavorLogs = make([]model.ImperialFavorLog, len(source.PendingFavorLogs))
+		for l := 0; l < len(source.PendingFavorLogs); l++ {
+			modelSettlement.PendingFavorLogs[l] = c.FromPendingFavorLogDTO(source.PendingFavorLogs[l])
+		}
+	}
+	modelSettlement.Treasury = source.Treasury
+	if source.PendingTreasuryEntries != nil {
+		modelSettlement.PendingTreasuryEntries = make([]model.TreasuryEntry, len(source.PendingTreasuryEntries))
+		for m := 0; m < len(source.PendingTreasuryEntries); m++ {
+			modelSettlement.PendingTreasuryEntries[m] = c.FromPendingTreasuryEntryDTO(source.PendingTreasuryEntries[m])
+		}
+	}
+	if source.PendingDeposits != nil {
+		modelSettlement.PendingDeposits = make([]model.PendingDeposit, len(source.PendingDeposits))
+		for n := 0; n < len(source.PendingDeposits); n++ {
+			modelSettlement.PendingDeposits[n] = c.settlementdtoPendingDepositToModelPendingDeposit(source.PendingDeposits[n])
+		}
+	}
+	if source.PendingPayouts != nil {
+		modelSettlement.PendingPayouts = make([]model.PendingPayout, len(source.PendingPayouts))
+		for o := 0; o < len(source.PendingPayouts); o++ {
+			modelSettlement.PendingPayouts[o] = c.settlementdtoPendingPayoutToModelPendingPayout(source.PendingPayouts[o])
+		}
+	}
+	if source.Treasurers != nil {
+		modelSettlement.Treasurers = make([]string, len(source.Treasurers))
+		for p := 0; p < len(source.Treasurers); p++ {
+			modelSettlement.Treasurers[p] = source.Treasurers[p]
+		}
+	}
+	if source.Heralds != nil {
+		modelSettlement.Heralds = make([]string, len(source.Heralds))
+		for q := 0; q < len(source.Heralds); q++ {
+			modelSettlement.Heralds[q] = source.Heralds[q]
+		}
+	}
+	modelSettlement.LastActivityAt = goverter.TimeToTime(source.LastActivityAt)
+	modelSettlement.InactiveSince = goverter.TimeToTime(source.InactiveSince)
+	modelSettlement.Hidden = source.Hidden
+	modelSettlement.ActivityExempt = source.ActivityExempt
 	modelSettlement.UpdatedAt = goverter.TimeToTime(source.Model.UpdatedAt)
 	modelSettlement.CreatedAt = goverter.TimeToTime(source.Model.CreatedAt)
 	return modelSettlement
@@ -92,6 +180,26 @@ func (c *MapperImpl) FromVerification(source verification.SettlementVerification
 	settlementdtoSettlement.Description = source.Description
 	return settlementdtoSettlement
 }
+func (c *MapperImpl) ToBoardPostDTO(source model.BoardPost) boardpost.BoardPost {
+	var boardpostdtoBoardPost boardpost.BoardPost
+	boardpostdtoBoardPost.AuthorId = source.AuthorId
+	boardpostdtoBoardPost.Title = source.Title
+	boardpostdtoBoardPost.Body = source.Body
+	if source.Attachments != nil {
+		boardpostdtoBoardPost.Attachments = make([]attachment.Attachment, len(source.Attachments))
+		for i := 0; i < len(source.Attachments); i++ {
+			boardpostdtoBoardPost.Attachments[i] = c.modelAttachmentToAttachmentdtoAttachment(source.Attachments[i])
+		}
+	}
+	boardpostdtoBoardPost.Visibility = string(source.Visibility)
+	boardpostdtoBoardPost.Announcement = source.Announcement
+	boardpostdtoBoardPost.Pinned = source.Pinned
+	boardpostdtoBoardPost.RemovedBy = source.RemovedBy
+	boardpostdtoBoardPost.RemovalReason = source.RemovalReason
+	boardpostdtoBoardPost.RemovedAt = goverter.TimeToTime(source.RemovedAt)
+	boardpostdtoBoardPost.RateSlot = source.RateSlot
+	return boardpostdtoBoardPost
+}
 func (c *MapperImpl) ToInvModel(source invitation.Invitation) model.Invitation {
 	var modelInvitation model.Invitation
 	modelInvitation.Id = goverter.ObjectIdToString(source.Id)
@@ -136,6 +244,47 @@ func (c *MapperImpl) ToSettlementDTO(source model.Settlement) settlement.Settlem
 		}
 	}
 	settlementdtoSettlement.ImperialFavor = source.ImperialFavor
+	if source.PendingFavorLogs != nil {
+		settlementdtoSettlement.PendingFavorLogs = make([]settlement.PendingFavorLog, len(source.PendingFavorLogs))
+		for l := 0; l < len(source.PendingFavorLogs); l++ {
+			settlementdtoSettlement.PendingFavorLogs[l] = c.modelImperialFavorLogToSettlementdtoPendingFavorLog(source.PendingFavorLogs[l])
+		}
+	}
+	settlementdtoSettlement.Treasury = source.Treasury
+	if source.PendingTreasuryEntries != nil {
+		settlementdtoSettlement.PendingTreasuryEntries = make([]settlement.PendingTreasuryEntry, len(source.PendingTreasuryEntries))
+		for m := 0; m < len(source.PendingTreasuryEntries); m++ {
+			settlementdtoSettlement.PendingTreasuryEntries[m] = c.modelTreasuryEntryToSettlementdtoPendingTreasuryEntry(source.PendingTreasuryEntries[m])
+		}
+	}
+	if source.PendingDeposits != nil {
+		settlementdtoSettlement.PendingDeposits = make([]settlement.PendingDeposit, len(source.PendingDeposits))
+		for n := 0; n < len(source.PendingDeposits); n++ {
+			settlementdtoSettlement.PendingDeposits[n] = c.modelPendingDepositToSettlementdtoPendingDeposit(source.PendingDeposits[n])
+		}
+	}
+	if source.PendingPayouts != nil {
+		settlementdtoSettlement.PendingPayouts = make([]settlement.PendingPayout, len(source.PendingPayouts))
+		for o := 0; o < len(source.PendingPayouts); o++ {
+			settlementdtoSettlement.PendingPayouts[o] = c.modelPendingPayoutToSettlementdtoPendingPayout(source.PendingPayouts[o])
+		}
+	}
+	if source.Treasurers != nil {
+		settlementdtoSettlement.Treasurers = make([]string, len(source.Treasurers))
+		for p := 0; p < len(source.Treasurers); p++ {
+			settlementdtoSettlement.Treasurers[p] = source.Treasurers[p]
+		}
+	}
+	if source.Heralds != nil {
+		settlementdtoSettlement.Heralds = make([]string, len(source.Heralds))
+		for q := 0; q < len(source.Heralds); q++ {
+			settlementdtoSettlement.Heralds[q] = source.Heralds[q]
+		}
+	}
+	settlementdtoSettlement.LastActivityAt = goverter.TimeToTime(source.LastActivityAt)
+	settlementdtoSettlement.InactiveSince = goverter.TimeToTime(source.InactiveSince)
+	settlementdtoSettlement.Hidden = source.Hidden
+	settlementdtoSettlement.ActivityExempt = source.ActivityExempt
 	return settlementdtoSettlement
 }
 func (c *MapperImpl) attachmentdtoAttachmentToAttachmentdtoAttachment(source attachment.Attachment) attachment.Attachment {
@@ -169,11 +318,53 @@ func (c *MapperImpl) modelAttachmentToAttachmentdtoAttachment(source model.Attac
 	attachmentdtoAttachment.MimeType = source.MimeType
 	return attachmentdtoAttachment
 }
+func (c *MapperImpl) modelImperialFavorLogToSettlementdtoPendingFavorLog(source model.ImperialFavorLog) settlement.PendingFavorLog {
+	var settlementdtoPendingFavorLog settlement.PendingFavorLog
+	settlementdtoPendingFavorLog.OpKey = source.OpKey
+	settlementdtoPendingFavorLog.AdminId = source.AdminId
+	settlementdtoPendingFavorLog.Amount = source.Amount
+	settlementdtoPendingFavorLog.Reason = source.Reason
+	settlementdtoPendingFavorLog.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return settlementdtoPendingFavorLog
+}
 func (c *MapperImpl) modelMemberToMemberdtoMember(source model.Member) member.Member {
 	var memberdtoMember member.Member
 	memberdtoMember.UserId = source.UserId
 	return memberdtoMember
 }
+func (c *MapperImpl) modelPendingDepositToSettlementdtoPendingDeposit(source model.PendingDeposit) settlement.PendingDeposit {
+	var settlementdtoPendingDeposit settlement.PendingDeposit
+	settlementdtoPendingDeposit.OpKey = source.OpKey
+	settlementdtoPendingDeposit.ActorId = source.ActorId
+	settlementdtoPendingDeposit.Amount = source.Amount
+	settlementdtoPendingDeposit.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return settlementdtoPendingDeposit
+}
+func (c *MapperImpl) modelPendingPayoutToSettlementdtoPendingPayout(source model.PendingPayout) settlement.PendingPayout {
+	var settlementdtoPendingPayout settlement.PendingPayout
+	settlementdtoPendingPayout.OpKey = source.OpKey
+	settlementdtoPendingPayout.ActorId = source.ActorId
+	settlementdtoPendingPayout.RecipientId = source.RecipientId
+	settlementdtoPendingPayout.Kind = string(source.Kind)
+	settlementdtoPendingPayout.Amount = source.Amount
+	settlementdtoPendingPayout.Reason = source.Reason
+	settlementdtoPendingPayout.ItemId = source.ItemId
+	settlementdtoPendingPayout.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return settlementdtoPendingPayout
+}
+func (c *MapperImpl) modelTreasuryEntryToSettlementdtoPendingTreasuryEntry(source model.TreasuryEntry) settlement.PendingTreasuryEntry {
+	var settlementdtoPendingTreasuryEntry settlement.PendingTreasuryEntry
+	settlementdtoPendingTreasuryEntry.OpKey = source.OpKey
+	settlementdtoPendingTreasuryEntry.ActorId = source.ActorId
+	settlementdtoPendingTreasuryEntry.Kind = string(source.Kind)
+	settlementdtoPendingTreasuryEntry.Amount = source.Amount
+	settlementdtoPendingTreasuryEntry.BalanceAfter = source.BalanceAfter
+	settlementdtoPendingTreasuryEntry.Reason = source.Reason
+	settlementdtoPendingTreasuryEntry.ItemId = source.ItemId
+	settlementdtoPendingTreasuryEntry.PurchaseId = source.PurchaseId
+	settlementdtoPendingTreasuryEntry.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return settlementdtoPendingTreasuryEntry
+}
 func (c *MapperImpl) modelVector2ToVector2dtoVector2(source model.Vector2) vector2.Vector2 {
 	var vector2dtoVector2 vector2.Vector2
 	vector2dtoVector2.X = source.X
@@ -187,6 +378,26 @@ func (c *MapperImpl) mongoxModelToMongoxModel(source mongox.Model) mongox.Model
 	mongoxModel.UpdatedAt = goverter.TimeToTime(source.UpdatedAt)
 	return mongoxModel
 }
+func (c *MapperImpl) settlementdtoPendingDepositToModelPendingDeposit(source settlement.PendingDeposit) model.PendingDeposit {
+	var modelPendingDeposit model.PendingDeposit
+	modelPendingDeposit.OpKey = source.OpKey
+	modelPendingDeposit.ActorId = source.ActorId
+	modelPendingDeposit.Amount = source.Amount
+	modelPendingDeposit.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return modelPendingDeposit
+}
+func (c *MapperImpl) settlementdtoPendingPayoutToModelPendingPayout(source settlement.PendingPayout) model.PendingPayout {
+	var modelPendingPayout model.PendingPayout
+	modelPendingPayout.OpKey = source.OpKey
+	modelPendingPayout.ActorId = source.ActorId
+	modelPendingPayout.RecipientId = source.RecipientId
+	modelPendingPayout.Kind = model.TreasuryEntryKind(source.Kind)
+	modelPendingPayout.Amount = source.Amount
+	modelPendingPayout.Reason = source.Reason
+	modelPendingPayout.ItemId = source.ItemId
+	modelPendingPayout.CreatedAt = goverter.TimeToTime(source.CreatedAt)
+	return modelPendingPayout
+}
 func (c *MapperImpl) vector2dtoVector2ToModelVector2(source vector2.Vector2) model.Vector2 {
 	var modelVector2 model.Vector2
 	modelVector2.X = source.X
@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	mongox "github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox/pagination"
+	treasuryentrydto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/treasury_entry"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.uber.org/zap"
+)
+
+// CreateTreasuryEntry implements service.SettlementDbRepository. An entry
+// with an OpKey is written at most once; writing it again is a no-op.
+func (r *Repository) CreateTreasuryEntry(ctx context.Context, entry model.TreasuryEntry) error {
+	l := r.log.WithMethod("CreateTreasuryEntry").
+		With(zap.String("settlement_id", entry.SettlementId), zap.String("kind", string(entry.Kind)), zap.Int64("amount", entry.Amount))
+
+	oid, err := mongox.ParseObjectID(entry.SettlementId)
+	if err != nil {
+		l.Error("invalid settlement id", zap.Error(err))
+		return err
+	}
+
+	dto := treasuryentrydto.TreasuryEntry{
+		Model:        mongox.NewModel(),
+		SettlementId: oid,
+		ActorId:      entry.ActorId,
+		Kind:         string(entry.Kind),
+		Amount:       entry.Amount,
+		BalanceAfter: entry.BalanceAfter,
+		Reason:       entry.Reason,
+		ItemId:       entry.ItemId,
+		PurchaseId:   entry.PurchaseId,
+		OpKey:        entry.OpKey,
+	}
+	if !entry.CreatedAt.IsZero() {
+		dto.CreatedAt = entry.CreatedAt
+		dto.UpdatedAt = entry.CreatedAt
+	}
+
+	if entry.OpKey == "" {
+		_, err = r.treasuryColl.InsertOne(ctx, dto)
+	} else {
+		_, err = r.treasuryColl.UpdateOne(ctx,
+			bson.M{"op_key": entry.OpKey},
+			bson.M{"$setOnInsert": dto},
+			options.UpdateOne().SetUpsert(true),
+		)
+	}
+	if err != nil {
+		l.Error("failed to insert treasury entry", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// HasTreasuryEntry implements service.SettlementDbRepository.
+func (r *Repository) HasTreasuryEntry(ctx context.Context, opKey string) (bool, error) {
+	n, err := r.treasuryColl.CountDocuments(ctx, bson.M{"op_key": opKey}, options.Count().SetLimit(1))
+	if err != nil {
+		r.log.WithMethod("HasTreasuryEntry").Error("failed to count treasury entries", zap.Error(err), zap.String("op_key", opKey))
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// SetTreasuryEntryPurchase implements service.SettlementDbRepository. Links
+// the ledger entry opKey to the shop purchase it paid for.
+func (r *Repository) SetTreasuryEntryPurchase(ctx context.Context, opKey, purchaseID string) error {
+	if _, err := r.treasuryColl.UpdateOne(ctx,
+		bson.M{"op_key": opKey},
+		bson.M{"$set": bson.M{"purchase_id": purchaseID, "updated_at": time.Now()}},
+	); err != nil {
+		r.log.WithMethod("SetTreasuryEntryPurchase").Error("failed to update treasury entry", zap.Error(err), zap.String("op_key", opKey))
+		return err
+	}
+	return nil
+}
+
+// FlushTreasuryEntries implements service.SettlementDbRepository. Copies the
+// settlement's pending treasury entries into the ledger, then removes them
+// from the settlement, as FlushFavorLogs does for the favor log.
+func (r *Repository) FlushTreasuryEntries(ctx context.Context, settlementID string) error {
+	l := r.log.WithMethod("FlushTreasuryEntries").With(zap.String("settlement_id", settlementID))
+
+	settlement, err := r.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return err
+	}
+	if len(settlement.PendingTreasuryEntries) == 0 {
+		return nil
+	}
+
+	keys := make([]string, len(settlement.PendingTreasuryEntries))
+	for i, entry := range settlement.PendingTreasuryEntries {
+		if err := r.CreateTreasuryEntry(ctx, entry); err != nil {
+			return err
+		}
+		keys[i] = entry.OpKey
+	}
+
+	oid, err := mongox.ParseObjectID(settlementID)
+	if err != nil {
+		return err
+	}
+	// updated_at is bumped so a concurrent UpdateSettlement retries on fresh
+	// state instead of putting the flushed entries back.
+	if _, err := r.setColl.UpdateByID(ctx, oid, bson.M{
+		"$pull": bson.M{"pending_treasury_entries": bson.M{"op_key": bson.M{"$in": keys}}},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}); err != nil {
+		l.Error("failed to clear pending treasury entries", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+// ListSettlementsWithPendingTreasury implements service.SettlementDbRepository.
+func (r *Repository) ListSettlementsWithPendingTreasury(ctx context.Context) ([]string, error) {
+	cur, err := r.setColl.Find(ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"pending_treasury_entries.0": bson.M{"$exists": true}},
+			bson.M{"pending_deposits.0": bson.M{"$exists": true}},
+			bson.M{"pending_payouts.0": bson.M{"$exists": true}},
+		}},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		r.log.WithMethod("ListSettlementsWithPendingTreasury").Error("failed to find settlements", zap.Error(err))
+		return nil, err
+	}
+
+	var docs []struct {
+		Id bson.ObjectID `bson:"_id"`
+	}
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(docs))
+	for i, d := range docs {
+		ids[i] = d.Id.Hex()
+	}
+	return ids, nil
+}
+
+// ListTreasuryEntries implements service.SettlementDbRepository. Newest first.
+func (r *Repository) ListTreasuryEntries(ctx context.Context, settlementID, nextToken string) ([]model.TreasuryEntry, string, error) {
+	l := r.log.WithMethod("ListTreasuryEntries").With(zap.String("settlement_id", settlementID))
+
+	oid, err := mongox.ParseObjectID(settlementID)
+	if err != nil {
+		l.Error("invalid settlement id", zap.Error(err))
+		return nil, "", err
+	}
+
+	entries, next, err := pagination.List(
+		ctx, r.treasuryColl, nextToken, 0, treasuryEntryFromDTO,
+		pagination.WithFilter(bson.M{"settlement_id": oid}),
+	)
+	if err != nil {
+		l.Error("failed to list treasury entries", zap.Error(err))
+		return nil, "", err
+	}
+
+	return entries, next, nil
+}
+
+func treasuryEntryFromDTO(d treasuryentrydto.TreasuryEntry) model.TreasuryEntry {
+	return model.TreasuryEntry{
+		Id:           d.Model.Id.Hex(),
+		SettlementId: d.SettlementId.Hex(),
+		ActorId:      d.ActorId,
+		Kind:         model.TreasuryEntryKind(d.Kind),
+		Amount:       d.Amount,
+		BalanceAfter: d.BalanceAfter,
+		Reason:       d.Reason,
+		ItemId:       d.ItemId,
+		PurchaseId:   d.PurchaseId,
+		OpKey:        d.OpKey,
+		CreatedAt:    d.CreatedAt,
+	}
+}
@@ -354,3 +354,29 @@ func (r *Repository) GetPendingSettlements(ctx context.Context) ([]model.Settlem
 	r.log.Info("successfully retrieved pending settlements", zap.Int("count", len(res)))
 	return res, nil
 }
+
+// SetRequestType implements service.SettlementRequestDbRepository. Only an
+// approved request is touched: a pending one carries the level-up the leader
+// asked for and is decided by Approve or Reject.
+func (r *Repository) SetRequestType(ctx context.Context, id string, t model.SettlementType) error {
+	l := r.log.
+		With(zap.String("settlement_id", id), zap.String("type", string(t))).
+		WithMethod("set_request_type")
+
+	objectID, err := mongomodel.ParseObjectID(id)
+	if err != nil {
+		l.Error("invalid settlement ID format", zap.Error(err))
+		return err
+	}
+
+	_, err = r.setReqColl.UpdateOne(
+		ctx,
+		bson.M{"_id": objectID, "status": model.SettlementStatusApproved},
+		bson.M{"$set": bson.M{"type": string(t), "updated_at": time.Now()}},
+	)
+	if err != nil {
+		l.Error("update error", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+)
+
+// errActivityUnchanged aborts a settlement update when the sweep found
+// nothing to persist, so idle settlements are not rewritten every tick.
+var errActivityUnchanged = errors.New("activity unchanged")
+
+// SetActivityExemption implements settlementv1.SettlementServiceServer.
+func (s *Service) SetActivityExemption(ctx context.Context, req *settlementv1.SetActivityExemptionRequest) (*settlementv1.SetActivityExemptionResponse, error) {
+	l := s.log.WithMethod("SetActivityExemption").With(zap.String("settlement_id", req.GetSettlementId()), zap.Bool("exempt", req.GetExempt()))
+
+	updated, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			settlement.SetActivityExempt(req.GetExempt())
+			return settlement, nil
+		},
+	)
+	if err != nil {
+		l.Error("failed to set activity exemption", zap.Error(err))
+		return nil, err
+	}
+
+	return &settlementv1.SetActivityExemptionResponse{
+		Settlement: s.mapper.ToSettlementProto(*updated),
+	}, nil
+}
+
+// RunActivitySweeps runs SweepActivity every interval until ctx is done.
+func (s *Service) RunActivitySweeps(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.SweepActivity(ctx, now); err != nil {
+				s.log.WithMethod("RunActivitySweeps").Error("activity sweep failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// SweepActivity measures every settlement's last activity from its members'
+// online state and applies the inactivity policy.
+//
+// Safe to run on several replicas at once: the transition is decided inside
+// the guarded update, so when two sweeps race only the one whose write lands
+// sees the transition and notifies the leader; the other reloads and finds
+// nothing left to do. A failure on one settlement is logged and the sweep
+// moves on.
+func (s *Service) SweepActivity(ctx context.Context, now time.Time) error {
+	l := s.log.WithMethod("SweepActivity")
+
+	settlements, err := s.dbRepo.GetAllSettlements(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, settlement := range settlements {
+		if err := s.sweepSettlement(ctx, settlement, now); err != nil {
+			l.Error("failed to apply activity", zap.String("settlement_id", settlement.Id), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *Service) sweepSettlement(ctx context.Context, settlement model.Settlement, now time.Time) error {
+	userIDs := append([]string{settlement.Leader.UserId}, lo.Map(settlement.Members, func(m model.Member, _ int) string {
+		return m.UserId
+	})...)
+
+	lastSeen, err := s.activity.LastSeen(ctx, userIDs, now)
+	if err != nil {
+		return err
+	}
+
+	var transition model.ActivityTransition
+	updated, err := s.dbRepo.UpdateSettlement(ctx, settlement.Id,
+		func(_ context.Context, st *model.Settlement) (*model.Settlement, error) {
+			before := st.LastActivityAt
+			transition = st.ApplyActivity(lastSeen, now, s.activityPolicy)
+			if transition == model.ActivityUnchanged && st.LastActivityAt.Equal(before) {
+				return nil, errActivityUnchanged
+			}
+			return st, nil
+		},
+	)
+	if errors.Is(err, errActivityUnchanged) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch transition {
+	case model.ActivityFlagged:
+		s.notifyLeader(ctx, updated, "Поселение неактивно",
+			fmt.Sprintf("Никто из жителей поселения %q не заходил в игру %s. Если активность не возобновится в течение %s, поселение будет понижено или скрыто.",
+				updated.Name, formatDays(s.activityPolicy.InactiveAfter), formatDays(s.activityPolicy.GracePeriod)))
+	case model.ActivityDowngraded:
+		if err := s.dbRepo.SetRequestType(ctx, updated.Id, updated.Type); err != nil {
+			s.log.WithMethod("sweepSettlement").Error("failed to sync request type", zap.String("settlement_id", updated.Id), zap.Error(err))
+		}
+		s.notifyLeader(ctx, updated, "Поселение понижено",
+			fmt.Sprintf("Поселение %q понижено из-за неактивности.", updated.Name))
+	case model.ActivityHidden:
+		s.notifyLeader(ctx, updated, "Поселение скрыто",
+			fmt.Sprintf("Поселение %q скрыто из списка из-за неактивности. Оно вернётся, как только кто-то из жителей зайдёт в игру.", updated.Name))
+	}
+	return nil
+}
+
+func (s *Service) notifyLeader(ctx context.Context, settlement *model.Settlement, title, message string) {
+	if err := s.notifier.CreateNotification(ctx, title, message, notificationuc.WithUserId(settlement.Leader.UserId)); err != nil {
+		s.log.WithMethod("notifyLeader").Error("failed to notify leader",
+			zap.String("settlement_id", settlement.Id), zap.Error(err))
+	}
+}
+
+func formatDays(d time.Duration) string {
+	return fmt.Sprintf("%d дн.", int(d.Hours()/24))
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/eapache/go-resiliency/retrier"
 	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
 	"github.com/lasthearth/vsservice/internal/pkg/logger"
 	"github.com/lasthearth/vsservice/internal/pkg/mediaurl"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
 	"go.uber.org/fx"
 )
 
@@ -20,6 +22,13 @@ type Opts struct {
 	DbRepo   SettlementRepository
 	Mapper   Mapper
 	MediaURL *mediaurl.Validator
+	Debitor  CoinDebitor
+	Creditor CoinCreditor
+	Shop     ShopGranter
+	Activity ActivityTracker
+	Notifier Notifier
+	Talents  TalentNodes
+	Config   config.Config
 }
 
 type Service struct {
@@ -29,6 +38,16 @@ type Service struct {
 	retrier  *retrier.Retrier
 	mapper   Mapper
 	mediaUrl *mediaurl.Validator
+	debitor  CoinDebitor
+	creditor CoinCreditor
+	shop     ShopGranter
+	activity ActivityTracker
+	notifier Notifier
+	talents  TalentNodes
+
+	activityPolicy model.ActivityPolicy
+	memberCaps     model.MemberCaps
+	boardLimit     model.BoardRateLimit
 }
 
 func New(opts Opts) *Service {
@@ -39,5 +58,22 @@ func New(opts Opts) *Service {
 		retrier:  opts.Retrier,
 		mapper:   opts.Mapper,
 		mediaUrl: opts.MediaURL,
+		debitor:  opts.Debitor,
+		creditor: opts.Creditor,
+		shop:     opts.Shop,
+		activity: opts.Activity,
+		notifier: opts.Notifier,
+		talents:  opts.Talents,
+
+		activityPolicy: model.ActivityPolicy{
+			InactiveAfter: opts.Config.SettlementInactiveAfter,
+			GracePeriod:   opts.Config.SettlementInactiveGrace,
+			Action:        model.DecayAction(opts.Config.SettlementDecayAction),
+		},
+		memberCaps: newMemberCaps(opts.Config.SettlementMemberCaps),
+		boardLimit: model.BoardRateLimit{
+			Posts:  opts.Config.SettlementBoardPostLimit,
+			Window: opts.Config.SettlementBoardPostWindow,
+		},
 	}
 }
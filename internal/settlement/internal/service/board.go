@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox/pagination"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateBoardPost implements settlementv1.SettlementServiceServer.
+func (s *Service) CreateBoardPost(ctx context.Context, req *settlementv1.CreateBoardPostRequest) (*settlementv1.CreateBoardPostResponse, error) {
+	l := s.log.WithMethod("CreateBoardPost").With(zap.String("settlement_id", req.GetSettlementId()))
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+	if !settlement.CanPost(callerID) {
+		return nil, ierror.ErrNotHerald
+	}
+
+	visibility, err := VisibilityFromProto(req.GetVisibility())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	attachs := make([]model.Attachment, len(req.GetAttachments()))
+	for i, a := range req.GetAttachments() {
+		if err := s.mediaUrl.Validate(a.GetUrl()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid attachment url")
+		}
+		attachs[i] = model.Attachment{Url: a.GetUrl(), Desc: a.GetDesc()}
+	}
+
+	post, err := model.NewBoardPost(settlement.Id, callerID, req.GetTitle(), req.GetBody(), attachs, visibility, req.GetAnnouncement())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slots := s.boardLimit.Slots(settlement.Id, callerID, time.Now())
+	created, err := s.dbRepo.CreateBoardPost(ctx, *post, slots)
+	if err != nil {
+		if !errors.Is(err, ierror.ErrBoardRateLimited) {
+			l.Error("failed to create board post", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	if created.Announcement {
+		s.notifyMembers(ctx, settlement, callerID,
+			fmt.Sprintf("Объявление поселения «%s»", settlement.Name), created.Title)
+	}
+
+	return &settlementv1.CreateBoardPostResponse{
+		Post: s.mapper.ToBoardPostProto(*created),
+	}, nil
+}
+
+// ListBoardPosts implements settlementv1.SettlementServiceServer.
+func (s *Service) ListBoardPosts(ctx context.Context, req *settlementv1.ListBoardPostsRequest) (*settlementv1.ListBoardPostsResponse, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listBoard(ctx, settlement.Id, !settlement.IsMember(callerID), req.GetNextToken())
+}
+
+// ListPublicBoardPosts implements settlementv1.SettlementServiceServer.
+func (s *Service) ListPublicBoardPosts(ctx context.Context, req *settlementv1.ListBoardPostsRequest) (*settlementv1.ListBoardPostsResponse, error) {
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listBoard(ctx, settlement.Id, true, req.GetNextToken())
+}
+
+// PinBoardPost implements settlementv1.SettlementServiceServer.
+func (s *Service) PinBoardPost(ctx context.Context, req *settlementv1.PinBoardPostRequest) (*settlementv1.PinBoardPostResponse, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+	if settlement.Leader.UserId != callerID {
+		return nil, ierror.ErrNotLeader
+	}
+
+	updated, err := s.dbRepo.UpdateBoardPost(ctx, req.GetPostId(),
+		func(_ context.Context, post *model.BoardPost) (*model.BoardPost, error) {
+			if post.SettlementId != settlement.Id || post.IsRemoved() {
+				return nil, ierror.ErrBoardPostNotFound
+			}
+			post.Pin(req.GetPinned())
+			return post, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.PinBoardPostResponse{
+		Post: s.mapper.ToBoardPostProto(*updated),
+	}, nil
+}
+
+// DeleteBoardPost implements settlementv1.SettlementServiceServer.
+func (s *Service) DeleteBoardPost(ctx context.Context, req *settlementv1.DeleteBoardPostRequest) (*settlementv1.DeleteBoardPostResponse, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.dbRepo.UpdateBoardPost(ctx, req.GetPostId(),
+		func(_ context.Context, post *model.BoardPost) (*model.BoardPost, error) {
+			if post.SettlementId != settlement.Id {
+				return nil, ierror.ErrBoardPostNotFound
+			}
+			if post.AuthorId != callerID && settlement.Leader.UserId != callerID {
+				return nil, ierror.ErrPermissionDenied
+			}
+			return removePost(post, callerID, "")
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.DeleteBoardPostResponse{}, nil
+}
+
+// TakeDownBoardPost implements settlementv1.SettlementServiceServer.
+func (s *Service) TakeDownBoardPost(ctx context.Context, req *settlementv1.TakeDownBoardPostRequest) (*settlementv1.TakeDownBoardPostResponse, error) {
+	l := s.log.WithMethod("TakeDownBoardPost").With(zap.String("post_id", req.GetPostId()))
+
+	if req.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+
+	moderatorID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.dbRepo.UpdateBoardPost(ctx, req.GetPostId(),
+		func(_ context.Context, post *model.BoardPost) (*model.BoardPost, error) {
+			return removePost(post, moderatorID, req.GetReason())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Info("board post taken down", zap.String("moderator_id", moderatorID), zap.String("reason", req.GetReason()))
+
+	return &settlementv1.TakeDownBoardPostResponse{}, nil
+}
+
+// AssignHerald implements settlementv1.SettlementServiceServer.
+func (s *Service) AssignHerald(ctx context.Context, req *settlementv1.AssignHeraldRequest) (*settlementv1.AssignHeraldResponse, error) {
+	updated, err := s.updateAsLeader(ctx, req.GetSettlementId(), func(settlement *model.Settlement) error {
+		return settlement.AssignHerald(req.GetUserId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.AssignHeraldResponse{HeraldIds: updated.Heralds}, nil
+}
+
+// RevokeHerald implements settlementv1.SettlementServiceServer.
+func (s *Service) RevokeHerald(ctx context.Context, req *settlementv1.RevokeHeraldRequest) (*settlementv1.RevokeHeraldResponse, error) {
+	updated, err := s.updateAsLeader(ctx, req.GetSettlementId(), func(settlement *model.Settlement) error {
+		return settlement.RevokeHerald(req.GetUserId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.RevokeHeraldResponse{HeraldIds: updated.Heralds}, nil
+}
+
+// listBoard returns one page of a settlement's board. Pinned posts are few
+// and come with the first page only, ahead of the paginated rest.
+func (s *Service) listBoard(ctx context.Context, settlementID string, publicOnly bool, nextToken string) (*settlementv1.ListBoardPostsResponse, error) {
+	var pinned []model.BoardPost
+	if nextToken == "" {
+		var err error
+		pinnedFilter := model.NewBoardPostFilter(settlementID, publicOnly, true)
+		if pinned, _, err = s.dbRepo.ListBoardPosts(ctx, pinnedFilter, "", pagination.MaxLimit); err != nil {
+			return nil, err
+		}
+	}
+
+	filter := model.NewBoardPostFilter(settlementID, publicOnly, false)
+	posts, next, err := s.dbRepo.ListBoardPosts(ctx, filter, nextToken, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.ListBoardPostsResponse{
+		Pinned:    s.mapper.ToBoardPostsProto(pinned),
+		Posts:     s.mapper.ToBoardPostsProto(posts),
+		NextToken: next,
+	}, nil
+}
+
+func removePost(post *model.BoardPost, actorID, reason string) (*model.BoardPost, error) {
+	if err := post.Remove(actorID, reason, time.Now()); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return post, nil
+}
+
+// notifyMembers sends a notification to everyone in the settlement except
+// the author. Failures are logged; the post is already published.
+func (s *Service) notifyMembers(ctx context.Context, settlement *model.Settlement, authorID, title, message string) {
+	recipients := append([]model.Member{settlement.Leader}, settlement.Members...)
+	for _, m := range recipients {
+		if m.UserId == authorID {
+			continue
+		}
+		if err := s.notifier.CreateNotification(ctx, title, message, notificationuc.WithUserId(m.UserId)); err != nil {
+			s.log.WithMethod("notifyMembers").Error("failed to notify member",
+				zap.String("settlement_id", settlement.Id), zap.String("user_id", m.UserId), zap.Error(err))
+		}
+	}
+}
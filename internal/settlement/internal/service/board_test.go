@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+// boardRepo adds an in-memory bulletin board to fakeRepo.
+type boardRepo struct {
+	*fakeRepo
+	posts []model.BoardPost
+	// slots holds the rate-limit slots taken, like the unique index does.
+	slots map[string]bool
+}
+
+func (r *boardRepo) CreateBoardPost(_ context.Context, p model.BoardPost, slots []string) (*model.BoardPost, error) {
+	if len(slots) > 0 {
+		i := slices.IndexFunc(slots, func(slot string) bool { return !r.slots[slot] })
+		if i < 0 {
+			return nil, ierror.ErrBoardRateLimited
+		}
+		r.slots[slots[i]] = true
+		p.MarkCreated(fmt.Sprintf("p%d", len(r.posts)+1), slots[i], time.Now())
+	} else {
+		p.MarkCreated(fmt.Sprintf("p%d", len(r.posts)+1), "", time.Now())
+	}
+	r.posts = append(r.posts, p)
+	return &p, nil
+}
+
+func (r *boardRepo) ListBoardPosts(_ context.Context, f model.BoardPostFilter, _ string, _ int64) ([]model.BoardPost, string, error) {
+	var out []model.BoardPost
+	for _, p := range r.posts {
+		if p.SettlementId != f.SettlementId || p.Pinned != f.Pinned || p.IsRemoved() {
+			continue
+		}
+		if f.PublicOnly && p.Visibility != model.PostVisibilityPublic {
+			continue
+		}
+		out = append(out, p)
+	}
+	return out, "", nil
+}
+
+// boardMapper maps posts to their id only; the rest is goverter's job.
+type boardMapper struct{ Mapper }
+
+func (boardMapper) ToBoardPostProto(p model.BoardPost) *settlementv1.BoardPost {
+	return &settlementv1.BoardPost{Id: p.Id}
+}
+
+func (m boardMapper) ToBoardPostsProto(ps []model.BoardPost) []*settlementv1.BoardPost {
+	out := make([]*settlementv1.BoardPost, len(ps))
+	for i, p := range ps {
+		out[i] = m.ToBoardPostProto(p)
+	}
+	return out
+}
+
+// fakeNotifier counts the notifications sent.
+type fakeNotifier struct{ sent int }
+
+func (n *fakeNotifier) CreateNotification(context.Context, string, string, ...notificationuc.NotificationOpts) error {
+	n.sent++
+	return nil
+}
+
+func newBoardService(t *testing.T) (*Service, *boardRepo, *fakeNotifier) {
+	t.Helper()
+	repo := &boardRepo{fakeRepo: newFakeRepo(nil), slots: map[string]bool{}}
+	repo.settlements[settlFrom] = &model.Settlement{
+		Id:      settlFrom,
+		Leader:  model.Member{UserId: "leader"},
+		Members: []model.Member{{UserId: "alice"}, {UserId: "bob"}},
+		Heralds: []string{"alice"},
+	}
+	notifier := &fakeNotifier{}
+	svc := newTestService(t, repo)
+	svc.mapper = boardMapper{}
+	svc.notifier = notifier
+	svc.boardLimit = model.BoardRateLimit{Posts: 2, Window: time.Hour}
+	return svc, repo, notifier
+}
+
+func post(t *testing.T, svc *Service, userID string, visibility settlementv1.BoardPost_Visibility, announcement bool) error {
+	t.Helper()
+	_, err := svc.CreateBoardPost(interceptor.ContextWithUserID(context.Background(), userID), &settlementv1.CreateBoardPostRequest{
+		SettlementId: settlFrom,
+		Title:        "Сбор у ворот",
+		Visibility:   visibility,
+		Announcement: announcement,
+	})
+	return err
+}
+
+func TestCreateBoardPostRoles(t *testing.T) {
+	svc, _, _ := newBoardService(t)
+
+	if err := post(t, svc, "alice", settlementv1.BoardPost_MEMBERS, false); err != nil {
+		t.Fatalf("herald post: %v", err)
+	}
+	if err := post(t, svc, "bob", settlementv1.BoardPost_MEMBERS, false); !errors.Is(err, ierror.ErrNotHerald) {
+		t.Fatalf("member post: got %v, want ErrNotHerald", err)
+	}
+}
+
+func TestCreateBoardPostRateLimit(t *testing.T) {
+	svc, _, _ := newBoardService(t)
+
+	for i := range 2 {
+		if err := post(t, svc, "leader", settlementv1.BoardPost_PUBLIC, false); err != nil {
+			t.Fatalf("post %d: %v", i+1, err)
+		}
+	}
+	if err := post(t, svc, "leader", settlementv1.BoardPost_PUBLIC, false); !errors.Is(err, ierror.ErrBoardRateLimited) {
+		t.Fatalf("third post: got %v, want ErrBoardRateLimited", err)
+	}
+	// The limit is per author.
+	if err := post(t, svc, "alice", settlementv1.BoardPost_PUBLIC, false); err != nil {
+		t.Fatalf("other author: %v", err)
+	}
+}
+
+func TestAnnouncementNotifiesEveryoneButAuthor(t *testing.T) {
+	svc, _, notifier := newBoardService(t)
+
+	if err := post(t, svc, "alice", settlementv1.BoardPost_MEMBERS, false); err != nil {
+		t.Fatal(err)
+	}
+	if notifier.sent != 0 {
+		t.Fatalf("plain post notified %d members", notifier.sent)
+	}
+
+	if err := post(t, svc, "alice", settlementv1.BoardPost_MEMBERS, true); err != nil {
+		t.Fatal(err)
+	}
+	if notifier.sent != 2 {
+		t.Fatalf("announcement notified %d members, want 2 (leader and bob)", notifier.sent)
+	}
+}
+
+func TestListBoardPostsHidesMembersOnlyFromOutsiders(t *testing.T) {
+	svc, _, _ := newBoardService(t)
+	if err := post(t, svc, "leader", settlementv1.BoardPost_MEMBERS, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := post(t, svc, "alice", settlementv1.BoardPost_PUBLIC, false); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &settlementv1.ListBoardPostsRequest{SettlementId: settlFrom}
+
+	member, err := svc.ListBoardPosts(interceptor.ContextWithUserID(context.Background(), "bob"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(member.GetPinned()) != 1 || len(member.GetPosts()) != 1 {
+		t.Fatalf("member sees %d pinned and %d posts, want 1 and 1", len(member.GetPinned()), len(member.GetPosts()))
+	}
+
+	outsider, err := svc.ListBoardPosts(interceptor.ContextWithUserID(context.Background(), "stranger"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outsider.GetPinned()) != 0 || len(outsider.GetPosts()) != 1 {
+		t.Fatalf("outsider sees %d pinned and %d posts, want 0 and 1", len(outsider.GetPinned()), len(outsider.GetPosts()))
+	}
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/mongox"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// favorTransferStaleAfter is how long a transfer may sit unfinished before the
+// recovery worker takes it over from the request that started it.
+const favorTransferStaleAfter = 5 * time.Minute
+
+// errFavorApplied aborts a settlement update whose favor movement was applied
+// before.
+var errFavorApplied = errors.New("favor movement already applied")
+
+// FavorStore is the part of the settlement repository favor movements use.
+type FavorStore interface {
+	GetSettlement(ctx context.Context, id string) (*model.Settlement, error)
+	UpdateSettlement(
+		ctx context.Context,
+		id string,
+		updateFn func(ctx context.Context, s *model.Settlement) (*model.Settlement, error),
+	) (*model.Settlement, error)
+	HasFavorLog(ctx context.Context, opKey string) (bool, error)
+}
+
+// ApplyFavor applies a favor movement to a settlement at most once per
+// entry.OpKey; when it was applied before, the settlement is returned as is.
+// An insufficient balance is FAILED_PRECONDITION. The log entry is left
+// pending on the settlement for the caller to flush.
+func ApplyFavor(ctx context.Context, repo FavorStore, settlementID string, entry model.ImperialFavorLog) (*model.Settlement, error) {
+	updated, err := repo.UpdateSettlement(ctx, settlementID,
+		func(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if settlement.HasPendingFavorLog(entry.OpKey) {
+				return nil, errFavorApplied
+			}
+			// The flusher writes the log before clearing the pending entry, so
+			// an entry missing here but logged was applied and already flushed.
+			logged, err := repo.HasFavorLog(ctx, entry.OpKey)
+			if err != nil {
+				return nil, err
+			}
+			if logged {
+				return nil, errFavorApplied
+			}
+			if err := settlement.ApplyFavor(entry); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return settlement, nil
+		},
+	)
+	if errors.Is(err, errFavorApplied) {
+		return repo.GetSettlement(ctx, settlementID)
+	}
+	return updated, err
+}
+
+// FavorApplied reports whether the movement opKey has been applied to the
+// settlement.
+func FavorApplied(ctx context.Context, repo FavorStore, settlementID, opKey string) (bool, error) {
+	settlement, err := repo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return false, err
+	}
+	if settlement.HasPendingFavorLog(opKey) {
+		return true, nil
+	}
+	return repo.HasFavorLog(ctx, opKey)
+}
+
+func (s *Service) applyFavor(ctx context.Context, settlementID string, entry model.ImperialFavorLog) (*model.Settlement, error) {
+	return ApplyFavor(ctx, s.dbRepo, settlementID, entry)
+}
+
+func (s *Service) favorApplied(ctx context.Context, settlementID, opKey string) (bool, error) {
+	return FavorApplied(ctx, s.dbRepo, settlementID, opKey)
+}
+
+// favorNotApplied reports whether err from applyFavor means the movement
+// certainly did not land, as opposed to an outcome that is unknown.
+func favorNotApplied(err error) bool {
+	return errors.Is(err, ierror.ErrNotFound) ||
+		errors.Is(err, mongox.ErrConflict) ||
+		status.Code(err) == codes.FailedPrecondition
+}
+
+// flushFavorLogs copies the settlements' pending favor logs to the favor log.
+// Failures are logged; RecoverFavor flushes whatever is left.
+func (s *Service) flushFavorLogs(ctx context.Context, l logger.Logger, settlementIDs ...string) {
+	for _, id := range settlementIDs {
+		if err := s.dbRepo.FlushFavorLogs(ctx, id); err != nil {
+			l.Error("failed to flush favor logs", zap.String("settlement_id", id), zap.Error(err))
+		}
+	}
+}
+
+// RunFavorRecovery runs RecoverFavor every interval until ctx is done.
+func (s *Service) RunFavorRecovery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.RecoverFavor(ctx, now); err != nil {
+				s.log.WithMethod("RunFavorRecovery").Error("favor recovery failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RecoverFavor finishes or reverses transfers that were left half-applied,
+// then flushes pending favor logs into the favor log.
+//
+// A stale pending transfer whose debit never landed is failed; one whose debit
+// did is carried on as debited. Every leg is idempotent and every state change
+// conditional, so several replicas may recover at once, and together with the
+// request that started a transfer.
+func (s *Service) RecoverFavor(ctx context.Context, now time.Time) error {
+	l := s.log.WithMethod("RecoverFavor")
+
+	transfers, err := s.dbRepo.ListStaleFavorTransfers(ctx, now.Add(-favorTransferStaleAfter))
+	if err != nil {
+		return err
+	}
+
+	for _, t := range transfers {
+		tl := l.With(zap.String("transfer_id", t.Id))
+		if _, _, err := s.recoverTransfer(ctx, tl, t); err != nil {
+			tl.Error("failed to recover favor transfer", zap.Error(err))
+		}
+	}
+
+	ids, err := s.dbRepo.ListSettlementsWithPendingFavorLogs(ctx)
+	if err != nil {
+		return err
+	}
+	s.flushFavorLogs(ctx, l, ids...)
+	return nil
+}
+
+func (s *Service) recoverTransfer(ctx context.Context, l logger.Logger, t model.FavorTransfer) (*model.Settlement, *model.Settlement, error) {
+	if t.State == model.FavorTransferPending {
+		debited, err := s.favorApplied(ctx, t.FromSettlementId, t.DebitKey())
+		if err != nil && !errors.Is(err, ierror.ErrNotFound) {
+			return nil, nil, err
+		}
+		if !debited {
+			l.Warn("abandoning favor transfer whose debit never landed")
+			return nil, nil, s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferPending, model.FavorTransferFailed)
+		}
+		if err := s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferPending, model.FavorTransferDebited); err != nil {
+			return nil, nil, err
+		}
+		t.MarkDebited()
+	}
+
+	l.Info("finishing favor transfer")
+	return s.runFavorTransfer(ctx, l, t)
+}
+
+// runFavorTransfer drives t from its current state to a final one: debit the
+// source, credit the target, and refund the source if the target is gone.
+// Returns both settlements when the transfer completed.
+func (s *Service) runFavorTransfer(ctx context.Context, l logger.Logger, t model.FavorTransfer) (*model.Settlement, *model.Settlement, error) {
+	if t.State == model.FavorTransferPending {
+		_, err := s.applyFavor(ctx, t.FromSettlementId, model.ImperialFavorLog{
+			OpKey:   t.DebitKey(),
+			AdminId: t.ActorId,
+			Amount:  -t.Amount,
+			Reason:  "transfer to " + t.ToSettlementId,
+		})
+		if err != nil {
+			if favorNotApplied(err) {
+				if serr := s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferPending, model.FavorTransferFailed); serr != nil {
+					l.Error("failed to mark favor transfer failed", zap.Error(serr))
+				}
+			}
+			return nil, nil, err
+		}
+
+		if err := s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferPending, model.FavorTransferDebited); err != nil {
+			// Recovery gave up on the transfer before the debit landed.
+			if errors.Is(err, ierror.ErrTransferStateChanged) {
+				if rerr := s.refundFavorTransfer(ctx, t); rerr != nil {
+					return nil, nil, fmt.Errorf("%w; refunding the debit failed: %w", err, rerr)
+				}
+			}
+			return nil, nil, err
+		}
+		t.MarkDebited()
+	}
+
+	to, err := s.applyFavor(ctx, t.ToSettlementId, model.ImperialFavorLog{
+		OpKey:   t.CreditKey(),
+		AdminId: t.ActorId,
+		Amount:  t.Amount,
+		Reason:  "transfer from " + t.FromSettlementId,
+	})
+	if err != nil {
+		if !errors.Is(err, ierror.ErrNotFound) {
+			return nil, nil, err
+		}
+		l.Warn("target settlement is gone, reversing favor transfer")
+		if rerr := s.refundFavorTransfer(ctx, t); rerr != nil {
+			return nil, nil, fmt.Errorf("%w; refunding the debit failed: %w", err, rerr)
+		}
+		if serr := s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferDebited, model.FavorTransferReversed); serr != nil {
+			l.Error("failed to mark favor transfer reversed", zap.Error(serr))
+		}
+		return nil, nil, err
+	}
+
+	// Both legs are in; if the journal is not updated the recovery worker
+	// replays the credit as a no-op and completes it.
+	if err := s.dbRepo.SetFavorTransferState(ctx, t.Id, model.FavorTransferDebited, model.FavorTransferCompleted); err != nil {
+		l.Error("failed to mark favor transfer completed", zap.Error(err))
+	}
+
+	from, err := s.dbRepo.GetSettlement(ctx, t.FromSettlementId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.flushFavorLogs(ctx, l, t.FromSettlementId, t.ToSettlementId)
+	return from, to, nil
+}
+
+// refundFavorTransfer gives the source of t its debit back.
+func (s *Service) refundFavorTransfer(ctx context.Context, t model.FavorTransfer) error {
+	_, err := s.applyFavor(ctx, t.FromSettlementId, model.ImperialFavorLog{
+		OpKey:   t.ReverseKey(),
+		AdminId: t.ActorId,
+		Amount:  t.Amount,
+		Reason:  "transfer to " + t.ToSettlementId + " reversed",
+	})
+	return err
+}
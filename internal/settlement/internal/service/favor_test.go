@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	settlFrom = "652f1c1d1c1d1c1d1c1d1c3f"
+	settlTo   = "652f1c1d1c1d1c1d1c1d1c4a"
+)
+
+// fakeRepo implements the slice of SettlementRepository that favor movements
+// touch; the embedded interface panics on anything else.
+type fakeRepo struct {
+	SettlementRepository
+
+	settlements map[string]*model.Settlement
+	logs        map[string]model.ImperialFavorLog
+	transfers   map[string]*model.FavorTransfer
+
+	// updateErr fails the next update of the keyed settlement once.
+	updateErr map[string]error
+}
+
+func newFakeRepo(favor map[string]int64) *fakeRepo {
+	r := &fakeRepo{
+		settlements: map[string]*model.Settlement{},
+		logs:        map[string]model.ImperialFavorLog{},
+		transfers:   map[string]*model.FavorTransfer{},
+		updateErr:   map[string]error{},
+	}
+	for id, f := range favor {
+		r.settlements[id] = &model.Settlement{Id: id, ImperialFavor: f}
+	}
+	return r
+}
+
+func (r *fakeRepo) UpdateSettlement(ctx context.Context, id string, fn func(context.Context, *model.Settlement) (*model.Settlement, error)) (*model.Settlement, error) {
+	if err := r.updateErr[id]; err != nil {
+		delete(r.updateErr, id)
+		return nil, err
+	}
+	stored, ok := r.settlements[id]
+	if !ok {
+		return nil, ierror.ErrNotFound
+	}
+	updated, err := fn(ctx, stored.Clone())
+	if err != nil {
+		return nil, err
+	}
+	r.settlements[id] = updated.Clone()
+	return updated, nil
+}
+
+func (r *fakeRepo) GetSettlement(_ context.Context, id string) (*model.Settlement, error) {
+	stored, ok := r.settlements[id]
+	if !ok {
+		return nil, ierror.ErrNotFound
+	}
+	return stored.Clone(), nil
+}
+
+func (r *fakeRepo) IsLeaderOfSettlement(context.Context, string, string) error { return nil }
+
+func (r *fakeRepo) HasFavorLog(_ context.Context, opKey string) (bool, error) {
+	_, ok := r.logs[opKey]
+	return ok, nil
+}
+
+func (r *fakeRepo) FlushFavorLogs(_ context.Context, id string) error {
+	stored, ok := r.settlements[id]
+	if !ok {
+		return ierror.ErrNotFound
+	}
+	keys := make([]string, len(stored.PendingFavorLogs))
+	for i, entry := range stored.PendingFavorLogs {
+		r.logs[entry.OpKey] = entry
+		keys[i] = entry.OpKey
+	}
+	stored.DropFavorLogs(keys)
+	return nil
+}
+
+func (r *fakeRepo) ListSettlementsWithPendingFavorLogs(context.Context) ([]string, error) {
+	var ids []string
+	for id, s := range r.settlements {
+		if len(s.PendingFavorLogs) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeRepo) CreateFavorTransfer(_ context.Context, t model.FavorTransfer) (*model.FavorTransfer, error) {
+	stored := storedTransfer(fmt.Sprintf("t%d", len(r.transfers)+1), t, t.State, time.Now())
+	r.transfers[stored.Id] = stored
+	out := *stored
+	return &out, nil
+}
+
+func (r *fakeRepo) SetFavorTransferState(_ context.Context, id string, from, to model.FavorTransferState) error {
+	t := r.transfers[id]
+	if t == nil || t.State != from {
+		return ierror.ErrTransferStateChanged
+	}
+	r.transfers[id] = storedTransfer(id, *t, to, t.UpdatedAt)
+	return nil
+}
+
+// storedTransfer is t as the repository reads it back.
+func storedTransfer(id string, t model.FavorTransfer, state model.FavorTransferState, updatedAt time.Time) *model.FavorTransfer {
+	return &model.FavorTransfer{
+		Id:               id,
+		FromSettlementId: t.FromSettlementId,
+		ToSettlementId:   t.ToSettlementId,
+		Amount:           t.Amount,
+		ActorId:          t.ActorId,
+		State:            state,
+		CreatedAt:        t.CreatedAt,
+		UpdatedAt:        updatedAt,
+	}
+}
+
+func (r *fakeRepo) ListStaleFavorTransfers(_ context.Context, before time.Time) ([]model.FavorTransfer, error) {
+	var out []model.FavorTransfer
+	for _, t := range r.transfers {
+		if !t.IsFinal() && t.UpdatedAt.Before(before) {
+			out = append(out, *t)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeRepo) logSum(settlementID string) int64 {
+	var sum int64
+	for _, l := range r.logs {
+		if l.SettlementId == settlementID {
+			sum += l.Amount
+		}
+	}
+	return sum
+}
+
+func newTestService(t *testing.T, repo SettlementRepository) *Service {
+	t.Helper()
+	zc := zap.NewProductionConfig()
+	zc.Level = zap.NewAtomicLevelAt(zapcore.FatalLevel)
+	l, err := logger.New(&zc)
+	if err != nil {
+		t.Fatalf("logger.New: %v", err)
+	}
+	return New(Opts{Log: l, DbRepo: repo})
+}
+
+func startTransfer(t *testing.T, repo *fakeRepo, amount int64) model.FavorTransfer {
+	t.Helper()
+	tr, err := repo.CreateFavorTransfer(context.Background(), model.FavorTransfer{
+		FromSettlementId: settlFrom,
+		ToSettlementId:   settlTo,
+		Amount:           amount,
+		ActorId:          "leader",
+		State:            model.FavorTransferPending,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *tr
+}
+
+func assertFavor(t *testing.T, repo *fakeRepo, id string, want int64) {
+	t.Helper()
+	if got := repo.settlements[id].ImperialFavor; got != want {
+		t.Fatalf("favor of %s = %d, want %d", id, got, want)
+	}
+}
+
+func TestRunFavorTransfer_Completes(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlFrom: 100, settlTo: 10})
+	svc := newTestService(t, repo)
+	tr := startTransfer(t, repo, 30)
+
+	from, to, err := svc.runFavorTransfer(context.Background(), svc.log, tr)
+	if err != nil {
+		t.Fatalf("runFavorTransfer: %v", err)
+	}
+	if from.ImperialFavor != 70 || to.ImperialFavor != 40 {
+		t.Fatalf("returned favor from=%d to=%d, want 70 and 40", from.ImperialFavor, to.ImperialFavor)
+	}
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferCompleted {
+		t.Fatalf("state = %s, want completed", got)
+	}
+	if repo.logSum(settlFrom) != -30 || repo.logSum(settlTo) != 30 {
+		t.Fatalf("favor log does not match the balances: %+v", repo.logs)
+	}
+}
+
+func TestRunFavorTransfer_InsufficientFavorFails(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlFrom: 10, settlTo: 0})
+	svc := newTestService(t, repo)
+	tr := startTransfer(t, repo, 30)
+
+	if _, _, err := svc.runFavorTransfer(context.Background(), svc.log, tr); err == nil {
+		t.Fatal("runFavorTransfer: got nil, want insufficient favor")
+	}
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferFailed {
+		t.Fatalf("state = %s, want failed", got)
+	}
+	assertFavor(t, repo, settlFrom, 10)
+	assertFavor(t, repo, settlTo, 0)
+}
+
+func TestRecoverFavor_FinishesDebitedTransfer(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlFrom: 100, settlTo: 10})
+	svc := newTestService(t, repo)
+	tr := startTransfer(t, repo, 30)
+
+	// The credit fails as if the process died between the two legs.
+	repo.updateErr[settlTo] = errors.New("connection reset")
+	if _, _, err := svc.runFavorTransfer(context.Background(), svc.log, tr); err == nil {
+		t.Fatal("runFavorTransfer: got nil, want the credit failure")
+	}
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferDebited {
+		t.Fatalf("state = %s, want debited", got)
+	}
+	assertFavor(t, repo, settlFrom, 70)
+	assertFavor(t, repo, settlTo, 10)
+
+	now := time.Now().Add(favorTransferStaleAfter + time.Minute)
+	for range 2 {
+		if err := svc.RecoverFavor(context.Background(), now); err != nil {
+			t.Fatalf("RecoverFavor: %v", err)
+		}
+	}
+
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferCompleted {
+		t.Fatalf("state = %s, want completed", got)
+	}
+	assertFavor(t, repo, settlFrom, 70)
+	assertFavor(t, repo, settlTo, 40)
+	if repo.logSum(settlFrom) != -30 || repo.logSum(settlTo) != 30 {
+		t.Fatalf("favor log does not match the balances: %+v", repo.logs)
+	}
+}
+
+func TestRecoverFavor_ReversesWhenTargetIsGone(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlFrom: 100})
+	svc := newTestService(t, repo)
+	tr := startTransfer(t, repo, 30)
+
+	_, _, err := svc.runFavorTransfer(context.Background(), svc.log, tr)
+	if !errors.Is(err, ierror.ErrNotFound) {
+		t.Fatalf("runFavorTransfer: got %v, want ErrNotFound", err)
+	}
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferReversed {
+		t.Fatalf("state = %s, want reversed", got)
+	}
+	if err := svc.RecoverFavor(context.Background(), time.Now()); err != nil {
+		t.Fatalf("RecoverFavor: %v", err)
+	}
+	assertFavor(t, repo, settlFrom, 100)
+	if len(repo.logs) != 2 || repo.logSum(settlFrom) != 0 {
+		t.Fatalf("want a debit and its reversal in the favor log, got %+v", repo.logs)
+	}
+}
+
+func TestRecoverFavor_FailsPendingTransferWithoutDebit(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlFrom: 100, settlTo: 10})
+	svc := newTestService(t, repo)
+	tr := startTransfer(t, repo, 30)
+
+	if err := svc.RecoverFavor(context.Background(), time.Now().Add(favorTransferStaleAfter+time.Minute)); err != nil {
+		t.Fatalf("RecoverFavor: %v", err)
+	}
+	if got := repo.transfers[tr.Id].State; got != model.FavorTransferFailed {
+		t.Fatalf("state = %s, want failed", got)
+	}
+	assertFavor(t, repo, settlFrom, 100)
+	assertFavor(t, repo, settlTo, 10)
+}
+
+func TestApplyFavor_OncePerOpKey(t *testing.T) {
+	repo := newFakeRepo(map[string]int64{settlTo: 0})
+	svc := newTestService(t, repo)
+	entry := model.ImperialFavorLog{OpKey: "k", Amount: 5}
+
+	for i := range 3 {
+		if _, err := svc.applyFavor(context.Background(), settlTo, entry); err != nil {
+			t.Fatalf("applyFavor #%d: %v", i, err)
+		}
+		// Replays must also be recognised once the entry left the settlement.
+		if i == 1 {
+			if err := repo.FlushFavorLogs(context.Background(), settlTo); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	assertFavor(t, repo, settlTo, 5)
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
 	"github.com/lasthearth/vsservice/internal/settlement/model"
@@ -70,3 +71,69 @@ func TypeFromReqProto(req settlementv1.SubmitRequest_Type) (*model.SettlementTyp
 	}
 	return &s, nil
 }
+
+// TreasuryEntryKindToProto converts a model.TreasuryEntryKind to its proto enum.
+func TreasuryEntryKindToProto(k model.TreasuryEntryKind) settlementv1.TreasuryEntry_Kind {
+	switch k {
+	case model.TreasuryDeposit:
+		return settlementv1.TreasuryEntry_DEPOSIT
+	case model.TreasuryWithdrawal:
+		return settlementv1.TreasuryEntry_WITHDRAWAL
+	case model.TreasurySpend:
+		return settlementv1.TreasuryEntry_SPEND
+	default:
+		return settlementv1.TreasuryEntry_KIND_UNSPECIFIED
+	}
+}
+
+// UnixOrZero converts t to unix seconds, keeping the zero time as 0.
+func UnixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// RequirementKindToProto converts a model.RequirementKind to its proto enum.
+func RequirementKindToProto(k model.RequirementKind) settlementv1.RequirementProgress_Kind {
+	switch k {
+	case model.RequirementMembers:
+		return settlementv1.RequirementProgress_KIND_MEMBERS
+	case model.RequirementImperialFavor:
+		return settlementv1.RequirementProgress_KIND_IMPERIAL_FAVOR
+	case model.RequirementAgeDays:
+		return settlementv1.RequirementProgress_KIND_AGE_DAYS
+	case model.RequirementTalentNode:
+		return settlementv1.RequirementProgress_KIND_TALENT_NODE
+	case model.RequirementFeeImperialFavor:
+		return settlementv1.RequirementProgress_KIND_FEE_IMPERIAL_FAVOR
+	case model.RequirementFeeCoins:
+		return settlementv1.RequirementProgress_KIND_FEE_COINS
+	default:
+		return settlementv1.RequirementProgress_KIND_UNSPECIFIED
+	}
+}
+
+// VisibilityToProto converts a model.PostVisibility to its proto enum.
+func VisibilityToProto(v model.PostVisibility) settlementv1.BoardPost_Visibility {
+	switch v {
+	case model.PostVisibilityMembers:
+		return settlementv1.BoardPost_MEMBERS
+	case model.PostVisibilityPublic:
+		return settlementv1.BoardPost_PUBLIC
+	default:
+		return settlementv1.BoardPost_VISIBILITY_UNSPECIFIED
+	}
+}
+
+// VisibilityFromProto converts a proto visibility to a model.PostVisibility.
+func VisibilityFromProto(v settlementv1.BoardPost_Visibility) (model.PostVisibility, error) {
+	switch v {
+	case settlementv1.BoardPost_MEMBERS:
+		return model.PostVisibilityMembers, nil
+	case settlementv1.BoardPost_PUBLIC:
+		return model.PostVisibilityPublic, nil
+	default:
+		return "", fmt.Errorf("unknown post visibility: %v", v)
+	}
+}
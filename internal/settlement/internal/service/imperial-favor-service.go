@@ -24,25 +24,17 @@ func (s *Service) AddImperialFavor(ctx context.Context, req *settlementv1.AddImp
 		return nil, err
 	}
 
-	updated, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
-		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
-			settlement.AddFavor(req.GetAmount())
-			return settlement, nil
-		},
-	)
+	updated, err := s.applyFavor(ctx, req.GetSettlementId(), model.ImperialFavorLog{
+		OpKey:   newOpKey("admin"),
+		AdminId: adminID,
+		Amount:  req.GetAmount(),
+		Reason:  req.GetReason(),
+	})
 	if err != nil {
 		l.Error("failed to add imperial favor", zap.Error(err))
 		return nil, err
 	}
-
-	if err := s.dbRepo.CreateFavorLog(ctx, model.ImperialFavorLog{
-		SettlementId: req.GetSettlementId(),
-		AdminId:      adminID,
-		Amount:       req.GetAmount(),
-		Reason:       req.GetReason(),
-	}); err != nil {
-		l.Error("failed to create favor log", zap.Error(err))
-	}
+	s.flushFavorLogs(ctx, l, req.GetSettlementId())
 
 	return &settlementv1.AddImperialFavorResponse{
 		Settlement: s.mapper.ToSettlementProto(*updated),
@@ -62,27 +54,20 @@ func (s *Service) DeductImperialFavor(ctx context.Context, req *settlementv1.Ded
 		return nil, err
 	}
 
-	updated, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
-		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
-			if err := settlement.DeductFavor(req.GetAmount()); err != nil {
-				return nil, status.Error(codes.InvalidArgument, err.Error())
-			}
-			return settlement, nil
-		},
-	)
+	updated, err := s.applyFavor(ctx, req.GetSettlementId(), model.ImperialFavorLog{
+		OpKey:   newOpKey("admin"),
+		AdminId: adminID,
+		Amount:  -req.GetAmount(),
+		Reason:  req.GetReason(),
+	})
 	if err != nil {
 		l.Error("failed to deduct imperial favor", zap.Error(err))
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, status.Error(codes.InvalidArgument, status.Convert(err).Message())
+		}
 		return nil, err
 	}
-
-	if err := s.dbRepo.CreateFavorLog(ctx, model.ImperialFavorLog{
-		SettlementId: req.GetSettlementId(),
-		AdminId:      adminID,
-		Amount:       -req.GetAmount(),
-		Reason:       req.GetReason(),
-	}); err != nil {
-		l.Error("failed to create favor log", zap.Error(err))
-	}
+	s.flushFavorLogs(ctx, l, req.GetSettlementId())
 
 	return &settlementv1.DeductImperialFavorResponse{
 		Settlement: s.mapper.ToSettlementProto(*updated),
@@ -3,8 +3,11 @@ package service
 
 import (
 	"context"
+	"time"
 
 	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
 	settlementdto "github.com/lasthearth/vsservice/internal/settlement/internal/dto/mongo/settlement"
 	"github.com/lasthearth/vsservice/internal/settlement/model"
 )
@@ -13,6 +16,9 @@ import (
 // goverter:output:file sermapper/mapper.go
 // goverter:extend TypeToProto
 // goverter:extend TagIdsToProto
+// goverter:extend TreasuryEntryKindToProto
+// goverter:extend RequirementKindToProto
+// goverter:extend VisibilityToProto
 // goverter:extend github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToTimestamp
 // goverter:extend github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToInt64
 // goverter:extend github.com/lasthearth/vsservice/internal/pkg/goverter:IntToInt32
@@ -31,10 +37,13 @@ type Mapper interface {
 
 	// goverter:ignore state sizeCache unknownFields
 	// goverter:map TagIds Tags
+	// goverter:map LastActivityAt | UnixOrZero
+	// goverter:map InactiveSince | UnixOrZero
 	ToSettlementProto(model.Settlement) *settlementv1.Settlement
 	ToSettlementProtos([]model.Settlement) []*settlementv1.Settlement
 	// goverter:ignore state sizeCache unknownFields
 	// goverter:ignore Members Tags ImperialFavor
+	// goverter:ignore LastActivityAt InactiveSince Hidden ActivityExempt
 	VerifToSettlementProto(model.SettlementVerification) *settlementv1.Settlement
 	VerifsToSettlementProtos([]model.SettlementVerification) []*settlementv1.Settlement
 
@@ -46,6 +55,33 @@ type Mapper interface {
 	// goverter:map CreatedAt | github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToInt64
 	ToImperialFavorLogProto(model.ImperialFavorLog) *settlementv1.ImperialFavorLog
 	ToImperialFavorLogsProto([]model.ImperialFavorLog) []*settlementv1.ImperialFavorLog
+
+	// goverter:ignore state sizeCache unknownFields
+	// goverter:map Id SettlementId
+	// goverter:map Treasury Balance
+	// goverter:map Treasurers TreasurerIds
+	ToTreasuryProto(model.Settlement) *settlementv1.Treasury
+
+	// goverter:ignore state sizeCache unknownFields
+	// goverter:map CreatedAt | github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToInt64
+	ToTreasuryEntryProto(model.TreasuryEntry) *settlementv1.TreasuryEntry
+	ToTreasuryEntriesProto([]model.TreasuryEntry) []*settlementv1.TreasuryEntry
+
+	// goverter:ignore state sizeCache unknownFields
+	ToTalentNodeRefProto(model.TalentNodeRef) *settlementv1.TalentNodeRef
+
+	// goverter:ignore state sizeCache unknownFields
+	// goverter:map UpdatedAt | github.com/lasthearth/vsservice/internal/pkg/goverter:TimeToInt64
+	ToLevelUpRequirementsProto(model.LevelUpRequirements) *settlementv1.LevelUpRequirements
+	ToLevelUpRequirementsProtos([]model.LevelUpRequirements) []*settlementv1.LevelUpRequirements
+
+	// goverter:ignore state sizeCache unknownFields
+	ToRequirementProgressProto(model.RequirementProgress) *settlementv1.RequirementProgress
+	ToRequirementProgressProtos([]model.RequirementProgress) []*settlementv1.RequirementProgress
+
+	// goverter:ignore state sizeCache unknownFields
+	ToBoardPostProto(model.BoardPost) *settlementv1.BoardPost
+	ToBoardPostsProto([]model.BoardPost) []*settlementv1.BoardPost
 }
 
 type SettlementRepository interface {
@@ -73,13 +109,39 @@ type SettlementDbRepository interface {
 	RemoveTag(ctx context.Context, settlementID, tagID string) error
 
 	CreateFavorLog(ctx context.Context, log model.ImperialFavorLog) error
+	HasFavorLog(ctx context.Context, opKey string) (bool, error)
+	FlushFavorLogs(ctx context.Context, settlementID string) error
+	ListSettlementsWithPendingFavorLogs(ctx context.Context) ([]string, error)
 	ListFavorLogs(ctx context.Context, settlementID, adminID, orderBy, nextToken string) ([]model.ImperialFavorLog, string, error)
 
+	CreateFavorTransfer(ctx context.Context, t model.FavorTransfer) (*model.FavorTransfer, error)
+	SetFavorTransferState(ctx context.Context, id string, from, to model.FavorTransferState) error
+	ListStaleFavorTransfers(ctx context.Context, before time.Time) ([]model.FavorTransfer, error)
+
+	CreateTreasuryEntry(ctx context.Context, entry model.TreasuryEntry) error
+	HasTreasuryEntry(ctx context.Context, opKey string) (bool, error)
+	SetTreasuryEntryPurchase(ctx context.Context, opKey, purchaseID string) error
+	FlushTreasuryEntries(ctx context.Context, settlementID string) error
+	ListSettlementsWithPendingTreasury(ctx context.Context) ([]string, error)
+	ListTreasuryEntries(ctx context.Context, settlementID, nextToken string) ([]model.TreasuryEntry, string, error)
+	// CreateBoardPost stores post in the first free one of slots, failing with
+	// ErrBoardRateLimited when all are taken. No slots stores it unlimited.
+	CreateBoardPost(ctx context.Context, post model.BoardPost, slots []string) (*model.BoardPost, error)
+	UpdateBoardPost(ctx context.Context, id string, updateFn func(ctx context.Context, p *model.BoardPost) (*model.BoardPost, error)) (*model.BoardPost, error)
+	// ListBoardPosts pages through the posts matching filter, newest first. A
+	// non-positive limit uses the pagination default.
+	ListBoardPosts(ctx context.Context, filter model.BoardPostFilter, nextToken string, limit int64) ([]model.BoardPost, string, error)
+
+	SetLevelUpRequirements(ctx context.Context, req model.LevelUpRequirements) (*model.LevelUpRequirements, error)
+	GetLevelUpRequirements(ctx context.Context, t model.SettlementType) (*model.LevelUpRequirements, error)
+	ListLevelUpRequirements(ctx context.Context) ([]model.LevelUpRequirements, error)
+
 	RemoveMember(ctx context.Context, settlementID, userID string) error
 	CreateInvitation(ctx context.Context, settlementID, userID string) error
 	DeleteInvitationForUser(ctx context.Context, invitationID, userID string) error
 	DeleteInvitationForLeader(ctx context.Context, invitationID, settlementID string) error
-	AcceptInvitation(ctx context.Context, invID, userID string) error
+	GetInvitation(ctx context.Context, invID string) (*model.Invitation, error)
+	AcceptInvitation(ctx context.Context, invID, userID string, maxMembers int) error
 	GetInvitations(ctx context.Context, settlementID string) ([]model.Invitation, error)
 	GetUserInvitations(ctx context.Context, userID string) ([]model.Invitation, error)
 }
@@ -90,6 +152,49 @@ type SettlementRequestDbRepository interface {
 	GetSettlementRequest(ctx context.Context, id string) (*model.SettlementVerification, error)
 	GetSettlementRequestByLeader(ctx context.Context, leaderID string) (*model.SettlementVerification, error)
 	GetPendingSettlements(ctx context.Context) ([]model.SettlementVerification, error)
+	// SetRequestType keeps the request's type in step with a settlement that
+	// was downgraded, so the next level-up starts from the right tier.
+	SetRequestType(ctx context.Context, id string, t model.SettlementType) error
 	Approve(ctx context.Context, id string) error
 	Reject(ctx context.Context, id string, rejectionReason string) error
 }
+
+// CoinDebitor takes donate coins out of a player's wallet at most once per
+// op key. Implemented by donateuc.DebitUseCase, injected via fx.
+type CoinDebitor interface {
+	DebitOnce(ctx context.Context, playerID string, amount int64, reason, opKey string) error
+	Debited(ctx context.Context, playerID, opKey string) (bool, error)
+}
+
+// CoinCreditor pays donate coins into a player's wallet at most once per op
+// key. Implemented by donateuc.AddCoinsUseCase, injected via fx.
+type CoinCreditor interface {
+	CreditOnce(ctx context.Context, playerID, playerName string, amount int64, reason, opKey string) error
+}
+
+// ShopGranter quotes shop items and issues them without charging a wallet,
+// at most once per op key. Implemented by donateuc.ShopUseCase, injected via fx.
+type ShopGranter interface {
+	Quote(ctx context.Context, itemID string) (donateuc.ItemQuote, error)
+	GrantOnce(ctx context.Context, playerID, itemID string, pricePaid int64, opKey string) (string, error)
+}
+
+// ActivityTracker reports when any of a set of players was last online.
+// Implemented by playeruc.ActivityUseCase, injected via fx.
+type ActivityTracker interface {
+	LastSeen(ctx context.Context, userIDs []string, now time.Time) (time.Time, error)
+}
+
+// Notifier delivers in-app notifications.
+// Implemented by notificationuc.Create, injected via fx.
+type Notifier interface {
+	CreateNotification(ctx context.Context, title, message string, opts ...notificationuc.NotificationOpts) error
+}
+
+// TalentNodes reports which talent nodes a settlement has purchased and
+// the bonuses they grant.
+// Implemented by progressionuc.NodesUseCase, injected via fx.
+type TalentNodes interface {
+	SettlementNodes(ctx context.Context, settlementID string) (map[string][]string, error)
+	MemberCapBonus(ctx context.Context, settlementID string) (int, error)
+}
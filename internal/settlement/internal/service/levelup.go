@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLevelUpRequirements implements settlementv1.SettlementServiceServer.
+func (s *Service) GetLevelUpRequirements(ctx context.Context, req *settlementv1.GetLevelUpRequirementsRequest) (*settlementv1.GetLevelUpRequirementsResponse, error) {
+	settlement, err := s.memberSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &settlementv1.GetLevelUpRequirementsResponse{
+		CurrentType: TypeToProto(settlement.Type),
+	}
+
+	next, ok := settlement.Type.Next()
+	if !ok {
+		return resp, nil
+	}
+
+	progress, err := s.levelUpProgress(ctx, settlement, next, time.Now())
+	if err != nil {
+		s.log.WithMethod("GetLevelUpRequirements").Error("failed to evaluate level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	resp.NextType = TypeToProto(next)
+	resp.Requirements = s.mapper.ToRequirementProgressProtos(progress)
+	resp.Ready = len(model.UnmetRequirements(progress)) == 0
+	return resp, nil
+}
+
+// SetLevelUpRequirements implements settlementv1.SettlementServiceServer.
+func (s *Service) SetLevelUpRequirements(ctx context.Context, req *settlementv1.SetLevelUpRequirementsRequest) (*settlementv1.SetLevelUpRequirementsResponse, error) {
+	r := req.GetRequirements()
+
+	stype, err := TypeFromProto(r.GetType())
+	if err != nil {
+		return nil, ierror.ErrInvalidSettlementType
+	}
+
+	nodes := make([]model.TalentNodeRef, len(r.GetRequiredNodes()))
+	for i, n := range r.GetRequiredNodes() {
+		nodes[i] = model.TalentNodeRef{TreeId: n.GetTreeId(), NodeId: n.GetNodeId()}
+	}
+
+	requirements := model.LevelUpRequirements{
+		Type:             *stype,
+		MinMembers:       int(r.GetMinMembers()),
+		MinImperialFavor: r.GetMinImperialFavor(),
+		MinAgeDays:       int(r.GetMinAgeDays()),
+		RequiredNodes:    nodes,
+		FeeImperialFavor: r.GetFeeImperialFavor(),
+		FeeCoins:         r.GetFeeCoins(),
+	}
+	if err := requirements.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	saved, err := s.dbRepo.SetLevelUpRequirements(ctx, requirements)
+	if err != nil {
+		s.log.WithMethod("SetLevelUpRequirements").Error("failed to save level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	return &settlementv1.SetLevelUpRequirementsResponse{
+		Requirements: s.mapper.ToLevelUpRequirementsProto(*saved),
+	}, nil
+}
+
+// ListLevelUpRequirements implements settlementv1.SettlementServiceServer.
+func (s *Service) ListLevelUpRequirements(ctx context.Context, _ *settlementv1.ListLevelUpRequirementsRequest) (*settlementv1.ListLevelUpRequirementsResponse, error) {
+	requirements, err := s.dbRepo.ListLevelUpRequirements(ctx)
+	if err != nil {
+		s.log.WithMethod("ListLevelUpRequirements").Error("failed to list level-up requirements", zap.Error(err))
+		return nil, err
+	}
+
+	return &settlementv1.ListLevelUpRequirementsResponse{
+		Requirements: s.mapper.ToLevelUpRequirementsProtos(requirements),
+	}, nil
+}
+
+// levelUpRequirements returns the requirements configured for leveling up to
+// t; none when nothing is configured.
+func (s *Service) levelUpRequirements(ctx context.Context, t model.SettlementType) (model.LevelUpRequirements, error) {
+	r, err := s.dbRepo.GetLevelUpRequirements(ctx, t)
+	if err != nil {
+		if errors.Is(err, ierror.ErrNotFound) {
+			return model.LevelUpRequirements{Type: t}, nil
+		}
+		return model.LevelUpRequirements{}, err
+	}
+	return *r, nil
+}
+
+// levelUpProgress measures settlement against the requirements for target.
+func (s *Service) levelUpProgress(ctx context.Context, settlement *model.Settlement, target model.SettlementType, now time.Time) ([]model.RequirementProgress, error) {
+	r, err := s.levelUpRequirements(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes map[string][]string
+	if len(r.RequiredNodes) > 0 {
+		if nodes, err = s.talents.SettlementNodes(ctx, settlement.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.Evaluate(settlement, nodes, now), nil
+}
+
+// checkLevelUp refuses a request that would level settlementID up to target
+// while any requirement is unmet. Nil for a settlement that was never approved
+// or a request that keeps the current type.
+func (s *Service) checkLevelUp(ctx context.Context, settlementID string, target model.SettlementType) error {
+	settlement, err := s.dbRepo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		if errors.Is(err, ierror.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if settlement.Type == target {
+		return nil
+	}
+
+	progress, err := s.levelUpProgress(ctx, settlement, target, time.Now())
+	if err != nil {
+		return err
+	}
+	if unmet := model.UnmetRequirements(progress); len(unmet) > 0 {
+		return unmetRequirementsError(unmet)
+	}
+	return nil
+}
+
+// unmetRequirementsError reports unmet as a FAILED_PRECONDITION status carrying
+// one PreconditionFailure violation per requirement.
+func unmetRequirementsError(unmet []model.RequirementProgress) error {
+	violations := make([]*errdetails.PreconditionFailure_Violation, len(unmet))
+	for i, p := range unmet {
+		v := &errdetails.PreconditionFailure_Violation{
+			Type:        string(p.Kind),
+			Description: fmt.Sprintf("requires %d, has %d", p.Required, p.Current),
+		}
+		if p.Kind == model.RequirementTalentNode {
+			v.Subject = p.Node.TreeId + "/" + p.Node.NodeId
+			v.Description = "talent node is not purchased"
+		}
+		violations[i] = v
+	}
+
+	st := status.New(codes.FailedPrecondition, "level-up requirements are not met")
+	detailed, err := st.WithDetails(&errdetails.PreconditionFailure{Violations: violations})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// chargeLevelUpFee checks that the settlement of request meets every
+// requirement for its type and takes the fee from its imperial favor and
+// treasury, in one update, keyed by levelUpFeeKey so a retried approval
+// charges it once. Returns the settlement after the charge; nil when there
+// was nothing to charge.
+func (s *Service) chargeLevelUpFee(ctx context.Context, request *model.SettlementVerification, adminID string) (*model.Settlement, error) {
+	settlement, r, err := s.levelUpFee(ctx, request)
+	if err != nil || settlement == nil {
+		return nil, err
+	}
+	if !r.HasFee() {
+		return nil, s.checkLevelUp(ctx, settlement.Id, request.Type)
+	}
+
+	key := levelUpFeeKey(request)
+	return s.dbRepo.UpdateSettlement(ctx, settlement.Id,
+		func(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			charged, err := s.levelUpFeeApplied(ctx, settlement, key)
+			if err != nil || charged {
+				return settlement, err
+			}
+			// A failed Reject returned the fee and left the request pending.
+			if refunded, err := s.levelUpFeeApplied(ctx, settlement, key+":refund"); err != nil || refunded {
+				if refunded {
+					err = status.Error(codes.FailedPrecondition, "level-up fee was refunded, the request must be resubmitted")
+				}
+				return nil, err
+			}
+			progress, err := s.levelUpProgress(ctx, settlement, request.Type, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			if unmet := model.UnmetRequirements(progress); len(unmet) > 0 {
+				return nil, unmetRequirementsError(unmet)
+			}
+			if err := settlement.ChargeLevelUpFee(r, model.ImperialFavorLog{
+				OpKey:   key,
+				AdminId: adminID,
+				Reason:  levelUpFeeReason(r),
+			}); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return settlement, nil
+		},
+	)
+}
+
+// refundLevelUpFee returns the fee chargeLevelUpFee took for request, once,
+// when the request is rejected instead of approved. Returns the settlement
+// after the refund; nil when no fee was charged.
+func (s *Service) refundLevelUpFee(ctx context.Context, request *model.SettlementVerification, adminID string) (*model.Settlement, error) {
+	settlement, r, err := s.levelUpFee(ctx, request)
+	if err != nil || settlement == nil || !r.HasFee() {
+		return nil, err
+	}
+
+	key := levelUpFeeKey(request)
+	refunded := false
+	updated, err := s.dbRepo.UpdateSettlement(ctx, settlement.Id,
+		func(ctx context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			charged, err := s.levelUpFeeApplied(ctx, settlement, key)
+			if err != nil || !charged {
+				return settlement, err
+			}
+			done, err := s.levelUpFeeApplied(ctx, settlement, key+":refund")
+			if err != nil || done {
+				return settlement, err
+			}
+			refunded = true
+			return settlement, settlement.RefundLevelUpFee(r, model.ImperialFavorLog{
+				OpKey:   key + ":refund",
+				AdminId: adminID,
+				Reason:  levelUpFeeReason(r) + " refunded",
+			})
+		},
+	)
+	if err != nil || !refunded {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// levelUpFee returns the approved settlement request would level up and the
+// requirements of its new type. The settlement is nil for a first approval or
+// a request that keeps the type.
+func (s *Service) levelUpFee(ctx context.Context, request *model.SettlementVerification) (*model.Settlement, model.LevelUpRequirements, error) {
+	settlement, err := s.dbRepo.GetSettlement(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, ierror.ErrNotFound) {
+			return nil, model.LevelUpRequirements{}, nil
+		}
+		return nil, model.LevelUpRequirements{}, err
+	}
+	if settlement.Type == request.Type {
+		return nil, model.LevelUpRequirements{}, nil
+	}
+
+	r, err := s.levelUpRequirements(ctx, request.Type)
+	if err != nil {
+		return nil, r, err
+	}
+	return settlement, r, nil
+}
+
+// levelUpFeeApplied reports whether the fee movement opKey landed on
+// settlement, read inside the update about to move it. An entry copied to the
+// favor log or the ledger has left settlement, so those are asked as well.
+func (s *Service) levelUpFeeApplied(ctx context.Context, settlement *model.Settlement, opKey string) (bool, error) {
+	if settlement.HasPendingFavorLog(opKey) || settlement.HasPendingTreasuryEntry(opKey) {
+		return true, nil
+	}
+	if ok, err := s.dbRepo.HasFavorLog(ctx, opKey); err != nil || ok {
+		return ok, err
+	}
+	return s.dbRepo.HasTreasuryEntry(ctx, opKey)
+}
+
+// levelUpFeeKey is the op key of the fee for request. Submit bumps UpdatedAt,
+// so each submission is charged under its own key and every retry of its
+// approval under the same one.
+func levelUpFeeKey(request *model.SettlementVerification) string {
+	return fmt.Sprintf("levelup:%s:%s:%d", request.Id, request.Type, request.UpdatedAt.UnixNano())
+}
+
+// recordLevelUpFee flushes the favor log and treasury ledger entry of a
+// charged fee. Failures are logged, the fee has already been taken.
+func (s *Service) recordLevelUpFee(ctx context.Context, l logger.Logger, charged *model.Settlement) {
+	s.flushFavorLogs(ctx, l, charged.Id)
+	s.flushTreasuryEntries(ctx, l, charged.Id)
+}
+
+func levelUpFeeReason(r model.LevelUpRequirements) string {
+	return "level-up fee: " + string(r.Type)
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// levelUpRepo adds a settlement request and level-up requirements to fakeRepo.
+type levelUpRepo struct {
+	*fakeRepo
+	request      model.SettlementVerification
+	requirements map[model.SettlementType]model.LevelUpRequirements
+	approved     bool
+
+	// approveErr fails the next Approve once.
+	approveErr error
+}
+
+func (r *levelUpRepo) GetSettlementRequest(context.Context, string) (*model.SettlementVerification, error) {
+	out := r.request
+	return &out, nil
+}
+
+func (r *levelUpRepo) Approve(context.Context, string) error {
+	if err := r.approveErr; err != nil {
+		r.approveErr = nil
+		return err
+	}
+	r.approved = true
+	return nil
+}
+
+func (r *levelUpRepo) GetLevelUpRequirements(_ context.Context, t model.SettlementType) (*model.LevelUpRequirements, error) {
+	req, ok := r.requirements[t]
+	if !ok {
+		return nil, ierror.ErrNotFound
+	}
+	return &req, nil
+}
+
+func (r *levelUpRepo) HasTreasuryEntry(context.Context, string) (bool, error) { return false, nil }
+
+func (r *levelUpRepo) FlushTreasuryEntries(context.Context, string) error { return nil }
+
+func newLevelUpRepo(favor int64, fee model.LevelUpRequirements) *levelUpRepo {
+	r := &levelUpRepo{
+		fakeRepo: newFakeRepo(nil),
+		request: model.SettlementVerification{
+			Id:        settlFrom,
+			Type:      fee.Type,
+			Status:    model.SettlementStatusPending,
+			UpdatedAt: time.Now(),
+		},
+		requirements: map[model.SettlementType]model.LevelUpRequirements{fee.Type: fee},
+	}
+	r.settlements[settlFrom] = &model.Settlement{Id: settlFrom, Type: model.SettlementTypeCamp, ImperialFavor: favor}
+	return r
+}
+
+func approve(svc *Service) error {
+	ctx := interceptor.ContextWithUserID(context.Background(), "admin")
+	_, err := svc.Approve(ctx, &settlementv1.ApproveRequest{Id: settlFrom})
+	return err
+}
+
+func TestApproveRetryChargesLevelUpFeeOnce(t *testing.T) {
+	repo := newLevelUpRepo(50, model.LevelUpRequirements{Type: model.SettlementTypeVillage, FeeImperialFavor: 10})
+	repo.approveErr = errors.New("connection reset")
+	svc := newTestService(t, repo)
+
+	if err := approve(svc); err == nil {
+		t.Fatal("first Approve: got nil, want the approve error")
+	}
+	// The fee stays charged, as the approval may have landed.
+	assertFavor(t, repo.fakeRepo, settlFrom, 40)
+	if err := approve(svc); err != nil || !repo.approved {
+		t.Fatalf("retried Approve: %v, approved = %v", err, repo.approved)
+	}
+	assertFavor(t, repo.fakeRepo, settlFrom, 40)
+}
+
+func TestApproveRechecksRequirements(t *testing.T) {
+	repo := newLevelUpRepo(50, model.LevelUpRequirements{Type: model.SettlementTypeVillage, MinImperialFavor: 100, FeeImperialFavor: 10})
+	svc := newTestService(t, repo)
+
+	if err := approve(svc); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Approve: got %v, want FailedPrecondition", err)
+	}
+	assertFavor(t, repo.fakeRepo, settlFrom, 50)
+	if repo.approved {
+		t.Fatal("request approved with unmet requirements")
+	}
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func newMemberCaps(caps map[string]int) model.MemberCaps {
+	out := make(model.MemberCaps, len(caps))
+	for t, c := range caps {
+		out[model.SettlementType(t)] = c
+	}
+	return out
+}
+
+// memberCap returns the member cap of s, its talent bonuses included; zero
+// means unlimited.
+func (s *Service) memberCap(ctx context.Context, st *model.Settlement) (int, error) {
+	if s.memberCaps.For(st.Type, 0) == 0 {
+		return 0, nil
+	}
+	bonus, err := s.talents.MemberCapBonus(ctx, st.Id)
+	if err != nil {
+		return 0, err
+	}
+	return s.memberCaps.For(st.Type, bonus), nil
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+// fakeTalents grants a fixed member cap bonus and counts the lookups.
+type fakeTalents struct {
+	TalentNodes
+
+	bonus int
+	calls int
+}
+
+func (f *fakeTalents) MemberCapBonus(context.Context, string) (int, error) {
+	f.calls++
+	return f.bonus, nil
+}
+
+func TestMemberCapAddsTalentBonus(t *testing.T) {
+	talents := &fakeTalents{bonus: 3}
+	svc := newTestService(t, newFakeRepo(nil))
+	svc.talents = talents
+	svc.memberCaps = newMemberCaps(map[string]int{"camp": 5})
+
+	got, err := svc.memberCap(context.Background(), &model.Settlement{Id: settlFrom, Type: model.SettlementTypeCamp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 8 {
+		t.Fatalf("camp cap = %d, want 8", got)
+	}
+
+	// Uncapped types skip the talent lookup entirely.
+	got, err = svc.memberCap(context.Background(), &model.Settlement{Id: settlFrom, Type: model.SettlementTypeCity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 0 || talents.calls != 1 {
+		t.Fatalf("city cap = %d after %d lookups, want 0 after 1", got, talents.calls)
+	}
+}
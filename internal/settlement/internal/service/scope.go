@@ -15,5 +15,9 @@ func (s *Service) Scope() map[interceptor.Method]interceptor.Scope {
 		interceptor.Method(srvName + "DeductImperialFavor"):     interceptor.Scope("settlements:manage"),
 		interceptor.Method(srvName + "ListImperialFavorLogs"):   interceptor.Scope("settlements:manage"),
 		interceptor.Method(srvName + "TransferImperialFavor"):   interceptor.Scope(""),
+		interceptor.Method(srvName + "SetActivityExemption"):    interceptor.Scope("settlements:manage"),
+		interceptor.Method(srvName + "SetLevelUpRequirements"):  interceptor.Scope("settlements:manage"),
+		interceptor.Method(srvName + "ListLevelUpRequirements"): interceptor.Scope("settlements:manage"),
+		interceptor.Method(srvName + "TakeDownBoardPost"):       interceptor.Scope("settlements:moderate"),
 	}
 }
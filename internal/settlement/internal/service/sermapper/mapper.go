@@ -28,6 +28,31 @@ func (c *MapperImpl) ToAttachmentsProto(source []model.Attachment) []*v1.Attachm
 	}
 	return pSettlementv1AttachmentList
 }
+func (c *MapperImpl) ToBoardPostProto(source model.BoardPost) *v1.BoardPost {
+	var settlementv1BoardPost v1.BoardPost
+	settlementv1BoardPost.Id = source.Id
+	settlementv1BoardPost.SettlementId = source.SettlementId
+	settlementv1BoardPost.AuthorId = source.AuthorId
+	settlementv1BoardPost.Title = source.Title
+	settlementv1BoardPost.Body = source.Body
+	settlementv1BoardPost.Attachments = c.ToAttachmentsProto(source.Attachments)
+	settlementv1BoardPost.Visibility = service.VisibilityToProto(source.Visibility)
+	settlementv1BoardPost.Announcement = source.Announcement
+	settlementv1BoardPost.Pinned = source.Pinned
+	settlementv1BoardPost.CreatedAt = goverter.TimeToInt64(source.CreatedAt)
+	settlementv1BoardPost.UpdatedAt = goverter.TimeToInt64(source.UpdatedAt)
+	return &settlementv1BoardPost
+}
+func (c *MapperImpl) ToBoardPostsProto(source []model.BoardPost) []*v1.BoardPost {
+	var pSettlementv1BoardPostList []*v1.BoardPost
+	if source != nil {
+		pSettlementv1BoardPostList = make([]*v1.BoardPost, len(source))
+		for i := 0; i < len(source); i++ {
+			pSettlementv1BoardPostList[i] = c.ToBoardPostProto(source[i])
+		}
+	}
+	return pSettlementv1BoardPostList
+}
 func (c *MapperImpl) ToImperialFavorLogProto(source model.ImperialFavorLog) *v1.ImperialFavorLog {
 	var settlementv1ImperialFavorLog v1.ImperialFavorLog
 	settlementv1ImperialFavorLog.Id = source.Id
@@ -65,6 +90,33 @@ func (c *MapperImpl) ToInvProtos(source []model.Invitation) []*v1.Invitation {
 	}
 	return pSettlementv1InvitationList
 }
+func (c *MapperImpl) ToLevelUpRequirementsProto(source model.LevelUpRequirements) *v1.LevelUpRequirements {
+	var settlementv1LevelUpRequirements v1.LevelUpRequirements
+	settlementv1LevelUpRequirements.Type = service.TypeToProto(source.Type)
+	settlementv1LevelUpRequirements.MinMembers = goverter.IntToInt32(source.MinMembers)
+	settlementv1LevelUpRequirements.MinImperialFavor = source.MinImperialFavor
+	settlementv1LevelUpRequirements.MinAgeDays = goverter.IntToInt32(source.MinAgeDays)
+	if source.RequiredNodes != nil {
+		settlementv1LevelUpRequirements.RequiredNodes = make([]*v1.TalentNodeRef, len(source.RequiredNodes))
+		for i := 0; i < len(source.RequiredNodes); i++ {
+			settlementv1LevelUpRequirements.RequiredNodes[i] = c.ToTalentNodeRefProto(source.RequiredNodes[i])
+		}
+	}
+	settlementv1LevelUpRequirements.FeeImperialFavor = source.FeeImperialFavor
+	settlementv1LevelUpRequirements.FeeCoins = source.FeeCoins
+	settlementv1LevelUpRequirements.UpdatedAt = goverter.TimeToInt64(source.UpdatedAt)
+	return &settlementv1LevelUpRequirements
+}
+func (c *MapperImpl) ToLevelUpRequirementsProtos(source []model.LevelUpRequirements) []*v1.LevelUpRequirements {
+	var pSettlementv1LevelUpRequirementsList []*v1.LevelUpRequirements
+	if source != nil {
+		pSettlementv1LevelUpRequirementsList = make([]*v1.LevelUpRequirements, len(source))
+		for i := 0; i < len(source); i++ {
+			pSettlementv1LevelUpRequirementsList[i] = c.ToLevelUpRequirementsProto(source[i])
+		}
+	}
+	return pSettlementv1LevelUpRequirementsList
+}
 func (c *MapperImpl) ToMemberProto(source model.Member) *v1.Member {
 	var settlementv1Member v1.Member
 	settlementv1Member.UserId = source.UserId
@@ -80,6 +132,25 @@ func (c *MapperImpl) ToMembersProto(source []model.Member) []*v1.Member {
 	}
 	return pSettlementv1MemberList
 }
+func (c *MapperImpl) ToRequirementProgressProto(source model.RequirementProgress) *v1.RequirementProgress {
+	var settlementv1RequirementProgress v1.RequirementProgress
+	settlementv1RequirementProgress.Kind = service.RequirementKindToProto(source.Kind)
+	settlementv1RequirementProgress.Node = c.ToTalentNodeRefProto(source.Node)
+	settlementv1RequirementProgress.Required = source.Required
+	settlementv1RequirementProgress.Current = source.Current
+	settlementv1RequirementProgress.Met = source.Met
+	return &settlementv1RequirementProgress
+}
+func (c *MapperImpl) ToRequirementProgressProtos(source []model.RequirementProgress) []*v1.RequirementProgress {
+	var pSettlementv1RequirementProgressList []*v1.RequirementProgress
+	if source != nil {
+		pSettlementv1RequirementProgressList = make([]*v1.RequirementProgress, len(source))
+		for i := 0; i < len(source); i++ {
+			pSettlementv1RequirementProgressList[i] = c.ToRequirementProgressProto(source[i])
+		}
+	}
+	return pSettlementv1RequirementProgressList
+}
 func (c *MapperImpl) ToSettlementProto(source model.Settlement) *v1.Settlement {
 	var settlementv1Settlement v1.Settlement
 	settlementv1Settlement.Id = source.Id
@@ -94,6 +165,10 @@ func (c *MapperImpl) ToSettlementProto(source model.Settlement) *v1.Settlement {
 	settlementv1Settlement.CreatedAt = goverter.TimeToInt64(source.CreatedAt)
 	settlementv1Settlement.UpdatedAt = goverter.TimeToInt64(source.UpdatedAt)
 	settlementv1Settlement.ImperialFavor = source.ImperialFavor
+	settlementv1Settlement.LastActivityAt = service.UnixOrZero(source.LastActivityAt)
+	settlementv1Settlement.InactiveSince = service.UnixOrZero(source.InactiveSince)
+	settlementv1Settlement.Hidden = source.Hidden
+	settlementv1Settlement.ActivityExempt = source.ActivityExempt
 	settlementv1Settlement.Tags = service.TagIdsToProto(source.TagIds)
 	return &settlementv1Settlement
 }
@@ -107,6 +182,48 @@ func (c *MapperImpl) ToSettlementProtos(source []model.Settlement) []*v1.Settlem
 	}
 	return pSettlementv1SettlementList
 }
+func (c *MapperImpl) ToTalentNodeRefProto(source model.TalentNodeRef) *v1.TalentNodeRef {
+	var settlementv1TalentNodeRef v1.TalentNodeRef
+	settlementv1TalentNodeRef.TreeId = source.TreeId
+	settlementv1TalentNodeRef.NodeId = source.NodeId
+	return &settlementv1TalentNodeRef
+}
+func (c *MapperImpl) ToTreasuryEntriesProto(source []model.TreasuryEntry) []*v1.TreasuryEntry {
+	var pSettlementv1TreasuryEntryList []*v1.TreasuryEntry
+	if source != nil {
+		pSettlementv1TreasuryEntryList = make([]*v1.TreasuryEntry, len(source))
+		for i := 0; i < len(source); i++ {
+			pSettlementv1TreasuryEntryList[i] = c.ToTreasuryEntryProto(source[i])
+		}
+	}
+	return pSettlementv1TreasuryEntryList
+}
+func (c *MapperImpl) ToTreasuryEntryProto(source model.TreasuryEntry) *v1.TreasuryEntry {
+	var settlementv1TreasuryEntry v1.TreasuryEntry
+	settlementv1TreasuryEntry.Id = source.Id
+	settlementv1TreasuryEntry.SettlementId = source.SettlementId
+	settlementv1TreasuryEntry.ActorId = source.ActorId
+	settlementv1TreasuryEntry.Kind = service.TreasuryEntryKindToProto(source.Kind)
+	settlementv1TreasuryEntry.Amount = source.Amount
+	settlementv1TreasuryEntry.BalanceAfter = source.BalanceAfter
+	settlementv1TreasuryEntry.Reason = source.Reason
+	settlementv1TreasuryEntry.ItemId = source.ItemId
+	settlementv1TreasuryEntry.PurchaseId = source.PurchaseId
+	settlementv1TreasuryEntry.CreatedAt = goverter.TimeToInt64(source.CreatedAt)
+	return &settlementv1TreasuryEntry
+}
+func (c *MapperImpl) ToTreasuryProto(source model.Settlement) *v1.Treasury {
+	var settlementv1Treasury v1.Treasury
+	settlementv1Treasury.SettlementId = source.Id
+	settlementv1Treasury.Balance = source.Treasury
+	if source.Treasurers != nil {
+		settlementv1Treasury.TreasurerIds = make([]string, len(source.Treasurers))
+		for i := 0; i < len(source.Treasurers); i++ {
+			settlementv1Treasury.TreasurerIds[i] = source.Treasurers[i]
+		}
+	}
+	return &settlementv1Treasury
+}
 func (c *MapperImpl) ToVector2Proto(source model.Vector2) *v1.Vector2 {
 	var settlementv1Vector2 v1.Vector2
 	settlementv1Vector2.X = goverter.IntToInt32(source.X)
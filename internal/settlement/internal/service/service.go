@@ -98,6 +98,11 @@ func (s *Service) Submit(ctx context.Context, req *settlementv1.SubmitRequest) (
 		)
 	}
 
+	if err := s.checkLevelUp(ctx, found.Id, found.Type); err != nil {
+		s.log.Info("level up refused", zap.String("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
 	opts.Type = found.Type
 	if err := s.dbRepo.UpdateRequest(ctx, opts); err != nil {
 		s.log.Error("failed to update settlement request", zap.Error(err))
@@ -123,8 +128,16 @@ func (s *Service) Get(ctx context.Context, req *settlementv1.GetRequest) (*settl
 		return nil, status.Error(codes.NotFound, ierror.ErrNotFound.Error())
 	}
 
+	memberCap, err := s.memberCap(ctx, settlement)
+	if err != nil {
+		s.log.Error("failed to get member cap", zap.Error(err))
+		return nil, err
+	}
+
 	return &settlementv1.GetResponse{
-		Settlement: s.mapper.ToSettlementProto(*settlement),
+		Settlement:  s.mapper.ToSettlementProto(*settlement),
+		MemberCount: int32(settlement.MemberCount()),
+		MemberCap:   int32(memberCap),
 	}, nil
 }
 
@@ -138,8 +151,12 @@ func (s *Service) List(ctx context.Context, req *settlementv1.ListRequest) (*set
 		return nil, err
 	}
 
+	visible := lo.Filter(settlements, func(st model.Settlement, _ int) bool {
+		return !st.Hidden
+	})
+
 	return &settlementv1.ListResponse{
-		Settlements: s.mapper.ToSettlementProtos(settlements),
+		Settlements: s.mapper.ToSettlementProtos(visible),
 	}, nil
 }
 
@@ -176,6 +193,24 @@ func (s *Service) Approve(ctx context.Context, req *settlementv1.ApproveRequest)
 		return nil, ierror.ErrAlreadyApproved
 	}
 
+	adminID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The requirements are checked again, as they may have lapsed since
+	// Submit. A level-up's fee is taken now rather than on Submit, so a
+	// rejected request costs nothing. It stays charged if approving fails, so
+	// a retry finds it paid; Reject returns it.
+	charged, err := s.chargeLevelUpFee(ctx, settlement, adminID)
+	if err != nil {
+		s.log.Info("level up refused", zap.String("settlement_id", settlement.Id), zap.Error(err))
+		return nil, err
+	}
+	if charged != nil {
+		s.recordLevelUpFee(ctx, s.log.WithMethod("Approve"), charged)
+	}
+
 	if err := s.dbRepo.Approve(ctx, req.GetId()); err != nil {
 		s.log.Error("failed to approve settlement", zap.Error(err))
 		return nil, err
@@ -190,6 +225,32 @@ func (s *Service) Reject(ctx context.Context, req *settlementv1.RejectRequest) (
 		zap.String("settlement_id", req.GetId()),
 		zap.String("rejection_reason", req.GetRejectionReason()))
 
+	request, err := s.dbRepo.GetSettlementRequest(ctx, req.GetId())
+	if err != nil {
+		s.log.Error("failed to get settlement", zap.Error(err))
+		return nil, err
+	}
+	if request == nil {
+		return nil, ierror.ErrNotFound
+	}
+
+	// Return a fee an earlier, failed approval of this request took. This
+	// comes first: rejecting bumps the request, and with it the fee's key.
+	if request.Status == model.SettlementStatusPending {
+		adminID, err := interceptor.GetUserID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		refunded, err := s.refundLevelUpFee(ctx, request, adminID)
+		if err != nil {
+			s.log.Error("failed to refund level-up fee", zap.Error(err))
+			return nil, err
+		}
+		if refunded != nil {
+			s.recordLevelUpFee(ctx, s.log.WithMethod("Reject"), refunded)
+		}
+	}
+
 	if err := s.dbRepo.Reject(ctx, req.GetId(), req.GetRejectionReason()); err != nil {
 		s.log.Error("failed to reject settlement", zap.Error(err))
 		return nil, err
@@ -236,6 +297,21 @@ func (s *Service) InviteMember(ctx context.Context, req *settlementv1.InviteMemb
 		}
 	}
 
+	// Only an early rejection: AcceptInvitation enforces the cap atomically.
+	settlement, err := s.dbRepo.GetSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		s.log.Error("failed to get settlement", zap.Error(err))
+		return nil, err
+	}
+	memberCap, err := s.memberCap(ctx, settlement)
+	if err != nil {
+		s.log.Error("failed to get member cap", zap.Error(err))
+		return nil, err
+	}
+	if !settlement.HasRoomFor(memberCap) {
+		return nil, ierror.ErrMemberCapReached
+	}
+
 	if err := s.dbRepo.CreateInvitation(ctx, req.GetSettlementId(), req.GetUserId()); err != nil {
 		s.log.Error("failed to add member", zap.Error(err))
 		return nil, status.Error(codes.Internal, err.Error())
@@ -304,8 +380,28 @@ func (s *Service) AcceptInvitation(ctx context.Context, req *settlementv1.Accept
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	inv, err := s.dbRepo.GetInvitation(ctx, req.GetInvitationId())
+	if err != nil {
+		s.log.Error("failed to get invitation", zap.Error(err))
+		return nil, err
+	}
+	if inv.UserId != uid {
+		return nil, ierror.ErrPermissionDenied
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, inv.SettlementId)
+	if err != nil {
+		s.log.Error("failed to get settlement", zap.Error(err))
+		return nil, err
+	}
+	memberCap, err := s.memberCap(ctx, settlement)
+	if err != nil {
+		s.log.Error("failed to get member cap", zap.Error(err))
+		return nil, err
+	}
+
 	// TODO: утекла бизнесуха в репу, придумать как вынести транкзакции и отрефакторить репу
-	if err := s.dbRepo.AcceptInvitation(ctx, req.GetInvitationId(), uid); err != nil {
+	if err := s.dbRepo.AcceptInvitation(ctx, req.GetInvitationId(), uid, memberCap); err != nil {
 		s.log.Error("failed to accept invitation", zap.Error(err))
 		return nil, err
 	}
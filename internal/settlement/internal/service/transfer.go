@@ -12,6 +12,11 @@ import (
 )
 
 // TransferImperialFavor implements settlementv1.SettlementServiceServer.
+//
+// The transfer is journaled as pending before any balance moves, then the
+// source is debited and the target credited, each exactly once per transfer.
+// If the request dies in between, RecoverFavor finishes the transfer, or
+// refunds the source when the target no longer exists.
 func (s *Service) TransferImperialFavor(ctx context.Context, req *settlementv1.TransferImperialFavorRequest) (*settlementv1.TransferImperialFavorResponse, error) {
 	l := s.log.WithMethod("TransferImperialFavor").
 		With(zap.String("from", req.GetFromSettlementId()),
@@ -34,40 +39,25 @@ func (s *Service) TransferImperialFavor(ctx context.Context, req *settlementv1.T
 		return nil, status.Error(codes.PermissionDenied, "caller is not the leader of the source settlement")
 	}
 
-	from, err := s.dbRepo.UpdateSettlement(ctx, req.GetFromSettlementId(),
-		func(_ context.Context, s *model.Settlement) (*model.Settlement, error) {
-			return s, s.DeductFavor(req.GetAmount())
-		},
-	)
+	transfer, err := s.dbRepo.CreateFavorTransfer(ctx, model.FavorTransfer{
+		FromSettlementId: req.GetFromSettlementId(),
+		ToSettlementId:   req.GetToSettlementId(),
+		Amount:           req.GetAmount(),
+		ActorId:          callerID,
+		State:            model.FavorTransferPending,
+	})
 	if err != nil {
-		l.Error("failed to deduct favor from source", zap.Error(err))
+		l.Error("failed to journal favor transfer", zap.Error(err))
 		return nil, err
 	}
 
-	to, err := s.dbRepo.UpdateSettlement(ctx, req.GetToSettlementId(),
-		func(_ context.Context, s *model.Settlement) (*model.Settlement, error) {
-			s.AddFavor(req.GetAmount())
-			return s, nil
-		},
-	)
+	l = l.With(zap.String("transfer_id", transfer.Id))
+	from, to, err := s.runFavorTransfer(ctx, l, *transfer)
 	if err != nil {
-		l.Error("failed to add favor to target (deduction already applied)", zap.Error(err))
+		l.Error("favor transfer did not complete", zap.Error(err))
 		return nil, err
 	}
 
-	_ = s.dbRepo.CreateFavorLog(ctx, model.ImperialFavorLog{
-		SettlementId: req.GetFromSettlementId(),
-		AdminId:      callerID,
-		Amount:       -req.GetAmount(),
-		Reason:       "transfer to " + req.GetToSettlementId(),
-	})
-	_ = s.dbRepo.CreateFavorLog(ctx, model.ImperialFavorLog{
-		SettlementId: req.GetToSettlementId(),
-		AdminId:      callerID,
-		Amount:       req.GetAmount(),
-		Reason:       "transfer from " + req.GetFromSettlementId(),
-	})
-
 	return &settlementv1.TransferImperialFavorResponse{
 		FromSettlement: s.mapper.ToSettlementProto(*from),
 		ToSettlement:   s.mapper.ToSettlementProto(*to),
@@ -0,0 +1,552 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	pkgierror "github.com/lasthearth/vsservice/internal/pkg/ierror"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/server/interceptor"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// treasuryStaleAfter is how long a deposit or payout may sit pending before
+// RecoverTreasury takes it over from the request that started it.
+const treasuryStaleAfter = 5 * time.Minute
+
+var (
+	// errDepositGone aborts a settlement update for a deposit that is no
+	// longer pending.
+	errDepositGone = errors.New("deposit is no longer pending")
+	// errPayoutGone does the same for a payout.
+	errPayoutGone = errors.New("payout is no longer pending")
+)
+
+// newOpKey returns a fresh op key of the given kind for a movement that is
+// never retried.
+func newOpKey(kind string) string {
+	return kind + ":" + uuid.NewString()
+}
+
+// GetTreasury implements settlementv1.SettlementServiceServer.
+func (s *Service) GetTreasury(ctx context.Context, req *settlementv1.GetTreasuryRequest) (*settlementv1.GetTreasuryResponse, error) {
+	settlement, err := s.memberSettlement(ctx, req.GetSettlementId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.GetTreasuryResponse{
+		Treasury: s.mapper.ToTreasuryProto(*settlement),
+	}, nil
+}
+
+// DepositToTreasury implements settlementv1.SettlementServiceServer.
+//
+// The deposit is journaled on the settlement under an op key before the coins
+// leave the member's wallet, then the wallet is debited under that key, then
+// the pending deposit is turned into treasury coins and their ledger entry in
+// one write. A deposit cut short at any step is finished or, if its debit
+// never landed, dropped by RecoverTreasury.
+func (s *Service) DepositToTreasury(ctx context.Context, req *settlementv1.DepositToTreasuryRequest) (*settlementv1.DepositToTreasuryResponse, error) {
+	l := s.log.WithMethod("DepositToTreasury").With(zap.String("settlement_id", req.GetSettlementId()), zap.Int64("amount", req.GetAmount()))
+
+	if req.GetAmount() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opKey := newOpKey("treasury-deposit")
+	if _, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if !settlement.IsMember(callerID) {
+				return nil, ierror.ErrNotMember
+			}
+			return settlement, settlement.StartDeposit(callerID, req.GetAmount(), opKey, time.Now())
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	reason := "settlement treasury deposit: " + req.GetSettlementId()
+	if err := s.debitor.DebitOnce(ctx, callerID, req.GetAmount(), reason, opKey); err != nil {
+		if !errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+			if walletRefused(err) {
+				// The wallet refused the debit, so nothing left it.
+				s.dropDeposit(ctx, l, req.GetSettlementId(), opKey)
+			}
+			return nil, err
+		}
+		l.Error("wallet debited without a donate ledger entry", zap.Error(err))
+	}
+
+	updated, err := s.completeDeposit(ctx, l, req.GetSettlementId(), model.PendingDeposit{
+		OpKey:   opKey,
+		ActorId: callerID,
+		Amount:  req.GetAmount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.DepositToTreasuryResponse{
+		Treasury: s.mapper.ToTreasuryProto(*updated),
+	}, nil
+}
+
+// WithdrawFromTreasury implements settlementv1.SettlementServiceServer.
+//
+// The coins are taken from the treasury, with their ledger entry, and
+// journaled as a pending payout in one write; the recipient's wallet is then
+// credited under the payout's op key. A payout the wallet refuses goes back
+// into the treasury with a refund entry; one cut short otherwise is finished
+// by RecoverTreasury.
+func (s *Service) WithdrawFromTreasury(ctx context.Context, req *settlementv1.WithdrawFromTreasuryRequest) (*settlementv1.WithdrawFromTreasuryResponse, error) {
+	l := s.log.WithMethod("WithdrawFromTreasury").With(zap.String("settlement_id", req.GetSettlementId()), zap.Int64("amount", req.GetAmount()))
+
+	if req.GetAmount() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientID := req.GetRecipientId()
+	if recipientID == "" {
+		recipientID = callerID
+	}
+
+	reason := "settlement treasury withdrawal: " + req.GetSettlementId()
+	if req.GetReason() != "" {
+		reason += ": " + req.GetReason()
+	}
+	payout := model.PendingPayout{
+		OpKey:       newOpKey("treasury-withdrawal"),
+		ActorId:     callerID,
+		RecipientId: recipientID,
+		Kind:        model.TreasuryWithdrawal,
+		Amount:      req.GetAmount(),
+		Reason:      reason,
+	}
+
+	updated, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if !settlement.CanManageTreasury(callerID) {
+				return nil, ierror.ErrNotTreasurer
+			}
+			if !settlement.IsMember(recipientID) {
+				return nil, status.Error(codes.InvalidArgument, "recipient is not a member of the settlement")
+			}
+			if err := settlement.StartPayout(payout, time.Now()); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return settlement, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.payOut(ctx, l, req.GetSettlementId(), payout); err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.WithdrawFromTreasuryResponse{
+		Treasury: s.mapper.ToTreasuryProto(*updated),
+	}, nil
+}
+
+// BuyShopItemFromTreasury implements settlementv1.SettlementServiceServer.
+//
+// The item is quoted, and its price taken from the treasury with its ledger
+// entry and journaled as a pending payout in one write; the purchase is then
+// granted to the caller, under the payout's op key, without touching their
+// wallet. A grant the shop refuses puts the price back into the treasury with
+// a refund entry; one cut short otherwise is finished by RecoverTreasury.
+func (s *Service) BuyShopItemFromTreasury(ctx context.Context, req *settlementv1.BuyShopItemFromTreasuryRequest) (*settlementv1.BuyShopItemFromTreasuryResponse, error) {
+	l := s.log.WithMethod("BuyShopItemFromTreasury").With(zap.String("settlement_id", req.GetSettlementId()), zap.String("item_id", req.GetItemId()))
+
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := s.shop.Quote(ctx, req.GetItemId())
+	if err != nil {
+		return nil, err
+	}
+
+	payout := model.PendingPayout{
+		OpKey:       newOpKey("treasury-spend"),
+		ActorId:     callerID,
+		RecipientId: callerID,
+		Kind:        model.TreasurySpend,
+		Amount:      quote.Price,
+		Reason:      "shop: " + quote.Name,
+		ItemId:      quote.ItemID,
+	}
+
+	updated, err := s.dbRepo.UpdateSettlement(ctx, req.GetSettlementId(),
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if !settlement.CanManageTreasury(callerID) {
+				return nil, ierror.ErrNotTreasurer
+			}
+			if err := settlement.StartPayout(payout, time.Now()); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return settlement, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	purchaseID, err := s.payOut(ctx, l, req.GetSettlementId(), payout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.BuyShopItemFromTreasuryResponse{
+		Treasury:   s.mapper.ToTreasuryProto(*updated),
+		PurchaseId: purchaseID,
+	}, nil
+}
+
+// ListTreasuryLedger implements settlementv1.SettlementServiceServer.
+func (s *Service) ListTreasuryLedger(ctx context.Context, req *settlementv1.ListTreasuryLedgerRequest) (*settlementv1.ListTreasuryLedgerResponse, error) {
+	l := s.log.WithMethod("ListTreasuryLedger").With(zap.String("settlement_id", req.GetSettlementId()))
+
+	if _, err := s.memberSettlement(ctx, req.GetSettlementId()); err != nil {
+		return nil, err
+	}
+
+	entries, nextToken, err := s.dbRepo.ListTreasuryEntries(ctx, req.GetSettlementId(), req.GetNextToken())
+	if err != nil {
+		l.Error("failed to list treasury entries", zap.Error(err))
+		return nil, err
+	}
+
+	return &settlementv1.ListTreasuryLedgerResponse{
+		Entries:   s.mapper.ToTreasuryEntriesProto(entries),
+		NextToken: nextToken,
+	}, nil
+}
+
+// AssignTreasurer implements settlementv1.SettlementServiceServer.
+func (s *Service) AssignTreasurer(ctx context.Context, req *settlementv1.AssignTreasurerRequest) (*settlementv1.AssignTreasurerResponse, error) {
+	updated, err := s.updateAsLeader(ctx, req.GetSettlementId(), func(settlement *model.Settlement) error {
+		return settlement.AssignTreasurer(req.GetUserId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.AssignTreasurerResponse{
+		Treasury: s.mapper.ToTreasuryProto(*updated),
+	}, nil
+}
+
+// RevokeTreasurer implements settlementv1.SettlementServiceServer.
+func (s *Service) RevokeTreasurer(ctx context.Context, req *settlementv1.RevokeTreasurerRequest) (*settlementv1.RevokeTreasurerResponse, error) {
+	updated, err := s.updateAsLeader(ctx, req.GetSettlementId(), func(settlement *model.Settlement) error {
+		return settlement.RevokeTreasurer(req.GetUserId())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &settlementv1.RevokeTreasurerResponse{
+		Treasury: s.mapper.ToTreasuryProto(*updated),
+	}, nil
+}
+
+// memberSettlement loads the settlement and checks that the caller belongs to it.
+func (s *Service) memberSettlement(ctx context.Context, settlementID string) (*model.Settlement, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	if !settlement.IsMember(callerID) {
+		return nil, ierror.ErrNotMember
+	}
+	return settlement, nil
+}
+
+// updateAsLeader applies fn to the settlement on behalf of its leader.
+func (s *Service) updateAsLeader(ctx context.Context, settlementID string, fn func(*model.Settlement) error) (*model.Settlement, error) {
+	callerID, err := interceptor.GetUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.dbRepo.UpdateSettlement(ctx, settlementID,
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if settlement.Leader.UserId != callerID {
+				return nil, ierror.ErrNotLeader
+			}
+			if err := fn(settlement); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return settlement, nil
+		},
+	)
+}
+
+// walletRefused reports whether err is the donate domain declining a wallet
+// move or a grant, e.g. for too few coins or an item off sale, so nothing
+// moved.
+func walletRefused(err error) bool {
+	var de *pkgierror.DomainError
+	return errors.As(err, &de) ||
+		errors.Is(err, donateuc.ErrNonPositiveAmount) ||
+		errors.Is(err, donateuc.ErrMissingOpKey)
+}
+
+// completeDeposit credits a deposit whose wallet debit landed to the treasury.
+// If the deposit is no longer pending, RecoverTreasury either completed it or
+// gave up on it before the debit landed; in the latter case the coins are
+// returned to the wallet.
+func (s *Service) completeDeposit(ctx context.Context, l logger.Logger, settlementID string, d model.PendingDeposit) (*model.Settlement, error) {
+	updated, err := s.dbRepo.UpdateSettlement(ctx, settlementID,
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			ok, err := settlement.CompleteDeposit(d.OpKey)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, errDepositGone
+			}
+			return settlement, nil
+		},
+	)
+	if err == nil {
+		s.flushTreasuryEntries(ctx, l, settlementID)
+		return updated, nil
+	}
+	if !errors.Is(err, errDepositGone) {
+		l.Error("failed to credit deposit to treasury, leaving it for recovery", zap.Error(err))
+		return nil, err
+	}
+
+	settlement, err := s.dbRepo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	// An entry reaches the ledger before it stops being pending, so checking
+	// in this order cannot miss it.
+	credited := settlement.HasPendingTreasuryEntry(d.OpKey)
+	if !credited {
+		if credited, err = s.dbRepo.HasTreasuryEntry(ctx, d.OpKey); err != nil {
+			return nil, err
+		}
+	}
+	if credited {
+		return settlement, nil
+	}
+
+	l.Warn("deposit was given up before its debit landed, returning the coins")
+	reason := "refund: settlement treasury deposit: " + settlementID
+	if err := s.creditor.CreditOnce(ctx, d.ActorId, "", d.Amount, reason, d.OpKey+":refund"); err != nil && !errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+		return nil, fmt.Errorf("%w; returning %d coins to the wallet failed: %w", ierror.ErrDepositAbandoned, d.Amount, err)
+	}
+	return nil, ierror.ErrDepositAbandoned
+}
+
+// dropDeposit forgets a deposit whose wallet debit never landed. A failure
+// only leaves it for RecoverTreasury.
+func (s *Service) dropDeposit(ctx context.Context, l logger.Logger, settlementID, opKey string) {
+	_, err := s.dbRepo.UpdateSettlement(ctx, settlementID,
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if !settlement.DropDeposit(opKey) {
+				return nil, errDepositGone
+			}
+			return settlement, nil
+		},
+	)
+	if err != nil && !errors.Is(err, errDepositGone) {
+		l.Error("failed to drop deposit", zap.Error(err))
+	}
+}
+
+// payOut credits the recipient of a pending payout, or grants them its item,
+// under the payout's op key and returns the purchase id of a grant. A payout
+// the donate domain refuses is refunded to the treasury and its error
+// returned; any other failure leaves the payout for RecoverTreasury, which
+// repeats it under the same key.
+func (s *Service) payOut(ctx context.Context, l logger.Logger, settlementID string, p model.PendingPayout) (string, error) {
+	var purchaseID string
+	var err error
+	switch p.Kind {
+	case model.TreasurySpend:
+		purchaseID, err = s.shop.GrantOnce(ctx, p.RecipientId, p.ItemId, p.Amount, p.OpKey)
+	default:
+		err = s.creditor.CreditOnce(ctx, p.RecipientId, "", p.Amount, p.Reason, p.OpKey)
+		if errors.Is(err, donateuc.ErrLedgerNotRecorded) {
+			// The coins landed; RecoverTreasury repeats the credit to write
+			// the wallet's ledger entry.
+			l.Error("wallet credited without a donate ledger entry", zap.Error(err))
+			return "", nil
+		}
+	}
+	if err != nil {
+		if !walletRefused(err) {
+			l.Error("failed to pay out, leaving it for recovery", zap.Error(err))
+			return "", err
+		}
+		return "", s.refundPayout(ctx, l, settlementID, p.OpKey, err)
+	}
+
+	s.finishPayout(ctx, l, settlementID, p.OpKey, purchaseID)
+	return purchaseID, nil
+}
+
+// finishPayout forgets a payout that reached its recipient and flushes its
+// ledger entry, linked to purchaseID for a spend. Failures are logged;
+// RecoverTreasury repeats the payout, which is then a no-op, and finishes it.
+func (s *Service) finishPayout(ctx context.Context, l logger.Logger, settlementID, opKey, purchaseID string) {
+	if _, err := s.dbRepo.UpdateSettlement(ctx, settlementID,
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			if !settlement.FinishPayout(opKey) {
+				return nil, errPayoutGone
+			}
+			return settlement, nil
+		},
+	); err != nil && !errors.Is(err, errPayoutGone) {
+		l.Error("failed to finish payout", zap.Error(err))
+		return
+	}
+
+	if s.flushTreasuryEntries(ctx, l, settlementID) && purchaseID != "" {
+		if err := s.dbRepo.SetTreasuryEntryPurchase(ctx, opKey, purchaseID); err != nil {
+			l.Error("failed to link purchase to treasury entry", zap.Error(err))
+		}
+	}
+}
+
+// refundPayout puts the coins of a pending payout the donate domain refused
+// back into the treasury, with a refund entry. Returns cause, or an error
+// naming both failures when the refund itself fails.
+func (s *Service) refundPayout(ctx context.Context, l logger.Logger, settlementID, opKey string, cause error) error {
+	if _, err := s.dbRepo.UpdateSettlement(ctx, settlementID,
+		func(_ context.Context, settlement *model.Settlement) (*model.Settlement, error) {
+			ok, err := settlement.RefundPayout(opKey)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				return nil, errPayoutGone
+			}
+			return settlement, nil
+		},
+	); err != nil && !errors.Is(err, errPayoutGone) {
+		return fmt.Errorf("%w; returning the coins to the treasury failed: %w", cause, err)
+	}
+	s.flushTreasuryEntries(ctx, l, settlementID)
+	return cause
+}
+
+// flushTreasuryEntries copies the settlement's pending treasury entries to the
+// ledger and reports whether it did. Failures are logged; RecoverTreasury
+// flushes whatever is left.
+func (s *Service) flushTreasuryEntries(ctx context.Context, l logger.Logger, settlementID string) bool {
+	if err := s.dbRepo.FlushTreasuryEntries(ctx, settlementID); err != nil {
+		l.Error("failed to flush treasury entries", zap.String("settlement_id", settlementID), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+// RunTreasuryRecovery runs RecoverTreasury every interval until ctx is done.
+func (s *Service) RunTreasuryRecovery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := s.RecoverTreasury(ctx, now); err != nil {
+				s.log.WithMethod("RunTreasuryRecovery").Error("treasury recovery failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RecoverTreasury finishes deposits and payouts left pending by a request
+// that stopped midway, then flushes pending treasury entries into the ledger.
+//
+// A stale deposit whose wallet debit landed is credited to the treasury; one
+// whose debit did not is dropped. Should that debit land afterwards, the
+// request completing it finds the deposit gone and returns the coins. A stale
+// payout is repeated under its op key, which pays it at most once, and then
+// finished, or refunded if the donate domain refuses it.
+func (s *Service) RecoverTreasury(ctx context.Context, now time.Time) error {
+	l := s.log.WithMethod("RecoverTreasury")
+
+	ids, err := s.dbRepo.ListSettlementsWithPendingTreasury(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		sl := l.With(zap.String("settlement_id", id))
+		settlement, err := s.dbRepo.GetSettlement(ctx, id)
+		if err != nil {
+			sl.Error("failed to get settlement", zap.Error(err))
+			continue
+		}
+
+		for _, d := range settlement.PendingDeposits {
+			if now.Sub(d.CreatedAt) < treasuryStaleAfter {
+				continue
+			}
+			dl := sl.With(zap.String("op_key", d.OpKey))
+			debited, err := s.debitor.Debited(ctx, d.ActorId, d.OpKey)
+			if err != nil {
+				dl.Error("failed to check deposit debit", zap.Error(err))
+				continue
+			}
+			if !debited {
+				dl.Warn("dropping deposit whose debit never landed")
+				s.dropDeposit(ctx, dl, id, d.OpKey)
+				continue
+			}
+			if _, err := s.completeDeposit(ctx, dl, id, d); err != nil {
+				dl.Error("failed to finish deposit", zap.Error(err))
+			}
+		}
+
+		for _, p := range settlement.PendingPayouts {
+			if now.Sub(p.CreatedAt) < treasuryStaleAfter {
+				continue
+			}
+			pl := sl.With(zap.String("op_key", p.OpKey))
+			if _, err := s.payOut(ctx, pl, id, p); err != nil {
+				pl.Error("failed to finish payout", zap.Error(err))
+			}
+		}
+
+		s.flushTreasuryEntries(ctx, sl, id)
+	}
+	return nil
+}
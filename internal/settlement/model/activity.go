@@ -0,0 +1,123 @@
+package model
+
+import "time"
+
+// DecayAction is what happens to a settlement once its grace period runs out.
+type DecayAction string
+
+const (
+	// DecayDowngrade lowers the type one tier per grace period, and hides a
+	// camp, which has nowhere lower to go.
+	DecayDowngrade DecayAction = "downgrade"
+	// DecayHide hides the settlement from List straight away.
+	DecayHide DecayAction = "hide"
+)
+
+// ActivityPolicy configures inactivity decay.
+type ActivityPolicy struct {
+	// InactiveAfter is how long no member may be online before the settlement
+	// is flagged inactive.
+	InactiveAfter time.Duration
+	// GracePeriod is how long a flagged settlement has before it decays.
+	GracePeriod time.Duration
+	Action      DecayAction
+}
+
+// ActivityTransition reports what ApplyActivity changed.
+type ActivityTransition int
+
+const (
+	ActivityUnchanged ActivityTransition = iota
+	// ActivityFlagged means the settlement just became inactive; the leader
+	// should be warned before anything is taken away.
+	ActivityFlagged
+	// ActivityDowngraded means the type was lowered one tier.
+	ActivityDowngraded
+	// ActivityHidden means the settlement was hidden from List.
+	ActivityHidden
+	// ActivityRestored means a flagged or hidden settlement became active
+	// again, or was exempted.
+	ActivityRestored
+)
+
+// IsInactive reports whether the settlement is currently flagged inactive.
+func (s *Settlement) IsInactive() bool {
+	return !s.InactiveSince.IsZero()
+}
+
+// ApplyActivity records lastActivity (the latest moment any member was
+// online) and moves the settlement through the inactivity lifecycle:
+// active → flagged → decayed, back to active as soon as a member returns.
+// Downgrades are not undone by returning; the settlement levels up again
+// through Submit.
+func (s *Settlement) ApplyActivity(lastActivity, now time.Time, p ActivityPolicy) ActivityTransition {
+	if lastActivity.After(s.LastActivityAt) {
+		s.LastActivityAt = lastActivity
+	}
+
+	// A settlement nobody has been seen in yet is measured from its creation,
+	// so a fresh one is not flagged on the first sweep.
+	since := s.LastActivityAt
+	if s.CreatedAt.After(since) {
+		since = s.CreatedAt
+	}
+
+	if s.ActivityExempt || now.Sub(since) < p.InactiveAfter {
+		return s.restore()
+	}
+
+	if !s.IsInactive() {
+		s.InactiveSince = now
+		return ActivityFlagged
+	}
+
+	if s.Hidden || now.Sub(s.InactiveSince) < p.GracePeriod {
+		return ActivityUnchanged
+	}
+
+	if p.Action == DecayDowngrade {
+		if lower, ok := s.Type.Lower(); ok {
+			s.Type = lower
+			// The next tier is only lost after another full grace period.
+			s.InactiveSince = now
+			return ActivityDowngraded
+		}
+	}
+
+	s.Hidden = true
+	return ActivityHidden
+}
+
+// SetActivityExempt exempts the settlement from inactivity decay, or lifts the
+// exemption. Exempting also clears any inactive flag and unhides it.
+func (s *Settlement) SetActivityExempt(exempt bool) {
+	s.ActivityExempt = exempt
+	if exempt {
+		s.restore()
+	}
+}
+
+func (s *Settlement) restore() ActivityTransition {
+	if !s.IsInactive() && !s.Hidden {
+		return ActivityUnchanged
+	}
+	s.InactiveSince = time.Time{}
+	s.Hidden = false
+	return ActivityRestored
+}
+
+// Lower returns the tier below t. False for a camp, the lowest tier.
+func (t SettlementType) Lower() (SettlementType, bool) {
+	switch t {
+	case SettlementTypeProvince:
+		return SettlementTypeCity, true
+	case SettlementTypeCity:
+		return SettlementTypeTownship, true
+	case SettlementTypeTownship:
+		return SettlementTypeVillage, true
+	case SettlementTypeVillage:
+		return SettlementTypeCamp, true
+	default:
+		return t, false
+	}
+}
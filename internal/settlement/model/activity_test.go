@@ -0,0 +1,84 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+var testPolicy = model.ActivityPolicy{
+	InactiveAfter: 30 * 24 * time.Hour,
+	GracePeriod:   7 * 24 * time.Hour,
+	Action:        model.DecayDowngrade,
+}
+
+func TestApplyActivity_Lifecycle(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &model.Settlement{Type: model.SettlementTypeVillage, CreatedAt: start}
+	day := 24 * time.Hour
+
+	steps := []struct {
+		now  time.Time
+		want model.ActivityTransition
+		typ  model.SettlementType
+	}{
+		{start.Add(10 * day), model.ActivityUnchanged, model.SettlementTypeVillage},
+		{start.Add(31 * day), model.ActivityFlagged, model.SettlementTypeVillage},
+		{start.Add(35 * day), model.ActivityUnchanged, model.SettlementTypeVillage},
+		{start.Add(38 * day), model.ActivityDowngraded, model.SettlementTypeCamp},
+		{start.Add(40 * day), model.ActivityUnchanged, model.SettlementTypeCamp},
+		{start.Add(45 * day), model.ActivityHidden, model.SettlementTypeCamp},
+		{start.Add(60 * day), model.ActivityUnchanged, model.SettlementTypeCamp},
+	}
+	for i, st := range steps {
+		if got := s.ApplyActivity(time.Time{}, st.now, testPolicy); got != st.want {
+			t.Fatalf("step %d: transition = %v, want %v", i, got, st.want)
+		}
+		if s.Type != st.typ {
+			t.Fatalf("step %d: type = %v, want %v", i, s.Type, st.typ)
+		}
+	}
+	if !s.Hidden || !s.IsInactive() {
+		t.Fatalf("expected hidden inactive settlement, got hidden=%v inactive=%v", s.Hidden, s.IsInactive())
+	}
+
+	now := start.Add(61 * day)
+	if got := s.ApplyActivity(now, now, testPolicy); got != model.ActivityRestored {
+		t.Fatalf("return: transition = %v, want restored", got)
+	}
+	if s.Hidden || s.IsInactive() || s.Type != model.SettlementTypeCamp {
+		t.Fatalf("restore must clear flags but keep the lowered type, got %+v", s)
+	}
+}
+
+func TestApplyActivity_HidePolicy(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &model.Settlement{Type: model.SettlementTypeCity, CreatedAt: start}
+	p := model.ActivityPolicy{
+		InactiveAfter: testPolicy.InactiveAfter,
+		GracePeriod:   testPolicy.GracePeriod,
+		Action:        model.DecayHide,
+	}
+
+	s.ApplyActivity(time.Time{}, start.Add(31*24*time.Hour), p)
+	if got := s.ApplyActivity(time.Time{}, start.Add(40*24*time.Hour), p); got != model.ActivityHidden {
+		t.Fatalf("transition = %v, want hidden", got)
+	}
+	if s.Type != model.SettlementTypeCity {
+		t.Fatalf("hide policy must not downgrade, got %v", s.Type)
+	}
+}
+
+func TestSetActivityExempt(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &model.Settlement{Type: model.SettlementTypeVillage, CreatedAt: start, InactiveSince: start, Hidden: true}
+
+	s.SetActivityExempt(true)
+	if s.Hidden || s.IsInactive() {
+		t.Fatal("exempting must clear the inactive flag and unhide")
+	}
+	if got := s.ApplyActivity(time.Time{}, start.Add(365*24*time.Hour), testPolicy); got != model.ActivityUnchanged {
+		t.Fatalf("exempt settlement transition = %v, want unchanged", got)
+	}
+}
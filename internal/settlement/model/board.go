@@ -0,0 +1,202 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxBoardPostTitle and MaxBoardPostBody bound a post, in characters.
+	MaxBoardPostTitle = 120
+	MaxBoardPostBody  = 4000
+	// MaxBoardPostAttachments bounds the attachments of one post.
+	MaxBoardPostAttachments = 5
+)
+
+var (
+	errEmptyPostTitle     = errors.New("post title cannot be empty")
+	errPostTitleTooLong   = errors.New("post title is too long")
+	errPostBodyTooLong    = errors.New("post body is too long")
+	errTooManyAttachments = errors.New("too many attachments")
+	errInvalidVisibility  = errors.New("invalid post visibility")
+	errPostRemoved        = errors.New("post is already removed")
+	errAlreadyHerald      = errors.New("user is already a herald")
+	errNotHerald          = errors.New("user is not a herald")
+)
+
+// PostVisibility says who may read a board post.
+type PostVisibility string
+
+const (
+	// PostVisibilityMembers posts are shown to members of the settlement only.
+	PostVisibilityMembers PostVisibility = "members"
+	// PostVisibilityPublic posts are shown to everyone.
+	PostVisibilityPublic PostVisibility = "public"
+)
+
+// BoardPost is a message on a settlement's bulletin board.
+type BoardPost struct {
+	Id           string
+	SettlementId string
+	AuthorId     string
+	Title        string
+	Body         string
+	Attachments  []Attachment
+	Visibility   PostVisibility
+	// Announcement posts notify every member when created.
+	Announcement bool
+	// Pinned posts are listed before the others.
+	Pinned bool
+
+	// RemovedBy is set once the post is taken down, by its author, the leader
+	// or a moderator. Removed posts are never listed.
+	RemovedBy     string
+	RemovalReason string
+	RemovedAt     time.Time
+
+	// RateSlot is the rate-limit slot the post took when stored, one of
+	// BoardRateLimit.Slots. A removed post keeps it.
+	RateSlot string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewBoardPost validates and builds a post. Announcements start pinned.
+func NewBoardPost(
+	settlementID, authorID, title, body string,
+	attachments []Attachment,
+	visibility PostVisibility,
+	announcement bool,
+) (*BoardPost, error) {
+	title = strings.TrimSpace(title)
+	body = strings.TrimSpace(body)
+
+	switch {
+	case title == "":
+		return nil, errEmptyPostTitle
+	case utf8.RuneCountInString(title) > MaxBoardPostTitle:
+		return nil, errPostTitleTooLong
+	case utf8.RuneCountInString(body) > MaxBoardPostBody:
+		return nil, errPostBodyTooLong
+	case len(attachments) > MaxBoardPostAttachments:
+		return nil, errTooManyAttachments
+	case visibility != PostVisibilityMembers && visibility != PostVisibilityPublic:
+		return nil, errInvalidVisibility
+	}
+
+	return &BoardPost{
+		SettlementId: settlementID,
+		AuthorId:     authorID,
+		Title:        title,
+		Body:         body,
+		Attachments:  attachments,
+		Visibility:   visibility,
+		Announcement: announcement,
+		Pinned:       announcement,
+	}, nil
+}
+
+// MarkCreated records the id, rate-limit slot and creation time the post was
+// stored with.
+func (p *BoardPost) MarkCreated(id, rateSlot string, at time.Time) {
+	p.Id = id
+	p.RateSlot = rateSlot
+	p.CreatedAt = at
+	p.UpdatedAt = at
+}
+
+// Pin moves the post in or out of the pinned posts.
+func (p *BoardPost) Pin(pinned bool) {
+	p.Pinned = pinned
+}
+
+// IsRemoved reports whether the post was taken down.
+func (p *BoardPost) IsRemoved() bool {
+	return p.RemovedBy != ""
+}
+
+// Remove takes the post down on behalf of actorID.
+func (p *BoardPost) Remove(actorID, reason string, now time.Time) error {
+	if p.IsRemoved() {
+		return errPostRemoved
+	}
+	p.RemovedBy = actorID
+	p.RemovalReason = reason
+	p.RemovedAt = now
+	p.Pinned = false
+	return nil
+}
+
+// BoardPostFilter selects board posts of one settlement. Removed posts are
+// never selected.
+type BoardPostFilter struct {
+	SettlementId string
+	// PublicOnly leaves out members-only posts.
+	PublicOnly bool
+	// Pinned selects the pinned posts instead of the others.
+	Pinned bool
+}
+
+// NewBoardPostFilter selects the posts of settlementID, the pinned ones or the
+// others, leaving out members-only posts when publicOnly is set.
+func NewBoardPostFilter(settlementID string, publicOnly, pinned bool) BoardPostFilter {
+	return BoardPostFilter{SettlementId: settlementID, PublicOnly: publicOnly, Pinned: pinned}
+}
+
+// BoardRateLimit bounds how many posts one author may publish on a board
+// within each Window. A non-positive Posts disables the limit.
+type BoardRateLimit struct {
+	Posts  int
+	Window time.Duration
+}
+
+// Slots returns the keys of the posts authorID may publish on settlementID's
+// board in the window holding now, one per allowed post; nil when the limit is
+// disabled. Windows are fixed, aligned to the Unix epoch. Each stored post
+// takes a free slot under a unique index, so concurrent posts cannot exceed
+// the limit.
+func (l BoardRateLimit) Slots(settlementID, authorID string, now time.Time) []string {
+	if l.Posts <= 0 || l.Window <= 0 {
+		return nil
+	}
+	window := now.UnixNano() / int64(l.Window)
+	slots := make([]string, l.Posts)
+	for i := range slots {
+		slots[i] = fmt.Sprintf("%s:%s:%d:%d", settlementID, authorID, window, i)
+	}
+	return slots
+}
+
+// CanPost reports whether userID may publish on the settlement's board: the
+// leader and the assigned heralds.
+func (s *Settlement) CanPost(userID string) bool {
+	return s.Leader.UserId == userID || slices.Contains(s.Heralds, userID)
+}
+
+// AssignHerald grants a member the right to post on the board. The leader
+// can always post and cannot be assigned.
+func (s *Settlement) AssignHerald(userID string) error {
+	if userID == s.Leader.UserId || !s.IsMember(userID) {
+		return errNotMember
+	}
+	if slices.Contains(s.Heralds, userID) {
+		return errAlreadyHerald
+	}
+	s.Heralds = append(s.Heralds, userID)
+	return nil
+}
+
+// RevokeHerald takes the right to post away from userID.
+func (s *Settlement) RevokeHerald(userID string) error {
+	i := slices.Index(s.Heralds, userID)
+	if i < 0 {
+		return errNotHerald
+	}
+	s.Heralds = slices.Delete(s.Heralds, i, i+1)
+	return nil
+}
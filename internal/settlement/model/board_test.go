@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func TestNewBoardPostValidates(t *testing.T) {
+	cases := map[string]struct {
+		title, body string
+		attachments int
+		visibility  model.PostVisibility
+	}{
+		"empty title":      {title: "  ", visibility: model.PostVisibilityPublic},
+		"long title":       {title: strings.Repeat("я", model.MaxBoardPostTitle+1), visibility: model.PostVisibilityPublic},
+		"long body":        {title: "t", body: strings.Repeat("я", model.MaxBoardPostBody+1), visibility: model.PostVisibilityPublic},
+		"many attachments": {title: "t", attachments: model.MaxBoardPostAttachments + 1, visibility: model.PostVisibilityPublic},
+		"no visibility":    {title: "t"},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			attachments := make([]model.Attachment, c.attachments)
+			if _, err := model.NewBoardPost("s", "a", c.title, c.body, attachments, c.visibility, false); err == nil {
+				t.Fatal("got nil, want error")
+			}
+		})
+	}
+
+	// Titles are measured in characters, not bytes.
+	p, err := model.NewBoardPost("s", "a", strings.Repeat("я", model.MaxBoardPostTitle), "", nil, model.PostVisibilityMembers, true)
+	if err != nil {
+		t.Fatalf("max-length title: %v", err)
+	}
+	if !p.Pinned {
+		t.Fatal("announcement is not pinned")
+	}
+}
+
+func TestBoardPostRemove(t *testing.T) {
+	p, err := model.NewBoardPost("s", "a", "t", "", nil, model.PostVisibilityPublic, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Remove("mod", "spam", time.Now()); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if !p.IsRemoved() || p.Pinned {
+		t.Fatalf("removed = %v, pinned = %v; want true, false", p.IsRemoved(), p.Pinned)
+	}
+	if err := p.Remove("mod", "spam", time.Now()); err == nil {
+		t.Fatal("Remove twice: got nil, want error")
+	}
+}
+
+func TestHeraldRole(t *testing.T) {
+	s := newSettlement()
+
+	if s.CanPost("alice") {
+		t.Fatal("alice can post before being assigned")
+	}
+	if !s.CanPost("leader") {
+		t.Fatal("leader cannot post")
+	}
+	if err := s.AssignHerald("alice"); err != nil {
+		t.Fatalf("AssignHerald(alice): %v", err)
+	}
+	if !s.CanPost("alice") {
+		t.Fatal("alice cannot post after being assigned")
+	}
+	if err := s.AssignHerald("stranger"); err == nil {
+		t.Fatal("AssignHerald(stranger): got nil, want error for a non-member")
+	}
+	if err := s.RevokeHerald("alice"); err != nil {
+		t.Fatalf("RevokeHerald(alice): %v", err)
+	}
+	if s.CanPost("alice") {
+		t.Fatal("alice can post after being revoked")
+	}
+}
+
+func TestBoardRateLimitSlots(t *testing.T) {
+	l := model.BoardRateLimit{Posts: 2, Window: time.Hour}
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	slots := l.Slots("s", "a", now)
+	if len(slots) != 2 || slots[0] == slots[1] {
+		t.Fatalf("slots = %v, want 2 distinct", slots)
+	}
+	if later := l.Slots("s", "a", now.Add(59*time.Minute)); !slices.Equal(later, slots) {
+		t.Fatalf("same window: slots = %v, want %v", later, slots)
+	}
+	if next := l.Slots("s", "a", now.Add(time.Hour)); next[0] == slots[0] {
+		t.Fatal("next window reuses the slots")
+	}
+	if other := l.Slots("s", "b", now); other[0] == slots[0] {
+		t.Fatal("another author shares the slots")
+	}
+	if (model.BoardRateLimit{}).Slots("s", "a", now) != nil {
+		t.Fatal("zero limit must be unlimited")
+	}
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// FavorTransferState is where a favor transfer is in its journal.
+type FavorTransferState string
+
+const (
+	// FavorTransferPending is recorded before either leg is applied.
+	FavorTransferPending FavorTransferState = "pending"
+	// FavorTransferDebited means the source has paid and the target not yet
+	// been credited.
+	FavorTransferDebited FavorTransferState = "debited"
+	// FavorTransferCompleted means both legs are applied.
+	FavorTransferCompleted FavorTransferState = "completed"
+	// FavorTransferReversed means the source was debited and then refunded
+	// because the target could not be credited.
+	FavorTransferReversed FavorTransferState = "reversed"
+	// FavorTransferFailed means the source was never debited.
+	FavorTransferFailed FavorTransferState = "failed"
+)
+
+// FavorTransfer is the journal entry of a favor transfer between settlements.
+// It is written before any balance moves so a half-applied transfer can be
+// finished or reversed after a crash.
+type FavorTransfer struct {
+	Id               string
+	FromSettlementId string
+	ToSettlementId   string
+	Amount           int64
+	ActorId          string
+	State            FavorTransferState
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// IsFinal reports whether the transfer needs no more work.
+func (t FavorTransfer) IsFinal() bool {
+	switch t.State {
+	case FavorTransferCompleted, FavorTransferReversed, FavorTransferFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarkDebited records that the source leg landed.
+func (t *FavorTransfer) MarkDebited() { t.State = FavorTransferDebited }
+
+// DebitKey is the op key of the source leg.
+func (t FavorTransfer) DebitKey() string { return "transfer:" + t.Id + ":debit" }
+
+// CreditKey is the op key of the target leg.
+func (t FavorTransfer) CreditKey() string { return "transfer:" + t.Id + ":credit" }
+
+// ReverseKey is the op key of the refund to the source.
+func (t FavorTransfer) ReverseKey() string { return "transfer:" + t.Id + ":reverse" }
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"errors"
+	"slices"
+	"time"
+)
 
 type ImperialFavorLog struct {
 	Id           string
@@ -8,5 +12,50 @@ type ImperialFavorLog struct {
 	AdminId      string
 	Amount       int64
 	Reason       string
-	CreatedAt    time.Time
+	// OpKey identifies the favor movement that produced the entry. A movement
+	// is applied at most once per key, so retrying it is safe.
+	OpKey     string
+	CreatedAt time.Time
+}
+
+// ApplyFavor moves the settlement's imperial favor by entry.Amount (negative
+// deducts) and queues entry in PendingFavorLogs, in the same write as the
+// balance change, until it is copied to the favor log. entry.OpKey is required.
+func (s *Settlement) ApplyFavor(entry ImperialFavorLog) error {
+	if entry.OpKey == "" {
+		return errors.New("favor movement needs an op key")
+	}
+	if entry.Amount == 0 {
+		return errors.New("favor movement cannot be zero")
+	}
+	if entry.Amount < 0 {
+		if err := s.DeductFavor(-entry.Amount); err != nil {
+			return err
+		}
+	} else {
+		s.AddFavor(entry.Amount)
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	entry.SettlementId = s.Id
+	s.PendingFavorLogs = append(s.PendingFavorLogs, entry)
+	return nil
+}
+
+// DropFavorLogs forgets the pending favor logs opKeys once they are copied to
+// the favor log.
+func (s *Settlement) DropFavorLogs(opKeys []string) {
+	s.PendingFavorLogs = slices.DeleteFunc(s.PendingFavorLogs, func(l ImperialFavorLog) bool {
+		return slices.Contains(opKeys, l.OpKey)
+	})
+}
+
+// HasPendingFavorLog reports whether the movement opKey is applied and not yet
+// copied to the favor log.
+func (s *Settlement) HasPendingFavorLog(opKey string) bool {
+	return slices.ContainsFunc(s.PendingFavorLogs, func(l ImperialFavorLog) bool {
+		return l.OpKey == opKey
+	})
 }
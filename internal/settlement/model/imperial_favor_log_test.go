@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func TestApplyFavorQueuesLogWithBalance(t *testing.T) {
+	s := &model.Settlement{Id: "s1", ImperialFavor: 10}
+
+	if err := s.ApplyFavor(model.ImperialFavorLog{OpKey: "a", Amount: -4}); err != nil {
+		t.Fatalf("ApplyFavor: %v", err)
+	}
+	if err := s.ApplyFavor(model.ImperialFavorLog{OpKey: "b", Amount: -7}); err == nil {
+		t.Fatal("ApplyFavor: got nil, want insufficient favor")
+	}
+
+	if s.ImperialFavor != 6 {
+		t.Fatalf("favor = %d, want 6", s.ImperialFavor)
+	}
+	if len(s.PendingFavorLogs) != 1 || !s.HasPendingFavorLog("a") || s.HasPendingFavorLog("b") {
+		t.Fatalf("pending favor logs = %+v, want only a", s.PendingFavorLogs)
+	}
+	if got := s.PendingFavorLogs[0]; got.SettlementId != "s1" || got.CreatedAt.IsZero() {
+		t.Fatalf("pending log = %+v, want settlement id and time set", got)
+	}
+}
+
+func TestApplyFavorRequiresOpKey(t *testing.T) {
+	s := &model.Settlement{}
+	if err := s.ApplyFavor(model.ImperialFavorLog{Amount: 1}); err == nil {
+		t.Fatal("ApplyFavor: got nil, want missing op key")
+	}
+	if s.ImperialFavor != 0 {
+		t.Fatalf("favor = %d, want 0", s.ImperialFavor)
+	}
+}
@@ -0,0 +1,188 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+// RequirementKind names one condition of a level-up.
+type RequirementKind string
+
+const (
+	RequirementMembers          RequirementKind = "members"
+	RequirementImperialFavor    RequirementKind = "imperial_favor"
+	RequirementAgeDays          RequirementKind = "age_days"
+	RequirementTalentNode       RequirementKind = "talent_node"
+	RequirementFeeImperialFavor RequirementKind = "fee_imperial_favor"
+	RequirementFeeCoins         RequirementKind = "fee_coins"
+)
+
+// TalentNodeRef points at a node of a progression talent tree.
+type TalentNodeRef struct {
+	TreeId string
+	NodeId string
+}
+
+// LevelUpRequirements are what a settlement must meet before it may be
+// promoted to Type. Zero fields are not required.
+type LevelUpRequirements struct {
+	Type SettlementType
+	// MinMembers counts the leader.
+	MinMembers int
+	// MinImperialFavor is checked before FeeImperialFavor is taken.
+	MinImperialFavor int64
+	// MinAgeDays counts from the settlement's first approval.
+	MinAgeDays    int
+	RequiredNodes []TalentNodeRef
+	// FeeImperialFavor is deducted from the settlement's imperial favor when
+	// the level-up is approved.
+	FeeImperialFavor int64
+	// FeeCoins is taken from the settlement treasury when the level-up is
+	// approved.
+	FeeCoins int64
+
+	UpdatedAt time.Time
+}
+
+// RequirementProgress is how far a settlement is toward one requirement.
+type RequirementProgress struct {
+	Kind RequirementKind
+	// Node is set for RequirementTalentNode.
+	Node     TalentNodeRef
+	Required int64
+	Current  int64
+	Met      bool
+}
+
+// Validate checks that the requirements can be stored.
+func (r LevelUpRequirements) Validate() error {
+	if _, ok := r.Type.Lower(); !ok {
+		return errors.New("requirements can only be set for a type a settlement levels up to")
+	}
+	if r.MinMembers < 0 || r.MinImperialFavor < 0 || r.MinAgeDays < 0 || r.FeeImperialFavor < 0 || r.FeeCoins < 0 {
+		return errors.New("requirements cannot be negative")
+	}
+	for _, n := range r.RequiredNodes {
+		if n.TreeId == "" || n.NodeId == "" {
+			return errors.New("required node needs both tree and node id")
+		}
+	}
+	return nil
+}
+
+// HasFee reports whether approving the level-up charges anything.
+func (r LevelUpRequirements) HasFee() bool {
+	return r.FeeImperialFavor > 0 || r.FeeCoins > 0
+}
+
+// Evaluate measures s against every configured requirement. nodes are the
+// settlement's purchased talent nodes keyed by tree id.
+func (r LevelUpRequirements) Evaluate(s *Settlement, nodes map[string][]string, now time.Time) []RequirementProgress {
+	var out []RequirementProgress
+	add := func(kind RequirementKind, required, current int64) {
+		if required > 0 {
+			out = append(out, RequirementProgress{Kind: kind, Required: required, Current: current, Met: current >= required})
+		}
+	}
+
+	add(RequirementMembers, int64(r.MinMembers), int64(s.MemberCount()))
+	add(RequirementImperialFavor, r.MinImperialFavor, s.ImperialFavor)
+	add(RequirementAgeDays, int64(r.MinAgeDays), int64(now.Sub(s.CreatedAt)/(24*time.Hour)))
+
+	for _, n := range r.RequiredNodes {
+		p := RequirementProgress{Kind: RequirementTalentNode, Node: n, Required: 1}
+		if slices.Contains(nodes[n.TreeId], n.NodeId) {
+			p.Current, p.Met = 1, true
+		}
+		out = append(out, p)
+	}
+
+	add(RequirementFeeImperialFavor, r.FeeImperialFavor, s.ImperialFavor)
+	add(RequirementFeeCoins, r.FeeCoins, s.Treasury)
+	return out
+}
+
+// UnmetRequirements returns the entries of progress that are not met.
+func UnmetRequirements(progress []RequirementProgress) []RequirementProgress {
+	var out []RequirementProgress
+	for _, p := range progress {
+		if !p.Met {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// MemberCount returns the number of members including the leader.
+func (s *Settlement) MemberCount() int {
+	return len(s.Members) + 1
+}
+
+// ChargeLevelUpFee takes the fee of r from imperial favor and the treasury.
+// Either both are taken or neither. favorLog describes the favor movement,
+// its amount set from r; the treasury entry takes its op key and reason.
+func (s *Settlement) ChargeLevelUpFee(r LevelUpRequirements, favorLog ImperialFavorLog) error {
+	if s.ImperialFavor < r.FeeImperialFavor {
+		return errors.New("insufficient imperial favor for the level-up fee")
+	}
+	if s.Treasury < r.FeeCoins {
+		return errors.New("insufficient treasury balance for the level-up fee")
+	}
+	if r.FeeCoins > 0 && favorLog.OpKey == "" {
+		return errMissingOpKey
+	}
+	if r.FeeImperialFavor > 0 {
+		favorLog.Amount = -r.FeeImperialFavor
+		if err := s.ApplyFavor(favorLog); err != nil {
+			return err
+		}
+	}
+	return s.applyLevelUpFeeCoins(-r.FeeCoins, favorLog)
+}
+
+// RefundLevelUpFee returns a fee taken by ChargeLevelUpFee. favorLog is used
+// as in ChargeLevelUpFee.
+func (s *Settlement) RefundLevelUpFee(r LevelUpRequirements, favorLog ImperialFavorLog) error {
+	if r.FeeCoins > 0 && favorLog.OpKey == "" {
+		return errMissingOpKey
+	}
+	if r.FeeImperialFavor > 0 {
+		favorLog.Amount = r.FeeImperialFavor
+		if err := s.ApplyFavor(favorLog); err != nil {
+			return err
+		}
+	}
+	return s.applyLevelUpFeeCoins(r.FeeCoins, favorLog)
+}
+
+// applyLevelUpFeeCoins moves the coin part of a level-up fee with a treasury
+// entry keyed and described like the favor log.
+func (s *Settlement) applyLevelUpFeeCoins(amount int64, favorLog ImperialFavorLog) error {
+	if amount == 0 {
+		return nil
+	}
+	return s.ApplyTreasury(TreasuryEntry{
+		ActorId: favorLog.AdminId,
+		Kind:    TreasurySpend,
+		Amount:  amount,
+		Reason:  favorLog.Reason,
+		OpKey:   favorLog.OpKey,
+	})
+}
+
+// Next returns the tier above t. False for a province, the highest tier.
+func (t SettlementType) Next() (SettlementType, bool) {
+	switch t {
+	case SettlementTypeCamp:
+		return SettlementTypeVillage, true
+	case SettlementTypeVillage:
+		return SettlementTypeTownship, true
+	case SettlementTypeTownship:
+		return SettlementTypeCity, true
+	case SettlementTypeCity:
+		return SettlementTypeProvince, true
+	default:
+		return t, false
+	}
+}
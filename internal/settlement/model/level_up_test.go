@@ -0,0 +1,127 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func TestLevelUpRequirementsEvaluate(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := &model.Settlement{
+		Leader:        model.Member{UserId: "leader"},
+		Members:       []model.Member{{UserId: "alice"}},
+		ImperialFavor: 50,
+		Treasury:      5,
+		CreatedAt:     now.Add(-10 * 24 * time.Hour),
+	}
+	r := model.LevelUpRequirements{
+		Type:             model.SettlementTypeTownship,
+		MinMembers:       2,
+		MinImperialFavor: 100,
+		MinAgeDays:       7,
+		RequiredNodes: []model.TalentNodeRef{
+			{TreeId: "t1", NodeId: "a"},
+			{TreeId: "t1", NodeId: "b"},
+		},
+		FeeCoins: 10,
+	}
+
+	progress := r.Evaluate(s, map[string][]string{"t1": {"a"}}, now)
+	if len(progress) != 6 {
+		t.Fatalf("got %d requirements, want 6: %+v", len(progress), progress)
+	}
+
+	unmet := model.UnmetRequirements(progress)
+	got := map[model.RequirementKind]int{}
+	for _, p := range unmet {
+		got[p.Kind]++
+	}
+	want := map[model.RequirementKind]int{
+		model.RequirementImperialFavor: 1,
+		model.RequirementTalentNode:    1,
+		model.RequirementFeeCoins:      1,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("unmet = %+v, want kinds %v", unmet, want)
+	}
+	for k, n := range want {
+		if got[k] != n {
+			t.Fatalf("unmet %s = %d, want %d", k, got[k], n)
+		}
+	}
+	for _, p := range unmet {
+		if p.Kind == model.RequirementTalentNode && p.Node.NodeId != "b" {
+			t.Fatalf("unmet node = %+v, want t1/b", p.Node)
+		}
+	}
+}
+
+func TestLevelUpRequirementsNoneConfigured(t *testing.T) {
+	r := model.LevelUpRequirements{Type: model.SettlementTypeVillage}
+	if progress := r.Evaluate(&model.Settlement{}, nil, time.Now()); len(progress) != 0 {
+		t.Fatalf("got %+v, want no requirements", progress)
+	}
+}
+
+func TestLevelUpRequirementsValidate(t *testing.T) {
+	cases := []struct {
+		name string
+		r    model.LevelUpRequirements
+		ok   bool
+	}{
+		{"village", model.LevelUpRequirements{Type: model.SettlementTypeVillage, MinMembers: 3}, true},
+		{"camp is never a target", model.LevelUpRequirements{Type: model.SettlementTypeCamp}, false},
+		{"negative fee", model.LevelUpRequirements{Type: model.SettlementTypeCity, FeeCoins: -1}, false},
+		{"incomplete node", model.LevelUpRequirements{Type: model.SettlementTypeCity, RequiredNodes: []model.TalentNodeRef{{TreeId: "t"}}}, false},
+	}
+	for _, tc := range cases {
+		if err := tc.r.Validate(); (err == nil) != tc.ok {
+			t.Errorf("%s: Validate() = %v, want ok=%v", tc.name, err, tc.ok)
+		}
+	}
+}
+
+func TestChargeLevelUpFeeIsAllOrNothing(t *testing.T) {
+	r := model.LevelUpRequirements{Type: model.SettlementTypeCity, FeeImperialFavor: 10, FeeCoins: 10}
+
+	s := &model.Settlement{ImperialFavor: 20, Treasury: 5}
+	if err := s.ChargeLevelUpFee(r, model.ImperialFavorLog{OpKey: "fee"}); err == nil {
+		t.Fatal("ChargeLevelUpFee: got nil, want insufficient treasury")
+	}
+	if s.ImperialFavor != 20 || s.Treasury != 5 {
+		t.Fatalf("failed charge changed balances: favor=%d treasury=%d", s.ImperialFavor, s.Treasury)
+	}
+
+	if err := s.DepositTreasury(10); err != nil {
+		t.Fatalf("DepositTreasury: %v", err)
+	}
+	if err := s.ChargeLevelUpFee(r, model.ImperialFavorLog{OpKey: "fee"}); err != nil {
+		t.Fatalf("ChargeLevelUpFee: %v", err)
+	}
+	if err := s.RefundLevelUpFee(r, model.ImperialFavorLog{OpKey: "refund"}); err != nil {
+		t.Fatalf("RefundLevelUpFee: %v", err)
+	}
+	if s.ImperialFavor != 20 || s.Treasury != 15 {
+		t.Fatalf("refund did not restore balances: favor=%d treasury=%d", s.ImperialFavor, s.Treasury)
+	}
+	if len(s.PendingFavorLogs) != 2 || s.PendingFavorLogs[0].Amount != -10 || s.PendingFavorLogs[1].Amount != 10 {
+		t.Fatalf("pending favor logs = %+v, want the fee and its refund", s.PendingFavorLogs)
+	}
+	if len(s.PendingTreasuryEntries) != 2 || s.PendingTreasuryEntries[0].Amount != -10 || s.PendingTreasuryEntries[1].Amount != 10 {
+		t.Fatalf("pending treasury entries = %+v, want the fee and its refund", s.PendingTreasuryEntries)
+	}
+}
+
+func TestVerificationLvlUpStopsAtProvince(t *testing.T) {
+	v := &model.SettlementVerification{Type: model.SettlementTypeCity}
+	v.LvlUp()
+	if v.Type != model.SettlementTypeProvince {
+		t.Fatalf("type = %s, want province", v.Type)
+	}
+	v.LvlUp()
+	if v.Type != model.SettlementTypeProvince {
+		t.Fatalf("type = %s, want province to stay", v.Type)
+	}
+}
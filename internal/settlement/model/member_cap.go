@@ -0,0 +1,20 @@
+package model
+
+// MemberCaps is the maximum number of members, leader included, per
+// settlement type. A missing or non-positive entry means unlimited.
+type MemberCaps map[SettlementType]int
+
+// For returns the cap of a settlement of type t whose talents add bonus
+// slots; zero means unlimited.
+func (c MemberCaps) For(t SettlementType, bonus int) int {
+	base := c[t]
+	if base <= 0 {
+		return 0
+	}
+	return base + bonus
+}
+
+// HasRoomFor reports whether one more member fits under memberCap.
+func (s *Settlement) HasRoomFor(memberCap int) bool {
+	return memberCap <= 0 || s.MemberCount() < memberCap
+}
@@ -0,0 +1,36 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func TestMemberCapsFor(t *testing.T) {
+	caps := model.MemberCaps{model.SettlementTypeCamp: 5, model.SettlementTypeVillage: 0}
+
+	if got := caps.For(model.SettlementTypeCamp, 2); got != 7 {
+		t.Fatalf("camp cap with bonus 2 = %d, want 7", got)
+	}
+	// Unlimited types stay unlimited whatever the bonus.
+	if got := caps.For(model.SettlementTypeVillage, 2); got != 0 {
+		t.Fatalf("village cap = %d, want 0 (unlimited)", got)
+	}
+	if got := caps.For(model.SettlementTypeCity, 2); got != 0 {
+		t.Fatalf("city cap = %d, want 0 (unlimited)", got)
+	}
+}
+
+func TestHasRoomForCountsLeader(t *testing.T) {
+	s := newSettlement() // leader + 2 members
+
+	if !s.HasRoomFor(4) {
+		t.Fatal("HasRoomFor(4) = false with 3 members")
+	}
+	if s.HasRoomFor(3) {
+		t.Fatal("HasRoomFor(3) = true with 3 members")
+	}
+	if !s.HasRoomFor(0) {
+		t.Fatal("HasRoomFor(0) = false, want unlimited")
+	}
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"errors"
+	"slices"
 	"time"
 	"unicode"
 	"unicode/utf8"
@@ -52,11 +53,53 @@ type Settlement struct {
 	Attachments   []Attachment
 	TagIds        []string
 	ImperialFavor int64
+	// PendingFavorLogs are favor movements applied to ImperialFavor and not
+	// yet copied to the favor log.
+	PendingFavorLogs []ImperialFavorLog
+	// Treasury is the settlement's shared balance of donate coins.
+	Treasury int64
+	// PendingTreasuryEntries are treasury movements applied to Treasury and
+	// not yet copied to the ledger.
+	PendingTreasuryEntries []TreasuryEntry
+	// PendingDeposits are deposits taken, or being taken, from a member's
+	// wallet and not yet credited to Treasury.
+	PendingDeposits []PendingDeposit
+	// PendingPayouts are withdrawals and spends taken from Treasury whose
+	// coins or item have not yet been confirmed paid out.
+	PendingPayouts []PendingPayout
+	// Treasurers are the members, besides the leader, allowed to spend Treasury.
+	Treasurers []string
+	// Heralds are the members, besides the leader, allowed to post on the board.
+	Heralds []string
+
+	// LastActivityAt is the latest time any member was seen online.
+	LastActivityAt time.Time
+	// InactiveSince is when the settlement was flagged inactive; zero while active.
+	InactiveSince time.Time
+	// Hidden settlements are left out of List.
+	Hidden bool
+	// ActivityExempt settlements are never flagged or decayed.
+	ActivityExempt bool
 
 	UpdatedAt time.Time
 	CreatedAt time.Time
 }
 
+// Clone returns a copy of s that shares none of its slices.
+func (s *Settlement) Clone() *Settlement {
+	out := *s
+	out.Members = slices.Clone(s.Members)
+	out.Attachments = slices.Clone(s.Attachments)
+	out.TagIds = slices.Clone(s.TagIds)
+	out.PendingFavorLogs = slices.Clone(s.PendingFavorLogs)
+	out.PendingTreasuryEntries = slices.Clone(s.PendingTreasuryEntries)
+	out.PendingDeposits = slices.Clone(s.PendingDeposits)
+	out.PendingPayouts = slices.Clone(s.PendingPayouts)
+	out.Treasurers = slices.Clone(s.Treasurers)
+	out.Heralds = slices.Clone(s.Heralds)
+	return &out
+}
+
 func (s *Settlement) AddFavor(amount int64) {
 	s.ImperialFavor += amount
 }
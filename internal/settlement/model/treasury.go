@@ -0,0 +1,284 @@
+package model
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	errNonPositiveAmount    = errors.New("amount must be positive")
+	errInsufficientTreasury = errors.New("insufficient treasury balance")
+	errNotMember            = errors.New("user is not a member of the settlement")
+	errAlreadyTreasurer     = errors.New("user is already a treasurer")
+	errNotTreasurer         = errors.New("user is not a treasurer")
+	errMissingOpKey         = errors.New("treasury movement needs an op key")
+)
+
+// TreasuryEntryKind names the movement a TreasuryEntry records.
+type TreasuryEntryKind string
+
+const (
+	// TreasuryDeposit is a member paying coins from their donate wallet in.
+	TreasuryDeposit TreasuryEntryKind = "deposit"
+	// TreasuryWithdrawal is coins paid out to a member's donate wallet.
+	TreasuryWithdrawal TreasuryEntryKind = "withdrawal"
+	// TreasurySpend is coins spent on a shop item for the settlement.
+	TreasurySpend TreasuryEntryKind = "spend"
+)
+
+// TreasuryEntry is one line of a settlement's treasury ledger. Amount is signed:
+// positive when coins came in, negative when they went out.
+type TreasuryEntry struct {
+	Id           string
+	SettlementId string
+	ActorId      string
+	Kind         TreasuryEntryKind
+	Amount       int64
+	BalanceAfter int64
+	Reason       string
+	// ItemId and PurchaseId are set for TreasurySpend.
+	ItemId     string
+	PurchaseId string
+	// OpKey identifies the movement that produced the entry. A movement is
+	// applied at most once per key, so retrying it is safe.
+	OpKey     string
+	CreatedAt time.Time
+}
+
+// PendingDeposit is a deposit whose coins are being taken from the member's
+// donate wallet under OpKey and are not yet in the treasury.
+type PendingDeposit struct {
+	OpKey     string
+	ActorId   string
+	Amount    int64
+	CreatedAt time.Time
+}
+
+// PendingPayout is a withdrawal or shop spend already taken from the treasury
+// whose coins or item are being paid out to RecipientId under OpKey.
+type PendingPayout struct {
+	OpKey       string
+	ActorId     string
+	RecipientId string
+	// Kind is TreasuryWithdrawal or TreasurySpend.
+	Kind TreasuryEntryKind
+	// Amount is the positive number of coins taken from the treasury.
+	Amount int64
+	Reason string
+	// ItemId is set for TreasurySpend.
+	ItemId    string
+	CreatedAt time.Time
+}
+
+// IsMember reports whether userID is the leader or one of the members.
+func (s *Settlement) IsMember(userID string) bool {
+	if s.Leader.UserId == userID {
+		return true
+	}
+	return slices.ContainsFunc(s.Members, func(m Member) bool { return m.UserId == userID })
+}
+
+// CanManageTreasury reports whether userID may withdraw or spend treasury coins:
+// the leader and the assigned treasurers.
+func (s *Settlement) CanManageTreasury(userID string) bool {
+	return s.Leader.UserId == userID || slices.Contains(s.Treasurers, userID)
+}
+
+// DepositTreasury adds amount to the treasury.
+func (s *Settlement) DepositTreasury(amount int64) error {
+	if amount <= 0 {
+		return errNonPositiveAmount
+	}
+	s.Treasury += amount
+	return nil
+}
+
+// WithdrawTreasury takes amount out of the treasury. Returns an error if the
+// balance is insufficient.
+func (s *Settlement) WithdrawTreasury(amount int64) error {
+	if amount <= 0 {
+		return errNonPositiveAmount
+	}
+	if s.Treasury < amount {
+		return errInsufficientTreasury
+	}
+	s.Treasury -= amount
+	return nil
+}
+
+// ApplyTreasury moves the treasury by entry.Amount (negative withdraws) and
+// queues entry in PendingTreasuryEntries, in the same write as the balance
+// change, until it is copied to the ledger. entry.OpKey is required.
+func (s *Settlement) ApplyTreasury(entry TreasuryEntry) error {
+	if entry.OpKey == "" {
+		return errMissingOpKey
+	}
+	var err error
+	if entry.Amount < 0 {
+		err = s.WithdrawTreasury(-entry.Amount)
+	} else {
+		err = s.DepositTreasury(entry.Amount)
+	}
+	if err != nil {
+		return err
+	}
+
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = time.Now()
+	}
+	entry.SettlementId = s.Id
+	entry.BalanceAfter = s.Treasury
+	s.PendingTreasuryEntries = append(s.PendingTreasuryEntries, entry)
+	return nil
+}
+
+// HasPendingTreasuryEntry reports whether the movement opKey is applied and
+// not yet copied to the ledger.
+func (s *Settlement) HasPendingTreasuryEntry(opKey string) bool {
+	return slices.ContainsFunc(s.PendingTreasuryEntries, func(e TreasuryEntry) bool {
+		return e.OpKey == opKey
+	})
+}
+
+// StartDeposit records that actorID is paying amount into the treasury under
+// opKey, before the coins leave their wallet.
+func (s *Settlement) StartDeposit(actorID string, amount int64, opKey string, now time.Time) error {
+	if opKey == "" {
+		return errMissingOpKey
+	}
+	if amount <= 0 {
+		return errNonPositiveAmount
+	}
+	if !s.IsMember(actorID) {
+		return errNotMember
+	}
+	s.PendingDeposits = append(s.PendingDeposits, PendingDeposit{
+		OpKey:     opKey,
+		ActorId:   actorID,
+		Amount:    amount,
+		CreatedAt: now,
+	})
+	return nil
+}
+
+// PendingDeposit returns the deposit started under opKey, if it is still
+// pending.
+func (s *Settlement) PendingDeposit(opKey string) (PendingDeposit, bool) {
+	i := slices.IndexFunc(s.PendingDeposits, func(d PendingDeposit) bool { return d.OpKey == opKey })
+	if i < 0 {
+		return PendingDeposit{}, false
+	}
+	return s.PendingDeposits[i], true
+}
+
+// CompleteDeposit credits the pending deposit opKey to the treasury, with its
+// ledger entry, and reports false if no such deposit is pending.
+func (s *Settlement) CompleteDeposit(opKey string) (bool, error) {
+	d, ok := s.PendingDeposit(opKey)
+	if !ok {
+		return false, nil
+	}
+	if err := s.ApplyTreasury(TreasuryEntry{
+		ActorId: d.ActorId,
+		Kind:    TreasuryDeposit,
+		Amount:  d.Amount,
+		OpKey:   opKey,
+	}); err != nil {
+		return false, err
+	}
+	s.DropDeposit(opKey)
+	return true, nil
+}
+
+// DropDeposit forgets the pending deposit opKey and reports whether it was
+// pending.
+func (s *Settlement) DropDeposit(opKey string) bool {
+	n := len(s.PendingDeposits)
+	s.PendingDeposits = slices.DeleteFunc(s.PendingDeposits, func(d PendingDeposit) bool { return d.OpKey == opKey })
+	return len(s.PendingDeposits) < n
+}
+
+// StartPayout takes p.Amount out of the treasury, with its ledger entry, and
+// records p as pending until its coins or item reach the recipient. A spend
+// of a free item moves no coins but is still recorded.
+func (s *Settlement) StartPayout(p PendingPayout, now time.Time) error {
+	if p.OpKey == "" {
+		return errMissingOpKey
+	}
+	if p.Amount < 0 || (p.Amount == 0 && p.Kind != TreasurySpend) {
+		return errNonPositiveAmount
+	}
+	if p.Amount > 0 {
+		if err := s.ApplyTreasury(TreasuryEntry{
+			ActorId:   p.ActorId,
+			Kind:      p.Kind,
+			Amount:    -p.Amount,
+			Reason:    p.Reason,
+			ItemId:    p.ItemId,
+			OpKey:     p.OpKey,
+			CreatedAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+	p.CreatedAt = now
+	s.PendingPayouts = append(s.PendingPayouts, p)
+	return nil
+}
+
+// FinishPayout forgets the pending payout opKey once it was paid and reports
+// whether it was pending.
+func (s *Settlement) FinishPayout(opKey string) bool {
+	n := len(s.PendingPayouts)
+	s.PendingPayouts = slices.DeleteFunc(s.PendingPayouts, func(p PendingPayout) bool { return p.OpKey == opKey })
+	return len(s.PendingPayouts) < n
+}
+
+// RefundPayout puts the coins of the pending payout opKey back into the
+// treasury, with a refund entry, and reports false if no such payout is
+// pending.
+func (s *Settlement) RefundPayout(opKey string) (bool, error) {
+	i := slices.IndexFunc(s.PendingPayouts, func(p PendingPayout) bool { return p.OpKey == opKey })
+	if i < 0 {
+		return false, nil
+	}
+	p := s.PendingPayouts[i]
+	if p.Amount > 0 {
+		if err := s.ApplyTreasury(TreasuryEntry{
+			ActorId: p.ActorId,
+			Kind:    p.Kind,
+			Amount:  p.Amount,
+			Reason:  "refund: " + p.Reason,
+			ItemId:  p.ItemId,
+			OpKey:   opKey + ":refund",
+		}); err != nil {
+			return false, err
+		}
+	}
+	s.FinishPayout(opKey)
+	return true, nil
+}
+
+// AssignTreasurer grants a member the treasurer role. The leader always has
+// treasury rights and cannot be assigned.
+func (s *Settlement) AssignTreasurer(userID string) error {
+	if userID == s.Leader.UserId || !s.IsMember(userID) {
+		return errNotMember
+	}
+	if slices.Contains(s.Treasurers, userID) {
+		return errAlreadyTreasurer
+	}
+	s.Treasurers = append(s.Treasurers, userID)
+	return nil
+}
+
+// RevokeTreasurer takes the treasurer role away from userID.
+func (s *Settlement) RevokeTreasurer(userID string) error {
+	i := slices.Index(s.Treasurers, userID)
+	if i < 0 {
+		return errNotTreasurer
+	}
+	s.Treasurers = slices.Delete(s.Treasurers, i, i+1)
+	return nil
+}
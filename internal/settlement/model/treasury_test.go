@@ -0,0 +1,106 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+func newSettlement() *model.Settlement {
+	return &model.Settlement{
+		Leader:  model.Member{UserId: "leader"},
+		Members: []model.Member{{UserId: "alice"}, {UserId: "bob"}},
+	}
+}
+
+func TestWithdrawTreasuryNeverGoesNegative(t *testing.T) {
+	s := newSettlement()
+	if err := s.DepositTreasury(10); err != nil {
+		t.Fatalf("DepositTreasury: %v", err)
+	}
+
+	if err := s.WithdrawTreasury(11); err == nil {
+		t.Fatal("WithdrawTreasury(11): got nil, want insufficient balance")
+	}
+	if err := s.WithdrawTreasury(10); err != nil {
+		t.Fatalf("WithdrawTreasury(10): %v", err)
+	}
+	if s.Treasury != 0 {
+		t.Fatalf("treasury = %d, want 0", s.Treasury)
+	}
+}
+
+func TestTreasuryRejectsNonPositiveAmounts(t *testing.T) {
+	s := newSettlement()
+	for _, amount := range []int64{0, -5} {
+		if err := s.DepositTreasury(amount); err == nil {
+			t.Fatalf("DepositTreasury(%d): got nil, want error", amount)
+		}
+		if err := s.WithdrawTreasury(amount); err == nil {
+			t.Fatalf("WithdrawTreasury(%d): got nil, want error", amount)
+		}
+	}
+}
+
+func TestRefundPayoutReturnsCoinsOnce(t *testing.T) {
+	s := newSettlement()
+	if err := s.DepositTreasury(10); err != nil {
+		t.Fatalf("DepositTreasury: %v", err)
+	}
+
+	p := model.PendingPayout{OpKey: "w1", Kind: model.TreasuryWithdrawal, Amount: 4}
+	if err := s.StartPayout(p, time.Now()); err != nil {
+		t.Fatalf("StartPayout: %v", err)
+	}
+	if s.Treasury != 6 || len(s.PendingPayouts) != 1 {
+		t.Fatalf("after start: treasury = %d, pending = %d, want 6 and 1", s.Treasury, len(s.PendingPayouts))
+	}
+
+	if ok, err := s.RefundPayout("w1"); err != nil || !ok {
+		t.Fatalf("RefundPayout: got %v, %v, want true, nil", ok, err)
+	}
+	if ok, _ := s.RefundPayout("w1"); ok {
+		t.Fatal("second RefundPayout: got true, want false")
+	}
+	if s.Treasury != 10 || len(s.PendingPayouts) != 0 {
+		t.Fatalf("after refund: treasury = %d, pending = %d, want 10 and 0", s.Treasury, len(s.PendingPayouts))
+	}
+}
+
+func TestTreasurerRole(t *testing.T) {
+	s := newSettlement()
+
+	if s.CanManageTreasury("alice") {
+		t.Fatal("alice can manage the treasury before being assigned")
+	}
+	if !s.CanManageTreasury("leader") {
+		t.Fatal("leader cannot manage the treasury")
+	}
+
+	if err := s.AssignTreasurer("alice"); err != nil {
+		t.Fatalf("AssignTreasurer(alice): %v", err)
+	}
+	if !s.CanManageTreasury("alice") {
+		t.Fatal("alice cannot manage the treasury after being assigned")
+	}
+	if err := s.AssignTreasurer("alice"); err == nil {
+		t.Fatal("AssignTreasurer(alice) twice: got nil, want error")
+	}
+	if err := s.AssignTreasurer("stranger"); err == nil {
+		t.Fatal("AssignTreasurer(stranger): got nil, want error for a non-member")
+	}
+	if err := s.AssignTreasurer("leader"); err == nil {
+		t.Fatal("AssignTreasurer(leader): got nil, want error")
+	}
+
+	if err := s.RevokeTreasurer("alice"); err != nil {
+		t.Fatalf("RevokeTreasurer(alice): %v", err)
+	}
+	if s.CanManageTreasury("alice") {
+		t.Fatal("alice can manage the treasury after being revoked")
+	}
+	if err := s.RevokeTreasurer("alice"); err == nil {
+		t.Fatal("RevokeTreasurer(alice) twice: got nil, want error")
+	}
+}
@@ -28,16 +28,5 @@ type SettlementVerification struct {
 }
 
 func (s *SettlementVerification) LvlUp() {
-	switch s.Type {
-	case SettlementTypeCamp:
-		s.Type = SettlementTypeVillage
-	case SettlementTypeVillage:
-		s.Type = SettlementTypeTownship
-	case SettlementTypeTownship:
-		s.Type = SettlementTypeCity
-	case SettlementTypeCity:
-		s.Type = SettlementTypeProvince
-	case SettlementTypeProvince:
-		break
-	}
+	s.Type, _ = s.Type.Next()
 }
@@ -3,17 +3,24 @@ package settlementuc
 import (
 	"context"
 
+	"github.com/lasthearth/vsservice/internal/settlement/internal/ierror"
+	"github.com/lasthearth/vsservice/internal/settlement/internal/service"
 	"github.com/lasthearth/vsservice/internal/settlement/model"
 )
 
+// ErrSettlementNotFound is returned when the settlement does not exist.
+var ErrSettlementNotFound = ierror.ErrNotFound
+
 type FavorRepository interface {
+	GetSettlement(ctx context.Context, id string) (*model.Settlement, error)
 	UpdateSettlement(
 		ctx context.Context,
 		id string,
 		updateFn func(ctx context.Context, s *model.Settlement) (*model.Settlement, error),
 	) (*model.Settlement, error)
 	IsLeaderOfSettlement(ctx context.Context, settlementID, userID string) error
-	CreateFavorLog(ctx context.Context, log model.ImperialFavorLog) error
+	FlushFavorLogs(ctx context.Context, settlementID string) error
+	HasFavorLog(ctx context.Context, opKey string) (bool, error)
 }
 
 type FavorOps struct {
@@ -24,22 +31,42 @@ func NewFavorOps(repo FavorRepository) *FavorOps {
 	return &FavorOps{repo: repo}
 }
 
-// Deduct removes amount from a settlement's imperial favor balance and records a log entry.
-func (f *FavorOps) Deduct(ctx context.Context, settlementID string, amount int64, reason, byPlayerID string) error {
-	_, err := f.repo.UpdateSettlement(ctx, settlementID,
-		func(_ context.Context, s *model.Settlement) (*model.Settlement, error) {
-			return s, s.DeductFavor(amount)
-		},
-	)
-	if err != nil {
+// Deduct removes amount from a settlement's imperial favor balance at most
+// once per opKey, so a caller that retries after a crash is never charged
+// twice. The log entry is written with the balance change and copied to the
+// favor log right away; if that copy fails the settlement's favor recovery
+// finishes it.
+func (f *FavorOps) Deduct(ctx context.Context, settlementID string, amount int64, reason, byPlayerID, opKey string) error {
+	return f.applyOnce(ctx, settlementID, model.ImperialFavorLog{
+		OpKey:   opKey,
+		AdminId: byPlayerID,
+		Amount:  -amount,
+		Reason:  reason,
+	})
+}
+
+// Credit adds amount to a settlement's imperial favor at most once per opKey,
+// so a caller that retries after a crash never pays twice.
+func (f *FavorOps) Credit(ctx context.Context, settlementID string, amount int64, reason, opKey string) error {
+	return f.applyOnce(ctx, settlementID, model.ImperialFavorLog{
+		OpKey:  opKey,
+		Amount: amount,
+		Reason: reason,
+	})
+}
+
+// Applied reports whether the favor movement opKey was applied to the
+// settlement.
+func (f *FavorOps) Applied(ctx context.Context, settlementID, opKey string) (bool, error) {
+	return service.FavorApplied(ctx, f.repo, settlementID, opKey)
+}
+
+func (f *FavorOps) applyOnce(ctx context.Context, settlementID string, entry model.ImperialFavorLog) error {
+	if _, err := service.ApplyFavor(ctx, f.repo, settlementID, entry); err != nil {
 		return err
 	}
-	_ = f.repo.CreateFavorLog(ctx, model.ImperialFavorLog{
-		SettlementId: settlementID,
-		AdminId:      byPlayerID,
-		Amount:       -amount,
-		Reason:       reason,
-	})
+	// Left pending on failure; the recovery worker flushes it.
+	_ = f.repo.FlushFavorLogs(ctx, settlementID)
 	return nil
 }
 
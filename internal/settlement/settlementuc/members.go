@@ -0,0 +1,35 @@
+package settlementuc
+
+import (
+	"context"
+
+	"github.com/lasthearth/vsservice/internal/settlement/model"
+)
+
+type MembersRepository interface {
+	GetSettlement(ctx context.Context, id string) (*model.Settlement, error)
+}
+
+// Members reads who belongs to a settlement for other domains.
+type Members struct {
+	repo MembersRepository
+}
+
+func NewMembers(repo MembersRepository) *Members {
+	return &Members{repo: repo}
+}
+
+// UserIds returns the user ids of the settlement's leader and members,
+// leader first. It returns ErrSettlementNotFound for an unknown settlement.
+func (m *Members) UserIds(ctx context.Context, settlementID string) ([]string, error) {
+	s, err := m.repo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(s.Members)+1)
+	ids = append(ids, s.Leader.UserId)
+	for _, member := range s.Members {
+		ids = append(ids, member.UserId)
+	}
+	return ids, nil
+}
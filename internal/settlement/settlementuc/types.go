@@ -0,0 +1,22 @@
+package settlementuc
+
+import "context"
+
+// Types reads settlement types for other domains.
+type Types struct {
+	repo MembersRepository
+}
+
+func NewTypes(repo MembersRepository) *Types {
+	return &Types{repo: repo}
+}
+
+// Type returns the settlement's type, e.g. "city". It returns
+// ErrSettlementNotFound for an unknown settlement.
+func (t *Types) Type(ctx context.Context, settlementID string) (string, error) {
+	s, err := t.repo.GetSettlement(ctx, settlementID)
+	if err != nil {
+		return "", err
+	}
+	return string(s.Type), nil
+}
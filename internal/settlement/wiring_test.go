@@ -0,0 +1,50 @@
+package settlement_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/eapache/go-resiliency/retrier"
+	settlementv1 "github.com/lasthearth/vsservice/gen/settlement/v1"
+	"github.com/lasthearth/vsservice/internal/donate/donateuc"
+	"github.com/lasthearth/vsservice/internal/notification/notificationuc"
+	"github.com/lasthearth/vsservice/internal/pkg/config"
+	"github.com/lasthearth/vsservice/internal/pkg/logger"
+	"github.com/lasthearth/vsservice/internal/pkg/mediaurl"
+	"github.com/lasthearth/vsservice/internal/player/playeruc"
+	"github.com/lasthearth/vsservice/internal/progression/progressionuc"
+	"github.com/lasthearth/vsservice/internal/settlement"
+	"github.com/lasthearth/vsservice/internal/settlement/settlementuc"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+// TestWiring pins that settlement's graph resolves against the use cases it
+// borrows from donate, player, notification and
+// progression.
+func TestWiring(t *testing.T) {
+	zc := zap.NewProductionConfig()
+	l, err := logger.New(&zc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = fx.ValidateApp(
+		fx.Supply(fx.Annotate(l, fx.As(new(logger.Logger)))),
+		fx.Supply(&mongo.Database{}, &mongo.Client{}, &http.Client{}, &retrier.Retrier{}, mediaurl.New(config.Config{})),
+		fx.Supply(&donateuc.AddCoinsUseCase{}, &donateuc.DebitUseCase{}, &donateuc.ShopUseCase{}),
+		fx.Supply(&playeruc.ActivityUseCase{}, &notificationuc.Create{}, &progressionuc.NodesUseCase{}, config.Config{}),
+		settlement.App,
+		fx.Invoke(func(
+			settlementv1.SettlementServiceServer,
+			*settlementuc.FavorOps,
+			*settlementuc.Members,
+			*settlementuc.Types,
+		) {
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
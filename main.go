@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/lasthearth/vsservice/internal/discord"
 	"github.com/lasthearth/vsservice/internal/donate"
+	"github.com/lasthearth/vsservice/internal/faction"
 	"github.com/lasthearth/vsservice/internal/hungergames"
 	"github.com/lasthearth/vsservice/internal/leaderboard"
 	"github.com/lasthearth/vsservice/internal/media"
@@ -91,6 +92,7 @@ func main() {
 		hungergames.App,
 		serverinfo.App,
 		media.App,
+		faction.App,
 		progression.App,
 		discord.App,
 	)